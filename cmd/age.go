@@ -0,0 +1,40 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	ageOlderThan string // --older-than で指定された期間
+	ageNewerThan string // --newer-than で指定された期間
+	ageBasis     string // --age-by で指定された判定に使用する日時
+)
+
+// addAgeFlags はリソースを作成日時・最終アクティビティ日時で絞り込むコマンドに --older-than / --newer-than / --age-by フラグを追加する
+func addAgeFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&ageOlderThan, "older-than", "", "指定した期間より前に作成（--age-by last-activity の場合は最終アクティビティ）されたものに絞り込み（例: 14d、2w、36h）")
+	cmd.Flags().StringVar(&ageNewerThan, "newer-than", "", "指定した期間以内に作成（--age-by last-activity の場合は最終アクティビティ）されたものに絞り込み（例: 1d、12h）")
+	cmd.Flags().StringVar(&ageBasis, "age-by", string(common.AgeByCreated), "--older-than / --newer-than の判定に使用する日時（created または last-activity）")
+}
+
+// ageFilter は --older-than / --newer-than / --age-by の指定値から絞り込み条件を作成する（期間が指定されていない場合は nil）
+func ageFilter() (*common.AgeFilter, error) {
+	f, err := common.NewAgeFilter(ageOlderThan, ageNewerThan, ageBasis)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+	}
+	return f, nil
+}
+
+// printAgeFilter は経過時間による絞り込み条件が指定されている場合に表示する
+func printAgeFilter(age *common.AgeFilter) {
+	if age.Enabled() {
+		logging.Infof(i18n.T("経過時間条件: %s\n"), age)
+	}
+}
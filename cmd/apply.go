@@ -0,0 +1,105 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	applysvc "github.com/ngsw877/awstk/internal/service/apply"
+	"github.com/ngsw877/awstk/internal/service/confirm"
+	"github.com/ngsw877/awstk/internal/service/plan"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/spf13/cobra"
+)
+
+var applySkipChanged bool
+
+// applyCmd represents the apply command
+var applyCmd = &cobra.Command{
+	Use:   "apply <plan-file>",
+	Short: "--plan-out で作成したプランを実行するコマンド",
+	Long: `破壊的コマンドの --plan-out で書き出したプランファイルを実行します。
+実行前に各リソースが存在し、プラン作成時から変更されていないことを再確認し、
+プランに記録されたリソースだけを削除します。
+削除の前に実行してよいかを確認します（--yes で省略）。
+プラン作成時とアカウントが異なる場合は実行せず、プロファイルが異なる場合は実行してよいかを確認します（--yes で省略）。
+
+例:
+  ` + AppName + ` cleanup all -s "test" --plan-out plan.json   # プランを作成（削除しない）
+  ` + AppName + ` apply plan.json                              # プランを実行
+  ` + AppName + ` apply plan.json --skip-changed               # 変更されたリソースを除いて実行`,
+	Args: cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		p, err := plan.Load(args[0])
+		if err != nil {
+			return fmt.Errorf("❌ %w", err)
+		}
+
+		// プラン作成時と異なるリージョンでの実行は防止する
+		if p.Region != "" && p.Region != region {
+			return fmt.Errorf(i18n.T("❌ プランのリージョン (%s) と実行リージョン (%s) が一致しません。-R %s を指定してください"), p.Region, region, p.Region)
+		}
+		// プラン作成時と異なるアカウントでの実行は防止し、異なるプロファイルでの実行は確認する
+		if p.Account != "" {
+			account := callerAccountId(cmd.Context(), awsCfg)
+			if account == "" {
+				return fmt.Errorf(i18n.T("❌ 実行中のアカウントIDを取得できないため、プラン作成時のアカウント (%s) と一致するか確認できません"), p.Account)
+			}
+			if account != p.Account {
+				return fmt.Errorf(i18n.T("❌ プラン作成時のアカウント (%s) と実行中のアカウント (%s) が一致しません"), p.Account, account)
+			}
+		}
+		currentProfile := profile
+		if currentProfile == "" {
+			currentProfile = os.Getenv("AWS_PROFILE")
+		}
+		if p.Profile != "" && currentProfile != "" && p.Profile != currentProfile {
+			ok, err := confirm.Ask(confirm.Request{
+				Message: fmt.Sprintf(i18n.T("⚠️  プラン作成時のプロファイル (%s) と異なるプロファイル (%s) で実行します"), p.Profile, currentProfile),
+				Prompt:  i18n.T("このプロファイルで実行しますか？"),
+			})
+			if err != nil {
+				return fmt.Errorf("❌ %w", err)
+			}
+			if !ok {
+				logging.Infof("%s\n", i18n.T("プランの実行をキャンセルしました"))
+				return nil
+			}
+		}
+
+		clients := applysvc.ClientSet{
+			S3Client:   s3.NewFromConfig(awsCfg, s3ClientOptions),
+			EcrClient:  ecr.NewFromConfig(awsCfg),
+			CfnClient:  cloudformation.NewFromConfig(awsCfg),
+			LogsClient: cloudwatchlogs.NewFromConfig(awsCfg),
+			IamClient:  awsiam.NewFromConfig(awsCfg),
+			ElbClient:  elasticloadbalancingv2.NewFromConfig(awsCfg),
+		}
+
+		results, err := applysvc.Run(cmd.Context(), clients, p, applysvc.Options{SkipChanged: applySkipChanged})
+		if results != nil {
+			printCleanupSummary(results)
+		}
+		if err != nil {
+			return fmt.Errorf(i18n.T("❌ プランの実行でエラー: %w"), err)
+		}
+		if results != nil {
+			logging.Infof("%s\n", i18n.T("✅ プランの実行が完了しました"))
+		}
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+func init() {
+	RootCmd.AddCommand(applyCmd)
+	applyCmd.Flags().BoolVar(&applySkipChanged, "skip-changed", false, "プラン作成後に変更・削除されたリソースをスキップして残りを実行")
+	addGuardrailFlag(applyCmd)
+}
@@ -1,10 +1,16 @@
 package cmd
 
 import (
-	"awstk/internal/service/aurora"
-	"awstk/internal/service/cfn"
+	"errors"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/aurora"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/selector"
+
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
@@ -34,34 +40,29 @@ var auroraStartCmd = &cobra.Command{
 	Use:   "start",
 	Short: "Aurora DBクラスターを起動するコマンド",
 	Long: `Aurora DBクラスターを起動します。
-CloudFormationスタック名を指定するか、クラスター名を直接指定することができます。
+CloudFormationスタック名またはクラスター名を指定するか、--tag でタグ条件に一致する停止中のクラスターをまとめて起動できます。
+いずれも指定しない場合は、クラスター一覧から対話的に選択できます。
 
 例:
   ` + AppName + ` aurora start -P my-profile -S my-stack
-  ` + AppName + ` aurora start -P my-profile -c my-cluster`,
+  ` + AppName + ` aurora start -P my-profile -c my-cluster
+  ` + AppName + ` aurora start -P my-profile --tag env=dev`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		resolveStackName()
-		clusterName, _ := cmd.Flags().GetString("cluster")
-		var err error
-
-		if stackName != "" {
-			clusterName, err = cfn.GetAuroraFromStack(cfnClient, stackName)
+		if hasTagFilters() {
+			ids, err := taggedAuroraClusterIds(cmd, "stopped")
 			if err != nil {
-				return fmt.Errorf("❌ CloudFormationスタックからクラスター名の取得に失敗: %w", err)
+				return err
 			}
-			fmt.Printf("✅ CloudFormationスタック '%s' からAuroraクラスター '%s' を検出しました\n", stackName, clusterName)
-		} else if clusterName == "" {
-			return fmt.Errorf("❌ エラー: Auroraクラスター名 (-c) またはスタック名 (-S) を指定してください")
+			return runForTagged(i18n.T("停止中のAurora DBクラスター"), ids, func(id string) error {
+				return startAuroraCluster(cmd, id)
+			})
 		}
 
-		fmt.Printf("🚀 Aurora DBクラスター (%s) を起動します...\n", clusterName)
-		err = aurora.StartAuroraCluster(rdsClient, clusterName)
+		clusterName, err := resolveAuroraClusterName(cmd)
 		if err != nil {
-			return fmt.Errorf("❌ Aurora DBクラスター起動エラー: %w", err)
+			return err
 		}
-
-		fmt.Printf("✅ Aurora DBクラスター (%s) の起動を開始しました\n", clusterName)
-		return nil
+		return startAuroraCluster(cmd, clusterName)
 	},
 	SilenceUsage: true,
 }
@@ -70,34 +71,29 @@ var auroraStopCmd = &cobra.Command{
 	Use:   "stop",
 	Short: "Aurora DBクラスターを停止するコマンド",
 	Long: `Aurora DBクラスターを停止します。
-CloudFormationスタック名を指定するか、クラスター名を直接指定することができます。
+CloudFormationスタック名またはクラスター名を指定するか、--tag でタグ条件に一致する起動中のクラスターをまとめて停止できます。
+いずれも指定しない場合は、クラスター一覧から対話的に選択できます。
 
 例:
   ` + AppName + ` aurora stop -P my-profile -S my-stack
-  ` + AppName + ` aurora stop -P my-profile -c my-cluster`,
+  ` + AppName + ` aurora stop -P my-profile -c my-cluster
+  ` + AppName + ` aurora stop -P my-profile --tag env=dev`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		resolveStackName()
-		clusterName, _ := cmd.Flags().GetString("cluster")
-		var err error
-
-		if stackName != "" {
-			clusterName, err = cfn.GetAuroraFromStack(cfnClient, stackName)
+		if hasTagFilters() {
+			ids, err := taggedAuroraClusterIds(cmd, "available")
 			if err != nil {
-				return fmt.Errorf("❌ CloudFormationスタックからクラスター名の取得に失敗: %w", err)
+				return err
 			}
-			fmt.Printf("✅ CloudFormationスタック '%s' からAuroraクラスター '%s' を検出しました\n", stackName, clusterName)
-		} else if clusterName == "" {
-			return fmt.Errorf("❌ エラー: Auroraクラスター名 (-c) またはスタック名 (-S) を指定してください")
+			return runForTagged(i18n.T("起動中のAurora DBクラスター"), ids, func(id string) error {
+				return stopAuroraCluster(cmd, id)
+			})
 		}
 
-		fmt.Printf("🛑 Aurora DBクラスター (%s) を停止します...\n", clusterName)
-		err = aurora.StopAuroraCluster(rdsClient, clusterName)
+		clusterName, err := resolveAuroraClusterName(cmd)
 		if err != nil {
-			return fmt.Errorf("❌ Aurora DBクラスター停止エラー: %w", err)
+			return err
 		}
-
-		fmt.Printf("✅ Aurora DBクラスター (%s) の停止を開始しました\n", clusterName)
-		return nil
+		return stopAuroraCluster(cmd, clusterName)
 	},
 	SilenceUsage: true,
 }
@@ -105,15 +101,103 @@ CloudFormationスタック名を指定するか、クラスター名を直接指
 var auroraLsCmd = &cobra.Command{
 	Use:   "ls",
 	Short: "Auroraクラスター一覧を表示するコマンド",
-	Long:  `Auroraクラスター一覧を表示します。`,
+	Long: `Auroraクラスター一覧を表示します。
+
+例:
+  ` + AppName + ` aurora ls
+  ` + AppName + ` aurora ls --regions all   # 有効な全リージョンを検索
+  ` + AppName + ` aurora ls --tag env=dev   # タグで絞り込み`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		if isMultiTarget() {
+			if err := validateMultiTargetStack(); err != nil {
+				return err
+			}
+			targets, err := resolveTargets(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return aurora.ListAuroraClustersAcross(cmd.Context(), targets, func(t common.Target) *rds.Client {
+				return rds.NewFromConfig(targetConfig(t))
+			}, tags)
+		}
 		resolveStackName()
 		// service層の統合関数を呼び出すだけ
-		return aurora.ListAuroraClusters(rdsClient, cfnClient, stackName)
+		return aurora.ListAuroraClusters(cmd.Context(), rdsClient, cfnClient, stackName, tags)
 	},
 	SilenceUsage: true,
 }
 
+// resolveAuroraClusterName は -S または -c の指定からAuroraクラスター名を解決する
+func resolveAuroraClusterName(cmd *cobra.Command) (string, error) {
+	resolveStackName()
+	clusterName, _ := cmd.Flags().GetString("cluster")
+
+	if stackName != "" {
+		clusterName, err := cfn.GetAuroraFromStack(cmd.Context(), cfnClient, stackName)
+		if err != nil {
+			return "", fmt.Errorf(i18n.T("❌ CloudFormationスタックからクラスター名の取得に失敗: %w"), err)
+		}
+		logging.Infof(i18n.T("✅ CloudFormationスタック '%s' からAuroraクラスター '%s' を検出しました\n"), stackName, clusterName)
+		return clusterName, nil
+	}
+	if clusterName != "" {
+		return clusterName, nil
+	}
+	// どちらも指定されていない場合は、端末であれば一覧から選択させる
+	if !selector.Available() {
+		return "", errors.New(i18n.T("❌ エラー: Auroraクラスター名 (-c) またはスタック名 (-S) を指定してください"))
+	}
+	return aurora.SelectAuroraCluster(cmd.Context(), rdsClient)
+}
+
+// startAuroraCluster はAurora DBクラスターを1つ起動する
+func startAuroraCluster(cmd *cobra.Command, clusterName string) error {
+	logging.Infof(i18n.T("🚀 Aurora DBクラスター (%s) を起動します...\n"), clusterName)
+	err := aurora.StartAuroraCluster(cmd.Context(), rdsClient, clusterName)
+	if err != nil {
+		return fmt.Errorf(i18n.T("❌ Aurora DBクラスター起動エラー: %w"), err)
+	}
+
+	logging.Infof(i18n.T("✅ Aurora DBクラスター (%s) の起動を開始しました\n"), clusterName)
+	return nil
+}
+
+// stopAuroraCluster はAurora DBクラスターを1つ停止する
+func stopAuroraCluster(cmd *cobra.Command, clusterName string) error {
+	logging.Infof(i18n.T("🛑 Aurora DBクラスター (%s) を停止します...\n"), clusterName)
+	err := aurora.StopAuroraCluster(cmd.Context(), rdsClient, clusterName)
+	if err != nil {
+		return fmt.Errorf(i18n.T("❌ Aurora DBクラスター停止エラー: %w"), err)
+	}
+
+	logging.Infof(i18n.T("✅ Aurora DBクラスター (%s) の停止を開始しました\n"), clusterName)
+	return nil
+}
+
+// taggedAuroraClusterIds はタグ条件に一致し、指定したステータスのAuroraクラスターのIDを返す
+func taggedAuroraClusterIds(cmd *cobra.Command, status string) ([]string, error) {
+	tags, err := tagSelector()
+	if err != nil {
+		return nil, err
+	}
+	clusters, err := aurora.FindAuroraClustersByTags(cmd.Context(), rdsClient, tags)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("❌ Auroraクラスター一覧の取得に失敗: %w"), err)
+	}
+
+	var ids []string
+	for _, c := range clusters {
+		if c.Status == status {
+			ids = append(ids, c.ClusterId)
+		}
+	}
+	return ids, nil
+}
+
 var auroraAcuCmd = &cobra.Command{
 	Use:   "acu",
 	Short: "Aurora Serverless v2のAcu使用状況を表示",
@@ -132,44 +216,35 @@ var auroraAcuCmd = &cobra.Command{
 
 		if showAll {
 			// 全Serverless v2クラスターのAcu情報を表示
-			capacityInfos, err := aurora.ListAuroraCapacityInfo(rdsClient, cwClient)
+			capacityInfos, err := aurora.ListAuroraCapacityInfo(cmd.Context(), rdsClient, cwClient)
 			if err != nil {
-				return fmt.Errorf("❌ Acu情報取得でエラー: %w", err)
+				return fmt.Errorf(i18n.T("❌ Acu情報取得でエラー: %w"), err)
 			}
 
-			if len(capacityInfos) == 0 {
-				fmt.Println("Aurora Serverless v2クラスターが見つかりませんでした")
-				return nil
-			}
-
-			fmt.Printf("Aurora Serverless v2 Acu使用状況: (全%d件)\n\n", len(capacityInfos))
-			for _, info := range capacityInfos {
-				aurora.DisplayCapacityInfo(&info)
-				fmt.Println()
-			}
+			aurora.DisplayCapacityInfoList(capacityInfos)
 			return nil
 		}
 
 		// 単一クラスターの処理
 		if stackName != "" {
 			var err error
-			clusterName, err = cfn.GetAuroraFromStack(cfnClient, stackName)
+			clusterName, err = cfn.GetAuroraFromStack(cmd.Context(), cfnClient, stackName)
 			if err != nil {
-				return fmt.Errorf("❌ CloudFormationスタックからクラスター名の取得に失敗: %w", err)
+				return fmt.Errorf(i18n.T("❌ CloudFormationスタックからクラスター名の取得に失敗: %w"), err)
 			}
-			fmt.Printf("✅ CloudFormationスタック '%s' からAuroraクラスター '%s' を検出しました\n\n", stackName, clusterName)
+			logging.Infof(i18n.T("✅ CloudFormationスタック '%s' からAuroraクラスター '%s' を検出しました\n\n"), stackName, clusterName)
 		} else if clusterName == "" {
-			return fmt.Errorf("❌ エラー: Auroraクラスター名 (-c) またはスタック名 (-S) を指定してください")
+			return errors.New(i18n.T("❌ エラー: Auroraクラスター名 (-c) またはスタック名 (-S) を指定してください"))
 		}
 
 		// Acu情報を取得
-		info, err := aurora.GetAuroraCapacityInfo(rdsClient, cwClient, clusterName)
+		info, err := aurora.GetAuroraCapacityInfo(cmd.Context(), rdsClient, cwClient, clusterName)
 		if err != nil {
-			return fmt.Errorf("❌ ACU情報取得でエラー: %w", err)
+			return fmt.Errorf(i18n.T("❌ ACU情報取得でエラー: %w"), err)
 		}
 
 		if !info.IsServerless {
-			fmt.Printf("ℹ️ クラスター '%s' はServerless v2ではありません\n", clusterName)
+			common.PrintEmptyResult(fmt.Sprintf(i18n.T("ℹ️ クラスター '%s' はServerless v2ではありません"), clusterName))
 			return nil
 		}
 
@@ -189,13 +264,17 @@ func init() {
 	// フラグの追加
 	auroraStartCmd.Flags().StringP("cluster", "c", "", "Aurora DBクラスター名")
 	auroraStartCmd.Flags().StringVarP(&stackName, "stack-name", "S", "", "CloudFormationスタック名")
-	// stack と cluster は同時指定不可
-	auroraStartCmd.MarkFlagsMutuallyExclusive("stack-name", "cluster")
+	addTagFlag(auroraStartCmd)
+	// stack と cluster と tag は同時指定不可
+	auroraStartCmd.MarkFlagsMutuallyExclusive("stack-name", "cluster", "tag")
 	auroraStopCmd.Flags().StringP("cluster", "c", "", "Aurora DBクラスター名")
 	auroraStopCmd.Flags().StringVarP(&stackName, "stack-name", "S", "", "CloudFormationスタック名")
-	// stack と cluster は同時指定不可
-	auroraStopCmd.MarkFlagsMutuallyExclusive("stack-name", "cluster")
+	addTagFlag(auroraStopCmd)
+	// stack と cluster と tag は同時指定不可
+	auroraStopCmd.MarkFlagsMutuallyExclusive("stack-name", "cluster", "tag")
 	auroraLsCmd.Flags().StringVarP(&stackName, "stack-name", "S", "", "CloudFormationスタック名")
+	addTargetFlags(auroraLsCmd)
+	addTagFlag(auroraLsCmd)
 	auroraAcuCmd.Flags().StringP("cluster", "c", "", "Aurora DBクラスター名")
 	auroraAcuCmd.Flags().StringVarP(&stackName, "stack-name", "S", "", "CloudFormationスタック名")
 	auroraAcuCmd.Flags().BoolP("all", "a", false, "全てのServerless v2クラスターを表示")
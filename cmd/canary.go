@@ -1,8 +1,11 @@
 package cmd
 
 import (
-	"awstk/internal/service/canary"
-	"fmt"
+	"errors"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/canary"
+	"github.com/ngsw877/awstk/internal/service/common"
 
 	"github.com/aws/aws-sdk-go-v2/service/synthetics"
 	"github.com/spf13/cobra"
@@ -13,7 +16,6 @@ var (
 	canarySearch     string
 	canarySearches   []string
 	canaryAll        bool
-	canaryYes        bool
 	canaryDryRun     bool
 	syntheticsClient *synthetics.Client
 )
@@ -45,9 +47,27 @@ var CanaryCmd = &cobra.Command{
 var canaryLsCmd = &cobra.Command{
 	Use:   "ls",
 	Short: "Canary一覧を表示するコマンド",
-	Long:  `AWS Synthetics Canaryの一覧を表示します。`,
+	Long: `AWS Synthetics Canaryの一覧を表示します。
+
+例:
+  ` + AppName + ` canary ls
+  ` + AppName + ` canary ls --regions all   # 有効な全リージョンを検索
+  ` + AppName + ` canary ls --tag env=dev   # タグで絞り込み`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return canary.ListCanaries(syntheticsClient)
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		if isMultiTarget() {
+			targets, err := resolveTargets(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return canary.ListCanariesAcross(cmd.Context(), targets, func(t common.Target) *synthetics.Client {
+				return synthetics.NewFromConfig(targetConfig(t))
+			}, tags)
+		}
+		return canary.ListCanaries(cmd.Context(), syntheticsClient, tags)
 	},
 	SilenceUsage: true,
 }
@@ -59,15 +79,15 @@ var canaryEnableCmd = &cobra.Command{
     --name, --search, --all のいずれかを指定してください。`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if canaryAll {
-			return canary.EnableAllCanaries(syntheticsClient, canaryYes)
+			return canary.EnableAllCanaries(cmd.Context(), syntheticsClient)
 		}
 		if canarySearch != "" {
-			return canary.EnableCanariesByFilter(syntheticsClient, canarySearch, canaryYes)
+			return canary.EnableCanariesByFilter(cmd.Context(), syntheticsClient, canarySearch)
 		}
 		if canaryName != "" {
-			return canary.EnableCanaryByName(syntheticsClient, canaryName)
+			return canary.EnableCanaryByName(cmd.Context(), syntheticsClient, canaryName)
 		}
-		return fmt.Errorf("オプションが指定されていません")
+		return errors.New(i18n.T("オプションが指定されていません"))
 	},
 	SilenceUsage: true,
 }
@@ -79,15 +99,15 @@ var canaryDisableCmd = &cobra.Command{
     --name, --search, --all のいずれかを指定してください。`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if canaryAll {
-			return canary.DisableAllCanaries(syntheticsClient, canaryYes)
+			return canary.DisableAllCanaries(cmd.Context(), syntheticsClient)
 		}
 		if canarySearch != "" {
-			return canary.DisableCanariesByFilter(syntheticsClient, canarySearch, canaryYes)
+			return canary.DisableCanariesByFilter(cmd.Context(), syntheticsClient, canarySearch)
 		}
 		if canaryName != "" {
-			return canary.DisableCanaryByName(syntheticsClient, canaryName)
+			return canary.DisableCanaryByName(cmd.Context(), syntheticsClient, canaryName)
 		}
-		return fmt.Errorf("オプションが指定されていません")
+		return errors.New(i18n.T("オプションが指定されていません"))
 	},
 	SilenceUsage: true,
 }
@@ -100,14 +120,14 @@ var canaryRunCmd = &cobra.Command{
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if canaryName != "" {
 			if canaryDryRun {
-				return canary.RunCanaryDryRun(syntheticsClient, canaryName)
+				return canary.RunCanaryDryRun(cmd.Context(), syntheticsClient, canaryName)
 			}
-			return canary.RunCanary(syntheticsClient, canaryName)
+			return canary.RunCanary(cmd.Context(), syntheticsClient, canaryName)
 		}
 		if len(canarySearches) > 0 {
-			return canary.RunCanariesByFilter(syntheticsClient, canarySearches, canaryDryRun, canaryYes)
+			return canary.RunCanariesByFilter(cmd.Context(), syntheticsClient, canarySearches, canaryDryRun)
 		}
-		return fmt.Errorf("--name または --search のいずれかを指定してください")
+		return errors.New(i18n.T("--name または --search のいずれかを指定してください"))
 	},
 	SilenceUsage: true,
 }
@@ -115,6 +135,8 @@ var canaryRunCmd = &cobra.Command{
 func init() {
 	RootCmd.AddCommand(CanaryCmd)
 	CanaryCmd.AddCommand(canaryLsCmd)
+	addTargetFlags(canaryLsCmd)
+	addTagFlag(canaryLsCmd)
 	CanaryCmd.AddCommand(canaryEnableCmd)
 	CanaryCmd.AddCommand(canaryDisableCmd)
 	CanaryCmd.AddCommand(canaryRunCmd)
@@ -124,7 +146,6 @@ func init() {
 		cmd.Flags().StringVarP(&canaryName, "name", "n", "", "Canary名")
 		cmd.Flags().StringVarP(&canarySearch, "search", "s", "", "名前パターン（ワイルドカード対応）")
 		cmd.Flags().BoolVarP(&canaryAll, "all", "a", false, "全てのCanaryを対象")
-		cmd.Flags().BoolVarP(&canaryYes, "yes", "y", false, "確認なしで実行")
 		// --name / --search / --all は相互排他かついずれか必須
 		cmd.MarkFlagsMutuallyExclusive("name", "search", "all")
 		cmd.MarkFlagsOneRequired("name", "search", "all")
@@ -134,7 +155,6 @@ func init() {
 	canaryRunCmd.Flags().StringVarP(&canaryName, "name", "n", "", "Canary名")
 	canaryRunCmd.Flags().StringSliceVarP(&canarySearches, "search", "s", []string{}, "名前パターン（複数指定可能、ワイルドカード対応）")
 	canaryRunCmd.Flags().BoolVarP(&canaryDryRun, "dry-run", "d", false, "ドライラン実行")
-	canaryRunCmd.Flags().BoolVarP(&canaryYes, "yes", "y", false, "確認なしで実行")
 	// --name と --search は相互排他かついずれか必須
 	canaryRunCmd.MarkFlagsMutuallyExclusive("name", "search")
 	canaryRunCmd.MarkFlagsOneRequired("name", "search")
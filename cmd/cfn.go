@@ -1,12 +1,16 @@
 package cmd
 
 import (
-	"awstk/internal/aws"
-	"awstk/internal/service/cfn"
-	"awstk/internal/service/common"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	"github.com/ngsw877/awstk/internal/service/cleanup"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
@@ -26,17 +30,41 @@ var showAll bool
 var cfnLsCmd = &cobra.Command{
 	Use:   "ls",
 	Short: "CloudFormationスタック一覧を表示するコマンド",
-	Long:  `CloudFormationスタック一覧を表示します。`,
+	Long: `CloudFormationスタック一覧を表示します。
+
+例:
+  ` + AppName + ` cfn ls
+  ` + AppName + ` cfn ls --regions all   # 有効な全リージョンを検索
+  ` + AppName + ` cfn ls --tag env=dev   # タグで絞り込み`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+
+		if isMultiTarget() {
+			targets, err := resolveTargets(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return cfn.ListCfnStacksAcross(cmd.Context(), targets, func(t common.Target) cfn.CfnApi {
+				return cloudformation.NewFromConfig(targetConfig(t))
+			}, showAll, tags)
+		}
+
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
 
-		stacks, err := cfn.ListCfnStacks(cfnClient, showAll)
+		stacks, err := cfn.ListCfnStacks(cmd.Context(), cfnClient, showAll)
 		if err != nil {
-			return common.FormatListError("CloudFormationスタック", err)
+			return common.FormatListError(i18n.T("CloudFormationスタック"), err)
+		}
+		stacks, err = cfn.SelectStacksByTags(cmd.Context(), tags, common.Target{}, stacks)
+		if err != nil {
+			return common.FormatListError(i18n.T("CloudFormationスタック"), err)
 		}
 
 		if len(stacks) == 0 {
-			fmt.Println(common.FormatEmptyMessage("CloudFormationスタック"))
+			common.PrintEmptyResult(common.FormatEmptyMessage(i18n.T("CloudFormationスタック")))
 			return nil
 		}
 
@@ -48,7 +76,7 @@ var cfnLsCmd = &cobra.Command{
 				Status: stk.Status,
 			}
 		}
-		common.PrintStatusList("CloudFormationスタック一覧", items, "スタック")
+		common.PrintStatusList(i18n.T("CloudFormationスタック一覧"), items, i18n.T("スタック"))
 
 		return nil
 	},
@@ -60,29 +88,29 @@ var cfnStartCmd = &cobra.Command{
 	Short: "CloudFormationスタック内のリソースを一括起動するコマンド",
 	Long: `CloudFormationスタック内の起動・停止可能なリソースを一括起動します。
 対象リソース: EC2インスタンス、RDSインスタンス、Aurora DBクラスター、ECSサービス
+--tag を指定すると、タグ条件に一致するスタックのリソースをまとめて起動できます。
+スタック名と --tag のいずれも指定しない場合は、スタック一覧から対話的に選択できます。
 
 例:
-  ` + AppName + ` cfn start -S my-stack -P my-profile`,
+  ` + AppName + ` cfn start -S my-stack -P my-profile
+  ` + AppName + ` cfn start --tag env=dev -P my-profile`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		resolveStackName()
-		if stackName == "" {
-			return fmt.Errorf("❌ エラー: スタック名 (-S) を指定してください")
+		if hasTagFilters() {
+			names, err := taggedStackNames(cmd)
+			if err != nil {
+				return err
+			}
+			return runForTagged(i18n.T("スタック"), names, func(name string) error {
+				return startStackResources(cmd, name)
+			})
 		}
 
-		printAwsContextWithInfo("Stack", stackName)
-
-		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		ec2Client := ec2.NewFromConfig(awsCfg)
-		rdsClient := rds.NewFromConfig(awsCfg)
-		aasClient := applicationautoscaling.NewFromConfig(awsCfg)
-
-		err := cfn.StartAllStackResources(cfnClient, ec2Client, rdsClient, aasClient, stackName)
-		if err != nil {
-			return fmt.Errorf("❌ リソース起動処理でエラー: %w", err)
+		if err := requireStackName(cmd); err != nil {
+			return err
 		}
 
-		fmt.Println("✅ リソース起動処理が完了しました")
-		return nil
+		printAwsContextWithInfo("Stack", stackName)
+		return startStackResources(cmd, stackName)
 	},
 	SilenceUsage: true,
 }
@@ -92,33 +120,88 @@ var cfnStopCmd = &cobra.Command{
 	Short: "CloudFormationスタック内のリソースを一括停止するコマンド",
 	Long: `CloudFormationスタック内の起動・停止可能なリソースを一括停止します。
 対象リソース: EC2インスタンス、RDSインスタンス、Aurora DBクラスター、ECSサービス
+--tag を指定すると、タグ条件に一致するスタックのリソースをまとめて停止できます。
+スタック名と --tag のいずれも指定しない場合は、スタック一覧から対話的に選択できます。
 
 例:
-  ` + AppName + ` cfn stop -S my-stack -P my-profile`,
+  ` + AppName + ` cfn stop -S my-stack -P my-profile
+  ` + AppName + ` cfn stop --tag env=dev -P my-profile`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		resolveStackName()
-		if stackName == "" {
-			return fmt.Errorf("❌ エラー: スタック名 (-S) を指定してください")
+		if hasTagFilters() {
+			names, err := taggedStackNames(cmd)
+			if err != nil {
+				return err
+			}
+			return runForTagged(i18n.T("スタック"), names, func(name string) error {
+				return stopStackResources(cmd, name)
+			})
 		}
 
-		printAwsContextWithInfo("Stack", stackName)
-
-		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		ec2Client := ec2.NewFromConfig(awsCfg)
-		rdsClient := rds.NewFromConfig(awsCfg)
-		aasClient := applicationautoscaling.NewFromConfig(awsCfg)
-
-		err := cfn.StopAllStackResources(cfnClient, ec2Client, rdsClient, aasClient, stackName)
-		if err != nil {
-			return fmt.Errorf("❌ リソース停止処理でエラー: %w", err)
+		if err := requireStackName(cmd); err != nil {
+			return err
 		}
 
-		fmt.Println("✅ リソース停止処理が完了しました")
-		return nil
+		printAwsContextWithInfo("Stack", stackName)
+		return stopStackResources(cmd, stackName)
 	},
 	SilenceUsage: true,
 }
 
+// startStackResources は1つのスタック内のリソースを一括起動する
+func startStackResources(cmd *cobra.Command, name string) error {
+	cfnClient := cloudformation.NewFromConfig(awsCfg)
+	ec2Client := ec2.NewFromConfig(awsCfg)
+	rdsClient := rds.NewFromConfig(awsCfg)
+	aasClient := applicationautoscaling.NewFromConfig(awsCfg)
+
+	_, err := cfn.StartAllStackResources(cmd.Context(), cfnClient, ec2Client, rdsClient, aasClient, name)
+	if err != nil {
+		return fmt.Errorf(i18n.T("❌ リソース起動処理でエラー: %w"), err)
+	}
+
+	logging.Infof("%s\n", i18n.T("✅ リソース起動処理が完了しました"))
+	return nil
+}
+
+// stopStackResources は1つのスタック内のリソースを一括停止する
+func stopStackResources(cmd *cobra.Command, name string) error {
+	cfnClient := cloudformation.NewFromConfig(awsCfg)
+	ec2Client := ec2.NewFromConfig(awsCfg)
+	rdsClient := rds.NewFromConfig(awsCfg)
+	aasClient := applicationautoscaling.NewFromConfig(awsCfg)
+
+	_, err := cfn.StopAllStackResources(cmd.Context(), cfnClient, ec2Client, rdsClient, aasClient, name)
+	if err != nil {
+		return fmt.Errorf(i18n.T("❌ リソース停止処理でエラー: %w"), err)
+	}
+
+	logging.Infof("%s\n", i18n.T("✅ リソース停止処理が完了しました"))
+	return nil
+}
+
+// taggedStackNames はタグ条件に一致する稼働中のスタック名を返す
+func taggedStackNames(cmd *cobra.Command) ([]string, error) {
+	tags, err := tagSelector()
+	if err != nil {
+		return nil, err
+	}
+	cfnClient := cloudformation.NewFromConfig(awsCfg)
+	stacks, err := cfn.ListCfnStacks(cmd.Context(), cfnClient, false)
+	if err != nil {
+		return nil, common.FormatListError(i18n.T("CloudFormationスタック"), err)
+	}
+	stacks, err = cfn.SelectStacksByTags(cmd.Context(), tags, common.Target{}, stacks)
+	if err != nil {
+		return nil, common.FormatListError(i18n.T("CloudFormationスタック"), err)
+	}
+
+	names := make([]string, len(stacks))
+	for i, stk := range stacks {
+		names[i] = stk.Name
+	}
+	return names, nil
+}
+
 var (
 	cleanupFilter string
 	cleanupStatus string
@@ -130,7 +213,9 @@ var cfnCleanupCmd = &cobra.Command{
 	Use:   "cleanup",
 	Short: "CloudFormationスタックを一括削除するコマンド",
 	Long: `指定した条件に一致するCloudFormationスタックを一括削除します。
-フィルターによる名前の部分一致検索、またはステータスによる絞り込みが可能です。
+フィルターによる名前の部分一致検索、ステータスやタグによる絞り込みが可能です。
+--older-than / --newer-than を指定すると、作成日時（--age-by last-activity の場合は最終更新日時）でも絞り込みます。
+削除前に、スタック名（複数のスタックの場合はアカウントエイリアスまたはアカウントID）の入力で確認します（--force または --yes で省略）。
 
 例:
   # 名前に "test-" を含むスタックを削除
@@ -142,21 +227,54 @@ var cfnCleanupCmd = &cobra.Command{
   # 両方の条件を組み合わせ
   ` + AppName + ` cfn cleanup --filter dev- --status CREATE_FAILED
 
+  # タグで絞り込み（名前・ステータスの条件と組み合わせ可能）
+  ` + AppName + ` cfn cleanup --tag env=dev --tag '!keep'
+
+  # 14日以上前に作成されたテスト用スタックを削除
+  ` + AppName + ` cfn cleanup --filter test- --older-than 14d
+
+  # 30日以上更新されていないスタックを削除
+  ` + AppName + ` cfn cleanup --filter dev- --older-than 30d --age-by last-activity
+
   # 確認プロンプトをスキップ
-  ` + AppName + ` cfn cleanup --filter test- --force`,
+  ` + AppName + ` cfn cleanup --filter test- --force
+
+  # 削除せずにプランを作成（` + AppName + ` apply で実行）
+  ` + AppName + ` cfn cleanup --filter test- --plan-out plan.json`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		age, err := ageFilter()
+		if err != nil {
+			return err
+		}
+
 		printAwsContext()
+		printAgeFilter(age)
 
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
 
-		err := cfn.CleanupStacks(cfnClient, cfn.CleanupOptions{
+		p := newPlanIfRequested()
+		opts := cfn.CleanupOptions{
 			Filter: cleanupFilter,
 			Status: cleanupStatus,
 			Force:  cleanupForce,
 			Exact:  cleanupExact,
-		})
+			Tags:   tags,
+			Age:    age,
+			Plan:   p,
+		}
+		if p == nil && !cleanupForce {
+			opts.AccountName = confirmAccountName(cmd.Context(), awsCfg)
+		}
+		err = cfn.CleanupStacks(cmd.Context(), cfnClient, opts)
 		if err != nil {
-			return fmt.Errorf("❌ スタック削除処理でエラー: %w", err)
+			return fmt.Errorf(i18n.T("❌ スタック削除処理でエラー: %w"), err)
+		}
+		if p != nil {
+			return writePlan(cmd.Context(), p)
 		}
 
 		return nil
@@ -164,6 +282,83 @@ var cfnCleanupCmd = &cobra.Command{
 	SilenceUsage: true,
 }
 
+var cfnOrphansCmd = &cobra.Command{
+	Use:   "orphans",
+	Short: "削除済みスタックに残されたリソースを削除するコマンド",
+	Long: `削除済み（DELETE_COMPLETE）のCloudFormationスタックから、DeletionPolicy: Retain 等により削除されずに残されたリソース（DELETE_SKIPPED）を検出し、
+現在も存在するものを ` + AppName + ` cleanup all と同じ手順で削除します。
+削除済みスタックの履歴は削除から90日間のみ参照できるため、それより前に削除されたスタックは対象外です。
+cleanup all の対象にできる種類のうち対象にした種類（既定は s3・ecr・logs。--types で指定）のみ削除し、それ以外の種類は一覧に表示するのみです。
+削除前に対象の一覧を表示し、アカウントエイリアス（未設定の場合はアカウントID）の入力で確認します（--yes で省略）。
+--report を指定すると、cleanup all と同様にリソースごとの削除結果をJSON・Markdown・JUnit XMLで書き出します。
+削除に失敗したリソース、または参照元を削除できず未実行のリソースがある場合は、サマリーとレポートを出力したうえでエラー（終了コード1）で終了します。
+
+例:
+  # すべての削除済みスタックに残されたリソースを削除
+  ` + AppName + ` cfn orphans
+
+  # 名前に "test-" を含む削除済みスタックに残されたリソースを表示するのみ
+  ` + AppName + ` cfn orphans --filter test- --dry-run
+
+  # S3バケットとCloudWatch Logsグループのみを削除
+  ` + AppName + ` cfn orphans --filter test- --types s3,logs
+
+  # 削除せずにプランを作成（` + AppName + ` apply で実行）
+  ` + AppName + ` cfn orphans --filter test- --plan-out plan.json`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		filter, _ := cmd.Flags().GetString("filter")
+		exact, _ := cmd.Flags().GetBool("exact")
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		types, _ := cmd.Flags().GetStringSlice("types")
+		if _, err := cleanup.SelectTypes(types); err != nil {
+			return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+		}
+
+		printAwsContext()
+
+		opts := cleanup.OrphanOptions{
+			StackFilter: filter,
+			Exact:       exact,
+			Types:       types,
+			ListOnly:    dryRun,
+		}
+		// --dry-run と --plan-out・--report の組み合わせはフラグの排他指定で拒否するため、削除する場合のみプランとレポートを作成する
+		if !dryRun {
+			opts.Plan = newPlanIfRequested()
+			var err error
+			opts.Report, err = newReportIfRequested()
+			if err != nil {
+				return err
+			}
+			if opts.Plan == nil {
+				opts.AccountName = accountNameResolver(cmd.Context(), awsCfg)
+			}
+		}
+		if opts.Report != nil {
+			opts.AccountId = callerAccountId(cmd.Context(), awsCfg)
+		}
+		results, err := cleanup.CleanupOrphans(cmd.Context(), newCleanupClients(awsCfg), opts)
+		// 中断された場合もここまでの結果を表示し、レポートに書き出す
+		if results != nil {
+			printCleanupSummary(results)
+		}
+		if err != nil {
+			err = fmt.Errorf(i18n.T("❌ クリーンアップ処理でエラー: %w"), err)
+		}
+		if err := finishReport(opts.Report, err); err != nil {
+			return err
+		}
+		if opts.Plan != nil {
+			return writePlan(cmd.Context(), opts.Plan)
+		}
+		if results != nil {
+			logging.Infof("%s\n", i18n.T("✅ クリーンアップが完了しました"))
+		}
+		return nil
+	},
+	SilenceUsage: true,
+}
+
 var cfnProtectCmd = &cobra.Command{
 	Use:   "protect",
 	Short: "CloudFormationスタックの削除保護を一括設定するコマンド",
@@ -198,7 +393,7 @@ var cfnProtectCmd = &cobra.Command{
 
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
 
-		err := cfn.UpdateProtection(cfnClient, cfn.ProtectOptions{
+		err := cfn.UpdateProtection(cmd.Context(), cfnClient, cfn.ProtectOptions{
 			Stacks: args,
 			Filter: protectFilter,
 			Status: protectStatus,
@@ -206,7 +401,7 @@ var cfnProtectCmd = &cobra.Command{
 			Exact:  protectExact,
 		})
 		if err != nil {
-			return fmt.Errorf("❌ 削除保護の更新処理でエラー: %w", err)
+			return fmt.Errorf(i18n.T("❌ 削除保護の更新処理でエラー: %w"), err)
 		}
 
 		return nil
@@ -247,14 +442,14 @@ var cfnDriftDetectCmd = &cobra.Command{
 
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
 
-		err := cfn.DetectDrift(cfnClient, cfn.DriftOptions{
+		_, err := cfn.DetectDrift(cmd.Context(), cfnClient, cfn.DriftOptions{
 			Stacks: args,
 			Filter: driftFilter,
 			All:    driftAll,
 			Exact:  driftExact,
 		})
 		if err != nil {
-			return fmt.Errorf("❌ ドリフト検出処理でエラー: %w", err)
+			return fmt.Errorf(i18n.T("❌ ドリフト検出処理でエラー: %w"), err)
 		}
 
 		return nil
@@ -279,7 +474,10 @@ var cfnDriftStatusCmd = &cobra.Command{
   ` + AppName + ` cfn drift-status stack-a stack-b
 
   # ドリフトしているスタックのみ表示
-  ` + AppName + ` cfn drift-status --filter prod- --drifted-only`,
+  ` + AppName + ` cfn drift-status --filter prod- --drifted-only
+
+  # 複数アカウントのドリフト状態をアカウント列付きで表示
+  ` + AppName + ` cfn drift-status --all --accounts 111111111111,222222222222 --role-name ReadOnlyRole`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		// フラグの値を取得
 		driftFilter, _ := cmd.Flags().GetString("filter")
@@ -292,19 +490,31 @@ var cfnDriftStatusCmd = &cobra.Command{
 			return err
 		}
 
-		printAwsContext()
-
-		cfnClient := cloudformation.NewFromConfig(awsCfg)
-
-		err := cfn.ShowDriftStatus(cfnClient, cfn.DriftStatusOptions{
+		opts := cfn.DriftStatusOptions{
 			Stacks:      args,
 			Filter:      driftFilter,
 			All:         driftAll,
 			DriftedOnly: driftedOnly,
 			Exact:       driftStatusExact,
-		})
+		}
+
+		if isMultiTarget() {
+			targets, err := resolveTargets(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return cfn.ShowDriftStatusAcross(cmd.Context(), targets, func(t common.Target) cfn.CfnApi {
+				return cloudformation.NewFromConfig(targetConfig(t))
+			}, opts)
+		}
+
+		printAwsContext()
+
+		cfnClient := cloudformation.NewFromConfig(awsCfg)
+
+		err := cfn.ShowDriftStatus(cmd.Context(), cfnClient, opts)
 		if err != nil {
-			return fmt.Errorf("❌ ドリフト状態確認処理でエラー: %w", err)
+			return fmt.Errorf(i18n.T("❌ ドリフト状態確認処理でエラー: %w"), err)
 		}
 
 		return nil
@@ -339,16 +549,14 @@ var cfnDeployCmd = &cobra.Command{
   ` + AppName + ` cfn deploy -t template.yaml -S my-stack -n`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		if deployTemplatePath == "" {
-			return fmt.Errorf("❌ エラー: テンプレートファイルパス (--template) を指定してください")
+			return errors.New(i18n.T("❌ エラー: テンプレートファイルパス (--template) を指定してください"))
 		}
 		if deployStackName == "" {
-			return fmt.Errorf("❌ エラー: スタック名 (--stack) を指定してください")
+			return errors.New(i18n.T("❌ エラー: スタック名 (--stack) を指定してください"))
 		}
 
 		printAwsContext()
 
-		awsCtx := aws.Context{Region: region, Profile: profile}
-
 		// パラメータの処理
 		var params map[string]string
 		var paramFile string
@@ -370,7 +578,7 @@ var cfnDeployCmd = &cobra.Command{
 			}
 		}
 
-		err := cfn.DeployStack(awsCtx, cfn.DeployOptions{
+		err := cfn.DeployStack(cmd.Context(), awsCtx, cfn.DeployOptions{
 			TemplatePath:    deployTemplatePath,
 			StackName:       deployStackName,
 			Parameters:      params,
@@ -378,7 +586,7 @@ var cfnDeployCmd = &cobra.Command{
 			IsChangeSetOnly: deployIsChangeSetOnly,
 		})
 		if err != nil {
-			return fmt.Errorf("❌ デプロイ処理でエラー: %w", err)
+			return fmt.Errorf(i18n.T("❌ デプロイ処理でエラー: %w"), err)
 		}
 
 		return nil
@@ -393,11 +601,14 @@ func init() {
 	CfnCmd.AddCommand(cfnStartCmd)
 	CfnCmd.AddCommand(cfnStopCmd)
 	CfnCmd.AddCommand(cfnCleanupCmd)
+	CfnCmd.AddCommand(cfnOrphansCmd)
 	CfnCmd.AddCommand(cfnProtectCmd)
 	CfnCmd.AddCommand(cfnDriftDetectCmd)
 	CfnCmd.AddCommand(cfnDriftStatusCmd)
 
 	cfnLsCmd.Flags().BoolVarP(&showAll, "all", "a", false, "全てのステータスのスタックを表示")
+	addTargetFlags(cfnLsCmd)
+	addTagFlag(cfnLsCmd)
 
 	// cfn deployコマンド用のフラグ
 	cfnDeployCmd.Flags().StringVarP(&deployTemplatePath, "template", "t", "", "テンプレートファイルのパス")
@@ -410,14 +621,36 @@ func init() {
 	// cfn start/stopコマンド用のフラグ
 	cfnStartCmd.Flags().StringVarP(&stackName, "stack-name", "S", "", "CloudFormationスタック名")
 	cfnStopCmd.Flags().StringVarP(&stackName, "stack-name", "S", "", "CloudFormationスタック名")
+	addTagFlag(cfnStartCmd)
+	addTagFlag(cfnStopCmd)
+	cfnStartCmd.MarkFlagsMutuallyExclusive("stack-name", "tag")
+	cfnStopCmd.MarkFlagsMutuallyExclusive("stack-name", "tag")
 
 	// cfn cleanupコマンド用のフラグ
 	cfnCleanupCmd.Flags().StringVar(&cleanupFilter, "filter", "", "スタック名のフィルター（部分一致）")
 	cfnCleanupCmd.Flags().StringVar(&cleanupStatus, "status", "", "削除対象のステータス（カンマ区切り）")
 	cfnCleanupCmd.Flags().BoolVarP(&cleanupForce, "force", "f", false, "確認プロンプトをスキップ")
 	cfnCleanupCmd.Flags().BoolVar(&cleanupExact, "exact", false, "大文字小文字を区別してマッチ")
-	// どちらか1つ必須
-	cfnCleanupCmd.MarkFlagsOneRequired("filter", "status")
+	addTagFlag(cfnCleanupCmd)
+	addAgeFlags(cfnCleanupCmd)
+	addPlanOutFlag(cfnCleanupCmd)
+	addGuardrailFlag(cfnCleanupCmd)
+	// いずれか1つ必須
+	cfnCleanupCmd.MarkFlagsOneRequired("filter", "status", "tag")
+
+	// cfn orphansコマンド用のフラグ
+	cfnOrphansCmd.Flags().StringP("filter", "F", "", "削除済みスタック名のフィルター（部分一致）")
+	cfnOrphansCmd.Flags().Bool("exact", false, "大文字小文字を区別してマッチ")
+	cfnOrphansCmd.Flags().StringSlice("types", nil, "対象にするリソースの種類（カンマ区切り。既定は s3,ecr,logs。all ですべて、!種類 で除外。例: s3,dynamodb、all,!kms）")
+	_ = cfnOrphansCmd.RegisterFlagCompletionFunc("types", cobra.FixedCompletions(append(cleanup.TypeNames(), "all"), cobra.ShellCompDirectiveNoFileComp))
+	cfnOrphansCmd.Flags().BoolP("dry-run", "d", false, "残されたリソースを表示するのみ（実際には削除しない）")
+	addPlanOutFlag(cfnOrphansCmd)
+	addGuardrailFlag(cfnOrphansCmd)
+	addReportFlags(cfnOrphansCmd)
+	cfnOrphansCmd.MarkFlagsMutuallyExclusive("dry-run", "plan-out")
+	cfnOrphansCmd.MarkFlagsMutuallyExclusive("dry-run", "report")
+	cfnOrphansCmd.MarkFlagsMutuallyExclusive("dry-run", "report-format")
+	cfnOrphansCmd.MarkFlagsMutuallyExclusive("plan-out", "report")
 
 	// cfn protectコマンド用のフラグ
 	cfnProtectCmd.Flags().StringP("filter", "F", "", "スタック名のフィルター（部分一致）")
@@ -439,4 +672,5 @@ func init() {
 	cfnDriftStatusCmd.Flags().BoolP("all", "a", false, "すべてのスタックを対象")
 	cfnDriftStatusCmd.Flags().BoolP("drifted-only", "d", false, "ドリフトしているスタックのみ表示")
 	cfnDriftStatusCmd.Flags().Bool("exact", false, "大文字小文字を区別してマッチ")
+	addTargetFlags(cfnDriftStatusCmd)
 }
@@ -1,13 +1,27 @@
 package cmd
 
 import (
-	cleanup "awstk/internal/service/cleanup"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	cleanup "github.com/ngsw877/awstk/internal/service/cleanup"
+	"github.com/ngsw877/awstk/internal/service/common"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/spf13/cobra"
 )
 
@@ -21,47 +35,156 @@ var cleanupCmd = &cobra.Command{
 // allCleanupCmd represents the all subcommand
 var allCleanupCmd = &cobra.Command{
 	Use:   "all",
-	Short: "S3バケット、ECRリポジトリ、CloudWatch Logsを横断削除",
-	Long: `指定した文字列を含むS3バケット、ECRリポジトリ、CloudWatch Logsグループを一括削除するコマンドです。
+	Short: "S3バケット、ECRリポジトリ、CloudWatch Logs等を横断削除",
+	Long: `指定した文字列を含むAWSリソースを一括削除するコマンドです。
+対象にできるリソースの種類は次のとおりです（既定は s3・ecr・logs のみ。それ以外の種類は --types で指定した場合のみ対象。--types all ですべて）:
+  s3 (S3バケット)、ecr (ECRリポジトリ)、logs (CloudWatch Logsグループ)、dynamodb (DynamoDBテーブル)、
+  sqs (SQSキュー)、sns (SNSトピック)、lambda (Lambda関数)、secrets (Secrets Managerのシークレット)、
+  ssm (SSMパラメータ)、kms (KMSキー)、ebs-snapshot (EBSスナップショット)、elb (ロードバランサー)、
+  target-group (ターゲットグループ)、eni (未使用のネットワークインターフェース)、security-group (セキュリティグループ)
+KMSキーはエイリアス・説明、EBSスナップショットはNameタグ・説明に検索文字列を含むものも対象にします。
+KMSキーは即時に削除できないため、7日後の削除を予約します。シークレットは7日間の復旧期間を設けて削除します（期間内は復元可能）。
+リソース間の参照（ロードバランサー→ターゲットグループ・セキュリティグループ、ENI・Lambda関数→セキュリティグループ、
+Lambda関数→ロググループ、ロググループ→サブスクリプション先、S3バケット→イベント通知先）を調べ、参照しているリソースから順に削除します。
+削除できなかったリソースが参照しているリソースは削除せず、サマリーに未実行として表示します。削除保護が有効なロードバランサーは削除しません。
+--tag を指定すると、タグ条件にも一致するリソースのみを対象にします。
+--older-than / --newer-than を指定すると、作成日時（Lambda関数・SSMパラメータは最終更新日時、日時を取得できないSNSトピック・ターゲットグループ・ENI・セキュリティグループは対象外。--age-by last-activity の場合はECRは最後のイメージのプッシュ・プル、ロググループは最終イベント、シークレットは最終アクセスの日時）でも絞り込みます。
 CloudFormationスタック名またはスタックIDを指定することで、スタック内のリソースを対象にすることもできます。
+--plan-out でプランを作成できるのは s3・ecr・logs・elb のみで、それ以外の種類を対象にした場合は --plan-out を指定できません。
+削除前に対象の一覧を表示し、アカウントエイリアス（未設定の場合はアカウントID）の入力で確認します（--yes で省略）。
+--report を指定すると、リソースごとの削除結果（ARN・所要時間・エラー・削除したオブジェクト数等）をJSON・Markdown・JUnit XMLで書き出します。
+削除に失敗したリソース、または参照元を削除できず未実行のリソースがある場合は、サマリーとレポートを出力したうえでエラー（終了コード1）で終了します。
+削除を開始すると実行IDを表示し、検出した対象と進捗を実行状態ファイルに記録します。中断した場合は --resume に実行IDを指定すると、
+再検出せずに未完了のリソースから（S3バケットはオブジェクトバージョン一覧の続きから）削除を再開します。すべて完了すると実行状態ファイルは削除されます。
 
 例:
   ` + AppName + ` cleanup all -s "test" -P my-profile
   ` + AppName + ` cleanup all -S my-stack -P my-profile
-  ` + AppName + ` cleanup all --stack-id arn:aws:cloudformation:... -P my-profile`,
+  ` + AppName + ` cleanup all --stack-id arn:aws:cloudformation:... -P my-profile
+  ` + AppName + ` cleanup all -s "test" --tag env=dev   # 検索文字列とタグの両方に一致するリソース
+  ` + AppName + ` cleanup all --tag purpose=poc --tag '!keep'
+  ` + AppName + ` cleanup all -s "test" --older-than 14d   # 14日以上前に作成されたリソース
+  ` + AppName + ` cleanup all -s "test" --types s3,dynamodb   # S3バケットとDynamoDBテーブルのみ
+  ` + AppName + ` cleanup all -s "test" --types all   # すべての種類
+  ` + AppName + ` cleanup all -s "test" --types 'all,!kms,!secrets'   # KMSキーとシークレット以外のすべての種類
+  ` + AppName + ` cleanup all -s "test" --types elb,target-group,security-group   # ロードバランサーと関連するVPCリソース
+  ` + AppName + ` cleanup all -s "test" --plan-out plan.json   # 削除せずにプランを作成
+  ` + AppName + ` cleanup all -s "test" --yes   # 確認せずに削除（CI等で実行する場合）
+  ` + AppName + ` cleanup all -s "pr-123" --yes --report report.xml   # 削除結果をJUnit XMLで書き出す（CIのテスト結果として表示）
+  ` + AppName + ` cleanup all --resume 20260101-120000-1a2b3c   # 中断した実行を再開
+  ` + AppName + ` cleanup all -s "test" --accounts 111111111111,222222222222   # 複数アカウントで順に削除`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		resolveStackName()
 		search, _ := cmd.Flags().GetString("search")
 		stackID, _ := cmd.Flags().GetString("stack-id")
 		exact, _ := cmd.Flags().GetBool("exact")
+		types, _ := cmd.Flags().GetStringSlice("types")
+		if _, err := cleanup.SelectTypes(types); err != nil {
+			return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+		}
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		age, err := ageFilter()
+		if err != nil {
+			return err
+		}
 
 		printAwsContext()
 
-		// クライアントセットを作成
-		clients := cleanup.ClientSet{
-			S3Client:   s3.NewFromConfig(awsCfg),
-			EcrClient:  ecr.NewFromConfig(awsCfg),
-			CfnClient:  cloudformation.NewFromConfig(awsCfg),
-			LogsClient: cloudwatchlogs.NewFromConfig(awsCfg),
-		}
-
 		opts := cleanup.Options{
 			SearchString: search,
 			StackName:    stackName,
 			StackId:      stackID,
 			Exact:        exact,
+			Types:        types,
+			Tags:         tags,
+			Age:          age,
+			Plan:         newPlanIfRequested(),
+		}
+
+		opts.Report, err = newReportIfRequested()
+		if err != nil {
+			return err
+		}
+
+		// 中断した場合に再開できるよう、削除する場合は実行状態を記録する
+		if resumeRunId != "" || (opts.Plan == nil && !isMultiAccount()) {
+			opts.Run, err = startRun(cmd.Context(), "cleanup all")
+			if err != nil {
+				return err
+			}
 		}
 
-		if err := cleanup.CleanupResources(clients, opts); err != nil {
-			return fmt.Errorf("❌ クリーンアップ処理でエラー: %w", err)
+		if isMultiAccount() {
+			// ロールの引き受け（MFAの入力）の前にオプションを検証する
+			if err := cleanup.ValidateAcrossOptions(opts); err != nil {
+				return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+			}
+			targets, err := resolveTargets(cmd.Context())
+			if err != nil {
+				return err
+			}
+			err = cleanup.CleanupResourcesAcross(cmd.Context(), targets, func(t common.Target) cleanup.ClientSet {
+				return newCleanupClients(targetConfig(t))
+			}, opts, printCleanupSummary)
+			if err != nil {
+				err = fmt.Errorf(i18n.T("❌ クリーンアップ処理でエラー: %w"), err)
+			}
+			if err := finishReport(opts.Report, err); err != nil {
+				return err
+			}
+			logging.Infof("%s\n", i18n.T("✅ クリーンアップが完了しました"))
+			return nil
 		}
 
-		fmt.Println("✅ クリーンアップが完了しました")
+		if opts.Plan == nil {
+			opts.AccountName = accountNameResolver(cmd.Context(), awsCfg)
+		}
+		if opts.Report != nil {
+			opts.AccountId = callerAccountId(cmd.Context(), awsCfg)
+		}
+		results, err := cleanup.CleanupResources(cmd.Context(), newCleanupClients(awsCfg), opts)
+		// 中断された場合もここまでの結果を表示し、レポートに書き出す
+		if results != nil {
+			printCleanupSummary(results)
+		}
+		if err != nil {
+			err = fmt.Errorf(i18n.T("❌ クリーンアップ処理でエラー: %w"), err)
+		}
+		if err := finishReport(opts.Report, err); err != nil {
+			return err
+		}
+		if opts.Plan != nil {
+			return writePlan(cmd.Context(), opts.Plan)
+		}
+
+		logging.Infof("%s\n", i18n.T("✅ クリーンアップが完了しました"))
 		return nil
 	},
 	SilenceUsage: true,
 }
 
+// newCleanupClients はクリーンアップ処理に必要なクライアントセットを作成する
+func newCleanupClients(cfg awsconfig.Config) cleanup.ClientSet {
+	return cleanup.ClientSet{
+		S3Client:       s3.NewFromConfig(cfg, s3ClientOptions),
+		EcrClient:      ecr.NewFromConfig(cfg),
+		CfnClient:      cloudformation.NewFromConfig(cfg),
+		LogsClient:     cloudwatchlogs.NewFromConfig(cfg),
+		DynamoDbClient: dynamodb.NewFromConfig(cfg),
+		SqsClient:      sqs.NewFromConfig(cfg),
+		SnsClient:      sns.NewFromConfig(cfg),
+		LambdaClient:   lambda.NewFromConfig(cfg),
+		SecretsClient:  secretsmanager.NewFromConfig(cfg),
+		SsmClient:      ssm.NewFromConfig(cfg),
+		KmsClient:      kms.NewFromConfig(cfg),
+		Ec2Client:      ec2.NewFromConfig(cfg),
+		ElbClient:      elasticloadbalancingv2.NewFromConfig(cfg),
+	}
+}
+
 func init() {
 	RootCmd.AddCommand(cleanupCmd)
 	cleanupCmd.AddCommand(allCleanupCmd)
@@ -69,4 +192,14 @@ func init() {
 	allCleanupCmd.Flags().StringVarP(&stackName, "stack-name", "S", "", "CloudFormationスタック名")
 	allCleanupCmd.Flags().StringP("stack-id", "i", "", "CloudFormationスタックID(ARN可)")
 	allCleanupCmd.Flags().Bool("exact", false, "大文字小文字を区別してマッチ")
+	allCleanupCmd.Flags().StringSlice("types", nil, "対象にするリソースの種類（カンマ区切り。既定は s3,ecr,logs。all ですべて、!種類 で除外。例: s3,dynamodb、all,!kms）")
+	_ = allCleanupCmd.RegisterFlagCompletionFunc("types", cobra.FixedCompletions(append(cleanup.TypeNames(), "all"), cobra.ShellCompDirectiveNoFileComp))
+	addTagFlag(allCleanupCmd)
+	addAgeFlags(allCleanupCmd)
+	addPlanOutFlag(allCleanupCmd)
+	addGuardrailFlag(allCleanupCmd)
+	addAccountsFlags(allCleanupCmd)
+	addResumeFlag(allCleanupCmd)
+	addReportFlags(allCleanupCmd)
+	allCleanupCmd.MarkFlagsMutuallyExclusive("report", "plan-out")
 }
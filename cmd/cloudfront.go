@@ -1,11 +1,13 @@
 package cmd
 
 import (
-	cfsvc "awstk/internal/service/cloudfront"
-	"awstk/internal/service/cloudfront/tenant"
-	"awstk/internal/service/common"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	cfsvc "github.com/ngsw877/awstk/internal/service/cloudfront"
+	"github.com/ngsw877/awstk/internal/service/cloudfront/tenant"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
 	"github.com/spf13/cobra"
@@ -48,7 +50,8 @@ var cfInvalidateCmd = &cobra.Command{
 【例】
   ` + AppName + ` cf invalidate E2ABC123DEF456 -p "/images/*" -p "/api/*"
   → 複数のパスを同時に無効化します`,
-	Args: cobra.MaximumNArgs(1),
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: firstArg(completeDistributionIds),
 	RunE: func(cmdCobra *cobra.Command, args []string) error {
 		resolveStackName()
 		paths, _ := cmdCobra.Flags().GetStringSlice("path")
@@ -66,7 +69,7 @@ var cfInvalidateCmd = &cobra.Command{
 			StackName:      stackName,
 		}
 
-		err := cfsvc.InvalidateByIdOrStack(cfClient, cfnClient, opts)
+		err := cfsvc.InvalidateByIdOrStack(cmdCobra.Context(), cfClient, cfnClient, opts)
 		if err != nil {
 			return fmt.Errorf("❌ %w", err)
 		}
@@ -84,15 +87,16 @@ var cfTenantCmd = &cobra.Command{
 
 // cfTenantListCmd represents the tenant list command
 var cfTenantListCmd = &cobra.Command{
-	Use:   "list <distribution-id>",
-	Short: "マルチテナントディストリビューションのテナント一覧を表示",
-	Args:  cobra.ExactArgs(1),
+	Use:               "list <distribution-id>",
+	Short:             "マルチテナントディストリビューションのテナント一覧を表示",
+	Args:              cobra.ExactArgs(1),
+	ValidArgsFunction: firstArg(completeDistributionIds),
 	RunE: func(cmdCobra *cobra.Command, args []string) error {
 		distributionId := args[0]
 
-		tenants, err := tenant.ListTenants(cfClient, distributionId)
+		tenants, err := tenant.ListTenants(cmdCobra.Context(), cfClient, distributionId)
 		if err != nil {
-			return common.FormatListError("テナント", err)
+			return common.FormatListError(i18n.T("テナント"), err)
 		}
 
 		// テナントIDの一覧を作成
@@ -102,11 +106,11 @@ var cfTenantListCmd = &cobra.Command{
 		}
 
 		// 共通関数で表示
-		title := fmt.Sprintf("テナント一覧 (ディストリビューション: %s)", distributionId)
+		title := fmt.Sprintf(i18n.T("テナント一覧 (ディストリビューション: %s)"), distributionId)
 		common.PrintNumberedList(common.ListOutput{
 			Title:        title,
 			Items:        tenantIds,
-			ResourceName: "テナント",
+			ResourceName: i18n.T("テナント"),
 		})
 
 		return nil
@@ -128,7 +132,8 @@ var cfTenantInvalidateCmd = &cobra.Command{
 【例】
   ` + AppName + ` cf tenant invalidate E2ABC123DEF456 --all -p "/api/*"
   → 全テナントの /api/* パスを無効化します`,
-	Args: cobra.RangeArgs(1, 2),
+	Args:              cobra.RangeArgs(1, 2),
+	ValidArgsFunction: firstArg(completeDistributionIds),
 	RunE: func(cmdCobra *cobra.Command, args []string) error {
 		paths, _ := cmdCobra.Flags().GetStringSlice("path")
 		all, _ := cmdCobra.Flags().GetBool("all")
@@ -150,13 +155,13 @@ var cfTenantInvalidateCmd = &cobra.Command{
 
 		if all {
 			// 全テナント無効化
-			err := cfsvc.InvalidateAllTenantsWithMessage(cfClient, opts)
+			err := cfsvc.InvalidateAllTenantsWithMessage(cmdCobra.Context(), cfClient, opts)
 			if err != nil {
 				return fmt.Errorf("❌ %w", err)
 			}
 		} else {
 			// 特定テナントまたは選択
-			err := cfsvc.InvalidateTenantByIdOrSelection(cfClient, list, opts)
+			err := cfsvc.InvalidateTenantByIdOrSelection(cmdCobra.Context(), cfClient, list, opts)
 			if err != nil {
 				return fmt.Errorf("❌ %w", err)
 			}
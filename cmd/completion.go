@@ -0,0 +1,216 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/aws"
+	"github.com/ngsw877/awstk/internal/completion"
+	"github.com/ngsw877/awstk/internal/config"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	cfsvc "github.com/ngsw877/awstk/internal/service/cloudfront"
+	ecssvc "github.com/ngsw877/awstk/internal/service/ecs"
+	"github.com/ngsw877/awstk/internal/service/schedule"
+	secretsmgrSvc "github.com/ngsw877/awstk/internal/service/secretsmanager"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/spf13/cobra"
+)
+
+// completionTimeout は補完候補の取得でAWS APIの応答を待つ最大時間
+const completionTimeout = 10 * time.Second
+
+// flagCompletions は文字列フラグ名ごとの補完候補の取得方法（そのフラグを持つすべてのコマンドに登録する）
+var flagCompletions = map[string]cobra.CompletionFunc{
+	"profile":    completeProfiles,
+	"stack-name": completeStackNames,
+}
+
+// ecsFlagCompletions はECSコマンドのフラグの補完候補の取得方法（Auroraの --cluster 等と区別するためECSコマンドにのみ登録する）
+var ecsFlagCompletions = map[string]cobra.CompletionFunc{
+	"cluster": completeEcsClusters,
+	"service": completeEcsServices,
+}
+
+// registerCompletions はコマンドツリーのフラグに補完候補の取得方法を登録する
+// フラグは各ファイルの init で定義されるため、すべての定義後（Execute の開始時）に呼び出す
+func registerCompletions(c *cobra.Command) {
+	register := func(completions map[string]cobra.CompletionFunc) {
+		for name, fn := range completions {
+			if f := c.LocalFlags().Lookup(name); f != nil && f.Value.Type() == "string" {
+				_ = c.RegisterFlagCompletionFunc(name, fn)
+			}
+		}
+	}
+	register(flagCompletions)
+	if c.HasParent() && c.Parent() == EcsCmd {
+		register(ecsFlagCompletions)
+	}
+
+	for _, sub := range c.Commands() {
+		registerCompletions(sub)
+	}
+}
+
+// firstArg は最初の位置引数だけを補完する関数を返す
+func firstArg(fn cobra.CompletionFunc) cobra.CompletionFunc {
+	return func(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+		if len(args) > 0 {
+			return nil, cobra.ShellCompDirectiveNoFileComp
+		}
+		return fn(cmd, args, toComplete)
+	}
+}
+
+// completeProfiles は共有設定ファイルのプロファイル名で補完する
+func completeProfiles(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return filterCompletions(aws.ListProfiles(), toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completeStackNames はアクティブなCloudFormationスタック名で補完する
+func completeStackNames(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return awsCompletions(cmd, "stacks", toComplete, func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		stacks, err := cfn.ListCfnStacks(ctx, cloudformation.NewFromConfig(cfg), false)
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, len(stacks))
+		for i, stack := range stacks {
+			names[i] = stack.Name
+		}
+		return names, nil
+	})
+}
+
+// completeEcsClusters はECSクラスター名で補完する
+func completeEcsClusters(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return awsCompletions(cmd, "ecs-clusters", toComplete, func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		return ecssvc.ListClusterNames(ctx, ecs.NewFromConfig(cfg))
+	})
+}
+
+// completeEcsServices は --cluster で指定したクラスターのECSサービス名で補完する（クラスター未指定時は候補なし）
+func completeEcsServices(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	cluster, _ := cmd.Flags().GetString("cluster")
+	if cluster == "" {
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return awsCompletions(cmd, "ecs-services|"+cluster, toComplete, func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		return ecssvc.ListServiceNames(ctx, ecs.NewFromConfig(cfg), cluster)
+	})
+}
+
+// completeSecretNames はSecrets Managerのシークレット名で補完する
+func completeSecretNames(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return awsCompletions(cmd, "secrets", toComplete, func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		return secretsmgrSvc.ListSecretNames(ctx, secretsmanager.NewFromConfig(cfg))
+	})
+}
+
+// completeScheduleNames はEventBridge Rule・EventBridge Schedulerのスケジュール名で補完する
+func completeScheduleNames(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return awsCompletions(cmd, "schedules", toComplete, func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		schedules, err := schedule.ListSchedules(ctx, eventbridge.NewFromConfig(cfg), scheduler.NewFromConfig(cfg), schedule.ListOptions{Type: "all"})
+		if err != nil {
+			return nil, err
+		}
+		names := make([]string, 0, len(schedules))
+		for _, s := range schedules {
+			names = append(names, fmt.Sprintf("%s\t%s %s", s.Name, s.Type, s.Expression))
+		}
+		return names, nil
+	})
+}
+
+// completeDistributionIds はCloudFrontディストリビューションIDで補完する（説明にドメイン名とコメントを表示）
+func completeDistributionIds(cmd *cobra.Command, args []string, toComplete string) ([]cobra.Completion, cobra.ShellCompDirective) {
+	return awsCompletions(cmd, "distributions", toComplete, func(ctx context.Context, cfg awsconfig.Config) ([]string, error) {
+		distributions, err := cfsvc.ListDistributions(ctx, cloudfront.NewFromConfig(cfg))
+		if err != nil {
+			return nil, err
+		}
+		ids := make([]string, len(distributions))
+		for i, d := range distributions {
+			ids[i] = cobra.CompletionWithDesc(d.Id, strings.TrimSpace(d.DomainName+" "+d.Comment))
+		}
+		return ids, nil
+	})
+}
+
+// awsCompletions はAWSから取得した候補（短時間キャッシュする）のうち、入力中の文字列で始まるものを返す
+// 取得に失敗した場合は補完候補なしとし、エラーは補完のデバッグログにのみ出力する
+func awsCompletions(cmd *cobra.Command, kind, toComplete string, fetch func(ctx context.Context, cfg awsconfig.Config) ([]string, error)) ([]cobra.Completion, cobra.ShellCompDirective) {
+	cfg, scope, err := completionAwsConfig(cmd)
+	if err != nil {
+		cobra.CompDebugln(err.Error(), true)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+
+	values, err := completion.Cached(kind+"|"+scope, func() ([]string, error) {
+		ctx, cancel := context.WithTimeout(cmd.Context(), completionTimeout)
+		defer cancel()
+		return fetch(ctx, cfg)
+	})
+	if err != nil {
+		cobra.CompDebugln(err.Error(), true)
+		return nil, cobra.ShellCompDirectiveNoFileComp
+	}
+	return filterCompletions(values, toComplete), cobra.ShellCompDirectiveNoFileComp
+}
+
+// completionAwsConfig は補完候補の取得に使用するAWS設定と、キャッシュを区別するための接続先の文字列を返す
+// 補完時は PersistentPreRunE の処理（取得元の表示・ロールの引き受け等）を行わず、フラグと設定ファイルから解決する
+func completionAwsConfig(cmd *cobra.Command) (awsconfig.Config, string, error) {
+	p, r := profile, region
+	if cfg, err := config.Load(); err == nil {
+		resolved, err := config.Resolve(cfg, config.Inputs{
+			EnvName:    envName,
+			EnvNameSet: cmd.Flags().Changed("env"),
+			Profile:    profile,
+			ProfileSet: profile != "",
+			Region:     region,
+			RegionSet:  cmd.Flags().Changed("region"),
+		})
+		if err == nil {
+			p, r = resolved.Profile.Value, resolved.Region.Value
+		}
+	}
+
+	endpoint, serviceEps, err := aws.ResolveEndpoints(endpointUrl, serviceEndpoints)
+	if err != nil {
+		return awsconfig.Config{}, "", err
+	}
+	cfg, err := aws.LoadAwsConfig(cmd.Context(), aws.Context{
+		Profile:          p,
+		Region:           r,
+		EndpointUrl:      endpoint,
+		ServiceEndpoints: serviceEps,
+	})
+	if err != nil {
+		return awsconfig.Config{}, "", err
+	}
+	if p == "" {
+		p = os.Getenv("AWS_PROFILE")
+	}
+	return cfg, strings.Join([]string{p, cfg.Region, endpoint, fmt.Sprint(serviceEps)}, "|"), nil
+}
+
+// filterCompletions は入力中の文字列で始まる候補を返す
+func filterCompletions(values []string, toComplete string) []cobra.Completion {
+	var matched []cobra.Completion
+	for _, v := range values {
+		if strings.HasPrefix(v, toComplete) {
+			matched = append(matched, v)
+		}
+	}
+	return matched
+}
@@ -0,0 +1,37 @@
+package cmd
+
+import (
+	"context"
+
+	"github.com/ngsw877/awstk/internal/service/confirm"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// confirmAccountName は高リスクの操作の確認で入力を求めるアカウント名を返す
+// アカウントエイリアスが設定されていればエイリアス、なければアカウントIDを返す
+// --yes 指定時や取得できない場合は空文字を返す（この場合は y/N で確認する）
+func confirmAccountName(ctx context.Context, cfg awsconfig.Config) string {
+	if confirm.AssumeYes() {
+		return ""
+	}
+	aliases, err := iam.NewFromConfig(cfg).ListAccountAliases(ctx, &iam.ListAccountAliasesInput{})
+	if err == nil && len(aliases.AccountAliases) > 0 {
+		return aliases.AccountAliases[0]
+	}
+	identity, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return ""
+	}
+	return awsconfig.ToString(identity.Account)
+}
+
+// accountNameResolver は confirmAccountName を呼び出す関数を返す
+// 削除対象がない場合にアカウント名を取得するAPI呼び出しを行わないよう、確認が必要になった時点で呼び出す
+func accountNameResolver(ctx context.Context, cfg awsconfig.Config) func() string {
+	return func() string {
+		return confirmAccountName(ctx, cfg)
+	}
+}
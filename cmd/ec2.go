@@ -1,9 +1,13 @@
 package cmd
 
 import (
-	ec2svc "awstk/internal/service/ec2"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+	ec2svc "github.com/ngsw877/awstk/internal/service/ec2"
+
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/spf13/cobra"
@@ -37,19 +41,22 @@ var ec2StartCmd = &cobra.Command{
 	Use:   "start",
 	Short: "EC2インスタンスを起動するコマンド",
 	Long: `EC2インスタンスを起動します。
-インスタンスIDを直接指定することができます。
+インスタンスIDを直接指定するか、--tag でタグ条件に一致する停止中のインスタンスをまとめて起動できます。
 
 例:
-  ` + AppName + ` ec2 start -i i-1234567890abcdef0`,
+  ` + AppName + ` ec2 start -i i-1234567890abcdef0
+  ` + AppName + ` ec2 start --tag env=dev --tag '!always-on'`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		fmt.Printf("🚀 EC2インスタンス (%s) を起動します...\n", ec2InstanceId)
-		err := ec2svc.StartEc2Instance(ec2Client, ec2InstanceId)
-		if err != nil {
-			return fmt.Errorf("❌ EC2インスタンス起動エラー: %w", err)
+		if hasTagFilters() {
+			ids, err := taggedEc2InstanceIds(cmd, "stopped")
+			if err != nil {
+				return err
+			}
+			return runForTagged(i18n.T("停止中のEC2インスタンス"), ids, func(id string) error {
+				return startEc2Instance(cmd, id)
+			})
 		}
-
-		fmt.Printf("✅ EC2インスタンス (%s) の起動を開始しました\n", ec2InstanceId)
-		return nil
+		return startEc2Instance(cmd, ec2InstanceId)
 	},
 	SilenceUsage: true,
 }
@@ -58,19 +65,22 @@ var ec2StopCmd = &cobra.Command{
 	Use:   "stop",
 	Short: "EC2インスタンスを停止するコマンド",
 	Long: `EC2インスタンスを停止します。
-インスタンスIDを直接指定することができます。
+インスタンスIDを直接指定するか、--tag でタグ条件に一致する実行中のインスタンスをまとめて停止できます。
 
 例:
-  ` + AppName + ` ec2 stop -i i-1234567890abcdef0`,
+  ` + AppName + ` ec2 stop -i i-1234567890abcdef0
+  ` + AppName + ` ec2 stop --tag env=dev --tag '!always-on'`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		fmt.Printf("🛑 EC2インスタンス (%s) を停止します...\n", ec2InstanceId)
-		err := ec2svc.StopEc2Instance(ec2Client, ec2InstanceId)
-		if err != nil {
-			return fmt.Errorf("❌ EC2インスタンス停止エラー: %w", err)
+		if hasTagFilters() {
+			ids, err := taggedEc2InstanceIds(cmd, "running")
+			if err != nil {
+				return err
+			}
+			return runForTagged(i18n.T("実行中のEC2インスタンス"), ids, func(id string) error {
+				return stopEc2Instance(cmd, id)
+			})
 		}
-
-		fmt.Printf("✅ EC2インスタンス (%s) の停止を開始しました\n", ec2InstanceId)
-		return nil
+		return stopEc2Instance(cmd, ec2InstanceId)
 	},
 	SilenceUsage: true,
 }
@@ -78,14 +88,81 @@ var ec2StopCmd = &cobra.Command{
 var ec2LsCmd = &cobra.Command{
 	Use:   "ls",
 	Short: "EC2インスタンス一覧を表示するコマンド",
-	Long:  `EC2インスタンス一覧を表示します。`,
+	Long: `EC2インスタンス一覧を表示します。
+
+例:
+  ` + AppName + ` ec2 ls
+  ` + AppName + ` ec2 ls --regions all                     # 有効な全リージョンを検索
+  ` + AppName + ` ec2 ls --regions ap-northeast-1,us-east-1
+  ` + AppName + ` ec2 ls --accounts 111111111111,222222222222   # 各アカウントのロールを引き受けてアカウント列付きで表示
+  ` + AppName + ` ec2 ls --tag env=dev --tag '!keep'       # タグで絞り込み`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		if isMultiTarget() {
+			if err := validateMultiTargetStack(); err != nil {
+				return err
+			}
+			targets, err := resolveTargets(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return ec2svc.ListEc2InstancesAcross(cmd.Context(), targets, func(t common.Target) *ec2.Client {
+				return ec2.NewFromConfig(targetConfig(t))
+			}, tags)
+		}
 		// service層の統合関数を呼び出すだけ
-		return ec2svc.ListEc2Instances(ec2Client, cfnClient, stackName)
+		return ec2svc.ListEc2Instances(cmd.Context(), ec2Client, cfnClient, stackName, tags)
 	},
 	SilenceUsage: true,
 }
 
+// startEc2Instance はEC2インスタンスを1台起動する
+func startEc2Instance(cmd *cobra.Command, instanceId string) error {
+	logging.Infof(i18n.T("🚀 EC2インスタンス (%s) を起動します...\n"), instanceId)
+	err := ec2svc.StartEc2Instance(cmd.Context(), ec2Client, instanceId)
+	if err != nil {
+		return fmt.Errorf(i18n.T("❌ EC2インスタンス起動エラー: %w"), err)
+	}
+
+	logging.Infof(i18n.T("✅ EC2インスタンス (%s) の起動を開始しました\n"), instanceId)
+	return nil
+}
+
+// stopEc2Instance はEC2インスタンスを1台停止する
+func stopEc2Instance(cmd *cobra.Command, instanceId string) error {
+	logging.Infof(i18n.T("🛑 EC2インスタンス (%s) を停止します...\n"), instanceId)
+	err := ec2svc.StopEc2Instance(cmd.Context(), ec2Client, instanceId)
+	if err != nil {
+		return fmt.Errorf(i18n.T("❌ EC2インスタンス停止エラー: %w"), err)
+	}
+
+	logging.Infof(i18n.T("✅ EC2インスタンス (%s) の停止を開始しました\n"), instanceId)
+	return nil
+}
+
+// taggedEc2InstanceIds はタグ条件に一致し、指定した状態のEC2インスタンスのIDを返す
+func taggedEc2InstanceIds(cmd *cobra.Command, state string) ([]string, error) {
+	tags, err := tagSelector()
+	if err != nil {
+		return nil, err
+	}
+	instances, err := ec2svc.FindEc2InstancesByTags(cmd.Context(), ec2Client, tags)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("❌ EC2インスタンス一覧の取得に失敗: %w"), err)
+	}
+
+	var ids []string
+	for _, ins := range instances {
+		if ins.State == state {
+			ids = append(ids, ins.InstanceId)
+		}
+	}
+	return ids, nil
+}
+
 func init() {
 	RootCmd.AddCommand(Ec2Cmd)
 	Ec2Cmd.AddCommand(ec2StartCmd)
@@ -94,8 +171,15 @@ func init() {
 
 	// フラグの追加
 	ec2StartCmd.Flags().StringVarP(&ec2InstanceId, "instance", "i", "", "EC2インスタンスID")
-	_ = ec2StartCmd.MarkFlagRequired("instance")
+	addTagFlag(ec2StartCmd)
+	// instance と tag はどちらか1つ必須
+	ec2StartCmd.MarkFlagsOneRequired("instance", "tag")
+	ec2StartCmd.MarkFlagsMutuallyExclusive("instance", "tag")
 	ec2StopCmd.Flags().StringVarP(&ec2InstanceId, "instance", "i", "", "EC2インスタンスID")
-	_ = ec2StopCmd.MarkFlagRequired("instance")
+	addTagFlag(ec2StopCmd)
+	ec2StopCmd.MarkFlagsOneRequired("instance", "tag")
+	ec2StopCmd.MarkFlagsMutuallyExclusive("instance", "tag")
 	ec2LsCmd.Flags().StringVarP(&stackName, "stack-name", "S", "", "CloudFormationスタック名")
+	addTargetFlags(ec2LsCmd)
+	addTagFlag(ec2LsCmd)
 }
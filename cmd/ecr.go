@@ -1,7 +1,9 @@
 package cmd
 
 import (
-	ecrsvc "awstk/internal/service/ecr"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	ecrsvc "github.com/ngsw877/awstk/internal/service/ecr"
 
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
 	"github.com/spf13/cobra"
@@ -37,14 +39,39 @@ var ecrCleanupCmd = &cobra.Command{
 	Use:   "cleanup",
 	Short: "ECRリポジトリを削除するコマンド",
 	Long: `指定したキーワードを含むECRリポジトリを削除します。
+--tag を指定すると、タグ条件にも一致するリポジトリのみを削除します。
+--older-than / --newer-than を指定すると、作成日時（--age-by last-activity の場合は最後にイメージをプッシュ・プルした日時）でも絞り込みます。
+削除前に確認を求めます（--yes で省略。標準入力が端末でない場合は --yes が必要です）。
 
 例:
   ` + AppName + ` ecr cleanup -s "test-repo" -P my-profile
-  ` + AppName + ` ecr cleanup -s "Test" --exact    # 大文字小文字を区別`,
+  ` + AppName + ` ecr cleanup -s "Test" --exact    # 大文字小文字を区別
+  ` + AppName + ` ecr cleanup -s "test" --tag env=dev   # 検索パターンとタグの両方に一致するもの
+  ` + AppName + ` ecr cleanup -s "test" --older-than 30d   # 30日以上前に作成されたもの
+  ` + AppName + ` ecr cleanup -s "test" --older-than 14d --age-by last-activity   # 14日以上プッシュ・プルされていないもの
+  ` + AppName + ` ecr cleanup -s "test" --plan-out plan.json   # 削除せずにプランを作成
+  ` + AppName + ` ecr cleanup -s "test" --yes   # 確認せずに削除`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		printAwsContextWithInfo("検索文字列", ecrCleanupSearch)
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		age, err := ageFilter()
+		if err != nil {
+			return err
+		}
 
-		return ecrsvc.CleanupRepositoriesByFilter(ecrClient, ecrCleanupSearch, ecrCleanupExact)
+		printAwsContextWithInfo(i18n.T("検索文字列"), ecrCleanupSearch)
+		printAgeFilter(age)
+
+		p := newPlanIfRequested()
+		if err := ecrsvc.CleanupRepositoriesByFilter(cmd.Context(), ecrClient, ecrCleanupSearch, ecrCleanupExact, tags, age, p); err != nil {
+			return err
+		}
+		if p != nil {
+			return writePlan(cmd.Context(), p)
+		}
+		return nil
 	},
 	SilenceUsage: true,
 }
@@ -62,6 +89,8 @@ var ecrLsCmd = &cobra.Command{
   ` + AppName + ` ecr ls -n                 # ライフサイクルポリシー未設定のリポジトリのみを表示
   ` + AppName + ` ecr ls --details          # 詳細情報付きで表示
   ` + AppName + ` ecr ls -e -n              # 空かつポリシー未設定のリポジトリを表示
+  ` + AppName + ` ecr ls --regions all      # 有効な全リージョンを検索
+  ` + AppName + ` ecr ls --tag env=dev      # タグが一致するリポジトリのみを表示
 
 【例】
   ` + AppName + ` ecr ls -n
@@ -73,14 +102,29 @@ var ecrLsCmd = &cobra.Command{
 		emptyOnly, _ := cmdCobra.Flags().GetBool("empty-only")
 		noLifecycle, _ := cmdCobra.Flags().GetBool("no-lifecycle")
 		showDetails, _ := cmdCobra.Flags().GetBool("details")
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
 
 		opts := ecrsvc.ListOptions{
 			EmptyOnly:   emptyOnly,
 			NoLifecycle: noLifecycle,
 			ShowDetails: showDetails,
+			Tags:        tags,
+		}
+
+		if isMultiTarget() {
+			targets, err := resolveTargets(cmdCobra.Context())
+			if err != nil {
+				return err
+			}
+			return ecrsvc.ListRepositoriesAcross(cmdCobra.Context(), targets, func(t common.Target) ecrsvc.EcrApi {
+				return ecr.NewFromConfig(targetConfig(t))
+			}, opts)
 		}
 
-		return ecrsvc.ListRepositories(ecrClient, opts)
+		return ecrsvc.ListRepositories(cmdCobra.Context(), ecrClient, opts)
 	},
 	SilenceUsage: true,
 }
@@ -94,9 +138,15 @@ func init() {
 	ecrLsCmd.Flags().BoolP("empty-only", "e", false, "空のリポジトリのみを表示")
 	ecrLsCmd.Flags().BoolP("no-lifecycle", "n", false, "ライフサイクルポリシー未設定のリポジトリのみを表示")
 	ecrLsCmd.Flags().BoolP("details", "d", false, "詳細情報を表示")
+	addTargetFlags(ecrLsCmd)
+	addTagFlag(ecrLsCmd)
 
 	// cleanup コマンドのフラグ
 	ecrCleanupCmd.Flags().StringVarP(&ecrCleanupSearch, "search", "s", "", "削除対象の検索パターン")
-	_ = ecrCleanupCmd.MarkFlagRequired("search")
 	ecrCleanupCmd.Flags().BoolVar(&ecrCleanupExact, "exact", false, "大文字小文字を区別してマッチ")
+	addTagFlag(ecrCleanupCmd)
+	addAgeFlags(ecrCleanupCmd)
+	ecrCleanupCmd.MarkFlagsOneRequired("search", "tag")
+	addPlanOutFlag(ecrCleanupCmd)
+	addGuardrailFlag(ecrCleanupCmd)
 }
@@ -1,10 +1,13 @@
 package cmd
 
 import (
-	"awstk/internal/aws"
-	ecssvc "awstk/internal/service/ecs"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	ecssvc "github.com/ngsw877/awstk/internal/service/ecs"
+	"github.com/ngsw877/awstk/internal/service/selector"
+
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
@@ -46,41 +49,51 @@ var ecsExecCmd = &cobra.Command{
 	Short: "Fargateコンテナに接続するコマンド",
 	Long: `Fargateコンテナにシェル接続するコマンドです。
 CloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。
+サービス名を省略した場合は、クラスター・サービスを一覧から対話的に選択できます。
 
 例:
   ` + AppName + ` ecs exec -P my-profile -S my-stack
-  ` + AppName + ` ecs exec -P my-profile -c my-cluster -s my-service -t app`,
+  ` + AppName + ` ecs exec -P my-profile -c my-cluster -s my-service -t app
+  ` + AppName + ` ecs exec -P my-profile                # クラスター・サービスを一覧から選択`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		var err error
 
 		resolveStackName()
+
+		// スタック名もサービス名も指定されていない場合は、端末であれば一覧から選択させる
+		if stackName == "" && serviceName == "" && selector.Available() {
+			clusterName, serviceName, err = ecssvc.SelectClusterAndService(cmd.Context(), ecsClient, clusterName)
+			if err != nil {
+				return err
+			}
+		}
+
 		opts := ecssvc.ResolveOptions{
 			StackName:   stackName,
 			ClusterName: clusterName,
 			ServiceName: serviceName,
 		}
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cfnClient, opts)
+		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cmd.Context(), cfnClient, opts)
 		if err != nil {
 			return err
 		}
 
 		// タスクIDを取得
-		taskId, err := ecssvc.GetRunningTask(ecsClient, clusterName, serviceName)
+		taskId, err := ecssvc.GetRunningTask(cmd.Context(), ecsClient, clusterName, serviceName)
 		if err != nil {
-			return fmt.Errorf("❌ エラー: %w", err)
+			return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
 		}
 
 		// シェル接続を実行
-		fmt.Printf("🔍 コンテナ '%s' に接続しています...\n", containerName)
-		awsCtx := aws.Context{Region: region, Profile: profile}
+		logging.Infof(i18n.T("🔍 コンテナ '%s' に接続しています...\n"), containerName)
 		err = ecssvc.ExecuteEcsCommand(awsCtx, ecssvc.ExecOptions{
 			ClusterName:   clusterName,
 			TaskId:        taskId,
 			ContainerName: containerName,
 		})
 		if err != nil {
-			return fmt.Errorf("❌ コンテナへの接続に失敗しました: %w", err)
+			return fmt.Errorf(i18n.T("❌ コンテナへの接続に失敗しました: %w"), err)
 		}
 		return nil
 	},
@@ -93,13 +106,21 @@ var ecsStartCmd = &cobra.Command{
 	Short: "ECSサービスのキャパシティを設定して起動するコマンド",
 	Long: `ECSサービスの最小・最大キャパシティを設定して起動するコマンドです。
 CloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。
+--tag を指定すると、全クラスターのうちタグ条件に一致するサービスをまとめて起動できます。
 サービスが指定したキャパシティになるまで必ず待機します。待機タイムアウトは-t/--timeoutで秒数指定できます（デフォルト: 300秒）。
 
 例:
   ` + AppName + ` ecs start -P my-profile -S my-stack -m 1 -M 2
   ` + AppName + ` ecs start -P my-profile -c my-cluster -s my-service -m 1 -M 3
-  ` + AppName + ` ecs start -P my-profile -S my-stack -m 1 -M 2`,
+  ` + AppName + ` ecs start -P my-profile -S my-stack -m 1 -M 2
+  ` + AppName + ` ecs start -P my-profile --tag env=dev -m 1 -M 2`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if hasTagFilters() {
+			return runForTaggedEcsServices(cmd, func(svc ecssvc.Service) error {
+				return startEcsService(cmd, svc)
+			})
+		}
+
 		var err error
 
 		resolveStackName()
@@ -109,25 +130,12 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
 			ServiceName: serviceName,
 		}
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cfnClient, opts)
+		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cmd.Context(), cfnClient, opts)
 		if err != nil {
 			return err
 		}
 
-		aasClient := applicationautoscaling.NewFromConfig(awsCfg)
-
-		startOpts := ecssvc.StartServiceOptions{
-			ClusterName:    clusterName,
-			ServiceName:    serviceName,
-			MinCapacity:    minCapacity,
-			MaxCapacity:    maxCapacity,
-			TimeoutSeconds: timeoutSeconds,
-		}
-		err = ecssvc.StartEcsService(ecsClient, aasClient, startOpts)
-		if err != nil {
-			return err
-		}
-		return nil
+		return startEcsService(cmd, ecssvc.Service{ClusterName: clusterName, ServiceName: serviceName})
 	},
 	SilenceUsage: true,
 }
@@ -138,13 +146,21 @@ var ecsStopCmd = &cobra.Command{
 	Short: "ECSサービスを停止するコマンド",
 	Long: `ECSサービスの最小・最大キャパシティを0に設定して停止するコマンドです。
 CloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。
+--tag を指定すると、全クラスターのうちタグ条件に一致するサービスをまとめて停止できます。
 サービスが完全に停止するまで必ず待機します。待機タイムアウトは-t/--timeoutで秒数指定できます（デフォルト: 300秒）。
 
 例:
   ` + AppName + ` ecs stop -P my-profile -S my-stack
   ` + AppName + ` ecs stop -P my-profile -c my-cluster -s my-service
-  ` + AppName + ` ecs stop -P my-profile -S my-stack`,
+  ` + AppName + ` ecs stop -P my-profile -S my-stack
+  ` + AppName + ` ecs stop -P my-profile --tag env=dev`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if hasTagFilters() {
+			return runForTaggedEcsServices(cmd, func(svc ecssvc.Service) error {
+				return stopEcsService(cmd, svc)
+			})
+		}
+
 		var err error
 
 		resolveStackName()
@@ -154,27 +170,65 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
 			ServiceName: serviceName,
 		}
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cfnClient, opts)
+		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cmd.Context(), cfnClient, opts)
 		if err != nil {
 			return err
 		}
 
-		aasClient := applicationautoscaling.NewFromConfig(awsCfg)
-
-		stopOpts := ecssvc.StopServiceOptions{
-			ClusterName:    clusterName,
-			ServiceName:    serviceName,
-			TimeoutSeconds: timeoutSeconds,
-		}
-		err = ecssvc.StopEcsService(ecsClient, aasClient, stopOpts)
-		if err != nil {
-			return err
-		}
-		return nil
+		return stopEcsService(cmd, ecssvc.Service{ClusterName: clusterName, ServiceName: serviceName})
 	},
 	SilenceUsage: true,
 }
 
+// startEcsService はECSサービスを1つ起動する
+func startEcsService(cmd *cobra.Command, svc ecssvc.Service) error {
+	aasClient := applicationautoscaling.NewFromConfig(awsCfg)
+
+	startOpts := ecssvc.StartServiceOptions{
+		ClusterName:    svc.ClusterName,
+		ServiceName:    svc.ServiceName,
+		MinCapacity:    minCapacity,
+		MaxCapacity:    maxCapacity,
+		TimeoutSeconds: timeoutSeconds,
+	}
+	return ecssvc.StartEcsService(cmd.Context(), ecsClient, aasClient, startOpts)
+}
+
+// stopEcsService はECSサービスを1つ停止する
+func stopEcsService(cmd *cobra.Command, svc ecssvc.Service) error {
+	aasClient := applicationautoscaling.NewFromConfig(awsCfg)
+
+	stopOpts := ecssvc.StopServiceOptions{
+		ClusterName:    svc.ClusterName,
+		ServiceName:    svc.ServiceName,
+		TimeoutSeconds: timeoutSeconds,
+	}
+	return ecssvc.StopEcsService(cmd.Context(), ecsClient, aasClient, stopOpts)
+}
+
+// runForTaggedEcsServices はタグ条件に一致する各ECSサービスに操作を実行する
+func runForTaggedEcsServices(cmd *cobra.Command, run func(svc ecssvc.Service) error) error {
+	tags, err := tagSelector()
+	if err != nil {
+		return err
+	}
+	services, err := ecssvc.FindServicesByTags(cmd.Context(), ecsClient, tags)
+	if err != nil {
+		return err
+	}
+
+	ids := make([]string, len(services))
+	byId := make(map[string]ecssvc.Service, len(services))
+	for i, svc := range services {
+		ids[i] = svc.ClusterName + "/" + svc.ServiceName
+		byId[ids[i]] = svc
+	}
+	return runForTagged(i18n.T("ECSサービス"), ids, func(id string) error {
+		logging.Infof(i18n.T("🚀 ECSサービス (%s) を処理します...\n"), id)
+		return run(byId[id])
+	})
+}
+
 // ecsRunCmd はECSタスクを実行してその完了を待機するコマンドです
 var ecsRunCmd = &cobra.Command{
 	Use:   "run",
@@ -198,7 +252,7 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
 			ServiceName: serviceName,
 		}
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cfnClient, opts)
+		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cmd.Context(), cfnClient, opts)
 		if err != nil {
 			return err
 		}
@@ -214,16 +268,16 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
 		}
 
 		// タスクを実行して完了を待機
-		fmt.Println("🚀 ECSタスクを実行します...")
-		exitCode, err := ecssvc.RunAndWaitForTask(ecsClient, runOpts)
+		logging.Infof("%s\n", i18n.T("🚀 ECSタスクを実行します..."))
+		exitCode, err := ecssvc.RunAndWaitForTask(cmd.Context(), ecsClient, runOpts)
 		if err != nil {
-			return fmt.Errorf("❌ タスク実行エラー: %w", err)
+			return fmt.Errorf(i18n.T("❌ タスク実行エラー: %w"), err)
 		}
 
-		fmt.Printf("✅ タスクが完了しました。終了コード: %d\n", exitCode)
+		logging.Infof(i18n.T("✅ タスクが完了しました。終了コード: %d\n"), exitCode)
 		// 終了コードが0以外の場合はエラーとして扱う
 		if exitCode != 0 {
-			return fmt.Errorf("❌ タスクが異常終了しました。終了コード: %d", exitCode)
+			return fmt.Errorf(i18n.T("❌ タスクが異常終了しました。終了コード: %d"), exitCode)
 		}
 		return nil
 	},
@@ -253,15 +307,15 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
 			ServiceName: serviceName,
 		}
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cfnClient, opts)
+		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cmd.Context(), cfnClient, opts)
 		if err != nil {
 			return err
 		}
 
 		// 強制再デプロイを実行
-		err = ecssvc.ForceRedeployService(ecsClient, clusterName, serviceName)
+		err = ecssvc.ForceRedeployService(cmd.Context(), ecsClient, clusterName, serviceName)
 		if err != nil {
-			return fmt.Errorf("❌ エラー: %w", err)
+			return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
 		}
 
 		// --no-waitフラグが指定されていない場合はデプロイ完了まで待機
@@ -272,9 +326,9 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
 				ServiceName:    serviceName,
 				TimeoutSeconds: timeoutSeconds,
 			}
-			err = ecssvc.WaitForDeploymentComplete(ecsClient, waitOpts)
+			err = ecssvc.WaitForDeploymentComplete(cmd.Context(), ecsClient, waitOpts)
 			if err != nil {
-				return fmt.Errorf("❌ デプロイ完了待機エラー: %w", err)
+				return fmt.Errorf(i18n.T("❌ デプロイ完了待機エラー: %w"), err)
 			}
 		}
 		return nil
@@ -302,7 +356,7 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
 			ServiceName: serviceName,
 		}
 		cfnClient := cloudformation.NewFromConfig(awsCfg)
-		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cfnClient, opts)
+		clusterName, serviceName, err = ecssvc.ResolveClusterAndService(cmd.Context(), cfnClient, opts)
 		if err != nil {
 			return err
 		}
@@ -315,9 +369,9 @@ CloudFormationスタック名を指定するか、クラスター名とサービ
 		}
 
 		// サービス状態を取得
-		status, err := ecssvc.GetServiceStatus(ecsClient, aasClient, statusOpts)
+		status, err := ecssvc.GetServiceStatus(cmd.Context(), ecsClient, aasClient, statusOpts)
 		if err != nil {
-			return fmt.Errorf("❌ エラー: %w", err)
+			return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
 		}
 
 		// 状態を表示
@@ -355,6 +409,9 @@ func init() {
 	ecsStartCmd.MarkFlagsMutuallyExclusive("stack-name", "cluster")
 	ecsStartCmd.MarkFlagsMutuallyExclusive("stack-name", "service")
 	ecsStartCmd.MarkFlagsRequiredTogether("cluster", "service")
+	addTagFlag(ecsStartCmd)
+	ecsStartCmd.MarkFlagsMutuallyExclusive("stack-name", "tag")
+	ecsStartCmd.MarkFlagsMutuallyExclusive("cluster", "tag")
 
 	// stopコマンドのフラグを設定
 	ecsStopCmd.Flags().StringVarP(&stackName, "stack-name", "S", "", "CloudFormationスタック名")
@@ -364,6 +421,9 @@ func init() {
 	ecsStopCmd.MarkFlagsMutuallyExclusive("stack-name", "cluster")
 	ecsStopCmd.MarkFlagsMutuallyExclusive("stack-name", "service")
 	ecsStopCmd.MarkFlagsRequiredTogether("cluster", "service")
+	addTagFlag(ecsStopCmd)
+	ecsStopCmd.MarkFlagsMutuallyExclusive("stack-name", "tag")
+	ecsStopCmd.MarkFlagsMutuallyExclusive("cluster", "tag")
 
 	// runコマンドのフラグを設定
 	ecsRunCmd.Flags().StringVarP(&stackName, "stack-name", "S", "", "CloudFormationスタック名")
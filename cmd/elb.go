@@ -1,7 +1,9 @@
 package cmd
 
 import (
-	elbsvc "awstk/internal/service/elb"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	elbsvc "github.com/ngsw877/awstk/internal/service/elb"
 
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/spf13/cobra"
@@ -46,6 +48,8 @@ var elbLsCmd = &cobra.Command{
   ` + AppName + ` elb ls --type gwlb        # GWLBのみを表示
   ` + AppName + ` elb ls -p                 # 削除保護が有効なもののみを表示
   ` + AppName + ` elb ls --details          # 詳細情報付きで表示
+  ` + AppName + ` elb ls --regions all      # 有効な全リージョンを検索
+  ` + AppName + ` elb ls --tag env=dev      # タグが一致するもののみを表示
 
 【例】
   ` + AppName + ` elb ls --type nlb -p
@@ -54,14 +58,29 @@ var elbLsCmd = &cobra.Command{
 		protectedOnly, _ := cmdCobra.Flags().GetBool("protected-only")
 		showDetails, _ := cmdCobra.Flags().GetBool("details")
 		lbType, _ := cmdCobra.Flags().GetString("type")
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
 
 		opts := elbsvc.ListOptions{
 			ProtectedOnly:    protectedOnly,
 			ShowDetails:      showDetails,
 			LoadBalancerType: lbType,
+			Tags:             tags,
 		}
 
-		return elbsvc.ListLoadBalancers(elbv2Client, opts)
+		if isMultiTarget() {
+			targets, err := resolveTargets(cmdCobra.Context())
+			if err != nil {
+				return err
+			}
+			return elbsvc.ListLoadBalancersAcross(cmdCobra.Context(), targets, func(t common.Target) *elasticloadbalancingv2.Client {
+				return elasticloadbalancingv2.NewFromConfig(targetConfig(t))
+			}, opts)
+		}
+
+		return elbsvc.ListLoadBalancers(cmdCobra.Context(), elbv2Client, opts)
 	},
 	SilenceUsage: true,
 }
@@ -71,21 +90,35 @@ var elbDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "ロードバランサーを削除するコマンド",
 	Long: `指定したキーワードを含むロードバランサー（ALB/NLB/GWLB）を削除します。
+--tag を指定すると、タグ条件にも一致するロードバランサーのみを削除します。
 削除保護が有効な場合は --force オプションで保護を解除して削除できます。
 
 例:
   ` + AppName + ` elb delete -s "test-" -P my-profile
   ` + AppName + ` elb delete -s "dev" --type alb
   ` + AppName + ` elb delete -s "stg" --with-target-groups
-  ` + AppName + ` elb delete -s "prod" --force    # 削除保護を解除して削除`,
+  ` + AppName + ` elb delete -s "prod" --force    # 削除保護を解除して削除
+  ` + AppName + ` elb delete --tag env=dev --tag '!keep'   # タグで指定
+  ` + AppName + ` elb delete -s "test-" --plan-out plan.json   # 削除せずにプランを作成`,
 	RunE: func(cmd *cobra.Command, args []string) error {
 		search, _ := cmd.Flags().GetString("search")
 		withTargetGroups, _ := cmd.Flags().GetBool("with-target-groups")
 		lbType, _ := cmd.Flags().GetString("type")
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
 
-		printAwsContextWithInfo("検索文字列", search)
+		printAwsContextWithInfo(i18n.T("検索文字列"), search)
 
-		return elbsvc.DeleteLoadBalancersByFilter(elbv2Client, search, withTargetGroups, lbType, elbDeleteExact, elbDeleteForce)
+		p := newPlanIfRequested()
+		if err := elbsvc.DeleteLoadBalancersByFilter(cmd.Context(), elbv2Client, search, withTargetGroups, lbType, elbDeleteExact, elbDeleteForce, tags, p); err != nil {
+			return err
+		}
+		if p != nil {
+			return writePlan(cmd.Context(), p)
+		}
+		return nil
 	},
 	SilenceUsage: true,
 }
@@ -99,6 +132,8 @@ func init() {
 	elbLsCmd.Flags().BoolP("protected-only", "p", false, "削除保護が有効なもののみを表示")
 	elbLsCmd.Flags().BoolP("details", "d", false, "詳細情報を表示")
 	elbLsCmd.Flags().String("type", "", "ロードバランサータイプでフィルタ (alb, nlb, gwlb)")
+	addTargetFlags(elbLsCmd)
+	addTagFlag(elbLsCmd)
 
 	// delete コマンドのフラグ
 	elbDeleteCmd.Flags().StringP("search", "s", "", "削除対象の検索パターン")
@@ -106,5 +141,8 @@ func init() {
 	elbDeleteCmd.Flags().String("type", "", "ロードバランサータイプでフィルタ (alb, nlb, gwlb)")
 	elbDeleteCmd.Flags().BoolVar(&elbDeleteExact, "exact", false, "大文字小文字を区別してマッチ")
 	elbDeleteCmd.Flags().BoolVar(&elbDeleteForce, "force", false, "削除保護を解除して削除")
-	_ = elbDeleteCmd.MarkFlagRequired("search")
+	addTagFlag(elbDeleteCmd)
+	elbDeleteCmd.MarkFlagsOneRequired("search", "tag")
+	addPlanOutFlag(elbDeleteCmd)
+	addGuardrailFlag(elbDeleteCmd)
 }
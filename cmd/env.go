@@ -1,9 +1,15 @@
 package cmd
 
 import (
-	"awstk/internal/service/env"
+	"errors"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/config"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/env"
+
 	"github.com/spf13/cobra"
 )
 
@@ -49,10 +55,10 @@ var envSetCmd = &cobra.Command{
 		}
 
 		if len(commands) == 0 {
-			return fmt.Errorf("❌ エラー: -S (スタック名) または -P (プロファイル) を指定してください")
+			return errors.New(i18n.T("❌ エラー: -S (スタック名) または -P (プロファイル) を指定してください"))
 		}
 
-		fmt.Println("✅ 以下のコマンドを実行して環境変数を設定してください：")
+		logging.Infof("%s\n", i18n.T("✅ 以下のコマンドを実行して環境変数を設定してください："))
 		for _, cmd := range commands {
 			fmt.Println(cmd)
 		}
@@ -62,15 +68,31 @@ var envSetCmd = &cobra.Command{
 
 var envShowCmd = &cobra.Command{
 	Use:   "show",
-	Short: "環境変数の現在値を表示",
-	Long: `現在設定されているAWS関連の環境変数を表示します。
+	Short: "環境変数と有効な設定を表示",
+	Long: `現在設定されているAWS関連の環境変数と、フラグ・環境変数・設定ファイルから
+解決した有効な設定（プロファイル、リージョン、スタック名など）とその取得元を表示します。
+
+設定ファイルはユーザー設定ディレクトリの awstk/` + config.UserFileName + ` と、
+カレントディレクトリから親ディレクトリへ探索した ` + config.FileName + ` を読み込みます。
 
 例:
-  ` + AppName + ` env show`,
+  ` + AppName + ` env show
+  ` + AppName + ` env show --env dev
+  ` + AppName + ` env show --output json`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		env.ShowAllVariables()
-		return nil
+		// 設定ファイルの読み込みに失敗していた場合はエラー内容を表示する
+		if resolvedConfig == nil {
+			if err := applyConfig(cmd, args); err != nil {
+				return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+			}
+		}
+		if !common.IsStructuredOutput() {
+			env.ShowAllVariables()
+			fmt.Println()
+		}
+		return env.ShowResolvedConfig(resolvedConfig)
 	},
+	SilenceUsage: true,
 }
 
 var envUnsetCmd = &cobra.Command{
@@ -105,10 +127,10 @@ var envUnsetCmd = &cobra.Command{
 		}
 
 		if len(commands) == 0 {
-			return fmt.Errorf("❌ エラー: -S (スタック名) または -P (プロファイル) を指定してください")
+			return errors.New(i18n.T("❌ エラー: -S (スタック名) または -P (プロファイル) を指定してください"))
 		}
 
-		fmt.Println("✅ 以下のコマンドを実行して環境変数を削除してください：")
+		logging.Infof("%s\n", i18n.T("✅ 以下のコマンドを実行して環境変数を削除してください："))
 		for _, cmd := range commands {
 			fmt.Println(cmd)
 		}
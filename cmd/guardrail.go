@@ -0,0 +1,29 @@
+package cmd
+
+import (
+	"github.com/ngsw877/awstk/internal/config"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/spf13/cobra"
+)
+
+// overrideGuardrail は --override-guardrail が指定されたか
+var overrideGuardrail bool
+
+// addGuardrailFlag はリソースを削除・停止するコマンドに --override-guardrail フラグを追加する
+func addGuardrailFlag(cmd *cobra.Command) {
+	cmd.Flags().BoolVar(&overrideGuardrail, "override-guardrail", false, "ガードレールに違反するリソースも、リソース名を入力して確認したうえで削除する")
+}
+
+// setupGuardrail は設定ファイルのガードレールと環境の保護パターンから、削除前に適用するポリシーを設定する
+func setupGuardrail(resolved *config.Resolved) {
+	deny := append(append([]string{}, resolved.Guardrail.Deny...), resolved.Environment.Protected...)
+	guardrail.SetPolicy(guardrail.NewPolicy(deny, resolved.Guardrail.RequiredTags, !resolved.Guardrail.DisableDefaults))
+	guardrail.SetTagClient(func(t common.Target) tagging.TaggingApi {
+		return resourcegroupstaggingapi.NewFromConfig(targetConfig(t))
+	})
+	guardrail.SetOverride(overrideGuardrail)
+}
@@ -0,0 +1,54 @@
+package cmd
+
+import (
+	"github.com/ngsw877/awstk/internal/journal"
+	historysvc "github.com/ngsw877/awstk/internal/service/history"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	historySearch  string
+	historySince   string
+	historyLimit   int
+	historyFailed  bool
+	historyDetails bool
+)
+
+// historyCmd represents the history command
+var historyCmd = &cobra.Command{
+	Use:   "history",
+	Short: "変更系コマンドの実行履歴を表示するコマンド",
+	Long: `削除・起動・停止などの変更系コマンドの実行履歴を新しい順に表示します。
+実行履歴はユーザー設定ディレクトリ配下の awstk/journal.jsonl に追記形式で記録されます。
+記録先は環境変数 ` + journal.PathEnv + ` で変更でき、off を指定すると記録しません。
+
+例:
+  ` + AppName + ` history                       # 直近20件の実行履歴を表示
+  ` + AppName + ` history -s test --since 7d    # 直近7日間で "test" を含む実行を表示
+  ` + AppName + ` history --failed -d           # 失敗を含む実行をリソース別に表示
+  ` + AppName + ` history -n 0 --output json    # 全件をJSONで出力`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		since, err := historysvc.ParseSince(historySince)
+		if err != nil {
+			return err
+		}
+		return historysvc.ShowHistory(historysvc.Options{
+			Search:     historySearch,
+			Since:      since,
+			Limit:      historyLimit,
+			FailedOnly: historyFailed,
+			Details:    historyDetails,
+		})
+	},
+	SilenceUsage: true,
+}
+
+func init() {
+	RootCmd.AddCommand(historyCmd)
+	historyCmd.Flags().StringVarP(&historySearch, "search", "s", "", "コマンドラインまたはリソース名に含まれる文字列で絞り込み")
+	historyCmd.Flags().StringVar(&historySince, "since", "", "指定期間内の実行のみ表示（例: 30m, 12h, 7d）")
+	historyCmd.Flags().IntVarP(&historyLimit, "limit", "n", 20, "表示する最大件数（0で無制限）")
+	historyCmd.Flags().BoolVar(&historyFailed, "failed", false, "失敗・中断を含む実行のみ表示")
+	historyCmd.Flags().BoolVarP(&historyDetails, "details", "d", false, "リソースごとの処理結果を表示")
+}
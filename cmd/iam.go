@@ -1,8 +1,8 @@
 package cmd
 
 import (
-	imPolicy "awstk/internal/service/iam/policy"
-	imRole "awstk/internal/service/iam/role"
+	imPolicy "github.com/ngsw877/awstk/internal/service/iam/policy"
+	imRole "github.com/ngsw877/awstk/internal/service/iam/role"
 
 	awsiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/spf13/cobra"
@@ -60,11 +60,17 @@ var iamRoleLsCmd = &cobra.Command{
   ` + AppName + ` iam role ls                 # 全ロール（最終使用日時つき）
   ` + AppName + ` iam role ls -u               # 一度も使用されていないロールのみ
   ` + AppName + ` iam role ls -u 180          # 180日以上未使用のロールのみ
-  ` + AppName + ` iam role ls -x AWSServiceRoleFor -x AWSReservedSSO`,
+  ` + AppName + ` iam role ls -x AWSServiceRoleFor -x AWSReservedSSO
+  ` + AppName + ` iam role ls --tag env=dev     # タグで絞り込み`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return imRole.ListIamRoles(iamClient, imRole.ListOptions{
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		return imRole.ListIamRoles(cmd.Context(), iamClient, imRole.ListOptions{
 			UnusedDays: iamRoleUnusedDays,
 			Exclude:    iamRoleExclude,
+			Tags:       tags,
 		})
 	},
 	SilenceUsage: true,
@@ -84,11 +90,17 @@ var iamPolicyLsCmd = &cobra.Command{
 例:
   ` + AppName + ` iam policy ls               # 全カスタマー管理ポリシー
   ` + AppName + ` iam policy ls --unattached  # 未アタッチのみ
-  ` + AppName + ` iam policy ls -x AWSReserved`,
+  ` + AppName + ` iam policy ls -x AWSReserved
+  ` + AppName + ` iam policy ls --tag env=dev # タグで絞り込み`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return imPolicy.ListIamPolicies(iamClient, imPolicy.ListOptions{
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		return imPolicy.ListIamPolicies(cmd.Context(), iamClient, imPolicy.ListOptions{
 			UnattachedOnly: iamPolicyUnattached,
 			Exclude:        iamPolicyExclude,
+			Tags:           tags,
 		})
 	},
 	SilenceUsage: true,
@@ -97,20 +109,36 @@ var iamPolicyLsCmd = &cobra.Command{
 var iamRoleDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "IAMロールを削除",
-	Long: `検索パターンに一致するIAMロールを削除します。
+	Long: `検索パターン・タグ条件に一致するIAMロールを削除します。
 
 例:
   ` + AppName + ` iam role delete -s "test-*"              # パターンマッチで削除
   ` + AppName + ` iam role delete -s "test" -u 180         # 180日未使用 AND "test"含む
   ` + AppName + ` iam role delete -s "test" -u             # 一度も未使用 AND "test"含む
-  ` + AppName + ` iam role delete -s "test" -x AWSReserved # 除外パターン指定`,
+  ` + AppName + ` iam role delete -s "test" -x AWSReserved # 除外パターン指定
+  ` + AppName + ` iam role delete --tag env=dev --tag '!keep' # タグ条件に一致するロールを削除
+  ` + AppName + ` iam role delete -s "test" --plan-out plan.json # 削除せずにプランを作成`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return imRole.DeleteRoles(iamClient, imRole.DeleteOptions{
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		p := newPlanIfRequested()
+		err = imRole.DeleteRoles(cmd.Context(), iamClient, imRole.DeleteOptions{
 			Filter:     iamRoleDeleteSearch,
+			Tags:       tags,
 			UnusedDays: iamRoleDeleteUnusedDays,
 			Exclude:    iamRoleDeleteExclude,
 			Exact:      iamRoleDeleteExact,
+			Plan:       p,
 		})
+		if err != nil {
+			return err
+		}
+		if p != nil {
+			return writePlan(cmd.Context(), p)
+		}
+		return nil
 	},
 	SilenceUsage: true,
 }
@@ -118,19 +146,35 @@ var iamRoleDeleteCmd = &cobra.Command{
 var iamPolicyDeleteCmd = &cobra.Command{
 	Use:   "delete",
 	Short: "IAMポリシーを削除",
-	Long: `検索パターンに一致するカスタマー管理ポリシーを削除します。
+	Long: `検索パターン・タグ条件に一致するカスタマー管理ポリシーを削除します。
 
 例:
   ` + AppName + ` iam policy delete -s "test-*"              # パターンマッチで削除
   ` + AppName + ` iam policy delete -s "test" --unattached   # 未アタッチ AND "test"含む
-  ` + AppName + ` iam policy delete -s "test" -x AWSReserved # 除外パターン指定`,
+  ` + AppName + ` iam policy delete -s "test" -x AWSReserved # 除外パターン指定
+  ` + AppName + ` iam policy delete --tag env=dev            # タグ条件に一致するポリシーを削除
+  ` + AppName + ` iam policy delete -s "test" --plan-out plan.json # 削除せずにプランを作成`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return imPolicy.DeletePolicies(iamClient, imPolicy.DeleteOptions{
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		p := newPlanIfRequested()
+		err = imPolicy.DeletePolicies(cmd.Context(), iamClient, imPolicy.DeleteOptions{
 			Filter:         iamPolicyDeleteSearch,
+			Tags:           tags,
 			UnattachedOnly: iamPolicyDeleteUnattached,
 			Exclude:        iamPolicyDeleteExclude,
 			Exact:          iamPolicyDeleteExact,
+			Plan:           p,
 		})
+		if err != nil {
+			return err
+		}
+		if p != nil {
+			return writePlan(cmd.Context(), p)
+		}
+		return nil
 	},
 	SilenceUsage: true,
 }
@@ -150,25 +194,34 @@ func init() {
 		unusedDaysFlag.NoOptDefVal = "-1"
 	}
 	iamRoleLsCmd.Flags().StringSliceVarP(&iamRoleExclude, "exclude", "x", []string{}, "除外パターン（名前に含む文字列、複数指定可）")
+	addTagFlag(iamRoleLsCmd)
 
 	// iam role delete flags
-	iamRoleDeleteCmd.Flags().StringVarP(&iamRoleDeleteSearch, "search", "s", "", "削除対象の検索パターン（必須）")
-	_ = iamRoleDeleteCmd.MarkFlagRequired("search")
+	iamRoleDeleteCmd.Flags().StringVarP(&iamRoleDeleteSearch, "search", "s", "", "削除対象の検索パターン")
+	addTagFlag(iamRoleDeleteCmd)
+	// search と tag はどちらか1つ必須（両方指定した場合は両方に一致するもの）
+	iamRoleDeleteCmd.MarkFlagsOneRequired("search", "tag")
 	iamRoleDeleteCmd.Flags().IntVarP(&iamRoleDeleteUnusedDays, "unused-days", "u", 0, "未使用とみなす経過日数（引数なし=一度も使用なし、数値指定=指定日数以上未使用、0=全件）")
 	if unusedDaysFlag := iamRoleDeleteCmd.Flags().Lookup("unused-days"); unusedDaysFlag != nil {
 		unusedDaysFlag.NoOptDefVal = "-1"
 	}
 	iamRoleDeleteCmd.Flags().StringSliceVarP(&iamRoleDeleteExclude, "exclude", "x", []string{}, "除外パターン（名前に含む文字列、複数指定可）")
 	iamRoleDeleteCmd.Flags().BoolVar(&iamRoleDeleteExact, "exact", false, "大文字小文字を区別してマッチ")
+	addPlanOutFlag(iamRoleDeleteCmd)
+	addGuardrailFlag(iamRoleDeleteCmd)
 
 	// iam policy ls flags
 	iamPolicyLsCmd.Flags().BoolVarP(&iamPolicyUnattached, "unattached", "u", false, "未アタッチのポリシーのみ表示")
 	iamPolicyLsCmd.Flags().StringSliceVarP(&iamPolicyExclude, "exclude", "x", []string{}, "除外パターン（名前に含む文字列、複数指定可）")
+	addTagFlag(iamPolicyLsCmd)
 
 	// iam policy delete flags
-	iamPolicyDeleteCmd.Flags().StringVarP(&iamPolicyDeleteSearch, "search", "s", "", "削除対象の検索パターン（必須）")
-	_ = iamPolicyDeleteCmd.MarkFlagRequired("search")
+	iamPolicyDeleteCmd.Flags().StringVarP(&iamPolicyDeleteSearch, "search", "s", "", "削除対象の検索パターン")
+	addTagFlag(iamPolicyDeleteCmd)
+	iamPolicyDeleteCmd.MarkFlagsOneRequired("search", "tag")
 	iamPolicyDeleteCmd.Flags().BoolVarP(&iamPolicyDeleteUnattached, "unattached", "u", false, "未アタッチのポリシーのみ削除")
 	iamPolicyDeleteCmd.Flags().StringSliceVarP(&iamPolicyDeleteExclude, "exclude", "x", []string{}, "除外パターン（名前に含む文字列、複数指定可）")
 	iamPolicyDeleteCmd.Flags().BoolVar(&iamPolicyDeleteExact, "exact", false, "大文字小文字を区別してマッチ")
+	addPlanOutFlag(iamPolicyDeleteCmd)
+	addGuardrailFlag(iamPolicyDeleteCmd)
 }
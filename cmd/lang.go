@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+)
+
+// langFlag は --lang で指定された表示言語
+var langFlag string
+
+// setupLanguage は表示言語を決定し、コマンドのヘルプ文を翻訳する
+// ヘルプ表示は PersistentPreRunE を経由せずに行われるため、コマンドの実行前に引数から --lang を読み取る
+func setupLanguage(args []string) error {
+	lang, err := i18n.Detect(langFromArgs(args))
+	if err != nil {
+		return err
+	}
+	i18n.SetLang(lang)
+	LocalizeCommands(RootCmd)
+	return nil
+}
+
+// langFromArgs はコマンドライン引数から --lang の値を取り出す（指定がなければ空文字）
+func langFromArgs(args []string) string {
+	for i, arg := range args {
+		if arg == "--" {
+			break
+		}
+		if arg == "--lang" && i+1 < len(args) {
+			return args[i+1]
+		}
+		if value, ok := strings.CutPrefix(arg, "--lang="); ok {
+			return value
+		}
+	}
+	return ""
+}
+
+// LocalizeCommands はコマンドツリーのヘルプ文とフラグの説明を現在の表示言語に翻訳する
+// コマンドとフラグはパッケージの初期化時に日本語で定義されるため、表示言語の決定後に呼び出す
+func LocalizeCommands(c *cobra.Command) {
+	c.Use = i18n.T(c.Use)
+	c.Short = i18n.T(c.Short)
+	c.Long = i18n.T(c.Long)
+	c.Example = i18n.T(c.Example)
+
+	localizeFlag := func(f *pflag.Flag) {
+		f.Usage = i18n.T(f.Usage)
+	}
+	c.LocalFlags().VisitAll(localizeFlag)
+	c.PersistentFlags().VisitAll(localizeFlag)
+
+	for _, sub := range c.Commands() {
+		LocalizeCommands(sub)
+	}
+}
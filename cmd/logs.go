@@ -1,10 +1,14 @@
 package cmd
 
 import (
-	"awstk/internal/service/common"
-	logssvc "awstk/internal/service/logs"
+	"errors"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	logssvc "github.com/ngsw877/awstk/internal/service/logs"
+	"github.com/ngsw877/awstk/internal/service/selector"
+
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/spf13/cobra"
 )
@@ -38,8 +42,10 @@ var logsDeleteCmd = &cobra.Command{
 	Use:   "delete [log-group-names...]",
 	Short: "CloudWatch Logsグループを削除するコマンド",
 	Long: `指定したCloudWatch Logsグループを削除します。
-ロググループ名の直接指定と検索パターンの両方に対応しています。
+ロググループ名の直接指定と検索パターン・タグ条件による指定に対応しています。
+検索パターン・タグ条件で指定したロググループは、--older-than / --newer-than で作成日時（--age-by last-activity の場合は最終イベント日時）による絞り込みもできます。
 削除保護が有効な場合は --force オプションで保護を解除して削除できます。
+いずれも指定しない場合は、ロググループ一覧から対話的に選択できます（Tab で複数選択）。
 
 【使い方】
   ` + AppName + ` logs delete my-log-group                    # 単一のロググループを削除
@@ -49,6 +55,9 @@ var logsDeleteCmd = &cobra.Command{
   ` + AppName + ` logs delete --search "*" --empty-only       # 空のロググループをすべて削除
   ` + AppName + ` logs delete --search "*" --no-retention     # 保存期間未設定のロググループを削除
   ` + AppName + ` logs delete -s "prod-*" --force             # 削除保護を解除して削除
+  ` + AppName + ` logs delete --tag env=dev --tag '!keep'     # タグ条件に一致するロググループを削除
+  ` + AppName + ` logs delete -s "/aws/lambda/*" --older-than 30d --age-by last-activity  # 30日以上ログが出力されていないものを削除
+  ` + AppName + ` logs delete -s "test-*" --plan-out plan.json # 削除せずにプランを作成
 
 【例】
   ` + AppName + ` logs delete /aws/lambda/my-function
@@ -61,10 +70,26 @@ var logsDeleteCmd = &cobra.Command{
 		emptyOnly, _ := cmdCobra.Flags().GetBool("empty-only")
 		noRetention, _ := cmdCobra.Flags().GetBool("no-retention")
 
-		// 引数も検索パターンも指定されていない場合はエラー
-		if len(args) == 0 && search == "" {
-			return fmt.Errorf("削除対象のロググループ名または検索パターンを指定してください")
+		// 引数も検索パターンもタグ条件も指定されていない場合は、端末であれば一覧から選択させる
+		if len(args) == 0 && search == "" && !hasTagFilters() {
+			if !selector.Available() {
+				return errors.New(i18n.T("削除対象のロググループ名、検索パターン、またはタグ条件を指定してください"))
+			}
+			selected, err := logssvc.SelectLogGroups(cmdCobra.Context(), logsClient, i18n.T("削除するロググループを選択してください"))
+			if err != nil {
+				return err
+			}
+			args = selected
+		}
+		tags, err := tagSelector()
+		if err != nil {
+			return err
 		}
+		age, err := ageFilter()
+		if err != nil {
+			return err
+		}
+		printAgeFilter(age)
 
 		opts := logssvc.DeleteOptions{
 			Filter:      search,
@@ -73,9 +98,18 @@ var logsDeleteCmd = &cobra.Command{
 			NoRetention: noRetention,
 			Exact:       logsDeleteExact,
 			Force:       logsDeleteForce,
+			Tags:        tags,
+			Age:         age,
+			Plan:        newPlanIfRequested(),
 		}
 
-		return logssvc.DeleteLogGroups(logsClient, opts)
+		if err := logssvc.DeleteLogGroups(cmdCobra.Context(), logsClient, opts); err != nil {
+			return err
+		}
+		if opts.Plan != nil {
+			return writePlan(cmdCobra.Context(), opts.Plan)
+		}
+		return nil
 	},
 	SilenceUsage: true,
 }
@@ -93,6 +127,8 @@ var logsLsCmd = &cobra.Command{
   ` + AppName + ` logs ls -n                 # 保存期間が未設定のログのみを表示
   ` + AppName + ` logs ls --details          # 詳細情報付きで表示
   ` + AppName + ` logs ls -e -n              # 空かつ保存期間未設定のログを表示
+  ` + AppName + ` logs ls --regions all      # 有効な全リージョンを検索
+  ` + AppName + ` logs ls --tag env=dev      # タグが一致するロググループのみを表示
 
 【例】
   ` + AppName + ` logs ls -e
@@ -104,15 +140,40 @@ var logsLsCmd = &cobra.Command{
 		emptyOnly, _ := cmdCobra.Flags().GetBool("empty-only")
 		noRetention, _ := cmdCobra.Flags().GetBool("no-retention")
 		showDetails, _ := cmdCobra.Flags().GetBool("details")
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+
+		if isMultiTarget() {
+			targets, err := resolveTargets(cmdCobra.Context())
+			if err != nil {
+				return err
+			}
+			opts := logssvc.ListOptions{
+				EmptyOnly:   emptyOnly,
+				NoRetention: noRetention,
+				ShowDetails: showDetails,
+				Tags:        tags,
+			}
+			return logssvc.ListLogGroupsAcross(cmdCobra.Context(), targets, func(t common.Target) logssvc.LogsApi {
+				return cloudwatchlogs.NewFromConfig(targetConfig(t))
+			}, opts)
+		}
 
 		// ログループ一覧を取得
-		logGroups, err := logssvc.ListLogGroups(logsClient)
+		logGroups, err := logssvc.ListLogGroups(cmdCobra.Context(), logsClient)
+		if err != nil {
+			return common.FormatListError(i18n.T("CloudWatch Logsグループ"), err)
+		}
+
+		logGroups, err = logssvc.SelectLogGroupsByTags(cmdCobra.Context(), tags, common.Target{}, logGroups)
 		if err != nil {
-			return common.FormatListError("CloudWatch Logsグループ", err)
+			return common.FormatListError(i18n.T("CloudWatch Logsグループ"), err)
 		}
 
 		if len(logGroups) == 0 {
-			fmt.Println(common.FormatEmptyMessage("CloudWatch Logsグループ"))
+			common.PrintEmptyResult(common.FormatEmptyMessage(i18n.T("CloudWatch Logsグループ")))
 			return nil
 		}
 
@@ -121,18 +182,21 @@ var logsLsCmd = &cobra.Command{
 		var conditions []string
 
 		if emptyOnly {
-			conditions = append(conditions, "空の")
+			conditions = append(conditions, i18n.T("空の"))
 			filteredGroups = logssvc.FilterEmptyLogGroups(filteredGroups)
 		}
 		if noRetention {
-			conditions = append(conditions, "保存期間未設定の")
+			conditions = append(conditions, i18n.T("保存期間未設定の"))
 			filteredGroups = logssvc.FilterNoRetentionLogGroups(filteredGroups)
 		}
 
-		title := common.GenerateFilteredTitle("CloudWatch Logsグループ", conditions...)
+		title := common.GenerateFilteredTitle(i18n.T("CloudWatch Logsグループ"), conditions...)
 
 		// 結果表示
-		if !showDetails {
+		if showDetails && common.IsStructuredOutput() {
+			// 構造化出力の場合は詳細情報をレコードとして出力
+			logssvc.DisplayLogGroupRecords(filteredGroups)
+		} else if !showDetails {
 			// シンプル表示
 			names := make([]string, len(filteredGroups))
 			for i, group := range filteredGroups {
@@ -141,20 +205,20 @@ var logsLsCmd = &cobra.Command{
 			common.PrintSimpleList(common.ListOutput{
 				Title:        title,
 				Items:        names,
-				ResourceName: "ログループ",
+				ResourceName: i18n.T("ログループ"),
 				ShowCount:    true,
 			})
 		} else {
 			// 詳細表示
 			fmt.Printf("%s:\n", title)
 			if len(filteredGroups) == 0 {
-				fmt.Println("該当するログループはありませんでした")
+				fmt.Println(i18n.T("該当するログループはありませんでした"))
 				return nil
 			}
 			for _, group := range filteredGroups {
 				logssvc.DisplayLogGroupDetails(group)
 			}
-			fmt.Printf("\n合計: %d個のログループ\n", len(filteredGroups))
+			fmt.Printf(i18n.T("\n合計: %d個のログループ\n"), len(filteredGroups))
 		}
 
 		return nil
@@ -171,6 +235,8 @@ func init() {
 	logsLsCmd.Flags().BoolP("empty-only", "e", false, "空のログループのみを表示")
 	logsLsCmd.Flags().BoolP("no-retention", "n", false, "保存期間が未設定のログのみを表示")
 	logsLsCmd.Flags().BoolP("details", "d", false, "詳細情報を表示")
+	addTargetFlags(logsLsCmd)
+	addTagFlag(logsLsCmd)
 
 	// delete コマンドのフラグ
 	logsDeleteCmd.Flags().StringP("search", "s", "", "削除対象の検索パターン（ワイルドカード対応）")
@@ -178,4 +244,8 @@ func init() {
 	logsDeleteCmd.Flags().BoolP("no-retention", "n", false, "保存期間が未設定のログのみを削除")
 	logsDeleteCmd.Flags().BoolVar(&logsDeleteExact, "exact", false, "大文字小文字を区別してマッチ")
 	logsDeleteCmd.Flags().BoolVar(&logsDeleteForce, "force", false, "削除保護を解除して削除")
+	addTagFlag(logsDeleteCmd)
+	addAgeFlags(logsDeleteCmd)
+	addPlanOutFlag(logsDeleteCmd)
+	addGuardrailFlag(logsDeleteCmd)
 }
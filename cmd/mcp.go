@@ -0,0 +1,97 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/ngsw877/awstk/internal/config"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/journal"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+	mcpsvc "github.com/ngsw877/awstk/internal/service/mcp"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	"github.com/spf13/cobra"
+)
+
+// mcpCmd represents the mcp command
+var mcpCmd = &cobra.Command{
+	Use:   "mcp",
+	Short: "MCP（Model Context Protocol）サーバー関連のコマンド",
+	Long:  `awstk の操作を MCP のツールとして AI アシスタントから利用するためのコマンド群です。`,
+}
+
+// mcpServeCmd は標準入出力でMCPサーバーを起動するコマンドです
+var mcpServeCmd = &cobra.Command{
+	Use:   "serve",
+	Short: "標準入出力でMCPサーバーを起動するコマンド",
+	Long: `標準入出力で MCP（Model Context Protocol）サーバーを起動し、awstk の操作をツールとして公開します。
+スタック一覧・ECSサービス状態・ACU使用状況・ドリフト状態・ロググループ一覧などの読み取り系ツールは常に公開されます。
+起動・停止・再デプロイ・ドリフト検出などの変更系ツールは、設定ファイル（` + config.FileName + `）の mcp.allowTools に
+列挙したものだけが公開され、実行結果は実行履歴（` + AppName + ` history）に記録されます。
+
+標準出力はMCPのメッセージ専用となり、進捗などの表示は標準エラー出力に書き出されます。
+
+例:
+  ` + AppName + ` mcp serve -P my-profile
+  ` + AppName + ` mcp serve --env dev
+
+  # MCPクライアントの設定（.mcp.json）の例
+  {"mcpServers": {"awstk": {"command": "awstk", "args": ["mcp", "serve", "-P", "my-profile"]}}}`,
+	Args: cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		clients := mcpsvc.ClientSet{
+			CfnClient:  cloudformation.NewFromConfig(awsCfg),
+			EcsClient:  ecs.NewFromConfig(awsCfg),
+			AasClient:  applicationautoscaling.NewFromConfig(awsCfg),
+			RdsClient:  rds.NewFromConfig(awsCfg),
+			CwClient:   cloudwatch.NewFromConfig(awsCfg),
+			LogsClient: cloudwatchlogs.NewFromConfig(awsCfg),
+		}
+
+		// 標準出力はMCPのメッセージ専用にし、ログ・進捗は標準エラー出力に書き出す
+		// ツールは表示用の関数を使わず結果を値で返すため、protocolOut 以外から標準出力には書き込まれない
+		var protocolOut io.Writer = os.Stdout
+		logging.SetOutput(os.Stderr)
+		defer logging.SetOutput(nil)
+
+		logging.Infof("%s\n", i18n.T("🔌 MCPサーバーを標準入出力で起動しました"))
+		err := mcpsvc.Serve(cmd.Context(), os.Stdin, protocolOut, mcpsvc.NewTools(clients), mcpsvc.Options{
+			Version:      Version,
+			AllowedTools: resolvedConfig.Mcp.AllowTools,
+			OnMutate:     journalMcpTool,
+		})
+		if err != nil {
+			return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+		}
+		return nil
+	},
+	SilenceUsage: true,
+}
+
+// journalMcpTool は変更系ツールの実行をジャーナルに記録する
+func journalMcpTool(tool string, args json.RawMessage) func(error) {
+	journalProfile := awsCtx.Profile
+	if journalProfile == "" {
+		journalProfile = os.Getenv("AWS_PROFILE")
+	}
+	run := journal.Start(fmt.Sprintf("%s mcp serve %s %s", AppName, tool, args), journalProfile, awsCtx.Region)
+	return func(err error) {
+		if werr := run.Finish(err); werr != nil {
+			logging.Warnf(i18n.T("%s ジャーナルの記録に失敗しました: %v\n"), common.WarningIcon, werr)
+		}
+	}
+}
+
+func init() {
+	RootCmd.AddCommand(mcpCmd)
+	mcpCmd.AddCommand(mcpServeCmd)
+}
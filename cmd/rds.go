@@ -1,10 +1,17 @@
 package cmd
 
 import (
-	"awstk/internal/service/cfn"
-	rdssvc "awstk/internal/service/rds"
+	"context"
+	"errors"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	"github.com/ngsw877/awstk/internal/service/common"
+	rdssvc "github.com/ngsw877/awstk/internal/service/rds"
+	"github.com/ngsw877/awstk/internal/service/selector"
+
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 	"github.com/spf13/cobra"
@@ -37,25 +44,29 @@ var rdsStartCmd = &cobra.Command{
 	Use:   "start",
 	Short: "RDSインスタンスを起動するコマンド",
 	Long: `RDSインスタンスを起動します。
-CloudFormationスタック名を指定するか、インスタンス名を直接指定することができます。
+CloudFormationスタック名またはインスタンス名を指定するか、--tag でタグ条件に一致する停止中のインスタンスをまとめて起動できます。
+いずれも指定しない場合は、インスタンス一覧から対話的に選択できます。
 
 例:
   ` + AppName + ` rds start -P my-profile -S my-stack
-  ` + AppName + ` rds start -P my-profile -i my-instance`,
+  ` + AppName + ` rds start -P my-profile -i my-instance
+  ` + AppName + ` rds start -P my-profile --tag env=dev`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		instanceName, err := resolveRdsInstanceName(cmd)
-		if err != nil {
-			return err
+		if hasTagFilters() {
+			ids, err := taggedRdsInstanceIds(cmd, "stopped")
+			if err != nil {
+				return err
+			}
+			return runForTagged(i18n.T("停止中のRDSインスタンス"), ids, func(id string) error {
+				return startRdsInstance(cmd, id)
+			})
 		}
 
-		fmt.Printf("🚀 RDSインスタンス (%s) を起動します...\n", instanceName)
-		err = rdssvc.StartRdsInstance(rdsClient, instanceName)
+		instanceName, err := resolveRdsInstanceName(cmd)
 		if err != nil {
-			return fmt.Errorf("❌ RDSインスタンス起動エラー: %w", err)
+			return err
 		}
-
-		fmt.Printf("✅ RDSインスタンス (%s) の起動を開始しました\n", instanceName)
-		return nil
+		return startRdsInstance(cmd, instanceName)
 	},
 	SilenceUsage: true,
 }
@@ -64,25 +75,29 @@ var rdsStopCmd = &cobra.Command{
 	Use:   "stop",
 	Short: "RDSインスタンスを停止するコマンド",
 	Long: `RDSインスタンスを停止します。
-CloudFormationスタック名を指定するか、インスタンス名を直接指定することができます。
+CloudFormationスタック名またはインスタンス名を指定するか、--tag でタグ条件に一致する起動中のインスタンスをまとめて停止できます。
+いずれも指定しない場合は、インスタンス一覧から対話的に選択できます。
 
 例:
   ` + AppName + ` rds stop -P my-profile -S my-stack
-  ` + AppName + ` rds stop -P my-profile -i my-instance`,
+  ` + AppName + ` rds stop -P my-profile -i my-instance
+  ` + AppName + ` rds stop -P my-profile --tag env=dev`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		instanceName, err := resolveRdsInstanceName(cmd)
-		if err != nil {
-			return err
+		if hasTagFilters() {
+			ids, err := taggedRdsInstanceIds(cmd, "available")
+			if err != nil {
+				return err
+			}
+			return runForTagged(i18n.T("起動中のRDSインスタンス"), ids, func(id string) error {
+				return stopRdsInstance(cmd, id)
+			})
 		}
 
-		fmt.Printf("🚀 RDSインスタンス (%s) を停止します...\n", instanceName)
-		err = rdssvc.StopRdsInstance(rdsClient, instanceName)
+		instanceName, err := resolveRdsInstanceName(cmd)
 		if err != nil {
-			return fmt.Errorf("❌ RDSインスタンス停止エラー: %w", err)
+			return err
 		}
-
-		fmt.Printf("✅ RDSインスタンス (%s) の停止を開始しました\n", instanceName)
-		return nil
+		return stopRdsInstance(cmd, instanceName)
 	},
 	SilenceUsage: true,
 }
@@ -90,14 +105,79 @@ CloudFormationスタック名を指定するか、インスタンス名を直接
 var rdsLsCmd = &cobra.Command{
 	Use:   "ls",
 	Short: "RDSインスタンス一覧を表示するコマンド",
-	Long:  `RDSインスタンス一覧を表示します。`,
+	Long: `RDSインスタンス一覧を表示します。
+
+例:
+  ` + AppName + ` rds ls
+  ` + AppName + ` rds ls --regions all   # 有効な全リージョンを検索
+  ` + AppName + ` rds ls --tag env=dev   # タグで絞り込み`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		if isMultiTarget() {
+			if err := validateMultiTargetStack(); err != nil {
+				return err
+			}
+			targets, err := resolveTargets(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return rdssvc.ListRdsInstancesAcross(cmd.Context(), targets, func(t common.Target) *rds.Client {
+				return rds.NewFromConfig(targetConfig(t))
+			}, tags)
+		}
 		resolveStackName()
-		return rdssvc.ListRdsInstances(rdsClient, cfnClient, stackName)
+		return rdssvc.ListRdsInstances(cmd.Context(), rdsClient, cfnClient, stackName, tags)
 	},
 	SilenceUsage: true,
 }
 
+// startRdsInstance はRDSインスタンスを1つ起動する
+func startRdsInstance(cmd *cobra.Command, instanceName string) error {
+	logging.Infof(i18n.T("🚀 RDSインスタンス (%s) を起動します...\n"), instanceName)
+	err := rdssvc.StartRdsInstance(cmd.Context(), rdsClient, instanceName)
+	if err != nil {
+		return fmt.Errorf(i18n.T("❌ RDSインスタンス起動エラー: %w"), err)
+	}
+
+	logging.Infof(i18n.T("✅ RDSインスタンス (%s) の起動を開始しました\n"), instanceName)
+	return nil
+}
+
+// stopRdsInstance はRDSインスタンスを1つ停止する
+func stopRdsInstance(cmd *cobra.Command, instanceName string) error {
+	logging.Infof(i18n.T("🚀 RDSインスタンス (%s) を停止します...\n"), instanceName)
+	err := rdssvc.StopRdsInstance(cmd.Context(), rdsClient, instanceName)
+	if err != nil {
+		return fmt.Errorf(i18n.T("❌ RDSインスタンス停止エラー: %w"), err)
+	}
+
+	logging.Infof(i18n.T("✅ RDSインスタンス (%s) の停止を開始しました\n"), instanceName)
+	return nil
+}
+
+// taggedRdsInstanceIds はタグ条件に一致し、指定したステータスのRDSインスタンスのIDを返す
+func taggedRdsInstanceIds(cmd *cobra.Command, status string) ([]string, error) {
+	tags, err := tagSelector()
+	if err != nil {
+		return nil, err
+	}
+	instances, err := rdssvc.FindRdsInstancesByTags(cmd.Context(), rdsClient, tags)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("❌ RDSインスタンス一覧の取得に失敗: %w"), err)
+	}
+
+	var ids []string
+	for _, ins := range instances {
+		if ins.Status == status {
+			ids = append(ids, ins.InstanceId)
+		}
+	}
+	return ids, nil
+}
+
 // resolveRdsInstanceName はRDSインスタンス名を解決する
 func resolveRdsInstanceName(cmd *cobra.Command) (string, error) {
 	resolveStackName()
@@ -105,7 +185,7 @@ func resolveRdsInstanceName(cmd *cobra.Command) (string, error) {
 
 	// スタック名が指定されている場合
 	if stackName != "" {
-		return getRdsInstanceFromStack(stackName)
+		return getRdsInstanceFromStack(cmd.Context(), stackName)
 	}
 
 	// インスタンス名が直接指定されている場合
@@ -113,17 +193,20 @@ func resolveRdsInstanceName(cmd *cobra.Command) (string, error) {
 		return instanceName, nil
 	}
 
-	// どちらも指定されていない場合
-	return "", fmt.Errorf("❌ エラー: RDSインスタンス名 (-i) またはスタック名 (-S) を指定してください")
+	// どちらも指定されていない場合は、端末であれば一覧から選択させる
+	if !selector.Available() {
+		return "", errors.New(i18n.T("❌ エラー: RDSインスタンス名 (-i) またはスタック名 (-S) を指定してください"))
+	}
+	return rdssvc.SelectRdsInstance(cmd.Context(), rdsClient)
 }
 
 // getRdsInstanceFromStack はCloudFormationスタックからRDSインスタンス名を取得する
-func getRdsInstanceFromStack(stackName string) (string, error) {
-	instanceName, err := cfn.GetRdsFromStack(cfnClient, stackName)
+func getRdsInstanceFromStack(ctx context.Context, stackName string) (string, error) {
+	instanceName, err := cfn.GetRdsFromStack(ctx, cfnClient, stackName)
 	if err != nil {
-		return "", fmt.Errorf("❌ CloudFormationスタックからインスタンス名の取得に失敗: %w", err)
+		return "", fmt.Errorf(i18n.T("❌ CloudFormationスタックからインスタンス名の取得に失敗: %w"), err)
 	}
-	fmt.Printf("✅ CloudFormationスタック '%s' からRDSインスタンス '%s' を検出しました\n", stackName, instanceName)
+	logging.Infof(i18n.T("✅ CloudFormationスタック '%s' からRDSインスタンス '%s' を検出しました\n"), stackName, instanceName)
 	return instanceName, nil
 }
 
@@ -132,11 +215,15 @@ func init() {
 	RdsCmd.AddCommand(rdsStartCmd)
 	RdsCmd.AddCommand(rdsStopCmd)
 	RdsCmd.AddCommand(rdsLsCmd)
+	addTargetFlags(rdsLsCmd)
+	addTagFlag(rdsLsCmd)
+	addTagFlag(rdsStartCmd)
+	addTagFlag(rdsStopCmd)
 
 	// 共通フラグをRdsCmd（親コマンド）に定義
 	RdsCmd.PersistentFlags().StringVarP(&rdsInstanceId, "instance", "i", "", "RDSインスタンス名")
 	RdsCmd.PersistentFlags().StringVarP(&stackName, "stack-name", "S", "", "CloudFormationスタック名")
-	// stack と instance は同時指定不可（どちらか片方使用）
-	rdsStartCmd.MarkFlagsMutuallyExclusive("stack-name", "instance")
-	rdsStopCmd.MarkFlagsMutuallyExclusive("stack-name", "instance")
+	// stack と instance と tag は同時指定不可（いずれか1つ使用）
+	rdsStartCmd.MarkFlagsMutuallyExclusive("stack-name", "instance", "tag")
+	rdsStopCmd.MarkFlagsMutuallyExclusive("stack-name", "instance", "tag")
 }
@@ -1,10 +1,13 @@
 package cmd
 
 import (
-	"awstk/internal/service/common"
-	regionSvc "awstk/internal/service/region"
+	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	regionSvc "github.com/ngsw877/awstk/internal/service/region"
+
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/spf13/cobra"
 )
@@ -37,24 +40,33 @@ var regionLsCmd = &cobra.Command{
   ` + AppName + ` region ls
   ` + AppName + ` region ls --all`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return listRegions(showAllRegions)
+		return listRegions(cmd.Context(), showAllRegions)
 	},
 	SilenceUsage: true,
 }
 
-func listRegions(showAllRegions bool) error {
+func listRegions(ctx context.Context, showAllRegions bool) error {
 	ec2Client := ec2.NewFromConfig(awsCfg)
 
-	regions, err := regionSvc.ListRegions(ec2Client, showAllRegions)
+	regions, err := regionSvc.ListRegions(ctx, ec2Client, showAllRegions)
 	if err != nil {
-		return common.FormatListError("リージョン", err)
+		return common.FormatListError(i18n.T("リージョン"), err)
+	}
+
+	// 構造化出力の場合はリージョン名とオプトイン状態をレコードとして出力
+	if common.IsStructuredOutput() {
+		if !showAllRegions {
+			regions, _ = regionSvc.GroupRegions(regions)
+		}
+		regionSvc.DisplayRegionRecords(regions)
+		return nil
 	}
 
 	if showAllRegions {
 		// 有効なリージョンと無効なリージョンを分けて表示
 		available, disabled := regionSvc.GroupRegions(regions)
 
-		fmt.Printf("AWSリージョン一覧: (全%d件)\n\n", len(regions))
+		fmt.Printf(i18n.T("AWSリージョン一覧: (全%d件)\n\n"), len(regions))
 
 		if len(available) > 0 {
 			availableNames := make([]string, len(available))
@@ -62,9 +74,9 @@ func listRegions(showAllRegions bool) error {
 				availableNames[i] = fmt.Sprintf("%s (%s)", region.RegionName, region.OptInStatus)
 			}
 			common.PrintNumberedList(common.ListOutput{
-				Title:        fmt.Sprintf("✅ 有効なリージョン (%d件)", len(available)),
+				Title:        fmt.Sprintf(i18n.T("✅ 有効なリージョン (%d件)"), len(available)),
 				Items:        availableNames,
-				ResourceName: "リージョン",
+				ResourceName: i18n.T("リージョン"),
 			})
 		}
 
@@ -75,9 +87,9 @@ func listRegions(showAllRegions bool) error {
 				disabledNames[i] = fmt.Sprintf("%s (%s)", region.RegionName, region.OptInStatus)
 			}
 			common.PrintNumberedList(common.ListOutput{
-				Title:        fmt.Sprintf("❌ 無効なリージョン (%d件)", len(disabled)),
+				Title:        fmt.Sprintf(i18n.T("❌ 無効なリージョン (%d件)"), len(disabled)),
 				Items:        disabledNames,
-				ResourceName: "リージョン",
+				ResourceName: i18n.T("リージョン"),
 			})
 		}
 	} else {
@@ -88,9 +100,9 @@ func listRegions(showAllRegions bool) error {
 			names[i] = region.RegionName
 		}
 		common.PrintNumberedList(common.ListOutput{
-			Title:        "利用可能なリージョン一覧",
+			Title:        i18n.T("利用可能なリージョン一覧"),
 			Items:        names,
-			ResourceName: "リージョン",
+			ResourceName: i18n.T("リージョン"),
 		})
 	}
 
@@ -108,7 +120,7 @@ func init() {
 	RegionCmd.RunE = func(cmd *cobra.Command, args []string) error {
 		// エイリアスで呼ばれた場合、lsコマンドのロジックを実行
 		if cmd.CalledAs() == regionLsAlias {
-			return listRegions(showAllRegions)
+			return listRegions(cmd.Context(), showAllRegions)
 		}
 		// 'region' コマンドが直接呼ばれた場合はヘルプを表示
 		return cmd.Help()
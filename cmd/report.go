@@ -0,0 +1,75 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/report"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	reportPath   string // --report で指定されたレポートの出力先
+	reportFormat string // --report-format で指定されたレポートの形式（空の場合は拡張子から判別）
+)
+
+// addReportFlags はクリーンアップの結果をレポートに書き出すフラグを追加する
+func addReportFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&reportPath, "report", "", "削除結果のレポートをファイルに書き出す（形式は拡張子 .json・.md・.xml から判別）")
+	cmd.Flags().StringVar(&reportFormat, "report-format", "", "レポートの形式（json、markdown、junit）")
+	_ = cmd.RegisterFlagCompletionFunc("report-format", cobra.FixedCompletions(report.Formats, cobra.ShellCompDirectiveNoFileComp))
+}
+
+// newReportIfRequested は --report が指定されている場合に空のレポートを作成する（未指定ならnil）
+// 削除を始める前にレポートの形式を判別し、判別できない場合はエラーを返す
+func newReportIfRequested() (*report.Report, error) {
+	if reportPath == "" {
+		if reportFormat != "" {
+			return nil, errors.New(i18n.T("❌ --report-format は --report と一緒に指定してください"))
+		}
+		return nil, nil
+	}
+	if _, err := report.FormatOf(reportPath, reportFormat); err != nil {
+		return nil, fmt.Errorf("❌ %w", err)
+	}
+	reportProfile := profile
+	if reportProfile == "" {
+		reportProfile = os.Getenv("AWS_PROFILE")
+	}
+	command := AppName + " " + strings.Join(os.Args[1:], " ")
+	return report.New(command, reportProfile, awsCfg.Region), nil
+}
+
+// writeReport はレポートを --report で指定されたファイルに書き出す（レポートが nil の場合は何もしない）
+func writeReport(r *report.Report) error {
+	if r == nil {
+		return nil
+	}
+	format, err := report.FormatOf(reportPath, reportFormat)
+	if err == nil {
+		err = report.Write(reportPath, format, r)
+	}
+	if err != nil {
+		return fmt.Errorf("❌ %w", err)
+	}
+	logging.Infof(i18n.T("📝 レポートを %s に書き出しました\n"), reportPath)
+	return nil
+}
+
+// finishReport はコマンドの結果に関わらずレポートを書き出し、コマンドのエラーを返す
+// コマンドが失敗した場合、レポートの書き出しの失敗は警告のみ表示する
+func finishReport(r *report.Report, err error) error {
+	reportErr := writeReport(r)
+	if err != nil {
+		if reportErr != nil {
+			logging.Warnf("%v\n", reportErr)
+		}
+		return err
+	}
+	return reportErr
+}
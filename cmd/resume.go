@@ -0,0 +1,65 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/runstate"
+	"github.com/ngsw877/awstk/internal/service/common"
+
+	"github.com/spf13/cobra"
+)
+
+var resumeRunId string // --resume で指定された中断した実行の実行ID
+
+// addResumeFlag は中断したクリーンアップを再開するコマンドに --resume フラグを追加する
+func addResumeFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&resumeRunId, "resume", "", "中断した実行を実行IDを指定して再開する（前回検出した対象のうち未完了のものを削除）")
+	_ = cmd.RegisterFlagCompletionFunc("resume", func(*cobra.Command, []string, string) ([]string, cobra.ShellCompDirective) {
+		return runstate.Ids(), cobra.ShellCompDirectiveNoFileComp
+	})
+}
+
+// startRun はクリーンアップの実行状態を用意する
+// --resume が指定された場合は中断した実行の状態を読み込み、それ以外は新しい実行状態を作成する
+// 新しい実行状態を作成できない場合は警告を表示して nil を返す（再開はできないが削除は実行できる）
+// 別のアカウントで再開されないよう、実行中の認証情報のアカウントIDを記録し、再開時に一致するか確認する
+func startRun(ctx context.Context, command string) (*runstate.Run, error) {
+	runProfile := awsCtx.Profile
+	if runProfile == "" {
+		runProfile = os.Getenv("AWS_PROFILE")
+	}
+
+	if resumeRunId == "" {
+		run, err := runstate.New(command, callerAccountId(ctx, awsCfg), runProfile, awsCtx.Region)
+		if err != nil {
+			logging.Warnf(i18n.T("%s 実行状態を保存できないため、中断した場合は再開できません: %v\n"), common.WarningIcon, err)
+			return nil, nil
+		}
+		return run, nil
+	}
+
+	run, err := runstate.Open(resumeRunId, command)
+	if err != nil {
+		return nil, fmt.Errorf("❌ %w", err)
+	}
+	if run.Region() != "" && run.Region() != awsCtx.Region {
+		return nil, fmt.Errorf(i18n.T("❌ 実行 %s はリージョン %s の実行のため、リージョン %s では再開できません"), run.Id(), run.Region(), awsCtx.Region)
+	}
+	if run.Profile() != "" && runProfile != "" && run.Profile() != runProfile {
+		return nil, fmt.Errorf(i18n.T("❌ 実行 %s はプロファイル %s の実行のため、プロファイル %s では再開できません"), run.Id(), run.Profile(), runProfile)
+	}
+	if run.Account() != "" {
+		account, err := fetchAccountId(ctx, awsCfg)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("❌ 実行中のアカウントIDを取得できないため、実行 %s のアカウント (%s) と一致するか確認できません: %w"), run.Id(), run.Account(), err)
+		}
+		if account != run.Account() {
+			return nil, fmt.Errorf(i18n.T("❌ 実行 %s はアカウント %s の実行のため、アカウント %s では再開できません"), run.Id(), run.Account(), account)
+		}
+	}
+	return run, nil
+}
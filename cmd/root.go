@@ -1,10 +1,22 @@
 package cmd
 
 import (
-	"awstk/internal/aws"
+	"context"
 	"errors"
 	"fmt"
 	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/ngsw877/awstk/internal/aws"
+	"github.com/ngsw877/awstk/internal/config"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/journal"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/confirm"
 
 	awsconfig "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -16,10 +28,66 @@ const AppName = "awstk"
 
 var region string
 var profile string
+var envName string
+var resolvedConfig *config.Resolved
+var outputFormat string
+var endpointUrl string
+var serviceEndpoints map[string]string
+var awsCtx aws.Context
 var awsCfg awsconfig.Config
 var stackName string
 var cfnClient *cloudformation.Client
 var rdsClient *rds.Client
+var journalRun *journal.Run
+var concurrencyFlag int
+var maxAttempts int
+var rateLimit float64
+var assumeYes bool
+var quiet bool
+var verbosity int
+var logFormat string
+
+// mutatingCommands はジャーナルに記録する変更系コマンド（ルートコマンド名を除いたコマンドパス）
+var mutatingCommands = map[string]bool{
+	"apply":                true,
+	"aurora start":         true,
+	"aurora stop":          true,
+	"canary enable":        true,
+	"canary disable":       true,
+	"canary run":           true,
+	"cf invalidate":        true,
+	"cf tenant invalidate": true,
+	"cfn start":            true,
+	"cfn stop":             true,
+	"cfn cleanup":          true,
+	"cfn orphans":          true,
+	"cfn protect":          true,
+	"cfn drift-detect":     true,
+	"cfn deploy":           true,
+	"cleanup all":          true,
+	"ec2 start":            true,
+	"ec2 stop":             true,
+	"ecr cleanup":          true,
+	"ecs start":            true,
+	"ecs stop":             true,
+	"ecs run":              true,
+	"ecs redeploy":         true,
+	"elb delete":           true,
+	"iam role delete":      true,
+	"iam policy delete":    true,
+	"logs delete":          true,
+	"rds start":            true,
+	"rds stop":             true,
+	"route53 delete":       true,
+	"s3 cleanup":           true,
+	"schedule trigger":     true,
+	"schedule enable":      true,
+	"schedule disable":     true,
+	"secrets delete":       true,
+	"ses verify":           true,
+	"ssm put-params":       true,
+	"ssm delete-params":    true,
+}
 
 // RootCmd represents the base command when called without any subcommands
 var RootCmd = &cobra.Command{
@@ -33,28 +101,129 @@ S3、ECR、ECS、CloudFormation などの各種 AWS サービスに対して、
 使用例:
   awstk cleanup all -k "test"    # "test"を含むS3/ECRを一括削除
   awstk s3 gunzip my-bucket/logs # S3の.gzファイルを一括ダウンロード&解凍
-  awstk ecs exec -s my-service   # Fargateコンテナへシェル接続`,
+  awstk ecs exec -s my-service   # Fargateコンテナへシェル接続
+  awstk ec2 ls --output json     # 一覧をJSONで出力（jq等と連携）
+  awstk s3 ls --endpoint-url http://localhost:4566  # LocalStack等のエミュレーターに接続`,
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
-	err := RootCmd.Execute()
+	if err := setupLanguage(os.Args[1:]); err != nil {
+		fmt.Fprintln(os.Stderr, "❌ "+err.Error())
+		os.Exit(1)
+	}
+
+	registerCompletions(RootCmd)
+
+	ctx, stop := newSignalContext()
+	defer stop()
+
+	err := RootCmd.ExecuteContext(ctx)
+	finishJournal(ctx, err)
 	if err != nil {
+		if ctx.Err() != nil {
+			os.Exit(130) // シグナルによる中断
+		}
 		os.Exit(1)
 	}
 }
 
+// newSignalContext はCtrl-C（SIGINT）とSIGTERMでキャンセルされるルートコンテキストを作成する
+// 1回目のシグナルで処理中のAPI呼び出しや待機ループを中断し、復元処理と途中結果の表示を行う
+// 2回目のシグナルでは通常どおりプロセスを強制終了する
+func newSignalContext() (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.Background())
+	sigCh := make(chan os.Signal, 1)
+	signal.Notify(sigCh, os.Interrupt, syscall.SIGTERM)
+
+	go func() {
+		select {
+		case <-sigCh:
+			fmt.Fprintln(os.Stderr, i18n.T("\n⚠️  中断を受け付けました。処理を停止しています...（もう一度 Ctrl-C で強制終了）"))
+			signal.Stop(sigCh) // 以降のシグナルはデフォルト動作（強制終了）に戻す
+			cancel()
+		case <-ctx.Done():
+		}
+	}()
+
+	return ctx, func() {
+		signal.Stop(sigCh)
+		cancel()
+	}
+}
+
+// setupLogging は -q, -v, --log-format からログのレベルと形式を設定する
+func setupLogging() error {
+	format, err := logging.ParseFormat(logFormat)
+	if err != nil {
+		return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+	}
+	if quiet && verbosity > 0 {
+		return errors.New(i18n.T("❌ エラー: -q と -v は同時に指定できません"))
+	}
+	level := logging.LevelInfo
+	switch {
+	case quiet:
+		level = logging.LevelWarn
+	case verbosity == 1:
+		level = logging.LevelDebug
+	case verbosity >= 2:
+		level = logging.LevelTrace
+	}
+	logging.Configure(level, format)
+	return nil
+}
+
+// isMutatingCommand はAWSリソースを変更するコマンドかどうかを判定する
+func isMutatingCommand(cmd *cobra.Command) bool {
+	path := strings.TrimPrefix(cmd.CommandPath(), AppName+" ")
+	return mutatingCommands[path]
+}
+
+// startJournal は変更系コマンドの実行記録を開始し、並列処理の結果をジャーナルに集める
+func startJournal(cmd *cobra.Command) {
+	if !isMutatingCommand(cmd) {
+		return
+	}
+	commandLine := strings.Join(append([]string{AppName}, os.Args[1:]...), " ")
+	journalProfile := awsCtx.Profile
+	if journalProfile == "" {
+		journalProfile = os.Getenv("AWS_PROFILE")
+	}
+	journalRun = journal.Start(commandLine, journalProfile, awsCtx.Region)
+	common.SetResultRecorder(journalRun.Record)
+}
+
+// finishJournal はコマンドの終了結果をジャーナルに追記する
+// 書き込みに失敗してもコマンド自体の結果には影響させず、警告のみ表示する
+func finishJournal(ctx context.Context, err error) {
+	if journalRun == nil {
+		return
+	}
+	common.SetResultRecorder(nil)
+	if err != nil && ctx.Err() != nil {
+		err = fmt.Errorf("%w: %v", context.Canceled, err)
+	}
+	if werr := journalRun.Finish(err); werr != nil {
+		logging.Warnf(i18n.T("%s ジャーナルの記録に失敗しました: %v\n"), common.WarningIcon, werr)
+	}
+	journalRun = nil
+}
+
 // isAuthNotRequired は認証が不要なコマンドかどうかを判定する
 func isAuthNotRequired(cmd *cobra.Command) bool {
 	// 認証が不要なコマンド
 	if cmd.Name() == "help" ||
-		cmd.Name() == "version" {
+		cmd.Name() == "version" ||
+		cmd.Name() == "history" ||
+		cmd.Name() == cobra.ShellCompRequestCmd ||
+		cmd.Name() == cobra.ShellCompNoDescRequestCmd {
 		return true
 	}
-	// 認証不要なコマンドのサブコマンド
+	// 認証不要なコマンドのサブコマンド（補完スクリプトの出力を含む）
 	if cmd.Parent() != nil &&
-		cmd.Parent().Name() == "env" {
+		(cmd.Parent().Name() == "env" || cmd.Parent().Name() == "completion") {
 		return true
 	}
 	return false
@@ -62,19 +231,80 @@ func isAuthNotRequired(cmd *cobra.Command) bool {
 
 // checkProfile はプロファイルの確認のみを行うプライベート関数
 func checkProfile(cmd *cobra.Command) error {
-	// プロファイルがすでに指定されている場合は案内を出して終了
-	if profile != "" {
-		cmd.Println("🔍 -Pオプションで指定されたプロファイル '" + profile + "' を使用します")
-		return nil
-	}
-	// 環境変数からプロファイル取得を試みる
-	envProfile := os.Getenv("AWS_PROFILE")
-	if envProfile == "" {
+	resolved := resolvedConfig.Profile
+	switch resolved.Origin {
+	case config.OriginFlag:
+		logging.Infof(i18n.T("🔍 -Pオプションで指定されたプロファイル '%s' を使用します\n"), profile)
+	case config.OriginEnvVar:
+		// SDKが環境変数 AWS_PROFILE を読み込むため profile は空のままにする
+		logging.Infof(i18n.T("🔍 環境変数 AWS_PROFILE の値 '%s' を使用します\n"), resolved.Value)
+	case config.OriginFile:
+		profile = resolved.Value
+		logging.Infof(i18n.T("🔍 環境 '%s' のプロファイル '%s' を使用します\n"), resolvedConfig.Env.Value, profile)
+	default:
 		// プロファイルが見つからない場合はエラー
 		cmd.SilenceUsage = true // エラー時のUsage表示を抑制
-		return errors.New("❌ エラー: プロファイルが指定されていません。-Pオプションまたは AWS_PROFILE 環境変数を指定してください")
+		return fmt.Errorf(i18n.T("❌ エラー: プロファイルが指定されていません。-Pオプション、AWS_PROFILE 環境変数、または設定ファイル（%s）の環境を指定してください"), config.FileName)
+	}
+	return nil
+}
+
+// applyConfig は設定ファイルを読み込み、フラグで指定されていない値に選択された環境の設定を反映する
+func applyConfig(cmd *cobra.Command, args []string) error {
+	cfg, err := config.Load()
+	if err != nil {
+		return err
+	}
+	for _, file := range cfg.Files {
+		logging.Debugf(i18n.T("📄 設定ファイル %s を読み込みました\n"), file)
+	}
+	resolved, err := config.Resolve(cfg, config.Inputs{
+		EnvName:       envName,
+		EnvNameSet:    cmd.Flags().Changed("env"),
+		Profile:       profile,
+		ProfileSet:    profile != "",
+		Region:        region,
+		RegionSet:     cmd.Flags().Changed("region"),
+		StackName:     stackName,
+		StackNameSet:  stackName != "",
+		RoleName:      assumeRoleName,
+		RoleNameSet:   assumeRoleName != "",
+		ExternalId:    assumeExternalId,
+		ExternalIdSet: assumeExternalId != "",
+		MfaSerial:     assumeMfaSerial,
+		MfaSerialSet:  assumeMfaSerial != "",
+		Concurrency:   concurrencyFlag,
+	})
+	if err != nil {
+		return err
+	}
+	resolvedConfig = resolved
+	if resolved.Env.IsSet() {
+		logging.Debugf(i18n.T("📄 環境 '%s' を使用します（%s）\n"), resolved.Env.Value, resolved.Env.Source)
+	}
+
+	region = resolved.Region.Value
+	if resolved.Concurrency.IsSet() {
+		n, _ := strconv.Atoi(resolved.Concurrency.Value)
+		common.SetConcurrency(n)
+	}
+	setupGuardrail(resolved)
+	confirm.SetAssumeYes(assumeYes)
+
+	// 検索フラグの既定値（ロググループ名などを直接指定した場合は対象が広がらないよう適用しない）
+	searchFlag := cmd.Flags().Lookup("search")
+	exactFlag := cmd.Flags().Lookup("exact")
+	if resolved.Search.IsSet() && searchFlag != nil && exactFlag != nil && !searchFlag.Changed && len(args) == 0 {
+		if err := cmd.Flags().Set("search", resolved.Search.Value); err != nil {
+			return fmt.Errorf(i18n.T("検索パターンの既定値の設定に失敗: %w"), err)
+		}
+		logging.Infof(i18n.T("🔍 環境 '%s' の検索パターン '%s' を使用します\n"), resolved.Env.Value, resolved.Search.Value)
+	}
+	if resolved.Exact.IsSet() && exactFlag != nil && !exactFlag.Changed {
+		if err := cmd.Flags().Set("exact", resolved.Exact.Value); err != nil {
+			return fmt.Errorf(i18n.T("--exact の既定値の設定に失敗: %w"), err)
+		}
 	}
-	cmd.Println("🔍 環境変数 AWS_PROFILE の値 '" + envProfile + "' を使用します")
 	return nil
 }
 
@@ -83,11 +313,57 @@ func init() {
 	// Cobra supports persistent flags, which, if defined here,
 	// will be global for your application.
 
-	RootCmd.PersistentFlags().StringVarP(&region, "region", "R", "ap-northeast-1", "AWSリージョン")
+	RootCmd.PersistentFlags().StringVarP(&region, "region", "R", config.DefaultRegion, "AWSリージョン（未指定時は設定ファイルの環境の値）")
 	RootCmd.PersistentFlags().StringVarP(&profile, "profile", "P", "", "AWSプロファイル")
+	RootCmd.PersistentFlags().StringVar(&envName, "env", "", "使用する環境名（設定ファイル "+config.FileName+" の environments、環境変数 "+config.EnvNameEnv+" でも指定可）")
+	RootCmd.PersistentFlags().StringVar(&langFlag, "lang", "", "表示言語 (ja|en、環境変数 "+i18n.LangEnv+" やロケールでも指定可)")
+	RootCmd.PersistentFlags().StringVar(&outputFormat, "output", string(common.OutputTable), "一覧の出力形式 (table|json|yaml|csv|tsv)")
+	RootCmd.PersistentFlags().StringVar(&endpointUrl, "endpoint-url", "", "全サービス共通のエンドポイントURL（LocalStack等、環境変数 "+aws.EndpointUrlEnv+" でも指定可）")
+	RootCmd.PersistentFlags().IntVar(&concurrencyFlag, "concurrency", 0, "並列処理の最大同時実行数（未指定時は設定ファイルの concurrency または各処理の既定値）")
+	RootCmd.PersistentFlags().IntVar(&maxAttempts, "max-attempts", common.DefaultRetryPolicy.MaxAttempts, "スロットリング等で失敗した処理の最大試行回数（1以上。1の場合は再試行しない）")
+	RootCmd.PersistentFlags().Float64Var(&rateLimit, "rate-limit", 0, "並列処理全体で共有する1秒あたりの最大リクエスト数（0は無制限、スロットリング検出時は自動で減速）")
+	RootCmd.PersistentFlags().StringToStringVar(&serviceEndpoints, "service-endpoint", nil, "サービスごとのエンドポイントURL（例: s3=http://localhost:4566、複数指定可、環境変数 "+aws.ServiceEndpointsEnv+" でも指定可）")
+	RootCmd.PersistentFlags().BoolVarP(&assumeYes, "yes", "y", false, "確認プロンプトを省略して実行（標準入力が端末でない場合に確認が必要な操作では必須）")
+	RootCmd.PersistentFlags().BoolVarP(&quiet, "quiet", "q", false, "進捗メッセージを表示せず、警告とエラーのみ標準エラー出力に表示")
+	RootCmd.PersistentFlags().CountVarP(&verbosity, "verbose", "v", "詳細なログを表示（-vv でAWS API呼び出しごとの所要時間・再試行回数も表示）")
+	RootCmd.PersistentFlags().StringVar(&logFormat, "log-format", string(logging.FormatText), "標準エラー出力に書き出すログの形式 (text|json)")
 
 	// コマンド実行前に共通でプロファイルチェックとawsCtx設定を行う
 	RootCmd.PersistentPreRunE = func(cmd *cobra.Command, args []string) error {
+		// ログの設定（以降の進捗・診断メッセージは標準エラー出力に書き出す）
+		if err := setupLogging(); err != nil {
+			cmd.SilenceUsage = true
+			return err
+		}
+
+		// 出力形式の設定
+		if err := common.SetOutputFormat(outputFormat); err != nil {
+			cmd.SilenceUsage = true
+			return err
+		}
+
+		// 並列処理・再試行の設定
+		if concurrencyFlag < 0 || rateLimit < 0 {
+			cmd.SilenceUsage = true
+			return errors.New(i18n.T("❌ エラー: --concurrency, --rate-limit には0以上の値を指定してください"))
+		}
+		if maxAttempts < 1 {
+			cmd.SilenceUsage = true
+			return errors.New(i18n.T("❌ エラー: --max-attempts には1以上の値を指定してください（1の場合は再試行しません）"))
+		}
+		common.SetMaxAttempts(maxAttempts)
+		common.SetRateLimit(rateLimit)
+
+		// 設定ファイル（名前付き環境）の反映
+		if err := applyConfig(cmd, args); err != nil {
+			if isAuthNotRequired(cmd) {
+				// ヘルプ等は設定ファイルに誤りがあっても実行できるようにする（env show ではエラー内容を表示）
+				return nil
+			}
+			cmd.SilenceUsage = true
+			return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+		}
+
 		// 認証が不要なコマンドはスキップ
 		if isAuthNotRequired(cmd) {
 			return nil
@@ -98,15 +374,42 @@ func init() {
 		if err != nil {
 			return err
 		}
+		if resolvedConfig.Region.Origin == config.OriginFile {
+			logging.Infof(i18n.T("🔍 環境 '%s' のリージョン '%s' を使用します\n"), resolvedConfig.Env.Value, region)
+		}
+
+		// エンドポイント上書きの解決
+		resolvedEndpointUrl, resolvedServiceEndpoints, err := aws.ResolveEndpoints(endpointUrl, serviceEndpoints)
+		if err != nil {
+			cmd.SilenceUsage = true
+			return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+		}
 
 		// awsCtxを設定
-		awsCtx := aws.Context{Region: region, Profile: profile}
+		awsCtx = aws.Context{
+			Region:           region,
+			Profile:          profile,
+			EndpointUrl:      resolvedEndpointUrl,
+			ServiceEndpoints: resolvedServiceEndpoints,
+			AssumeRole: aws.AssumeRole{
+				RoleName:   resolvedConfig.RoleName.Value,
+				ExternalId: resolvedConfig.ExternalId.Value,
+				MfaSerial:  resolvedConfig.MfaSerial.Value,
+			},
+		}
+		if summary := awsCtx.EndpointSummary(); summary != "" {
+			logging.Infof("%s\n", i18n.T("🔍 エンドポイントを上書きします: ")+summary)
+		}
 
 		// AWS設定を読み込み
-		awsCfg, err = aws.LoadAwsConfig(awsCtx)
+		awsCfg, err = aws.LoadAwsConfig(cmd.Context(), awsCtx)
 		if err != nil {
-			return fmt.Errorf("aws設定の読み込みエラー: %w", err)
+			return fmt.Errorf(i18n.T("aws設定の読み込みエラー: %w"), err)
 		}
+		logging.Debugf(i18n.T("📄 AWS設定を読み込みました（プロファイル: %s、リージョン: %s）\n"), resolvedConfig.Profile.Value, awsCfg.Region)
+
+		// 変更系コマンドの実行記録を開始
+		startJournal(cmd)
 
 		return nil
 	}
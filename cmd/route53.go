@@ -1,10 +1,13 @@
 package cmd
 
 import (
+	"errors"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	route53Service "github.com/ngsw877/awstk/internal/service/route53"
+
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/spf13/cobra"
-
-	route53Service "awstk/internal/service/route53"
 )
 
 var (
@@ -34,18 +37,27 @@ var route53Cmd = &cobra.Command{
 var route53LsCmd = &cobra.Command{
 	Use:   "ls",
 	Short: "ホストゾーン一覧を表示",
-	Long:  `アカウント内のすべてのRoute53ホストゾーンを一覧表示します。`,
+	Long: `アカウント内のすべてのRoute53ホストゾーンを一覧表示します。
+
+例:
+  ` + AppName + ` route53 ls
+  ` + AppName + ` route53 ls --tag env=dev   # タグで絞り込み`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		return route53Service.ListHostedZones(route53Client)
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		return route53Service.ListHostedZones(cmd.Context(), route53Client, tags)
 	},
 }
 
 // deleteCmd represents the delete command
 var route53DeleteCmd = &cobra.Command{
-	Use:   "delete <ドメイン名またはゾーンID>",
+	Use:   "delete [ドメイン名またはゾーンID]",
 	Short: "ホストゾーンを削除",
 	Long: `Route53のホストゾーンを削除します。デフォルトではドメイン名を指定します。
 ホストゾーンIDを指定する場合は --id フラグを使用してください。
+--tag を指定すると、タグ条件に一致するホストゾーンをまとめて削除できます。
 
 このコマンドは以下の処理を実行します：
 1. すべてのリソースレコードセットを削除（NSとSOAレコードを除く）
@@ -53,10 +65,10 @@ var route53DeleteCmd = &cobra.Command{
 
 【使用例】
   ` + AppName + ` route53 delete example.com
-  ` + AppName + ` route53 delete --id Z1234567890ABC`,
-	Args: cobra.ExactArgs(1),
+  ` + AppName + ` route53 delete --id Z1234567890ABC
+  ` + AppName + ` route53 delete --tag env=dev --tag '!keep'`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		identifier := args[0]
 		force, _ := cmd.Flags().GetBool("force")
 		dryRun, _ := cmd.Flags().GetBool("dry-run")
 
@@ -66,7 +78,32 @@ var route53DeleteCmd = &cobra.Command{
 			DryRun: dryRun,
 		}
 
-		return route53Service.DeleteHostedZone(route53Client, identifier, opts)
+		if hasTagFilters() {
+			if len(args) > 0 {
+				return errors.New(i18n.T("❌ エラー: ドメイン名・ゾーンIDと --tag は同時に指定できません"))
+			}
+			tags, err := tagSelector()
+			if err != nil {
+				return err
+			}
+			zones, err := route53Service.FindHostedZonesByTags(cmd.Context(), route53Client, tags)
+			if err != nil {
+				return err
+			}
+			ids := make([]string, len(zones))
+			for i, zone := range zones {
+				ids[i] = zone.Id
+			}
+			opts.UseId = true
+			return runForTagged(i18n.T("ホストゾーン"), ids, func(id string) error {
+				return route53Service.DeleteHostedZone(cmd.Context(), route53Client, id, opts)
+			})
+		}
+		if len(args) == 0 {
+			return errors.New(i18n.T("❌ エラー: ドメイン名・ゾーンIDまたは --tag を指定してください"))
+		}
+
+		return route53Service.DeleteHostedZone(cmd.Context(), route53Client, args[0], opts)
 	},
 }
 
@@ -75,8 +112,13 @@ func init() {
 	route53Cmd.AddCommand(route53LsCmd)
 	route53Cmd.AddCommand(route53DeleteCmd)
 
+	// ls command flags
+	addTagFlag(route53LsCmd)
+
 	// delete command flags
 	route53DeleteCmd.Flags().BoolVarP(&useId, "id", "i", false, "引数をホストゾーンIDとして扱う（デフォルト：ドメイン名）")
 	route53DeleteCmd.Flags().BoolP("force", "f", false, "確認プロンプトをスキップ")
 	route53DeleteCmd.Flags().BoolP("dry-run", "d", false, "削除対象を表示するのみ（実際には削除しない）")
+	addTagFlag(route53DeleteCmd)
+	addGuardrailFlag(route53DeleteCmd)
 }
@@ -1,16 +1,29 @@
 package cmd
 
 import (
-	"awstk/internal/service/common"
-	s3svc "awstk/internal/service/s3"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/runstate"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/confirm"
+	s3svc "github.com/ngsw877/awstk/internal/service/s3"
+
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/spf13/cobra"
 )
 
 var s3Client *s3.Client
 
+// s3ClientOptions はS3クライアントのオプションを設定する
+// エンドポイントが上書きされている場合、エミュレーター向けにパス形式のアドレッシングを使用する
+func s3ClientOptions(o *s3.Options) {
+	if awsCtx.HasEndpointOverride("s3") {
+		o.UsePathStyle = true
+	}
+}
+
 // S3Cmd represents the s3 command
 var S3Cmd = &cobra.Command{
 	Use:          "s3",
@@ -23,7 +36,7 @@ var S3Cmd = &cobra.Command{
 		}
 
 		// S3用クライアント生成
-		s3Client = s3.NewFromConfig(awsCfg)
+		s3Client = s3.NewFromConfig(awsCfg, s3ClientOptions)
 
 		return nil
 	},
@@ -39,6 +52,7 @@ S3パスを指定した場合、デフォルトでファイルサイズが表示
 【使い方】
   ` + AppName + ` s3 ls                          # バケット一覧を表示
   ` + AppName + ` s3 ls -e                       # 空のバケットのみを表示
+  ` + AppName + ` s3 ls --tag env=dev            # タグが一致するバケットのみを表示
   ` + AppName + ` s3 ls my-bucket                # バケット内をツリー形式で表示（サイズ付き）
   ` + AppName + ` s3 ls my-bucket/prefix/        # 指定プレフィックス以下をツリー形式で表示（サイズ付き）
   ` + AppName + ` s3 ls my-bucket -t             # 更新日時も一緒に表示
@@ -56,39 +70,43 @@ S3パスを指定した場合、デフォルトでファイルサイズが表示
 
 		if len(args) == 0 {
 			// 引数がない場合はバケット一覧表示
-			buckets, err := s3svc.ListS3Buckets(s3Client)
+			tags, err := tagSelector()
+			if err != nil {
+				return err
+			}
+			buckets, err := s3svc.ListS3Buckets(cmdCobra.Context(), s3Client, tags)
 			if err != nil {
-				return common.FormatListError("S3バケット", err)
+				return common.FormatListError(i18n.T("S3バケット"), err)
 			}
 			if len(buckets) == 0 {
-				fmt.Println(common.FormatEmptyMessage("S3バケット"))
+				fmt.Println(common.FormatEmptyMessage(i18n.T("S3バケット")))
 				return nil
 			}
 
 			// 空バケットのみ表示する場合
 			if emptyOnly {
-				emptyBuckets, err := s3svc.FilterEmptyBuckets(s3Client, buckets)
+				emptyBuckets, err := s3svc.FilterEmptyBuckets(cmdCobra.Context(), s3Client, buckets)
 				if err != nil {
-					return fmt.Errorf("❌ 空バケットのチェックでエラー: %w", err)
+					return fmt.Errorf(i18n.T("❌ 空バケットのチェックでエラー: %w"), err)
 				}
 				common.PrintSimpleList(common.ListOutput{
-					Title:        "空のS3バケット一覧",
+					Title:        i18n.T("空のS3バケット一覧"),
 					Items:        emptyBuckets,
-					ResourceName: "バケット",
+					ResourceName: i18n.T("バケット"),
 					ShowCount:    false,
 				})
 			} else {
 				common.PrintSimpleList(common.ListOutput{
-					Title:        "S3バケット一覧",
+					Title:        i18n.T("S3バケット一覧"),
 					Items:        buckets,
-					ResourceName: "バケット",
+					ResourceName: i18n.T("バケット"),
 					ShowCount:    false,
 				})
 			}
 		} else {
 			// 引数がある場合は指定S3パスをツリー形式で表示
 			s3Path := args[0]
-			err := s3svc.ListS3TreeView(s3Client, s3Path, showTime)
+			err := s3svc.ListS3TreeView(cmdCobra.Context(), s3Client, s3Path, showTime)
 			if err != nil {
 				return fmt.Errorf("❌ %w", err)
 			}
@@ -121,10 +139,10 @@ var s3GunzipCmd = &cobra.Command{
 			outDir = "./outputs/"
 		}
 
-		fmt.Printf("S3パス: %s\n出力先: %s\n", s3Path, outDir)
+		logging.Infof(i18n.T("S3パス: %s\n出力先: %s\n"), s3Path, outDir)
 
-		if err := s3svc.DownloadAndExtractGzFiles(s3Client, s3Path, outDir); err != nil {
-			return fmt.Errorf("❌ gunzip失敗: %w", err)
+		if err := s3svc.DownloadAndExtractGzFiles(cmdCobra.Context(), s3Client, s3Path, outDir); err != nil {
+			return fmt.Errorf(i18n.T("❌ gunzip失敗: %w"), err)
 		}
 		return nil
 	},
@@ -138,7 +156,7 @@ var s3AvailCmd = &cobra.Command{
 	Long:  `指定した複数のS3バケット名が利用可能か（未作成か）を判定します。\n\n【使い方】\n  ` + AppName + ` s3 avail bucket1 bucket2 ...\n\n【出力例】\n  [404] my-bucket-1: 利用可能\n  [200] my-bucket-2: 利用不可（すでに存在）\n  [403] my-bucket-3: 利用不可（存在するがアクセス権限なし）`,
 	Args:  cobra.MinimumNArgs(1),
 	RunE: func(cmdCobra *cobra.Command, args []string) error {
-		return s3svc.CheckAndDisplayBucketsAvailability(s3Client, args)
+		return s3svc.CheckAndDisplayBucketsAvailability(cmdCobra.Context(), s3Client, args)
 	},
 	SilenceUsage: true,
 }
@@ -148,36 +166,120 @@ var (
 	s3CleanupExact  bool
 )
 
+// s3RunType は実行状態に記録するリソースの種類の名前（cleanup all の --types と同じ）
+const s3RunType = "s3"
+
 // s3CleanupCmd represents the cleanup command
 var s3CleanupCmd = &cobra.Command{
 	Use:   "cleanup",
 	Short: "S3バケットを削除するコマンド",
 	Long: `指定したキーワードを含むS3バケットを削除します。
+--tag を指定すると、タグ条件にも一致するバケットのみを削除します。
+--older-than / --newer-than を指定すると、作成日時でも絞り込みます（S3バケットは最終アクティビティ日時を取得できないため、--age-by last-activity でも作成日時で判定します）。
+削除前に確認を求めます（--yes で省略。標準入力が端末でない場合は --yes が必要です）。
+削除を開始すると実行IDを表示します。中断した場合は --resume に実行IDを指定すると、再検出せずに未完了のバケットから
+（オブジェクトバージョン一覧の続きから）削除を再開します。
 
 例:
   ` + AppName + ` s3 cleanup -s "test-bucket" -P my-profile
-  ` + AppName + ` s3 cleanup -s "Test" --exact    # 大文字小文字を区別`,
+  ` + AppName + ` s3 cleanup -s "Test" --exact    # 大文字小文字を区別
+  ` + AppName + ` s3 cleanup --tag env=dev --tag '!keep'   # タグで指定
+  ` + AppName + ` s3 cleanup -s "test" --older-than 14d   # 14日以上前に作成されたもの
+  ` + AppName + ` s3 cleanup -s "test" --plan-out plan.json   # 削除せずにプランを作成
+  ` + AppName + ` s3 cleanup -s "test" --yes   # 確認せずに削除
+  ` + AppName + ` s3 cleanup --resume 20260101-120000-1a2b3c   # 中断した実行を再開`,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		printAwsContextWithInfo("検索文字列", s3CleanupSearch)
-
-		// 検索パターンに一致するバケットを取得
-		buckets, err := s3svc.GetS3BucketsByFilter(s3Client, s3CleanupSearch, s3CleanupExact)
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+		age, err := ageFilter()
 		if err != nil {
-			return fmt.Errorf("❌ S3バケット一覧取得エラー: %w", err)
+			return err
+		}
+
+		printAwsContextWithInfo(i18n.T("検索文字列"), s3CleanupSearch)
+		printAgeFilter(age)
+
+		// 中断した場合に再開できるよう、削除する場合は実行状態を記録する
+		var run *runstate.Run
+		if resumeRunId != "" || planOutPath == "" {
+			run, err = startRun(cmd.Context(), "s3 cleanup")
+			if err != nil {
+				return err
+			}
+		}
+
+		var buckets []string
+		if run.HasTargets() {
+			// 中断した実行を再開する場合は、前回検出したバケットのうち未完了のものを削除する
+			logging.Infof(i18n.T("中断した実行 %s を再開します\n"), run.Id())
+			for _, bucket := range run.Targets()[s3RunType] {
+				if run.IsCompleted(s3RunType, bucket) {
+					logging.Infof(i18n.T("✅ %s %s は前回の実行で削除済みです\n"), i18n.T("S3バケット"), bucket)
+					continue
+				}
+				buckets = append(buckets, bucket)
+			}
+			if len(buckets) == 0 {
+				run.Finish(cmd.Context())
+				logging.Infof("%s\n", i18n.T("✅ S3バケットの削除が完了しました"))
+				return nil
+			}
+		} else {
+			// 検索パターン（とタグ条件）に一致するバケットを取得
+			buckets, err = s3svc.GetS3BucketsByFilter(cmd.Context(), s3Client, s3CleanupSearch, s3CleanupExact, tags, age)
+			if err != nil {
+				return fmt.Errorf(i18n.T("❌ S3バケット一覧取得エラー: %w"), err)
+			}
+
+			if len(buckets) == 0 {
+				logging.Infof(i18n.T("検索パターン '%s' に一致するS3バケットが見つかりませんでした\n"), s3CleanupSearch)
+				return nil
+			}
+
+			// プラン作成モードの場合は削除せずにプランを書き出す
+			if p := newPlanIfRequested(); p != nil {
+				actions, err := s3svc.PlanBucketDeletion(cmd.Context(), s3Client, buckets)
+				if err != nil {
+					return fmt.Errorf("❌ %w", err)
+				}
+				p.Add(actions...)
+				return writePlan(cmd.Context(), p)
+			}
 		}
 
-		if len(buckets) == 0 {
-			fmt.Printf("検索パターン '%s' に一致するS3バケットが見つかりませんでした\n", s3CleanupSearch)
+		// 削除前の確認
+		ok, err := confirm.AskDeletion(i18n.T("S3バケット"), len(buckets))
+		if err != nil {
+			return fmt.Errorf("❌ %w", err)
+		}
+		if !ok {
 			return nil
 		}
 
+		// 中断した場合に再開できるよう、削除対象を保存する（保存に失敗しても削除は継続する）
+		ctx := cmd.Context()
+		if run != nil {
+			if !run.HasTargets() {
+				if err := run.SetTargets(map[string][]string{s3RunType: buckets}); err != nil {
+					logging.Warnf(i18n.T("⚠️  実行状態を保存できなかったため、中断した場合は再開できません: %v\n"), err)
+				} else {
+					logging.Infof(i18n.T("実行ID: %s\n"), run.Id())
+				}
+			}
+			ctx = common.WithCheckpoint(ctx, run.Checkpoint(s3RunType))
+		}
+
 		// バケットを削除
-		result := s3svc.CleanupS3Buckets(s3Client, buckets)
+		result := s3svc.CleanupS3Buckets(ctx, s3Client, buckets)
+		run.Complete(s3RunType, result.Deleted...)
+		run.Finish(cmd.Context())
 		if len(result.Failed) > 0 {
-			return fmt.Errorf("❌ %d個のS3バケットの削除に失敗しました", len(result.Failed))
+			return fmt.Errorf(i18n.T("❌ %d個のS3バケットの削除に失敗しました"), len(result.Failed))
 		}
 
-		fmt.Println("✅ S3バケットの削除が完了しました")
+		logging.Infof("%s\n", i18n.T("✅ S3バケットの削除が完了しました"))
 		return nil
 	},
 	SilenceUsage: true,
@@ -197,9 +299,19 @@ func init() {
 	s3LsCmd.Flags().BoolP("time", "t", false, "ファイルの更新日時も一緒に表示")
 	// ls コマンドに --empty-only フラグを追加
 	s3LsCmd.Flags().BoolP("empty-only", "e", false, "空のバケットのみを表示")
+	addTagFlag(s3LsCmd)
 
 	// cleanup コマンドのフラグ
 	s3CleanupCmd.Flags().StringVarP(&s3CleanupSearch, "search", "s", "", "削除対象の検索パターン")
-	_ = s3CleanupCmd.MarkFlagRequired("search")
 	s3CleanupCmd.Flags().BoolVar(&s3CleanupExact, "exact", false, "大文字小文字を区別してマッチ")
+	addTagFlag(s3CleanupCmd)
+	addAgeFlags(s3CleanupCmd)
+	addPlanOutFlag(s3CleanupCmd)
+	addGuardrailFlag(s3CleanupCmd)
+	addResumeFlag(s3CleanupCmd)
+	s3CleanupCmd.MarkFlagsOneRequired("search", "tag", "resume")
+	// 再開する場合は前回検出した対象を削除する
+	for _, flag := range []string{"search", "tag", "older-than", "newer-than", "plan-out"} {
+		s3CleanupCmd.MarkFlagsMutuallyExclusive("resume", flag)
+	}
 }
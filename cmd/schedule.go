@@ -1,9 +1,14 @@
 package cmd
 
 import (
-	"awstk/internal/service/schedule"
+	"errors"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/schedule"
+	"github.com/ngsw877/awstk/internal/service/selector"
+
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 	"github.com/spf13/cobra"
@@ -34,8 +39,26 @@ var scheduleLsCmd = &cobra.Command{
 例:
   ` + AppName + ` schedule ls                    # 両方のスケジュールを表示
   ` + AppName + ` schedule ls --type rule       # EventBridge Rulesのみ表示
-  ` + AppName + ` schedule ls --type scheduler  # EventBridge Schedulerのみ表示`,
+  ` + AppName + ` schedule ls --type scheduler  # EventBridge Schedulerのみ表示
+  ` + AppName + ` schedule ls --regions all     # 有効な全リージョンを検索
+  ` + AppName + ` schedule ls --tag env=dev     # タグで絞り込み（Schedulerはスケジュールグループのタグで判定）`,
 	RunE: func(cmd *cobra.Command, args []string) error {
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
+
+		if isMultiTarget() {
+			targets, err := resolveTargets(cmd.Context())
+			if err != nil {
+				return err
+			}
+			return schedule.ListSchedulesAcross(cmd.Context(), targets, func(t common.Target) (*eventbridge.Client, *scheduler.Client) {
+				cfg := targetConfig(t)
+				return eventbridge.NewFromConfig(cfg), scheduler.NewFromConfig(cfg)
+			}, schedule.ListOptions{Type: scheduleType, Tags: tags})
+		}
+
 		// クライアント生成
 		eventBridgeClient := eventbridge.NewFromConfig(awsCfg)
 		schedulerClient := scheduler.NewFromConfig(awsCfg)
@@ -43,12 +66,13 @@ var scheduleLsCmd = &cobra.Command{
 		// オプション設定
 		opts := schedule.ListOptions{
 			Type: scheduleType,
+			Tags: tags,
 		}
 
 		// スケジュール一覧取得
-		schedules, err := schedule.ListSchedules(eventBridgeClient, schedulerClient, opts)
+		schedules, err := schedule.ListSchedules(cmd.Context(), eventBridgeClient, schedulerClient, opts)
 		if err != nil {
-			return fmt.Errorf("スケジュール一覧の取得に失敗: %w", err)
+			return fmt.Errorf(i18n.T("スケジュール一覧の取得に失敗: %w"), err)
 		}
 
 		// 表示
@@ -60,18 +84,19 @@ var scheduleLsCmd = &cobra.Command{
 }
 
 var scheduleTriggerCmd = &cobra.Command{
-	Use:   "trigger NAME",
+	Use:   "trigger [NAME]",
 	Short: "スケジュールを手動実行",
 	Long: `EventBridge RuleまたはEventBridge Schedulerを手動で実行します。
 スケジュールを一時的に"rate(1 minute)"に変更し、実行後に元に戻します。
+スケジュール名を省略した場合は、スケジュール一覧から対話的に選択できます。
 
 例:
   ` + AppName + ` schedule trigger my-rule              # 自動でタイプを判別
+  ` + AppName + ` schedule trigger                      # 一覧から選択
   ` + AppName + ` schedule trigger my-scheduler --no-wait # 待機せずに終了`,
-	Args: cobra.ExactArgs(1),
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: firstArg(completeScheduleNames),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		name := args[0]
-
 		// クライアント生成
 		eventBridgeClient := eventbridge.NewFromConfig(awsCfg)
 		schedulerClient := scheduler.NewFromConfig(awsCfg)
@@ -82,8 +107,23 @@ var scheduleTriggerCmd = &cobra.Command{
 			NoWait:  triggerNoWait,
 		}
 
+		// スケジュール名が省略された場合は一覧から選択（選択したタイプで実行）
+		var name string
+		switch {
+		case len(args) == 1:
+			name = args[0]
+		case selector.Available():
+			selected, err := schedule.SelectSchedule(cmd.Context(), eventBridgeClient, schedulerClient)
+			if err != nil {
+				return err
+			}
+			name, opts.Type = selected.Name, selected.Type
+		default:
+			return errors.New(i18n.T("スケジュール名を指定してください"))
+		}
+
 		// スケジュール実行
-		return schedule.TriggerSchedule(eventBridgeClient, schedulerClient, name, opts)
+		return schedule.TriggerSchedule(cmd.Context(), eventBridgeClient, schedulerClient, name, opts)
 	},
 	SilenceUsage: true,
 }
@@ -106,12 +146,12 @@ var scheduleEnableCmd = &cobra.Command{
 		// 単一指定または検索パターン指定の確認
 		if len(args) == 1 && enableSearch == "" {
 			// 単一スケジュールの有効化
-			return schedule.EnableSchedule(eventBridgeClient, schedulerClient, args[0])
+			return schedule.EnableSchedule(cmd.Context(), eventBridgeClient, schedulerClient, args[0])
 		} else if len(args) == 0 && enableSearch != "" {
 			// 検索パターンによる一括有効化
-			return schedule.EnableSchedulesWithFilter(eventBridgeClient, schedulerClient, enableSearch)
+			return schedule.EnableSchedulesWithFilter(cmd.Context(), eventBridgeClient, schedulerClient, enableSearch)
 		} else {
-			return fmt.Errorf("スケジュール名または検索パターンのいずれか一方を指定してください")
+			return errors.New(i18n.T("スケジュール名または検索パターンのいずれか一方を指定してください"))
 		}
 	},
 	SilenceUsage: true,
@@ -135,12 +175,12 @@ var scheduleDisableCmd = &cobra.Command{
 		// 単一指定または検索パターン指定の確認
 		if len(args) == 1 && disableSearch == "" {
 			// 単一スケジュールの無効化
-			return schedule.DisableSchedule(eventBridgeClient, schedulerClient, args[0])
+			return schedule.DisableSchedule(cmd.Context(), eventBridgeClient, schedulerClient, args[0])
 		} else if len(args) == 0 && disableSearch != "" {
 			// 検索パターンによる一括無効化
-			return schedule.DisableSchedulesWithFilter(eventBridgeClient, schedulerClient, disableSearch)
+			return schedule.DisableSchedulesWithFilter(cmd.Context(), eventBridgeClient, schedulerClient, disableSearch)
 		} else {
-			return fmt.Errorf("スケジュール名または検索パターンのいずれか一方を指定してください")
+			return errors.New(i18n.T("スケジュール名または検索パターンのいずれか一方を指定してください"))
 		}
 	},
 	SilenceUsage: true,
@@ -155,6 +195,8 @@ func init() {
 
 	// フラグ定義
 	scheduleLsCmd.Flags().StringVarP(&scheduleType, "type", "t", "all", "表示タイプ (all|rule|scheduler)")
+	addTargetFlags(scheduleLsCmd)
+	addTagFlag(scheduleLsCmd)
 
 	// trigger サブコマンドのフラグ
 	scheduleTriggerCmd.Flags().IntVar(&triggerTimeout, "timeout", 90, "実行待機時間（秒）")
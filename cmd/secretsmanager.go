@@ -1,10 +1,16 @@
 package cmd
 
 import (
-	secretsmgrSvc "awstk/internal/service/secretsmanager"
 	"encoding/json"
+	"errors"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/confirm"
+	secretsmgrSvc "github.com/ngsw877/awstk/internal/service/secretsmanager"
+	"github.com/ngsw877/awstk/internal/service/selector"
+
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 	"github.com/spf13/cobra"
 )
@@ -28,28 +34,43 @@ var secretsmanagerCmd = &cobra.Command{
 }
 
 var secretsmanagerGetCmd = &cobra.Command{
-	Use:   "get <secret-name>",
+	Use:   "get [secret-name]",
 	Short: "Secrets Managerからシークレット値を取得するコマンド",
 	Long: `指定したSecrets Managerのシークレット名またはARNから値を取得し、JSON形式で出力します。
+シークレット名を省略した場合は、シークレット一覧から対話的に選択できます。
 
 例:
   ` + AppName + ` secrets get my-secret-name
+  ` + AppName + ` secrets get                  # 一覧から選択
   ` + AppName + ` secrets get arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:my-secret-abc123`,
-	Args: cobra.ExactArgs(1),
+	Args:              cobra.MaximumNArgs(1),
+	ValidArgsFunction: firstArg(completeSecretNames),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		secretName := args[0]
+		var secretName string
+		switch {
+		case len(args) == 1:
+			secretName = args[0]
+		case selector.Available():
+			var err error
+			secretName, err = secretsmgrSvc.SelectSecret(cmd.Context(), secretsmanagerClient)
+			if err != nil {
+				return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+			}
+		default:
+			return errors.New(i18n.T("❌ エラー: シークレット名を指定してください"))
+		}
 
-		fmt.Printf("🔍 シークレット (%s) の値を取得します...\n", secretName)
+		logging.Infof(i18n.T("🔍 シークレット (%s) の値を取得します...\n"), secretName)
 
-		secretMap, err := secretsmgrSvc.GetSecretValues(secretsmanagerClient, secretName)
+		secretMap, err := secretsmgrSvc.GetSecretValues(cmd.Context(), secretsmanagerClient, secretName)
 		if err != nil {
-			return fmt.Errorf("❌ シークレット取得エラー: %w", err)
+			return fmt.Errorf(i18n.T("❌ シークレット取得エラー: %w"), err)
 		}
 
 		// JSON形式で整形して出力
 		jsonBytes, err := json.MarshalIndent(secretMap, "", "  ")
 		if err != nil {
-			return fmt.Errorf("❌ JSON変換エラー: %w", err)
+			return fmt.Errorf(i18n.T("❌ JSON変換エラー: %w"), err)
 		}
 
 		fmt.Println(string(jsonBytes))
@@ -60,26 +81,92 @@ var secretsmanagerGetCmd = &cobra.Command{
 
 // secretsmanagerDeleteCmd represents the delete command
 var secretsmanagerDeleteCmd = &cobra.Command{
-	Use:   "delete <secret-id>",
+	Use:   "delete [secret-id]",
 	Short: "Secrets Managerのシークレットを即時削除します。",
 	Long: `指定したシークレットを復旧期間なしで即時削除します。
+--tag を指定すると、タグ条件に一致するシークレットをまとめて削除できます。
+
+この操作は元に戻すことができません。削除前に確認を求めます（--yes で省略）。
 
-この操作は元に戻すことができません。`,
-	Args: cobra.ExactArgs(1),
+例:
+  ` + AppName + ` secrets delete my-secret-name
+  ` + AppName + ` secrets delete my-secret-name --yes   # 確認せずに削除
+  ` + AppName + ` secrets delete --tag env=dev --tag '!keep'`,
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
+		if hasTagFilters() {
+			if len(args) > 0 {
+				return errors.New(i18n.T("❌ エラー: シークレットIDと --tag は同時に指定できません"))
+			}
+			return deleteSecretsByTags(cmd)
+		}
+		if len(args) == 0 {
+			return errors.New(i18n.T("❌ エラー: シークレットIDまたは --tag を指定してください"))
+		}
 		secretId := args[0]
 
-		if err := secretsmgrSvc.DeleteSecret(secretsmanagerClient, secretId); err != nil {
+		ok, err := confirm.Ask(confirm.Request{
+			Message: i18n.T("⚠️  以下のシークレットを復旧期間なしで即時削除します:"),
+			Targets: []string{secretId},
+			Prompt:  i18n.T("本当に削除しますか？"),
+		})
+		if err != nil {
+			return fmt.Errorf("❌ %w", err)
+		}
+		if !ok {
+			logging.Infof("%s\n", i18n.T("削除をキャンセルしました"))
+			return nil
+		}
+
+		if err := secretsmgrSvc.DeleteSecret(cmd.Context(), secretsmanagerClient, secretId); err != nil {
 			return err
 		}
 
-		fmt.Printf("シークレット %s は正常に削除されました。\n", secretId)
+		logging.Infof(i18n.T("シークレット %s は正常に削除されました。\n"), secretId)
 		return nil
 	},
 }
 
+// deleteSecretsByTags はタグ条件に一致するシークレットを確認の上でまとめて削除する
+func deleteSecretsByTags(cmd *cobra.Command) error {
+	tags, err := tagSelector()
+	if err != nil {
+		return err
+	}
+	secretIds, err := secretsmgrSvc.GetSecretsByFilter(cmd.Context(), secretsmanagerClient, "", false, tags, nil)
+	if err != nil {
+		return fmt.Errorf("❌ %w", err)
+	}
+
+	if len(secretIds) > 0 {
+		ok, err := confirm.Ask(confirm.Request{
+			Message: i18n.T("⚠️  以下のシークレットを復旧期間なしで即時削除します:"),
+			Targets: secretIds,
+			Prompt:  i18n.T("本当に削除しますか？"),
+		})
+		if err != nil {
+			return fmt.Errorf("❌ %w", err)
+		}
+		if !ok {
+			logging.Infof("%s\n", i18n.T("削除をキャンセルしました"))
+			return nil
+		}
+	}
+
+	return runForTagged(i18n.T("シークレット"), secretIds, func(secretId string) error {
+		if err := secretsmgrSvc.DeleteSecret(cmd.Context(), secretsmanagerClient, secretId); err != nil {
+			return err
+		}
+		logging.Infof(i18n.T("シークレット %s は正常に削除されました。\n"), secretId)
+		return nil
+	})
+}
+
 func init() {
 	RootCmd.AddCommand(secretsmanagerCmd)
 	secretsmanagerCmd.AddCommand(secretsmanagerGetCmd)
 	secretsmanagerCmd.AddCommand(secretsmanagerDeleteCmd)
+
+	addTagFlag(secretsmanagerDeleteCmd)
+	addGuardrailFlag(secretsmanagerDeleteCmd)
 }
@@ -1,9 +1,10 @@
 package cmd
 
 import (
-	sesSvc "awstk/internal/service/ses"
 	"fmt"
 
+	sesSvc "github.com/ngsw877/awstk/internal/service/ses"
+
 	"github.com/aws/aws-sdk-go-v2/service/ses"
 	"github.com/spf13/cobra"
 )
@@ -32,7 +33,7 @@ var sesVerifyCmd = &cobra.Command{
 			FilePath:  emailFile,
 		}
 
-		result, err := sesSvc.VerifyEmailsFromFile(opts)
+		result, err := sesSvc.VerifyEmailsFromFile(cmd.Context(), opts)
 		if err != nil {
 			return fmt.Errorf("❌ %v", err)
 		}
@@ -1,11 +1,14 @@
 package cmd
 
 import (
-	"awstk/internal/aws"
-	ssmsvc "awstk/internal/service/ssm"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	ssmsvc "github.com/ngsw877/awstk/internal/service/ssm"
+
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/spf13/cobra"
@@ -44,10 +47,9 @@ var ssmSessionStartCmd = &cobra.Command{
   ` + AppName + ` ssm session [-P <aws-profile>]  # インスタンス一覧から選択
 `,
 	RunE: func(cmd *cobra.Command, args []string) error {
-		awsCtx := aws.Context{Region: region, Profile: profile}
 		ec2Client := ec2.NewFromConfig(awsCfg)
 
-		return ssmsvc.SelectAndStartSession(awsCtx, ec2Client, ssmInstanceId)
+		return ssmsvc.SelectAndStartSession(cmd.Context(), awsCtx, ec2Client, ssmInstanceId)
 	},
 	SilenceUsage: true,
 }
@@ -72,7 +74,7 @@ var ssmPutParamsCmd = &cobra.Command{
 
 		// ファイル拡張子のバリデーション
 		if !strings.HasSuffix(filePath, ".csv") && !strings.HasSuffix(filePath, ".json") {
-			return fmt.Errorf("❌ サポートされていないファイル形式です。.csv または .json ファイルを指定してください")
+			return errors.New(i18n.T("❌ サポートされていないファイル形式です。.csv または .json ファイルを指定してください"))
 		}
 
 		opts := ssmsvc.PutParamsOptions{
@@ -81,15 +83,15 @@ var ssmPutParamsCmd = &cobra.Command{
 			DryRun:   ssmParamsDryRun,
 		}
 
-		err := ssmsvc.PutParametersFromFile(ssmClient, opts)
+		err := ssmsvc.PutParametersFromFile(cmd.Context(), ssmClient, opts)
 		if err != nil {
-			return fmt.Errorf("❌ パラメータの登録に失敗しました: %w", err)
+			return fmt.Errorf(i18n.T("❌ パラメータの登録に失敗しました: %w"), err)
 		}
 
 		if ssmParamsDryRun {
-			fmt.Println("✅ ドライラン完了")
+			logging.Infof("%s\n", i18n.T("✅ ドライラン完了"))
 		} else {
-			fmt.Println("✅ パラメータの登録が完了しました")
+			logging.Infof("%s\n", i18n.T("✅ パラメータの登録が完了しました"))
 		}
 		return nil
 	},
@@ -97,7 +99,7 @@ var ssmPutParamsCmd = &cobra.Command{
 }
 
 var ssmDeleteParamsCmd = &cobra.Command{
-	Use:   "delete-params <file>",
+	Use:   "delete-params [file]",
 	Short: "ファイルからParameter Storeを一括削除",
 	Long: `テキストファイルに記載されたパラメータ名のリストから、AWS Systems Manager Parameter Storeのパラメータを一括削除します。
 
@@ -105,32 +107,45 @@ var ssmDeleteParamsCmd = &cobra.Command{
   - 1行に1つのパラメータ名を記載
   - 空行と#で始まるコメント行は無視されます
 
+--tag を指定すると、タグ条件に一致するパラメータのみを削除します。
+ファイルを省略した場合は、タグ条件に一致するすべてのパラメータ（--prefix 指定時はその配下のみ）が対象です。
+
 例:
   ` + AppName + ` ssm delete-params params.txt
   ` + AppName + ` ssm delete-params params.txt --force
   ` + AppName + ` ssm delete-params params.txt --dry-run
   ` + AppName + ` ssm delete-params params.txt --prefix /myapp/  # 削除対象パラメータ名に/myapp/を付加
+  ` + AppName + ` ssm delete-params --tag env=dev --prefix /myapp/ --dry-run
 `,
-	Args: cobra.ExactArgs(1),
+	Args: cobra.MaximumNArgs(1),
 	RunE: func(cmd *cobra.Command, args []string) error {
-		filePath := args[0]
+		if len(args) == 0 && !hasTagFilters() {
+			return errors.New(i18n.T("❌ エラー: ファイルまたは --tag を指定してください"))
+		}
+		tags, err := tagSelector()
+		if err != nil {
+			return err
+		}
 
 		opts := ssmsvc.DeleteParamsOptions{
-			FilePath: filePath,
-			Prefix:   ssmParamsPrefix,
-			DryRun:   ssmParamsDryRun,
-			Force:    ssmDeleteForce,
+			Prefix: ssmParamsPrefix,
+			DryRun: ssmParamsDryRun,
+			Force:  ssmDeleteForce,
+			Tags:   tags,
+		}
+		if len(args) > 0 {
+			opts.FilePath = args[0]
 		}
 
-		err := ssmsvc.DeleteParametersFromFile(ssmClient, opts)
+		err = ssmsvc.DeleteParametersFromFile(cmd.Context(), ssmClient, opts)
 		if err != nil {
-			return fmt.Errorf("❌ パラメータの削除に失敗しました: %w", err)
+			return fmt.Errorf(i18n.T("❌ パラメータの削除に失敗しました: %w"), err)
 		}
 
 		if ssmParamsDryRun {
-			fmt.Println("✅ ドライラン完了")
+			logging.Infof("%s\n", i18n.T("✅ ドライラン完了"))
 		} else {
-			fmt.Println("✅ パラメータの削除が完了しました")
+			logging.Infof("%s\n", i18n.T("✅ パラメータの削除が完了しました"))
 		}
 		return nil
 	},
@@ -154,4 +169,5 @@ func init() {
 	ssmDeleteParamsCmd.Flags().StringVarP(&ssmParamsPrefix, "prefix", "p", "", "パラメータ名のプレフィックス")
 	ssmDeleteParamsCmd.Flags().BoolVarP(&ssmParamsDryRun, "dry-run", "d", false, "実際には削除せず、削除対象を確認")
 	ssmDeleteParamsCmd.Flags().BoolVarP(&ssmDeleteForce, "force", "f", false, "確認プロンプトをスキップ")
+	addTagFlag(ssmDeleteParamsCmd)
 }
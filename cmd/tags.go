@@ -0,0 +1,67 @@
+package cmd
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/spf13/cobra"
+)
+
+// tagFilters は --tag で指定されたタグ条件（指定形式のまま）
+var tagFilters []string
+
+// addTagFlag はリソースをタグで絞り込むコマンドに --tag フラグを追加する
+func addTagFlag(cmd *cobra.Command) {
+	cmd.Flags().StringArrayVar(&tagFilters, "tag", nil, "タグで絞り込み（key=value、key、!key、key!=value。複数指定時はすべてに一致するもの）")
+}
+
+// hasTagFilters は --tag が指定されているかを返す
+func hasTagFilters() bool {
+	return len(tagFilters) > 0
+}
+
+// tagSelector は --tag の指定値からタグ条件を作成する（--tag が指定されていない場合は nil）
+// タグの取得には実行対象のアカウント・リージョン用の設定を使用する
+func tagSelector() (*tagging.Selector, error) {
+	if !hasTagFilters() {
+		return nil, nil
+	}
+	filters, err := tagging.ParseFilters(tagFilters)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+	}
+	return &tagging.Selector{
+		Filters: filters,
+		NewClient: func(t common.Target) tagging.TaggingApi {
+			return resourcegroupstaggingapi.NewFromConfig(targetConfig(t))
+		},
+	}, nil
+}
+
+// runForTagged はタグ条件に一致した各リソースに操作を実行する
+// いずれかのリソースで失敗しても残りのリソースの処理は継続し、最後に失敗したリソースをまとめてエラーとして返す
+func runForTagged(resourceName string, ids []string, run func(id string) error) error {
+	if len(ids) == 0 {
+		logging.Infof(i18n.T("タグ条件に一致する%sが見つかりませんでした\n"), resourceName)
+		return nil
+	}
+	logging.Infof(i18n.T("🔍 タグ条件に一致する%sが%d件見つかりました\n"), resourceName, len(ids))
+
+	var failed []string
+	for _, id := range ids {
+		if err := run(id); err != nil {
+			logging.Warnf("%v\n", err)
+			failed = append(failed, id)
+		}
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf(i18n.T("❌ %d件の%sで失敗しました: %s"), len(failed), resourceName, strings.Join(failed, ", "))
+	}
+	return nil
+}
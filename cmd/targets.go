@@ -0,0 +1,209 @@
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/aws"
+	"github.com/ngsw877/awstk/internal/config"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+	regionSvc "github.com/ngsw877/awstk/internal/service/region"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/spf13/cobra"
+)
+
+// allTargets は --regions / --accounts ですべての対象を指定する値
+// --regions では有効な全リージョン、--accounts では設定ファイルの環境に定義したアカウントを表す
+const allTargets = "all"
+
+// lsRegions は --regions で指定された一覧取得対象のリージョン
+var lsRegions string
+
+// 複数アカウント実行のフラグ
+var (
+	targetAccounts   string
+	assumeRoleName   string
+	assumeExternalId string
+	assumeMfaSerial  string
+)
+
+// accountConfigs は対象アカウントごとのAWS設定（resolveTargets で作成する）
+var accountConfigs map[string]awsconfig.Config
+
+// addRegionsFlag は読み取り専用の一覧コマンドに --regions フラグを追加する
+func addRegionsFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&lsRegions, "regions", "", "複数リージョンを並列に検索してリージョン列付きで表示（all または ap-northeast-1,us-east-1 のようにカンマ区切り）")
+}
+
+// addAccountsFlags は複数アカウントで実行できるコマンドに --accounts と関連フラグを追加する
+func addAccountsFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&targetAccounts, "accounts", "", "ロールを引き受けて複数アカウントで実行（アカウントIDまたはロールARNをカンマ区切り、all で設定ファイルの環境の accounts）")
+	cmd.Flags().StringVar(&assumeRoleName, "role-name", "", "各アカウントで引き受けるロール名（デフォルト: "+config.DefaultRoleName+"）")
+	cmd.Flags().StringVar(&assumeExternalId, "external-id", "", "ロールの引き受けに使用する外部ID")
+	cmd.Flags().StringVar(&assumeMfaSerial, "mfa-serial", "", "MFAデバイスのARN（指定時は実行前に一度だけトークンコードを入力）")
+}
+
+// addTargetFlags は読み取り専用の一覧コマンドに --regions と --accounts を追加する
+func addTargetFlags(cmd *cobra.Command) {
+	addRegionsFlag(cmd)
+	addAccountsFlags(cmd)
+}
+
+// isMultiRegion は --regions が指定されているかを返す
+func isMultiRegion() bool {
+	return lsRegions != ""
+}
+
+// isMultiAccount は --accounts が指定されているかを返す
+func isMultiAccount() bool {
+	return targetAccounts != ""
+}
+
+// isMultiTarget は複数のアカウントまたはリージョンで実行するかを返す
+func isMultiTarget() bool {
+	return isMultiRegion() || isMultiAccount()
+}
+
+// resolveTargets は --accounts と --regions の指定値から実行対象の一覧を作成する
+// 複数アカウントの場合は各アカウントのロールを引き受けるAWS設定も用意する（MFA指定時はここでトークンを入力する）
+func resolveTargets(ctx context.Context) ([]common.Target, error) {
+	accounts := []string{""}
+	if isMultiAccount() {
+		configs, err := loadAccountConfigs(ctx)
+		if err != nil {
+			return nil, err
+		}
+		accounts = make([]string, len(configs))
+		for i, c := range configs {
+			accounts[i] = c.Account
+		}
+	}
+
+	regions := []string{""}
+	if isMultiRegion() {
+		var err error
+		regions, err = resolveRegions(ctx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	targets := make([]common.Target, 0, len(accounts)*len(regions))
+	for _, account := range accounts {
+		for _, r := range regions {
+			targets = append(targets, common.Target{Account: account, Region: r})
+		}
+	}
+	return targets, nil
+}
+
+// loadAccountConfigs は --accounts で指定された各アカウントのロールを引き受けるAWS設定を作成する
+func loadAccountConfigs(ctx context.Context) ([]aws.AccountConfig, error) {
+	assumeRole := awsCtx.AssumeRole
+	if strings.EqualFold(strings.TrimSpace(targetAccounts), allTargets) {
+		if resolvedConfig == nil || len(resolvedConfig.Environment.Accounts) == 0 {
+			return nil, errors.New(i18n.T("❌ エラー: --accounts all を使用するには設定ファイルの環境に accounts を定義してください"))
+		}
+		assumeRole.Accounts = resolvedConfig.Environment.Accounts
+	} else {
+		assumeRole.Accounts = strings.Split(targetAccounts, ",")
+	}
+
+	configs, err := aws.LoadAccountConfigs(ctx, awsCfg, assumeRole, promptMfaToken)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+	}
+	accountConfigs = make(map[string]awsconfig.Config, len(configs))
+	for _, c := range configs {
+		accountConfigs[c.Account] = c.Config
+	}
+	if roleName := commonRoleName(configs); roleName != "" {
+		logging.Infof(i18n.T("🔍 %d個のアカウントでロール '%s' を引き受けて実行します\n"), len(configs), roleName)
+	} else {
+		logging.Infof(i18n.T("🔍 %d個のアカウントでロールを引き受けて実行します\n"), len(configs))
+	}
+	return configs, nil
+}
+
+// commonRoleName は全アカウントで引き受けるロール名が共通の場合にそのロール名を返す（異なる場合は空文字）
+func commonRoleName(configs []aws.AccountConfig) string {
+	roleName := ""
+	for i, c := range configs {
+		name := c.RoleArn[strings.LastIndex(c.RoleArn, "/")+1:]
+		if i > 0 && name != roleName {
+			return ""
+		}
+		roleName = name
+	}
+	return roleName
+}
+
+// promptMfaToken はMFAのトークンコードを標準入力から読み込む
+func promptMfaToken() (string, error) {
+	fmt.Fprintf(os.Stderr, i18n.T("🔐 MFAトークンコードを入力してください (%s): "), awsCtx.AssumeRole.MfaSerial)
+	token, err := common.Stdin.ReadString('\n')
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(token), nil
+}
+
+// resolveRegions は --regions の指定値を対象リージョンの一覧に変換する
+// all の場合は有効な（オプトイン不要またはオプトイン済みの）全リージョンを対象とする
+func resolveRegions(ctx context.Context) ([]string, error) {
+	if strings.EqualFold(strings.TrimSpace(lsRegions), allTargets) {
+		regions, err := regionSvc.ListRegions(ctx, ec2.NewFromConfig(awsCfg), false)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("❌ リージョン一覧の取得に失敗: %w"), err)
+		}
+		available, _ := regionSvc.GroupRegions(regions)
+		names := make([]string, len(available))
+		for i, r := range available {
+			names[i] = r.RegionName
+		}
+		return names, nil
+	}
+
+	seen := make(map[string]bool)
+	var names []string
+	for _, r := range strings.Split(lsRegions, ",") {
+		r = strings.TrimSpace(r)
+		if r == "" || seen[r] {
+			continue
+		}
+		seen[r] = true
+		names = append(names, r)
+	}
+	if len(names) == 0 {
+		return nil, errors.New(i18n.T("❌ エラー: --regions にリージョンを指定してください（all または カンマ区切り）"))
+	}
+	return names, nil
+}
+
+// targetConfig は実行対象のアカウント・リージョン用のAWS設定を返す
+func targetConfig(t common.Target) awsconfig.Config {
+	cfg := awsCfg
+	if c, ok := accountConfigs[t.Account]; ok {
+		cfg = c
+	}
+	cfg = cfg.Copy()
+	if t.Region != "" {
+		cfg.Region = t.Region
+	}
+	return cfg
+}
+
+// validateMultiTargetStack は --regions / --accounts と -S の同時指定を防ぐ（スタックは単一アカウント・単一リージョンのリソースのため）
+func validateMultiTargetStack() error {
+	if isMultiTarget() && stackName != "" {
+		return errors.New(i18n.T("❌ エラー: --regions / --accounts と -S (スタック名) は同時に指定できません"))
+	}
+	return nil
+}
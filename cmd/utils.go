@@ -1,34 +1,77 @@
 package cmd
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"os"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/config"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/plan"
+	"github.com/ngsw877/awstk/internal/service/selector"
+
+	awsconfig "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+	"github.com/spf13/cobra"
 )
 
-// resolveStackName はコマンドライン引数または環境変数からスタック名を決定し、グローバル変数 stackName にセットする
+// planOutPath は --plan-out で指定されたプランファイルの出力先
+var planOutPath string
+
+// resolveStackName はコマンドライン引数・環境変数・設定ファイルからスタック名を決定し、グローバル変数 stackName にセットする
 func resolveStackName() {
 	if stackName != "" {
-		fmt.Println("🔍 -Sオプションで指定されたスタック名 '" + stackName + "' を使用します")
+		logging.Infof(i18n.T("🔍 -Sオプションで指定されたスタック名 '%s' を使用します\n"), stackName)
 		return
 	}
-	envStack := os.Getenv("AWS_STACK_NAME")
-	if envStack != "" {
-		fmt.Println("🔍 環境変数 AWS_STACK_NAME の値 '" + envStack + "' を使用します")
-		stackName = envStack
+	if resolvedConfig == nil {
+		return
+	}
+	resolved := resolvedConfig.StackName
+	switch resolved.Origin {
+	case config.OriginEnvVar:
+		logging.Infof(i18n.T("🔍 環境変数 AWS_STACK_NAME の値 '%s' を使用します\n"), resolved.Value)
+		stackName = resolved.Value
+	case config.OriginFile:
+		logging.Infof(i18n.T("🔍 環境 '%s' のスタック名 '%s' を使用します\n"), resolvedConfig.Env.Value, resolved.Value)
+		stackName = resolved.Value
+	}
+	// いずれもなければstackNameは空のまま
+}
+
+// requireStackName はスタック名を決定し、指定されていない場合は端末であればスタック一覧から選択させる
+func requireStackName(cmd *cobra.Command) error {
+	resolveStackName()
+	if stackName != "" {
+		return nil
 	}
-	// どちらもなければstackNameは空のまま
+	if !selector.Available() {
+		return errors.New(i18n.T("❌ エラー: スタック名 (-S) を指定してください"))
+	}
+	selected, err := cfn.SelectCfnStack(cmd.Context(), cloudformation.NewFromConfig(awsCfg))
+	if err != nil {
+		return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+	}
+	stackName = selected
+	return nil
 }
 
 // printAwsContext はAWSコンテキスト情報を表示する共通関数
 func printAwsContext() {
-	fmt.Printf("Profile: %s\n", profile)
-	fmt.Printf("Region: %s\n", region)
+	logging.Infof("Profile: %s\n", profile)
+	logging.Infof("Region: %s\n", region)
 }
 
 // printAwsContextWithInfo はAWSコンテキスト情報と追加情報を表示する共通関数
 func printAwsContextWithInfo(infoLabel string, infoValue string) {
 	printAwsContext()
-	fmt.Printf("%s: %s\n", infoLabel, infoValue)
+	logging.Infof("%s: %s\n", infoLabel, infoValue)
 }
 
 // ValidateStackSelection は位置引数とオプションの排他チェックを行います
@@ -36,12 +79,144 @@ func ValidateStackSelection(args []string, hasOptions bool) error {
 	hasArgs := len(args) > 0
 
 	if !hasArgs && !hasOptions {
-		return fmt.Errorf("❌ エラー: スタック名またはオプションを指定してください")
+		return errors.New(i18n.T("❌ エラー: スタック名またはオプションを指定してください"))
 	}
 
 	if hasArgs && hasOptions {
-		return fmt.Errorf("❌ エラー: スタック名とオプションは同時に指定できません")
+		return errors.New(i18n.T("❌ エラー: スタック名とオプションは同時に指定できません"))
 	}
 
 	return nil
 }
+
+// addPlanOutFlag は破壊的コマンドに --plan-out フラグを追加する
+func addPlanOutFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&planOutPath, "plan-out", "", "削除せずに実行計画をJSONファイルに書き出す（"+AppName+" apply で実行）")
+	_ = cmd.MarkFlagFilename("plan-out", "json")
+}
+
+// newPlanIfRequested は --plan-out が指定されている場合に空のプランを作成する（未指定ならnil）
+func newPlanIfRequested() *plan.Plan {
+	if planOutPath == "" {
+		return nil
+	}
+	planProfile := profile
+	if planProfile == "" {
+		planProfile = os.Getenv("AWS_PROFILE")
+	}
+	command := AppName + " " + strings.Join(os.Args[1:], " ")
+	return plan.New(command, planProfile, region)
+}
+
+// writePlan はプランを --plan-out で指定されたファイルに書き出す
+// apply 時に別のアカウントで実行されないよう、実行中の認証情報のアカウントIDを記録する
+func writePlan(ctx context.Context, p *plan.Plan) error {
+	if len(p.Actions) == 0 {
+		logging.Infof("%s\n", i18n.T("削除対象がないため、プランファイルは作成しませんでした"))
+		return nil
+	}
+	account, err := fetchAccountId(ctx, awsCfg)
+	if err != nil {
+		return fmt.Errorf(i18n.T("❌ 実行中のアカウントIDを取得できないため、プランを作成できません（apply 時に同じアカウントか確認するために記録します）: %w"), err)
+	}
+	p.Account = account
+	if err := plan.Write(planOutPath, p); err != nil {
+		return fmt.Errorf("❌ %w", err)
+	}
+	fmt.Println()
+	plan.Print(p)
+	logging.Infof(i18n.T("\n📝 プランを %s に書き出しました（削除はまだ実行されていません）\n"), planOutPath)
+	logging.Infof(i18n.T("   実行するには: %s apply %s\n"), AppName, planOutPath)
+	return nil
+}
+
+// callerAccountId は実行中の認証情報のアカウントIDを返す（取得できない場合は空文字）
+func callerAccountId(ctx context.Context, cfg awsconfig.Config) string {
+	account, err := fetchAccountId(ctx, cfg)
+	if err != nil {
+		logging.Debugf(i18n.T("アカウントIDの取得に失敗: %v\n"), err)
+		return ""
+	}
+	return account
+}
+
+// fetchAccountId は実行中の認証情報のアカウントIDを取得する
+func fetchAccountId(ctx context.Context, cfg awsconfig.Config) (string, error) {
+	identity, err := sts.NewFromConfig(cfg).GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return "", err
+	}
+	account := awsconfig.ToString(identity.Account)
+	if account == "" {
+		return "", errors.New(i18n.T("アカウントIDが返されませんでした"))
+	}
+	return account, nil
+}
+
+// printCleanupSummary はクリーンアップ結果のサマリーを表示する
+func printCleanupSummary(results []common.CleanupResult) {
+	fmt.Println()
+	fmt.Println("════════════════════════════════════════════════════════════")
+	fmt.Println(i18n.T("                    クリーンアップ サマリー"))
+	fmt.Println("════════════════════════════════════════════════════════════")
+
+	totalDeleted := 0
+	totalFailed := 0
+	totalSkipped := 0
+
+	for _, result := range results {
+		if result.TotalCount() == 0 {
+			continue
+		}
+
+		fmt.Printf(i18n.T("\n【%s】\n"), result.ResourceType)
+
+		if len(result.Deleted) > 0 {
+			fmt.Printf(i18n.T("  ✅ 削除成功: %d件\n"), len(result.Deleted))
+			for _, name := range result.Deleted {
+				fmt.Printf("     - %s\n", name)
+			}
+		}
+
+		if len(result.Failed) > 0 {
+			fmt.Printf(i18n.T("  ❌ 削除失敗: %d件\n"), len(result.Failed))
+			for _, name := range result.Failed {
+				fmt.Printf("     - %s\n", name)
+			}
+		}
+
+		if len(result.Skipped) > 0 {
+			fmt.Printf(i18n.T("  ⚠️  中断により未実行: %d件\n"), len(result.Skipped))
+			for _, name := range result.Skipped {
+				fmt.Printf("     - %s\n", name)
+			}
+		}
+
+		if len(result.Blocked) > 0 {
+			fmt.Printf(i18n.T("  ⛔ 参照元のリソースを削除できなかったため未実行: %d件\n"), len(result.Blocked))
+			for _, name := range result.Blocked {
+				fmt.Printf("     - %s\n", name)
+			}
+		}
+
+		if len(result.Protected) > 0 {
+			fmt.Printf(i18n.T("  🛡️  ガードレールにより未実行: %d件\n"), len(result.Protected))
+			for _, name := range result.Protected {
+				fmt.Printf("     - %s\n", name)
+			}
+		}
+
+		totalDeleted += len(result.Deleted)
+		totalFailed += len(result.Failed)
+		totalSkipped += len(result.Skipped) + len(result.Blocked) + len(result.Protected)
+	}
+
+	fmt.Println()
+	fmt.Println("────────────────────────────────────────────────────────────")
+	if totalSkipped > 0 {
+		fmt.Printf(i18n.T("合計: 削除成功 %d件 / 削除失敗 %d件 / 未実行 %d件\n"), totalDeleted, totalFailed, totalSkipped)
+	} else {
+		fmt.Printf(i18n.T("合計: 削除成功 %d件 / 削除失敗 %d件\n"), totalDeleted, totalFailed)
+	}
+	fmt.Println("════════════════════════════════════════════════════════════")
+}
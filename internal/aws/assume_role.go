@@ -0,0 +1,125 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+const (
+	// SessionName は引き受けたロールのセッション名（CloudTrail で awstk からの操作を識別できるようにする）
+	SessionName = "awstk"
+	// mfaSessionDuration はMFA認証済みセッションの有効期間（秒）
+	mfaSessionDuration = 3600
+)
+
+// accountIdPattern は12桁のAWSアカウントID
+var accountIdPattern = regexp.MustCompile(`^\d{12}$`)
+
+// RoleArnFor は対象アカウントの指定（アカウントIDまたはロールARN）から、アカウントIDと引き受けるロールのARNを返す
+func RoleArnFor(target, roleName, region string) (string, string, error) {
+	target = strings.TrimSpace(target)
+	if strings.HasPrefix(target, "arn:") {
+		parsed, err := arn.Parse(target)
+		if err != nil || parsed.Service != "iam" || !strings.HasPrefix(parsed.Resource, "role/") {
+			return "", "", fmt.Errorf(i18n.T("ロールARNの形式が不正です: %s"), target)
+		}
+		return parsed.AccountID, target, nil
+	}
+	if !accountIdPattern.MatchString(target) {
+		return "", "", fmt.Errorf(i18n.T("アカウントIDは12桁の数字またはロールARNで指定してください: %s"), target)
+	}
+	if roleName == "" {
+		return "", "", fmt.Errorf(i18n.T("アカウントID %s で引き受けるロール名が指定されていません"), target)
+	}
+	return target, fmt.Sprintf("arn:%s:iam::%s:role/%s", PartitionFor(region), target, roleName), nil
+}
+
+// PartitionFor はリージョンが属するパーティションを返す
+func PartitionFor(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return "aws-cn"
+	case strings.HasPrefix(region, "us-gov-"):
+		return "aws-us-gov"
+	default:
+		return "aws"
+	}
+}
+
+// LoadAccountConfigs は基準のAWS設定から、対象アカウントごとにロールを引き受けるAWS設定を作成する
+// MFAが指定されている場合は readToken でトークンコードを一度だけ取得してMFA認証済みのセッションを作成し、
+// そのセッションから各アカウントのロールを引き受ける（アカウントごとにトークンを入力する必要はない）
+// ロールの引き受けは各アカウントで最初にAPIを呼び出した時点で行われるため、
+// 引き受けに失敗したアカウントはそのアカウントの処理のエラーとなり、他のアカウントの処理は継続できる
+func LoadAccountConfigs(ctx context.Context, base aws.Config, assumeRole AssumeRole, readToken func() (string, error)) ([]AccountConfig, error) {
+	type accountTarget struct {
+		account string
+		roleArn string
+	}
+	seen := make(map[string]bool)
+	var targets []accountTarget
+	for _, t := range assumeRole.Accounts {
+		if strings.TrimSpace(t) == "" {
+			continue
+		}
+		account, roleArn, err := RoleArnFor(t, assumeRole.RoleName, base.Region)
+		if err != nil {
+			return nil, err
+		}
+		if seen[account] {
+			continue
+		}
+		seen[account] = true
+		targets = append(targets, accountTarget{account: account, roleArn: roleArn})
+	}
+	if len(targets) == 0 {
+		return nil, errors.New(i18n.T("対象アカウントが指定されていません"))
+	}
+
+	source := base.Copy()
+	if assumeRole.MfaSerial != "" {
+		token, err := readToken()
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("MFAトークンコードの入力に失敗: %w"), err)
+		}
+		output, err := sts.NewFromConfig(base).GetSessionToken(ctx, &sts.GetSessionTokenInput{
+			SerialNumber:    aws.String(assumeRole.MfaSerial),
+			TokenCode:       aws.String(strings.TrimSpace(token)),
+			DurationSeconds: aws.Int32(mfaSessionDuration),
+		})
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("MFA認証済みセッションの取得に失敗: %w"), err)
+		}
+		source.Credentials = aws.NewCredentialsCache(credentials.NewStaticCredentialsProvider(
+			aws.ToString(output.Credentials.AccessKeyId),
+			aws.ToString(output.Credentials.SecretAccessKey),
+			aws.ToString(output.Credentials.SessionToken),
+		))
+	}
+
+	stsClient := sts.NewFromConfig(source)
+	configs := make([]AccountConfig, len(targets))
+	for i, t := range targets {
+		provider := stscreds.NewAssumeRoleProvider(stsClient, t.roleArn, func(o *stscreds.AssumeRoleOptions) {
+			o.RoleSessionName = SessionName
+			if assumeRole.ExternalId != "" {
+				o.ExternalID = aws.String(assumeRole.ExternalId)
+			}
+		})
+		cfg := base.Copy()
+		cfg.Credentials = aws.NewCredentialsCache(provider)
+		configs[i] = AccountConfig{Account: t.account, RoleArn: t.roleArn, Config: cfg}
+	}
+	return configs, nil
+}
@@ -3,6 +3,8 @@ package aws
 import (
 	"context"
 
+	"github.com/ngsw877/awstk/internal/logging"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/config"
 )
@@ -10,14 +12,32 @@ import (
 // LoadAwsConfig は認証情報からAWS設定を読み込む
 // オプションで指定されたRegion, Profileを優先してAWS設定を読み込む
 // いずれも指定されなければconfig.LoadDefaultConfigで環境変数AWS_PROFILE等から設定を読み込む
-func LoadAwsConfig(ctx Context) (aws.Config, error) {
+// エンドポイントが指定されている場合は、以降に生成する全サービスクライアントの接続先を上書きする
+func LoadAwsConfig(ctx context.Context, awsCtx Context) (aws.Config, error) {
 	opts := make([]func(*config.LoadOptions) error, 0)
 
-	if ctx.Profile != "" {
-		opts = append(opts, config.WithSharedConfigProfile(ctx.Profile))
+	if awsCtx.Profile != "" {
+		opts = append(opts, config.WithSharedConfigProfile(awsCtx.Profile))
+	}
+	if awsCtx.Region != "" {
+		opts = append(opts, config.WithRegion(awsCtx.Region))
 	}
-	if ctx.Region != "" {
-		opts = append(opts, config.WithRegion(ctx.Region))
+	cfg, err := config.LoadDefaultConfig(ctx, opts...)
+	if err != nil {
+		return cfg, err
+	}
+
+	// エンドポイントの上書き（全体はBaseEndpoint、サービスごとは設定ソースの先頭に追加して優先させる）
+	if awsCtx.EndpointUrl != "" {
+		cfg.BaseEndpoint = aws.String(awsCtx.EndpointUrl)
+	}
+	if len(awsCtx.ServiceEndpoints) > 0 {
+		cfg.ConfigSources = append([]interface{}{serviceEndpointSource{endpoints: awsCtx.ServiceEndpoints}}, cfg.ConfigSources...)
+	}
+
+	// -vv の場合はAPI呼び出しをトレースする
+	if logging.Enabled(logging.LevelTrace) {
+		cfg.APIOptions = append(cfg.APIOptions, addTraceMiddleware)
 	}
-	return config.LoadDefaultConfig(context.Background(), opts...)
+	return cfg, nil
 }
@@ -0,0 +1,140 @@
+package aws
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+)
+
+const (
+	// EndpointUrlEnv は全サービス共通のエンドポイントURLを指定する環境変数
+	EndpointUrlEnv = "AWSTK_ENDPOINT_URL"
+	// ServiceEndpointsEnv はサービスごとのエンドポイントURLを指定する環境変数（例: "s3=http://localhost:4566,logs=http://localhost:4567"）
+	ServiceEndpointsEnv = "AWSTK_SERVICE_ENDPOINTS"
+)
+
+// serviceAliases はCLIで使われる短縮名をSDKのサービスID（正規化済み）に対応付ける
+var serviceAliases = map[string]string{
+	"logs":    "cloudwatchlogs",
+	"cfn":     "cloudformation",
+	"elb":     "elasticloadbalancingv2",
+	"elbv2":   "elasticloadbalancingv2",
+	"events":  "eventbridge",
+	"secrets": "secretsmanager",
+	"aas":     "applicationautoscaling",
+}
+
+// normalizeServiceKey はサービス名を比較用に正規化する
+// 小文字化して英数字以外を取り除き、短縮名はSDKのサービスIDに変換する
+// 例: "CloudWatch Logs" → "cloudwatchlogs", "logs" → "cloudwatchlogs"
+func normalizeServiceKey(service string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(service) {
+		if (r >= 'a' && r <= 'z') || (r >= '0' && r <= '9') {
+			b.WriteRune(r)
+		}
+	}
+	key := b.String()
+	if alias, ok := serviceAliases[key]; ok {
+		return alias
+	}
+	return key
+}
+
+// ResolveEndpoints はフラグと環境変数からエンドポイント設定を解決する
+// 全体URLはフラグの値を優先し、未指定の場合は AWSTK_ENDPOINT_URL を使用する
+// サービスごとのURLは AWSTK_SERVICE_ENDPOINTS にフラグの値を上書きで重ねる
+func ResolveEndpoints(endpointUrl string, serviceEndpoints map[string]string) (string, map[string]string, error) {
+	if endpointUrl == "" {
+		endpointUrl = os.Getenv(EndpointUrlEnv)
+	}
+	if endpointUrl != "" {
+		if err := validateEndpointUrl(endpointUrl); err != nil {
+			return "", nil, err
+		}
+	}
+
+	resolved := make(map[string]string)
+	if env := os.Getenv(ServiceEndpointsEnv); env != "" {
+		for _, pair := range strings.Split(env, ",") {
+			pair = strings.TrimSpace(pair)
+			if pair == "" {
+				continue
+			}
+			kv := strings.SplitN(pair, "=", 2)
+			if len(kv) != 2 || strings.TrimSpace(kv[0]) == "" {
+				return "", nil, fmt.Errorf(i18n.T("%s の形式が不正です（service=url をカンマ区切りで指定してください）: %s"), ServiceEndpointsEnv, pair)
+			}
+			resolved[normalizeServiceKey(kv[0])] = strings.TrimSpace(kv[1])
+		}
+	}
+	for service, u := range serviceEndpoints {
+		resolved[normalizeServiceKey(service)] = u
+	}
+	for service, u := range resolved {
+		if err := validateEndpointUrl(u); err != nil {
+			return "", nil, fmt.Errorf(i18n.T("サービス '%s': %w"), service, err)
+		}
+	}
+	return endpointUrl, resolved, nil
+}
+
+// validateEndpointUrl はエンドポイントURLがスキーム付きの絶対URLであることを確認する
+func validateEndpointUrl(endpointUrl string) error {
+	u, err := url.Parse(endpointUrl)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return fmt.Errorf(i18n.T("エンドポイントURLが不正です（例: http://localhost:4566）: %s"), endpointUrl)
+	}
+	return nil
+}
+
+// EndpointFor は指定したサービスのエンドポイントURLを返す
+// サービスごとの設定を優先し、なければ全体の設定を返す（どちらもなければ空文字）
+func (c Context) EndpointFor(service string) string {
+	if u, ok := c.ServiceEndpoints[normalizeServiceKey(service)]; ok && u != "" {
+		return u
+	}
+	return c.EndpointUrl
+}
+
+// HasEndpointOverride は指定したサービスのエンドポイントが上書きされているかを返す
+func (c Context) HasEndpointOverride(service string) bool {
+	return c.EndpointFor(service) != ""
+}
+
+// EndpointSummary はエンドポイント上書きの内容を表示用の文字列で返す（上書きがなければ空文字）
+func (c Context) EndpointSummary() string {
+	var parts []string
+	if c.EndpointUrl != "" {
+		parts = append(parts, c.EndpointUrl)
+	}
+	services := make([]string, 0, len(c.ServiceEndpoints))
+	for service := range c.ServiceEndpoints {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		parts = append(parts, service+"="+c.ServiceEndpoints[service])
+	}
+	return strings.Join(parts, ", ")
+}
+
+// serviceEndpointSource はサービスごとのエンドポイントをSDKに渡すための設定ソース
+// aws.Config.ConfigSources に追加すると、各サービスクライアントの生成時に参照される
+type serviceEndpointSource struct {
+	endpoints map[string]string
+}
+
+// GetServiceBaseEndpoint はSDKのサービスID（例: "CloudWatch Logs"）に対応するエンドポイントを返す
+func (s serviceEndpointSource) GetServiceBaseEndpoint(ctx context.Context, sdkId string) (string, bool, error) {
+	u, ok := s.endpoints[normalizeServiceKey(sdkId)]
+	if !ok || u == "" {
+		return "", false, nil
+	}
+	return u, true, nil
+}
@@ -0,0 +1,70 @@
+package aws
+
+import (
+	"bufio"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/config"
+)
+
+// ListProfiles は共有設定ファイル（~/.aws/config）と共有認証情報ファイル（~/.aws/credentials）に定義されたプロファイル名を返す
+// 環境変数 AWS_CONFIG_FILE / AWS_SHARED_CREDENTIALS_FILE が指定されている場合はそのファイルを読み込む
+// 読み込めないファイルは無視する
+func ListProfiles() []string {
+	configFile := os.Getenv("AWS_CONFIG_FILE")
+	if configFile == "" {
+		configFile = config.DefaultSharedConfigFilename()
+	}
+	credentialsFile := os.Getenv("AWS_SHARED_CREDENTIALS_FILE")
+	if credentialsFile == "" {
+		credentialsFile = config.DefaultSharedCredentialsFilename()
+	}
+
+	seen := make(map[string]bool)
+	for _, name := range readProfileSections(configFile, true) {
+		seen[name] = true
+	}
+	for _, name := range readProfileSections(credentialsFile, false) {
+		seen[name] = true
+	}
+
+	profiles := make([]string, 0, len(seen))
+	for name := range seen {
+		profiles = append(profiles, name)
+	}
+	sort.Strings(profiles)
+	return profiles
+}
+
+// readProfileSections は設定ファイルのセクション名からプロファイル名を取り出す
+// 共有設定ファイルでは "[profile name]" 形式（default のみ "[default]"）で、sso-session 等のセクションは対象外とする
+func readProfileSections(path string, sharedConfig bool) []string {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil
+	}
+	defer f.Close()
+
+	var names []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "[") || !strings.HasSuffix(line, "]") {
+			continue
+		}
+		section := strings.TrimSpace(line[1 : len(line)-1])
+		if sharedConfig && section != "default" {
+			name, ok := strings.CutPrefix(section, "profile ")
+			if !ok {
+				continue
+			}
+			section = strings.TrimSpace(name)
+		}
+		if section != "" {
+			names = append(names, section)
+		}
+	}
+	return names
+}
@@ -0,0 +1,77 @@
+package aws
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+
+	awsmiddleware "github.com/aws/aws-sdk-go-v2/aws/middleware"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go/middleware"
+	smithyhttp "github.com/aws/smithy-go/transport/http"
+)
+
+// addTraceMiddleware はAWS API呼び出しごとにサービス・操作・レイテンシー・再試行回数をトレースログ（-vv）に出力するミドルウェアを追加する
+// SDKの再試行を含めた呼び出し全体の時間を計測するため、再試行より外側のInitializeステップに追加する
+func addTraceMiddleware(stack *middleware.Stack) error {
+	return stack.Initialize.Add(middleware.InitializeMiddlewareFunc("AwstkTrace", func(
+		ctx context.Context, in middleware.InitializeInput, next middleware.InitializeHandler,
+	) (middleware.InitializeOutput, middleware.Metadata, error) {
+		start := time.Now()
+		out, metadata, err := next.HandleInitialize(ctx, in)
+		traceCall(ctx, metadata, time.Since(start), err)
+		return out, metadata, err
+	}), middleware.After)
+}
+
+// traceCall は1回のAPI呼び出しの結果をトレースログに出力する
+func traceCall(ctx context.Context, metadata middleware.Metadata, latency time.Duration, err error) {
+	service := awsmiddleware.GetServiceID(ctx)
+	operation := awsmiddleware.GetOperationName(ctx)
+
+	retries := 0
+	if results, ok := retry.GetAttemptResults(metadata); ok && len(results.Results) > 0 {
+		retries = len(results.Results) - 1
+	}
+	status := 0
+	if resp, ok := awsmiddleware.GetRawResponse(metadata).(*smithyhttp.Response); ok && resp != nil {
+		status = resp.StatusCode
+	}
+	var respErr interface{ HTTPStatusCode() int }
+	if status == 0 && errors.As(err, &respErr) {
+		status = respErr.HTTPStatusCode()
+	}
+	requestId, _ := awsmiddleware.GetRequestIDMetadata(metadata)
+
+	fields := map[string]any{
+		"service":   service,
+		"operation": operation,
+		"latencyMs": latency.Milliseconds(),
+		"retries":   retries,
+	}
+	if status != 0 {
+		fields["status"] = status
+	}
+	if requestId != "" {
+		fields["requestId"] = requestId
+	}
+
+	text := fmt.Sprintf(i18n.T("🔎 %s.%s %dms（再試行 %d回）"), service, operation, latency.Milliseconds(), retries)
+	if status != 0 {
+		text = fmt.Sprintf(i18n.T("🔎 %s.%s %dms（HTTP %d、再試行 %d回）"), service, operation, latency.Milliseconds(), status, retries)
+	}
+	if err != nil {
+		text += ": " + err.Error()
+	}
+	logging.Log(logging.Record{
+		Level:  logging.LevelTrace,
+		Kind:   "api",
+		Text:   text + "\n",
+		Err:    err,
+		Fields: fields,
+	})
+}
@@ -1,7 +1,34 @@
 package aws
 
+import "github.com/aws/aws-sdk-go-v2/aws"
+
 // Context AwsContext は認証情報を保持
 type Context struct {
 	Profile string
 	Region  string
+	// EndpointUrl は全サービス共通のエンドポイントURL（LocalStack等のエミュレーター向け、空の場合はAWS標準）
+	EndpointUrl string
+	// ServiceEndpoints はサービスごとのエンドポイントURL（キーは正規化済みのサービス名）
+	ServiceEndpoints map[string]string
+	// AssumeRole は複数アカウント実行時のロール引き受けの設定（Accounts が空の場合は基準の認証情報のみを使用）
+	AssumeRole AssumeRole
+}
+
+// AssumeRole は対象アカウントでロールを引き受けるための設定
+type AssumeRole struct {
+	// Accounts は対象アカウント（12桁のアカウントIDまたはロールARN）
+	Accounts []string
+	// RoleName はアカウントIDで指定した場合に引き受けるロール名
+	RoleName string
+	// ExternalId は信頼ポリシーで sts:ExternalId を要求している場合の外部ID
+	ExternalId string
+	// MfaSerial はMFAデバイスのARN（指定時は最初に一度だけトークンコードを入力する）
+	MfaSerial string
+}
+
+// AccountConfig は対象アカウントごとのAWS設定
+type AccountConfig struct {
+	Account string
+	RoleArn string
+	Config  aws.Config
 }
@@ -0,0 +1,315 @@
+package awsfake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/service/cfn"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+var _ cfn.CfnApi = (*CloudFormation)(nil)
+
+// StackResource はフェイクのスタックに含めるリソース
+type StackResource struct {
+	LogicalId    string
+	PhysicalId   string
+	ResourceType string // 例: "AWS::S3::Bucket"
+	Retain       bool   // DeletionPolicy: Retain（スタックの削除後のステータスが DELETE_SKIPPED になる）
+}
+
+// Stack はフェイクに登録するCloudFormationスタックの情報
+type Stack struct {
+	Name                  string
+	Status                types.StackStatus // 未指定の場合は CREATE_COMPLETE
+	TerminationProtection bool
+	DriftStatus           types.StackDriftStatus
+	Resources             []StackResource
+	CreatedAt             time.Time
+}
+
+// CloudFormation はインメモリのCloudFormationフェイク
+// DeleteStack はスタックを即座に DELETE_COMPLETE にする
+type CloudFormation struct {
+	base
+	PageSize  int // ListStacks・ListStackResources の1ページあたりの件数（0の場合は100）
+	AccountId string
+	Region    string
+	stacks    map[string]*Stack
+	driftSeq  int
+}
+
+// NewCloudFormation は空のCloudFormationフェイクを作成する
+func NewCloudFormation() *CloudFormation {
+	return &CloudFormation{
+		AccountId: "123456789012",
+		Region:    "ap-northeast-1",
+		stacks:    map[string]*Stack{},
+	}
+}
+
+// AddStack はスタックを登録する
+func (f *CloudFormation) AddStack(stack Stack) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if stack.Status == "" {
+		stack.Status = types.StackStatusCreateComplete
+	}
+	if stack.DriftStatus == "" {
+		stack.DriftStatus = types.StackDriftStatusNotChecked
+	}
+	if stack.CreatedAt.IsZero() {
+		stack.CreatedAt = time.Now()
+	}
+	f.stacks[stack.Name] = &stack
+}
+
+// StackStatus はスタックの現在のステータスを返す（存在しない場合は空文字）
+func (f *CloudFormation) StackStatus(name string) types.StackStatus {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if s, ok := f.stacks[name]; ok {
+		return s.Status
+	}
+	return ""
+}
+
+// stackId はスタック名からスタックIDを生成する
+func (f *CloudFormation) stackId(name string) string {
+	return fmt.Sprintf("arn:aws:cloudformation:%s:%s:stack/%s/00000000-0000-0000-0000-000000000000", f.Region, f.AccountId, name)
+}
+
+// findStack はスタック名またはスタックIDでスタックを検索する
+// 削除済みスタックは名前では検索できず、スタックIDでのみ検索できる（実際のAPIと同様）
+func (f *CloudFormation) findStack(nameOrId string) (*Stack, error) {
+	for name, s := range f.stacks {
+		if f.stackId(name) == nameOrId {
+			return s, nil
+		}
+		if name == nameOrId && s.Status != types.StackStatusDeleteComplete {
+			return s, nil
+		}
+	}
+	return nil, apiError("ValidationError", "Stack with id %s does not exist", nameOrId)
+}
+
+// toSdkStack はフェイクのスタックをSDKの型に変換する
+func (f *CloudFormation) toSdkStack(s *Stack) types.Stack {
+	return types.Stack{
+		StackName:                   aws.String(s.Name),
+		StackId:                     aws.String(f.stackId(s.Name)),
+		StackStatus:                 s.Status,
+		CreationTime:                aws.Time(s.CreatedAt),
+		EnableTerminationProtection: aws.Bool(s.TerminationProtection),
+		DriftInformation:            &types.StackDriftInformation{StackDriftStatus: s.DriftStatus},
+	}
+}
+
+// ListStacks は名前順にスタックのサマリーを返す（StackStatusFilter と NextToken に対応）
+func (f *CloudFormation) ListStacks(ctx context.Context, params *cloudformation.ListStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ListStacksOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("ListStacks", ""); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for name, s := range f.stacks {
+		if len(params.StackStatusFilter) > 0 && !containsStatus(params.StackStatusFilter, s.Status) {
+			continue
+		}
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pageSize := f.PageSize
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+	start, end, next := pageBounds(params.NextToken, pageSize, len(names))
+	out := &cloudformation.ListStacksOutput{NextToken: next}
+	for _, name := range names[start:end] {
+		s := f.stacks[name]
+		out.StackSummaries = append(out.StackSummaries, types.StackSummary{
+			StackName:    aws.String(s.Name),
+			StackId:      aws.String(f.stackId(s.Name)),
+			StackStatus:  s.Status,
+			CreationTime: aws.Time(s.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+// DescribeStacks はスタックの詳細を返す（StackName未指定の場合は削除済み以外の全スタック）
+func (f *CloudFormation) DescribeStacks(ctx context.Context, params *cloudformation.DescribeStacksInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	nameOrId := aws.ToString(params.StackName)
+	if err := f.record("DescribeStacks", nameOrId); err != nil {
+		return nil, err
+	}
+
+	out := &cloudformation.DescribeStacksOutput{}
+	if nameOrId != "" {
+		s, err := f.findStack(nameOrId)
+		if err != nil {
+			return nil, err
+		}
+		out.Stacks = append(out.Stacks, f.toSdkStack(s))
+		return out, nil
+	}
+
+	var names []string
+	for name, s := range f.stacks {
+		if s.Status != types.StackStatusDeleteComplete {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		out.Stacks = append(out.Stacks, f.toSdkStack(f.stacks[name]))
+	}
+	return out, nil
+}
+
+// DescribeStackResources はスタック内のリソースを返す（削除済みスタックのリソースは DELETE_COMPLETE または DELETE_SKIPPED）
+func (f *CloudFormation) DescribeStackResources(ctx context.Context, params *cloudformation.DescribeStackResourcesInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DescribeStackResourcesOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	nameOrId := aws.ToString(params.StackName)
+	if err := f.record("DescribeStackResources", nameOrId); err != nil {
+		return nil, err
+	}
+	s, err := f.findStack(nameOrId)
+	if err != nil {
+		return nil, err
+	}
+
+	out := &cloudformation.DescribeStackResourcesOutput{}
+	for _, r := range s.Resources {
+		out.StackResources = append(out.StackResources, types.StackResource{
+			StackName:          aws.String(s.Name),
+			StackId:            aws.String(f.stackId(s.Name)),
+			LogicalResourceId:  aws.String(r.LogicalId),
+			PhysicalResourceId: aws.String(r.PhysicalId),
+			ResourceType:       aws.String(r.ResourceType),
+			ResourceStatus:     resourceStatus(s, r),
+			Timestamp:          aws.Time(s.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+// ListStackResources はスタック内のリソースの概要をページングして返す（ステータスは DescribeStackResources と同じ）
+func (f *CloudFormation) ListStackResources(ctx context.Context, params *cloudformation.ListStackResourcesInput, optFns ...func(*cloudformation.Options)) (*cloudformation.ListStackResourcesOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	nameOrId := aws.ToString(params.StackName)
+	if err := f.record("ListStackResources", nameOrId); err != nil {
+		return nil, err
+	}
+	s, err := f.findStack(nameOrId)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := f.PageSize
+	if pageSize <= 0 {
+		pageSize = 100
+	}
+	start, end, next := pageBounds(params.NextToken, pageSize, len(s.Resources))
+	out := &cloudformation.ListStackResourcesOutput{NextToken: next}
+	for _, r := range s.Resources[start:end] {
+		out.StackResourceSummaries = append(out.StackResourceSummaries, types.StackResourceSummary{
+			LogicalResourceId:    aws.String(r.LogicalId),
+			PhysicalResourceId:   aws.String(r.PhysicalId),
+			ResourceType:         aws.String(r.ResourceType),
+			ResourceStatus:       resourceStatus(s, r),
+			LastUpdatedTimestamp: aws.Time(s.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+// resourceStatus はスタックのステータスに応じたリソースのステータスを返す
+func resourceStatus(s *Stack, r StackResource) types.ResourceStatus {
+	if s.Status != types.StackStatusDeleteComplete {
+		return types.ResourceStatusCreateComplete
+	}
+	if r.Retain {
+		return types.ResourceStatusDeleteSkipped
+	}
+	return types.ResourceStatusDeleteComplete
+}
+
+// DeleteStack はスタックを削除する（削除保護が有効な場合はエラー）
+func (f *CloudFormation) DeleteStack(ctx context.Context, params *cloudformation.DeleteStackInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DeleteStackOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	nameOrId := aws.ToString(params.StackName)
+	if err := f.record("DeleteStack", nameOrId); err != nil {
+		return nil, err
+	}
+	s, err := f.findStack(nameOrId)
+	if err != nil {
+		// 実際のAPIと同様、存在しないスタックの削除は成功扱い
+		return &cloudformation.DeleteStackOutput{}, nil
+	}
+	if s.TerminationProtection {
+		return nil, apiError("ValidationError", "Stack [%s] cannot be deleted while TerminationProtection is enabled", s.Name)
+	}
+	s.Status = types.StackStatusDeleteComplete
+	return &cloudformation.DeleteStackOutput{}, nil
+}
+
+// DetectStackDrift はドリフト検出を開始し、スタックのドリフト状態を IN_SYNC にする
+func (f *CloudFormation) DetectStackDrift(ctx context.Context, params *cloudformation.DetectStackDriftInput, optFns ...func(*cloudformation.Options)) (*cloudformation.DetectStackDriftOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	nameOrId := aws.ToString(params.StackName)
+	if err := f.record("DetectStackDrift", nameOrId); err != nil {
+		return nil, err
+	}
+	s, err := f.findStack(nameOrId)
+	if err != nil {
+		return nil, err
+	}
+	if s.DriftStatus == types.StackDriftStatusNotChecked {
+		s.DriftStatus = types.StackDriftStatusInSync
+	}
+	f.driftSeq++
+	return &cloudformation.DetectStackDriftOutput{
+		StackDriftDetectionId: aws.String(fmt.Sprintf("drift-%d", f.driftSeq)),
+	}, nil
+}
+
+// UpdateTerminationProtection はスタックの削除保護を設定する
+func (f *CloudFormation) UpdateTerminationProtection(ctx context.Context, params *cloudformation.UpdateTerminationProtectionInput, optFns ...func(*cloudformation.Options)) (*cloudformation.UpdateTerminationProtectionOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	nameOrId := aws.ToString(params.StackName)
+	if err := f.record("UpdateTerminationProtection", nameOrId); err != nil {
+		return nil, err
+	}
+	s, err := f.findStack(nameOrId)
+	if err != nil {
+		return nil, err
+	}
+	s.TerminationProtection = aws.ToBool(params.EnableTerminationProtection)
+	return &cloudformation.UpdateTerminationProtectionOutput{StackId: aws.String(f.stackId(s.Name))}, nil
+}
+
+// containsStatus はステータスがリストに含まれるかを返す
+func containsStatus(statuses []types.StackStatus, status types.StackStatus) bool {
+	for _, s := range statuses {
+		if s == status {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,174 @@
+package awsfake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	ecrsvc "github.com/ngsw877/awstk/internal/service/ecr"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
+)
+
+var _ ecrsvc.EcrApi = (*Ecr)(nil)
+
+// Repository はフェイクに登録するECRリポジトリの情報
+type Repository struct {
+	Name         string
+	ImageSizes   []int64 // 各イメージのサイズ（要素数がイメージ数）
+	HasLifecycle bool
+	CreatedAt    time.Time
+}
+
+// Ecr はインメモリのECRフェイク
+type Ecr struct {
+	base
+	PageSize     int // DescribeRepositories / DescribeImages の1ページあたりの件数（0の場合は100）
+	AccountId    string
+	Region       string
+	repositories map[string]Repository
+}
+
+// NewEcr は空のECRフェイクを作成する
+func NewEcr() *Ecr {
+	return &Ecr{
+		AccountId:    "123456789012",
+		Region:       "ap-northeast-1",
+		repositories: map[string]Repository{},
+	}
+}
+
+// AddRepository はリポジトリを登録する
+func (f *Ecr) AddRepository(repo Repository) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if repo.CreatedAt.IsZero() {
+		repo.CreatedAt = time.Now()
+	}
+	f.repositories[repo.Name] = repo
+}
+
+// HasRepository はリポジトリが存在するかを返す
+func (f *Ecr) HasRepository(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.repositories[name]
+	return ok
+}
+
+func (f *Ecr) pageSize() int {
+	if f.PageSize <= 0 {
+		return 100
+	}
+	return f.PageSize
+}
+
+// DescribeRepositories は名前順にリポジトリを返す（RepositoryNames と NextToken に対応）
+func (f *Ecr) DescribeRepositories(ctx context.Context, params *ecr.DescribeRepositoriesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeRepositoriesOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("DescribeRepositories", ""); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	if len(params.RepositoryNames) > 0 {
+		for _, name := range params.RepositoryNames {
+			if _, ok := f.repositories[name]; !ok {
+				return nil, &types.RepositoryNotFoundException{Message: aws.String(fmt.Sprintf("repository does not exist: %s", name))}
+			}
+			names = append(names, name)
+		}
+	} else {
+		for name := range f.repositories {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+	}
+
+	start, end, next := pageBounds(params.NextToken, f.pageSize(), len(names))
+	out := &ecr.DescribeRepositoriesOutput{NextToken: next}
+	for _, name := range names[start:end] {
+		repo := f.repositories[name]
+		out.Repositories = append(out.Repositories, types.Repository{
+			RepositoryName: aws.String(repo.Name),
+			RepositoryUri:  aws.String(fmt.Sprintf("%s.dkr.ecr.%s.amazonaws.com/%s", f.AccountId, f.Region, repo.Name)),
+			CreatedAt:      aws.Time(repo.CreatedAt),
+		})
+	}
+	return out, nil
+}
+
+// DescribeImages はリポジトリ内のイメージを返す（MaxResults と NextToken に対応）
+func (f *Ecr) DescribeImages(ctx context.Context, params *ecr.DescribeImagesInput, optFns ...func(*ecr.Options)) (*ecr.DescribeImagesOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(params.RepositoryName)
+	if err := f.record("DescribeImages", name); err != nil {
+		return nil, err
+	}
+	repo, ok := f.repositories[name]
+	if !ok {
+		return nil, &types.RepositoryNotFoundException{Message: aws.String(fmt.Sprintf("repository does not exist: %s", name))}
+	}
+
+	pageSize := f.pageSize()
+	if params.MaxResults != nil && int(*params.MaxResults) < pageSize {
+		pageSize = int(*params.MaxResults)
+	}
+	start, end, next := pageBounds(params.NextToken, pageSize, len(repo.ImageSizes))
+	out := &ecr.DescribeImagesOutput{NextToken: next}
+	for i := start; i < end; i++ {
+		out.ImageDetails = append(out.ImageDetails, types.ImageDetail{
+			RepositoryName:   aws.String(name),
+			ImageDigest:      aws.String(fmt.Sprintf("sha256:%064d", i)),
+			ImageSizeInBytes: aws.Int64(repo.ImageSizes[i]),
+		})
+	}
+	return out, nil
+}
+
+// GetLifecyclePolicy はライフサイクルポリシーを返す（未設定の場合は LifecyclePolicyNotFoundException）
+func (f *Ecr) GetLifecyclePolicy(ctx context.Context, params *ecr.GetLifecyclePolicyInput, optFns ...func(*ecr.Options)) (*ecr.GetLifecyclePolicyOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(params.RepositoryName)
+	if err := f.record("GetLifecyclePolicy", name); err != nil {
+		return nil, err
+	}
+	repo, ok := f.repositories[name]
+	if !ok {
+		return nil, &types.RepositoryNotFoundException{Message: aws.String(fmt.Sprintf("repository does not exist: %s", name))}
+	}
+	if !repo.HasLifecycle {
+		return nil, &types.LifecyclePolicyNotFoundException{Message: aws.String(fmt.Sprintf("lifecycle policy does not exist: %s", name))}
+	}
+	return &ecr.GetLifecyclePolicyOutput{
+		RepositoryName:      aws.String(name),
+		LifecyclePolicyText: aws.String(`{"rules":[]}`),
+	}, nil
+}
+
+// DeleteRepository はリポジトリを削除する（Force未指定でイメージが残っている場合はエラー）
+func (f *Ecr) DeleteRepository(ctx context.Context, params *ecr.DeleteRepositoryInput, optFns ...func(*ecr.Options)) (*ecr.DeleteRepositoryOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(params.RepositoryName)
+	if err := f.record("DeleteRepository", name); err != nil {
+		return nil, err
+	}
+	repo, ok := f.repositories[name]
+	if !ok {
+		return nil, &types.RepositoryNotFoundException{Message: aws.String(fmt.Sprintf("repository does not exist: %s", name))}
+	}
+	if len(repo.ImageSizes) > 0 && !params.Force {
+		return nil, &types.RepositoryNotEmptyException{Message: aws.String(fmt.Sprintf("repository is not empty: %s", name))}
+	}
+	delete(f.repositories, name)
+	return &ecr.DeleteRepositoryOutput{
+		Repository: &types.Repository{RepositoryName: aws.String(name)},
+	}, nil
+}
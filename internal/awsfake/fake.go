@@ -0,0 +1,102 @@
+// Package awsfake はサービス層のAPIインターフェースを満たすインメモリのフェイク実装を提供する
+//
+// 実際のAWSに接続せずに削除系などの処理フローを検証するためのもので、
+// 各フェイクは呼び出し履歴の記録と、操作単位でのエラー注入をサポートする。
+package awsfake
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/aws/smithy-go"
+)
+
+// Call はフェイクに対して行われたAPI呼び出しの記録
+type Call struct {
+	Operation string // 例: "DeleteBucket"
+	Resource  string // 例: バケット名、スタック名
+}
+
+// base は各フェイクで共通の呼び出し記録・エラー注入の仕組み
+type base struct {
+	mu     sync.Mutex
+	calls  []Call
+	faults map[string]error
+}
+
+// FailOn は指定した操作・リソースに対する呼び出しで err を返すように設定する
+// resource が空文字の場合はその操作のすべての呼び出しが対象になる
+func (b *base) FailOn(operation, resource string, err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if b.faults == nil {
+		b.faults = map[string]error{}
+	}
+	b.faults[faultKey(operation, resource)] = err
+}
+
+// Calls はこれまでに記録された呼び出し履歴のコピーを返す
+func (b *base) Calls() []Call {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	calls := make([]Call, len(b.calls))
+	copy(calls, b.calls)
+	return calls
+}
+
+// CallCount は指定した操作が呼び出された回数を返す
+func (b *base) CallCount(operation string) int {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	count := 0
+	for _, c := range b.calls {
+		if c.Operation == operation {
+			count++
+		}
+	}
+	return count
+}
+
+// record は呼び出しを記録し、注入されたエラーがあれば返す
+// 呼び出し元は b.mu を保持していること
+func (b *base) record(operation, resource string) error {
+	b.calls = append(b.calls, Call{Operation: operation, Resource: resource})
+	if err, ok := b.faults[faultKey(operation, resource)]; ok {
+		return err
+	}
+	if err, ok := b.faults[faultKey(operation, "")]; ok {
+		return err
+	}
+	return nil
+}
+
+// faultKey はエラー注入用のマップキーを生成する
+func faultKey(operation, resource string) string {
+	return operation + "\x00" + resource
+}
+
+// apiError は実際のSDKと同様に smithy.APIError を満たすエラーを生成する
+func apiError(code, format string, args ...any) error {
+	return &smithy.GenericAPIError{
+		Code:    code,
+		Message: fmt.Sprintf(format, args...),
+		Fault:   smithy.FaultClient,
+	}
+}
+
+// pageBounds はトークン（開始インデックス）とページサイズから取得範囲を計算する
+// 次のページがある場合は次のトークンを返す
+func pageBounds(token *string, pageSize, total int) (start, end int, next *string) {
+	if token != nil {
+		_, _ = fmt.Sscanf(*token, "%d", &start)
+	}
+	if start > total {
+		start = total
+	}
+	end = start + pageSize
+	if end >= total {
+		return start, total, nil
+	}
+	nextToken := fmt.Sprintf("%d", end)
+	return start, end, &nextToken
+}
@@ -0,0 +1,169 @@
+package awsfake
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	logssvc "github.com/ngsw877/awstk/internal/service/logs"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
+)
+
+var _ logssvc.LogsApi = (*Logs)(nil)
+
+// LogGroup はフェイクに登録するロググループの情報
+type LogGroup struct {
+	Name               string
+	StoredBytes        int64
+	RetentionInDays    int32 // 0の場合は無期限
+	DeletionProtection bool
+	CreatedAt          time.Time
+	LastEventAt        time.Time // ゼロ値の場合はログストリームなし
+}
+
+// Logs はインメモリのCloudWatch Logsフェイク
+type Logs struct {
+	base
+	PageSize  int // DescribeLogGroups の1ページあたりの件数（0の場合は50）
+	logGroups map[string]LogGroup
+}
+
+// NewLogs は空のCloudWatch Logsフェイクを作成する
+func NewLogs() *Logs {
+	return &Logs{logGroups: map[string]LogGroup{}}
+}
+
+// AddLogGroup はロググループを登録する
+func (f *Logs) AddLogGroup(group LogGroup) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if group.CreatedAt.IsZero() {
+		group.CreatedAt = time.Now()
+	}
+	f.logGroups[group.Name] = group
+}
+
+// HasLogGroup はロググループが存在するかを返す
+func (f *Logs) HasLogGroup(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.logGroups[name]
+	return ok
+}
+
+// DescribeLogGroups は名前順にロググループを返す（LogGroupNamePrefix と NextToken に対応）
+func (f *Logs) DescribeLogGroups(ctx context.Context, params *cloudwatchlogs.DescribeLogGroupsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogGroupsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	prefix := aws.ToString(params.LogGroupNamePrefix)
+	if err := f.record("DescribeLogGroups", prefix); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for name := range f.logGroups {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	pageSize := f.PageSize
+	if pageSize <= 0 {
+		pageSize = 50
+	}
+	start, end, next := pageBounds(params.NextToken, pageSize, len(names))
+
+	out := &cloudwatchlogs.DescribeLogGroupsOutput{NextToken: next}
+	for _, name := range names[start:end] {
+		g := f.logGroups[name]
+		lg := types.LogGroup{
+			LogGroupName:              aws.String(g.Name),
+			StoredBytes:               aws.Int64(g.StoredBytes),
+			CreationTime:              aws.Int64(g.CreatedAt.UnixMilli()),
+			DeletionProtectionEnabled: aws.Bool(g.DeletionProtection),
+			MetricFilterCount:         aws.Int32(0),
+		}
+		if g.RetentionInDays > 0 {
+			lg.RetentionInDays = aws.Int32(g.RetentionInDays)
+		}
+		out.LogGroups = append(out.LogGroups, lg)
+	}
+	return out, nil
+}
+
+// DescribeLogStreams はロググループの最終イベント日時を持つログストリームを1件だけ返す（LastEventAt が未設定の場合は空）
+func (f *Logs) DescribeLogStreams(ctx context.Context, params *cloudwatchlogs.DescribeLogStreamsInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeLogStreamsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(params.LogGroupName)
+	if err := f.record("DescribeLogStreams", name); err != nil {
+		return nil, err
+	}
+	g, ok := f.logGroups[name]
+	if !ok {
+		return nil, apiError("ResourceNotFoundException", "the specified log group does not exist: %s", name)
+	}
+	out := &cloudwatchlogs.DescribeLogStreamsOutput{}
+	if !g.LastEventAt.IsZero() {
+		out.LogStreams = []types.LogStream{{
+			LogStreamName:      aws.String("stream"),
+			LastEventTimestamp: aws.Int64(g.LastEventAt.UnixMilli()),
+		}}
+	}
+	return out, nil
+}
+
+// DeleteLogGroup はロググループを削除する（削除保護が有効な場合はエラー）
+func (f *Logs) DeleteLogGroup(ctx context.Context, params *cloudwatchlogs.DeleteLogGroupInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DeleteLogGroupOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(params.LogGroupName)
+	if err := f.record("DeleteLogGroup", name); err != nil {
+		return nil, err
+	}
+	g, ok := f.logGroups[name]
+	if !ok {
+		return nil, apiError("ResourceNotFoundException", "the specified log group does not exist: %s", name)
+	}
+	if g.DeletionProtection {
+		return nil, apiError("InvalidOperationException", "deletion protection is enabled for log group: %s", name)
+	}
+	delete(f.logGroups, name)
+	return &cloudwatchlogs.DeleteLogGroupOutput{}, nil
+}
+
+// PutLogGroupDeletionProtection はロググループの削除保護を設定する
+func (f *Logs) PutLogGroupDeletionProtection(ctx context.Context, params *cloudwatchlogs.PutLogGroupDeletionProtectionInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.PutLogGroupDeletionProtectionOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(params.LogGroupIdentifier)
+	if err := f.record("PutLogGroupDeletionProtection", name); err != nil {
+		return nil, err
+	}
+	g, ok := f.logGroups[name]
+	if !ok {
+		return nil, apiError("ResourceNotFoundException", "the specified log group does not exist: %s", name)
+	}
+	g.DeletionProtection = aws.ToBool(params.DeletionProtectionEnabled)
+	f.logGroups[name] = g
+	return &cloudwatchlogs.PutLogGroupDeletionProtectionOutput{}, nil
+}
+
+// DescribeSubscriptionFilters は空のサブスクリプションフィルター一覧を返す（サブスクリプションフィルターには対応しない）
+func (f *Logs) DescribeSubscriptionFilters(ctx context.Context, params *cloudwatchlogs.DescribeSubscriptionFiltersInput, optFns ...func(*cloudwatchlogs.Options)) (*cloudwatchlogs.DescribeSubscriptionFiltersOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	name := aws.ToString(params.LogGroupName)
+	if err := f.record("DescribeSubscriptionFilters", name); err != nil {
+		return nil, err
+	}
+	if _, ok := f.logGroups[name]; !ok {
+		return nil, apiError("ResourceNotFoundException", "the specified log group does not exist: %s", name)
+	}
+	return &cloudwatchlogs.DescribeSubscriptionFiltersOutput{}, nil
+}
@@ -0,0 +1,250 @@
+package awsfake
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	s3svc "github.com/ngsw877/awstk/internal/service/s3"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+var _ s3svc.S3Api = (*S3)(nil)
+
+// S3 はバージョニング対応バケットを模したインメモリのS3フェイク
+type S3 struct {
+	base
+	PageSize   int // ListObjectVersions の1ページあたりの件数（0の場合は1000）
+	buckets    map[string]*fakeBucket
+	versionSeq int
+}
+
+type fakeBucket struct {
+	createdAt time.Time
+	entries   []s3Entry
+}
+
+type s3Entry struct {
+	key          string
+	versionId    string
+	deleteMarker bool
+}
+
+// NewS3 は空のS3フェイクを作成する
+func NewS3() *S3 {
+	return &S3{buckets: map[string]*fakeBucket{}}
+}
+
+// AddBucket はバケットを作成する
+func (f *S3) AddBucket(name string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if _, ok := f.buckets[name]; !ok {
+		f.buckets[name] = &fakeBucket{createdAt: time.Now()}
+	}
+}
+
+// PutObject はオブジェクトの新しいバージョンを追加し、そのバージョンIDを返す
+func (f *S3) PutObject(bucket, key string) string {
+	return f.addEntry(bucket, key, false)
+}
+
+// PutDeleteMarker はオブジェクトに削除マーカーを追加し、そのバージョンIDを返す
+func (f *S3) PutDeleteMarker(bucket, key string) string {
+	return f.addEntry(bucket, key, true)
+}
+
+// HasBucket はバケットが存在するかを返す
+func (f *S3) HasBucket(name string) bool {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	_, ok := f.buckets[name]
+	return ok
+}
+
+// EntryCount はバケット内のオブジェクトバージョンと削除マーカーの合計数を返す
+func (f *S3) EntryCount(bucket string) int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if b, ok := f.buckets[bucket]; ok {
+		return len(b.entries)
+	}
+	return 0
+}
+
+func (f *S3) addEntry(bucket, key string, deleteMarker bool) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	b, ok := f.buckets[bucket]
+	if !ok {
+		b = &fakeBucket{createdAt: time.Now()}
+		f.buckets[bucket] = b
+	}
+	f.versionSeq++
+	versionId := fmt.Sprintf("v%06d", f.versionSeq)
+	b.entries = append(b.entries, s3Entry{key: key, versionId: versionId, deleteMarker: deleteMarker})
+	return versionId
+}
+
+// ListBuckets はバケット一覧を名前順で返す
+func (f *S3) ListBuckets(ctx context.Context, params *s3.ListBucketsInput, optFns ...func(*s3.Options)) (*s3.ListBucketsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record("ListBuckets", ""); err != nil {
+		return nil, err
+	}
+
+	names := make([]string, 0, len(f.buckets))
+	for name := range f.buckets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	out := &s3.ListBucketsOutput{}
+	for _, name := range names {
+		out.Buckets = append(out.Buckets, types.Bucket{
+			Name:         aws.String(name),
+			CreationDate: aws.Time(f.buckets[name].createdAt),
+		})
+	}
+	return out, nil
+}
+
+// ListObjectVersions はキー順にバージョンと削除マーカーを返す
+// KeyMarker / VersionIdMarker によるページネーションに対応する
+func (f *S3) ListObjectVersions(ctx context.Context, params *s3.ListObjectVersionsInput, optFns ...func(*s3.Options)) (*s3.ListObjectVersionsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	bucketName := aws.ToString(params.Bucket)
+	if err := f.record("ListObjectVersions", bucketName); err != nil {
+		return nil, err
+	}
+	b, ok := f.buckets[bucketName]
+	if !ok {
+		return nil, apiError("NoSuchBucket", "the specified bucket does not exist: %s", bucketName)
+	}
+
+	entries := make([]s3Entry, len(b.entries))
+	copy(entries, b.entries)
+	sort.SliceStable(entries, func(i, j int) bool { return entries[i].key < entries[j].key })
+
+	// マーカーのエントリが削除済みの場合も、同じキーのそれより新しいバージョンから返す（バージョンIDは作成順に増加する）
+	start := 0
+	if params.KeyMarker != nil {
+		keyMarker, versionIdMarker := aws.ToString(params.KeyMarker), aws.ToString(params.VersionIdMarker)
+		start = len(entries)
+		for i, e := range entries {
+			if e.key > keyMarker || (e.key == keyMarker && versionIdMarker != "" && e.versionId > versionIdMarker) {
+				start = i
+				break
+			}
+		}
+	}
+
+	pageSize := f.PageSize
+	if pageSize <= 0 {
+		pageSize = 1000
+	}
+	end := start + pageSize
+	if end > len(entries) {
+		end = len(entries)
+	}
+
+	out := &s3.ListObjectVersionsOutput{IsTruncated: aws.Bool(end < len(entries))}
+	for _, e := range entries[start:end] {
+		if e.deleteMarker {
+			out.DeleteMarkers = append(out.DeleteMarkers, types.DeleteMarkerEntry{
+				Key:       aws.String(e.key),
+				VersionId: aws.String(e.versionId),
+			})
+		} else {
+			out.Versions = append(out.Versions, types.ObjectVersion{
+				Key:       aws.String(e.key),
+				VersionId: aws.String(e.versionId),
+			})
+		}
+	}
+	if end < len(entries) && end > start {
+		last := entries[end-1]
+		out.NextKeyMarker = aws.String(last.key)
+		out.NextVersionIdMarker = aws.String(last.versionId)
+	}
+	return out, nil
+}
+
+// DeleteObjects は指定したバージョンを削除する
+// バージョンIDが未指定の場合は削除マーカーを追加する
+func (f *S3) DeleteObjects(ctx context.Context, params *s3.DeleteObjectsInput, optFns ...func(*s3.Options)) (*s3.DeleteObjectsOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	bucketName := aws.ToString(params.Bucket)
+	if err := f.record("DeleteObjects", bucketName); err != nil {
+		return nil, err
+	}
+	b, ok := f.buckets[bucketName]
+	if !ok {
+		return nil, apiError("NoSuchBucket", "the specified bucket does not exist: %s", bucketName)
+	}
+
+	out := &s3.DeleteObjectsOutput{}
+	if params.Delete == nil {
+		return out, nil
+	}
+	for _, obj := range params.Delete.Objects {
+		key := aws.ToString(obj.Key)
+		if obj.VersionId == nil {
+			f.versionSeq++
+			versionId := fmt.Sprintf("v%06d", f.versionSeq)
+			b.entries = append(b.entries, s3Entry{key: key, versionId: versionId, deleteMarker: true})
+			out.Deleted = append(out.Deleted, types.DeletedObject{Key: obj.Key, DeleteMarker: aws.Bool(true)})
+			continue
+		}
+		remaining := b.entries[:0]
+		for _, e := range b.entries {
+			if e.key == key && e.versionId == aws.ToString(obj.VersionId) {
+				continue
+			}
+			remaining = append(remaining, e)
+		}
+		b.entries = remaining
+		out.Deleted = append(out.Deleted, types.DeletedObject{Key: obj.Key, VersionId: obj.VersionId})
+	}
+	return out, nil
+}
+
+// DeleteBucket はバケットを削除する（空でない場合はエラー）
+func (f *S3) DeleteBucket(ctx context.Context, params *s3.DeleteBucketInput, optFns ...func(*s3.Options)) (*s3.DeleteBucketOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	bucketName := aws.ToString(params.Bucket)
+	if err := f.record("DeleteBucket", bucketName); err != nil {
+		return nil, err
+	}
+	b, ok := f.buckets[bucketName]
+	if !ok {
+		return nil, apiError("NoSuchBucket", "the specified bucket does not exist: %s", bucketName)
+	}
+	if len(b.entries) > 0 {
+		return nil, apiError("BucketNotEmpty", "the bucket you tried to delete is not empty: %s", bucketName)
+	}
+	delete(f.buckets, bucketName)
+	return &s3.DeleteBucketOutput{}, nil
+}
+
+// GetBucketNotificationConfiguration は空の通知設定を返す（バケットの通知には対応しない）
+func (f *S3) GetBucketNotificationConfiguration(ctx context.Context, params *s3.GetBucketNotificationConfigurationInput, optFns ...func(*s3.Options)) (*s3.GetBucketNotificationConfigurationOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	bucketName := aws.ToString(params.Bucket)
+	if err := f.record("GetBucketNotificationConfiguration", bucketName); err != nil {
+		return nil, err
+	}
+	if _, ok := f.buckets[bucketName]; !ok {
+		return nil, apiError("NoSuchBucket", "the specified bucket does not exist: %s", bucketName)
+	}
+	return &s3.GetBucketNotificationConfigurationOutput{}, nil
+}
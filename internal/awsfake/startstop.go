@@ -0,0 +1,217 @@
+package awsfake
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/service/cfn"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+	rdstypes "github.com/aws/aws-sdk-go-v2/service/rds/types"
+)
+
+var (
+	_ cfn.Ec2Api         = (*Ec2)(nil)
+	_ cfn.RdsApi         = (*Rds)(nil)
+	_ cfn.AutoScalingApi = (*AutoScaling)(nil)
+)
+
+// Ec2 はインスタンスの起動・停止状態を保持するインメモリのEC2フェイク
+type Ec2 struct {
+	base
+	instances map[string]ec2types.InstanceStateName
+}
+
+// NewEc2 は空のEC2フェイクを作成する
+func NewEc2() *Ec2 {
+	return &Ec2{instances: map[string]ec2types.InstanceStateName{}}
+}
+
+// AddInstance はインスタンスを指定した状態で登録する
+func (f *Ec2) AddInstance(instanceId string, state ec2types.InstanceStateName) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.instances[instanceId] = state
+}
+
+// InstanceState はインスタンスの現在の状態を返す（存在しない場合は空文字）
+func (f *Ec2) InstanceState(instanceId string) ec2types.InstanceStateName {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.instances[instanceId]
+}
+
+// StartInstances はインスタンスを running にする
+func (f *Ec2) StartInstances(ctx context.Context, params *ec2.StartInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StartInstancesOutput, error) {
+	changes, err := f.changeState("StartInstances", params.InstanceIds, ec2types.InstanceStateNameRunning)
+	if err != nil {
+		return nil, err
+	}
+	return &ec2.StartInstancesOutput{StartingInstances: changes}, nil
+}
+
+// StopInstances はインスタンスを stopped にする
+func (f *Ec2) StopInstances(ctx context.Context, params *ec2.StopInstancesInput, optFns ...func(*ec2.Options)) (*ec2.StopInstancesOutput, error) {
+	changes, err := f.changeState("StopInstances", params.InstanceIds, ec2types.InstanceStateNameStopped)
+	if err != nil {
+		return nil, err
+	}
+	return &ec2.StopInstancesOutput{StoppingInstances: changes}, nil
+}
+
+func (f *Ec2) changeState(operation string, instanceIds []string, state ec2types.InstanceStateName) ([]ec2types.InstanceStateChange, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	var changes []ec2types.InstanceStateChange
+	for _, id := range instanceIds {
+		if err := f.record(operation, id); err != nil {
+			return nil, err
+		}
+		previous, ok := f.instances[id]
+		if !ok {
+			return nil, apiError("InvalidInstanceID.NotFound", "The instance ID '%s' does not exist", id)
+		}
+		f.instances[id] = state
+		changes = append(changes, ec2types.InstanceStateChange{
+			InstanceId:    aws.String(id),
+			PreviousState: &ec2types.InstanceState{Name: previous},
+			CurrentState:  &ec2types.InstanceState{Name: state},
+		})
+	}
+	return changes, nil
+}
+
+// Rds はDBインスタンス・DBクラスターの状態を保持するインメモリのRDSフェイク
+type Rds struct {
+	base
+	instances map[string]string
+	clusters  map[string]string
+}
+
+// NewRds は空のRDSフェイクを作成する
+func NewRds() *Rds {
+	return &Rds{instances: map[string]string{}, clusters: map[string]string{}}
+}
+
+// AddInstance はDBインスタンスを指定したステータスで登録する
+func (f *Rds) AddInstance(instanceId, status string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.instances[instanceId] = status
+}
+
+// AddCluster はDBクラスターを指定したステータスで登録する
+func (f *Rds) AddCluster(clusterId, status string) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.clusters[clusterId] = status
+}
+
+// InstanceStatus はDBインスタンスの現在のステータスを返す
+func (f *Rds) InstanceStatus(instanceId string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.instances[instanceId]
+}
+
+// ClusterStatus はDBクラスターの現在のステータスを返す
+func (f *Rds) ClusterStatus(clusterId string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.clusters[clusterId]
+}
+
+// StartDBInstance はDBインスタンスを available にする
+func (f *Rds) StartDBInstance(ctx context.Context, params *rds.StartDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StartDBInstanceOutput, error) {
+	id := aws.ToString(params.DBInstanceIdentifier)
+	if err := f.setStatus("StartDBInstance", f.instances, id, "available"); err != nil {
+		return nil, err
+	}
+	return &rds.StartDBInstanceOutput{DBInstance: &rdstypes.DBInstance{DBInstanceIdentifier: aws.String(id), DBInstanceStatus: aws.String("starting")}}, nil
+}
+
+// StopDBInstance はDBインスタンスを stopped にする
+func (f *Rds) StopDBInstance(ctx context.Context, params *rds.StopDBInstanceInput, optFns ...func(*rds.Options)) (*rds.StopDBInstanceOutput, error) {
+	id := aws.ToString(params.DBInstanceIdentifier)
+	if err := f.setStatus("StopDBInstance", f.instances, id, "stopped"); err != nil {
+		return nil, err
+	}
+	return &rds.StopDBInstanceOutput{DBInstance: &rdstypes.DBInstance{DBInstanceIdentifier: aws.String(id), DBInstanceStatus: aws.String("stopping")}}, nil
+}
+
+// StartDBCluster はDBクラスターを available にする
+func (f *Rds) StartDBCluster(ctx context.Context, params *rds.StartDBClusterInput, optFns ...func(*rds.Options)) (*rds.StartDBClusterOutput, error) {
+	id := aws.ToString(params.DBClusterIdentifier)
+	if err := f.setStatus("StartDBCluster", f.clusters, id, "available"); err != nil {
+		return nil, err
+	}
+	return &rds.StartDBClusterOutput{DBCluster: &rdstypes.DBCluster{DBClusterIdentifier: aws.String(id), Status: aws.String("starting")}}, nil
+}
+
+// StopDBCluster はDBクラスターを stopped にする
+func (f *Rds) StopDBCluster(ctx context.Context, params *rds.StopDBClusterInput, optFns ...func(*rds.Options)) (*rds.StopDBClusterOutput, error) {
+	id := aws.ToString(params.DBClusterIdentifier)
+	if err := f.setStatus("StopDBCluster", f.clusters, id, "stopped"); err != nil {
+		return nil, err
+	}
+	return &rds.StopDBClusterOutput{DBCluster: &rdstypes.DBCluster{DBClusterIdentifier: aws.String(id), Status: aws.String("stopping")}}, nil
+}
+
+func (f *Rds) setStatus(operation string, resources map[string]string, id, status string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if err := f.record(operation, id); err != nil {
+		return err
+	}
+	if _, ok := resources[id]; !ok {
+		return apiError("DBInstanceNotFound", "%s not found", id)
+	}
+	resources[id] = status
+	return nil
+}
+
+// ScalableTarget はAutoScalingフェイクに登録されたスケーラブルターゲット
+type ScalableTarget struct {
+	MinCapacity int32
+	MaxCapacity int32
+}
+
+// AutoScaling はスケーラブルターゲットの登録内容を保持するインメモリのApplication Auto Scalingフェイク
+type AutoScaling struct {
+	base
+	targets map[string]ScalableTarget
+}
+
+// NewAutoScaling は空のApplication Auto Scalingフェイクを作成する
+func NewAutoScaling() *AutoScaling {
+	return &AutoScaling{targets: map[string]ScalableTarget{}}
+}
+
+// Target はリソースID（例: "service/cluster/service"）に登録されたターゲットを返す
+func (f *AutoScaling) Target(resourceId string) (ScalableTarget, bool) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	t, ok := f.targets[resourceId]
+	return t, ok
+}
+
+// RegisterScalableTarget はスケーラブルターゲットを登録（上書き）する
+func (f *AutoScaling) RegisterScalableTarget(ctx context.Context, params *applicationautoscaling.RegisterScalableTargetInput, optFns ...func(*applicationautoscaling.Options)) (*applicationautoscaling.RegisterScalableTargetOutput, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	resourceId := aws.ToString(params.ResourceId)
+	if err := f.record("RegisterScalableTarget", resourceId); err != nil {
+		return nil, err
+	}
+	f.targets[resourceId] = ScalableTarget{
+		MinCapacity: aws.ToInt32(params.MinCapacity),
+		MaxCapacity: aws.ToInt32(params.MaxCapacity),
+	}
+	return &applicationautoscaling.RegisterScalableTargetOutput{
+		ScalableTargetARN: aws.String(fmt.Sprintf("arn:aws:application-autoscaling:::scalable-target/%s", resourceId)),
+	}, nil
+}
@@ -1,11 +1,13 @@
 package cli
 
 import (
-	"awstk/internal/aws"
 	"bytes"
+	"context"
 	"io"
 	"os"
 	"os/exec"
+
+	"github.com/ngsw877/awstk/internal/aws"
 )
 
 // AwsCommandResult はAWS CLIコマンドの実行結果
@@ -15,23 +17,31 @@ type AwsCommandResult struct {
 }
 
 // buildAwsCliArgs はAWS CLIコマンドの引数を構築する（認証情報を追加）
-func buildAwsCliArgs(ctx aws.Context, args []string) []string {
+func buildAwsCliArgs(awsCtx aws.Context, args []string) []string {
 	// プロファイルが指定されている場合、引数に追加
-	if ctx.Profile != "" {
-		args = append(args, "--profile", ctx.Profile)
+	if awsCtx.Profile != "" {
+		args = append(args, "--profile", awsCtx.Profile)
 	}
 
 	// リージョンが指定されている場合、引数に追加
-	if ctx.Region != "" {
-		args = append(args, "--region", ctx.Region)
+	if awsCtx.Region != "" {
+		args = append(args, "--region", awsCtx.Region)
+	}
+
+	// エンドポイントが上書きされている場合、先頭のサービス名に対応するURLを追加
+	if len(args) > 0 {
+		if endpointUrl := awsCtx.EndpointFor(args[0]); endpointUrl != "" {
+			args = append(args, "--endpoint-url", endpointUrl)
+		}
 	}
 
 	return args
 }
 
 // ExecuteAwsCommand はAWS CLIコマンドを実行する共通関数
-func ExecuteAwsCommand(ctx aws.Context, args []string) error {
-	args = buildAwsCliArgs(ctx, args)
+// 対話型セッション（ssm / ecs exec）で使うため、Ctrl-C はプロセスを終了させずに子プロセスへそのまま届ける
+func ExecuteAwsCommand(awsCtx aws.Context, args []string) error {
+	args = buildAwsCliArgs(awsCtx, args)
 
 	cmd := exec.Command("aws", args...)
 	cmd.Stdin = os.Stdin
@@ -42,10 +52,11 @@ func ExecuteAwsCommand(ctx aws.Context, args []string) error {
 }
 
 // ExecuteAwsCommandWithCapture はAWS CLIコマンドを実行し、出力をキャプチャする
-func ExecuteAwsCommandWithCapture(ctx aws.Context, args []string) (*AwsCommandResult, error) {
-	args = buildAwsCliArgs(ctx, args)
+// ctx がキャンセルされた場合は実行中のプロセスを終了する
+func ExecuteAwsCommandWithCapture(ctx context.Context, awsCtx aws.Context, args []string) (*AwsCommandResult, error) {
+	args = buildAwsCliArgs(awsCtx, args)
 
-	cmd := exec.Command("aws", args...)
+	cmd := exec.CommandContext(ctx, "aws", args...)
 	cmd.Stdin = os.Stdin
 
 	// 出力をキャプチャしつつ、リアルタイムで表示
@@ -0,0 +1,85 @@
+package completion
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// CacheTTL は補完候補のキャッシュの有効期間
+// タブキーを押すたびにAWS APIを呼び出さないよう、短時間だけ取得結果を再利用する
+const CacheTTL = 2 * time.Minute
+
+// CacheDirEnv はキャッシュの保存先ディレクトリを上書きする環境変数（"off" でキャッシュを無効化）
+const CacheDirEnv = "AWSTK_COMPLETION_CACHE"
+
+// Cached はキーに対応する補完候補を返す
+// 有効期間内のキャッシュがあればそれを返し、なければ fetch で取得してキャッシュに保存する
+// キャッシュの読み書きに失敗しても補完自体は継続する
+func Cached(key string, fetch func() ([]string, error)) ([]string, error) {
+	path := cachePath(key)
+	if path != "" {
+		if values, ok := readCache(path); ok {
+			return values, nil
+		}
+	}
+
+	values, err := fetch()
+	if err != nil {
+		return nil, err
+	}
+	if path != "" {
+		writeCache(path, values)
+	}
+	return values, nil
+}
+
+// cachePath はキーに対応するキャッシュファイルのパスを返す（キャッシュが無効な場合は空文字）
+func cachePath(key string) string {
+	dir := os.Getenv(CacheDirEnv)
+	if strings.EqualFold(dir, "off") {
+		return ""
+	}
+	if dir == "" {
+		base, err := os.UserCacheDir()
+		if err != nil {
+			return ""
+		}
+		dir = filepath.Join(base, "awstk", "completion")
+	}
+	sum := sha256.Sum256([]byte(key))
+	return filepath.Join(dir, hex.EncodeToString(sum[:8])+".json")
+}
+
+// readCache は有効期間内のキャッシュを読み込む
+func readCache(path string) ([]string, bool) {
+	info, err := os.Stat(path)
+	if err != nil || time.Since(info.ModTime()) > CacheTTL {
+		return nil, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var values []string
+	if err := json.Unmarshal(data, &values); err != nil {
+		return nil, false
+	}
+	return values, true
+}
+
+// writeCache は補完候補をキャッシュに保存する（失敗は無視する）
+func writeCache(path string, values []string) {
+	data, err := json.Marshal(values)
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+	_ = os.WriteFile(path, data, 0o600)
+}
@@ -0,0 +1,253 @@
+package config
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+
+	"github.com/gobwas/glob"
+	"gopkg.in/yaml.v3"
+)
+
+// Load はユーザー設定とプロジェクト設定を読み込んでマージする
+// 設定ファイルが1つも存在しない場合は空の設定を返す
+func Load() (*Config, error) {
+	cfg := &Config{
+		Environments: map[string]Environment{},
+		sources:      map[string]map[string]string{},
+	}
+
+	paths, err := discover()
+	if err != nil {
+		return nil, err
+	}
+	for _, path := range paths {
+		file, err := readFile(path)
+		if err != nil {
+			return nil, err
+		}
+		cfg.merge(path, file)
+	}
+	return cfg, nil
+}
+
+// discover は読み込む設定ファイルのパスを優先度の低い順に返す
+func discover() ([]string, error) {
+	var paths []string
+
+	if dir, err := os.UserConfigDir(); err == nil {
+		userPath := filepath.Join(dir, "awstk", UserFileName)
+		if fileExists(userPath) {
+			paths = append(paths, userPath)
+		}
+	}
+
+	if explicit := os.Getenv(PathEnv); explicit != "" {
+		if !fileExists(explicit) {
+			return nil, fmt.Errorf(i18n.T("環境変数 %s で指定された設定ファイルが見つかりません: %s"), PathEnv, explicit)
+		}
+		return append(paths, explicit), nil
+	}
+
+	if projectPath := findProjectFile(); projectPath != "" {
+		paths = append(paths, projectPath)
+	}
+	return paths, nil
+}
+
+// findProjectFile はカレントディレクトリから親ディレクトリへ順にプロジェクト設定ファイルを探す
+func findProjectFile() string {
+	dir, err := os.Getwd()
+	if err != nil {
+		return ""
+	}
+	for {
+		path := filepath.Join(dir, FileName)
+		if fileExists(path) {
+			return path
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return ""
+		}
+		dir = parent
+	}
+}
+
+// fileExists は通常ファイルが存在するかを返す
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
+
+// readFile は設定ファイルを読み込む（未知のキーはタイプミスとしてエラーにする）
+func readFile(path string) (File, error) {
+	var file File
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return file, fmt.Errorf(i18n.T("設定ファイルの読み込みに失敗: %w"), err)
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+	if err := decoder.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return file, fmt.Errorf(i18n.T("設定ファイルの解析に失敗 (%s): %w"), path, err)
+	}
+
+	for name, env := range file.Environments {
+		if env.Concurrency < 0 {
+			return file, fmt.Errorf(i18n.T("設定ファイル %s の環境 '%s': concurrency は0以上を指定してください"), path, name)
+		}
+		for _, pattern := range env.Protected {
+			if _, err := glob.Compile(strings.ToLower(pattern)); err != nil {
+				return file, fmt.Errorf(i18n.T("設定ファイル %s の環境 '%s': protected のパターンが不正です: %s"), path, name, pattern)
+			}
+		}
+	}
+	for _, pattern := range file.Guardrail.Deny {
+		if _, err := glob.Compile(strings.ToLower(pattern)); err != nil {
+			return file, fmt.Errorf(i18n.T("設定ファイル %s: guardrail.deny のパターンが不正です: %s"), path, pattern)
+		}
+	}
+	return file, nil
+}
+
+// merge は設定ファイルの内容を項目単位で上書きマージする
+func (c *Config) merge(path string, file File) {
+	c.Files = append(c.Files, path)
+	if file.DefaultEnv != "" {
+		c.DefaultEnv = file.DefaultEnv
+		c.defaultEnvFile = path
+	}
+	c.mergeGuardrail(path, file.Guardrail)
+	if file.Mcp.AllowTools != nil {
+		c.Mcp = file.Mcp
+		c.mcpFile = path
+	}
+
+	for name, src := range file.Environments {
+		dst := c.Environments[name]
+		if c.sources[name] == nil {
+			c.sources[name] = map[string]string{}
+		}
+		sources := c.sources[name]
+
+		if src.Profile != "" {
+			dst.Profile = src.Profile
+			sources["profile"] = path
+		}
+		if src.Region != "" {
+			dst.Region = src.Region
+			sources["region"] = path
+		}
+		if src.StackName != "" {
+			dst.StackName = src.StackName
+			sources["stackName"] = path
+		}
+		if src.Filters.Search != "" {
+			dst.Filters.Search = src.Filters.Search
+			sources["filters.search"] = path
+		}
+		if src.Filters.Exact != nil {
+			dst.Filters.Exact = src.Filters.Exact
+			sources["filters.exact"] = path
+		}
+		if src.Concurrency != 0 {
+			dst.Concurrency = src.Concurrency
+			sources["concurrency"] = path
+		}
+		if src.Protected != nil {
+			dst.Protected = src.Protected
+			sources["protected"] = path
+		}
+		if src.Accounts != nil {
+			dst.Accounts = src.Accounts
+			sources["accounts"] = path
+		}
+		if src.AssumeRole.RoleName != "" {
+			dst.AssumeRole.RoleName = src.AssumeRole.RoleName
+			sources["assumeRole.roleName"] = path
+		}
+		if src.AssumeRole.ExternalId != "" {
+			dst.AssumeRole.ExternalId = src.AssumeRole.ExternalId
+			sources["assumeRole.externalId"] = path
+		}
+		if src.AssumeRole.MfaSerial != "" {
+			dst.AssumeRole.MfaSerial = src.AssumeRole.MfaSerial
+			sources["assumeRole.mfaSerial"] = path
+		}
+		c.Environments[name] = dst
+	}
+}
+
+// mergeGuardrail はガードレールの設定をマージする
+// 後から読み込んだファイルでガードレールが弱まらないよう、拒否パターンは和集合とし、必須タグはキー単位で上書きする
+func (c *Config) mergeGuardrail(path string, src Guardrail) {
+	if len(src.Deny) == 0 && len(src.RequiredTags) == 0 && !src.DisableDefaults {
+		return
+	}
+	c.guardrailFiles = append(c.guardrailFiles, path)
+	for _, pattern := range src.Deny {
+		if !slices.Contains(c.Guardrail.Deny, pattern) {
+			c.Guardrail.Deny = append(c.Guardrail.Deny, pattern)
+		}
+	}
+	for key, value := range src.RequiredTags {
+		if c.Guardrail.RequiredTags == nil {
+			c.Guardrail.RequiredTags = map[string]string{}
+		}
+		c.Guardrail.RequiredTags[key] = value
+	}
+	if src.DisableDefaults {
+		c.Guardrail.DisableDefaults = true
+	}
+}
+
+// EnvNames は定義されている環境名をソートして返す
+func (c *Config) EnvNames() []string {
+	names := make([]string, 0, len(c.Environments))
+	for name := range c.Environments {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// fileSource は設定ファイル由来の値の取得元を表示用に返す
+func (c *Config) fileSource(envName, key string) string {
+	return fmt.Sprintf(i18n.T("設定ファイル %s（環境: %s）"), c.sources[envName][key], envName)
+}
+
+// selectEnv は使用する環境名と取得元を決定する
+// explicit は --env または環境変数 AWSTK_ENV で明示的に選択されたかどうか
+func (c *Config) selectEnv(in Inputs) (env Value, explicit bool, err error) {
+	switch {
+	case in.EnvNameSet && in.EnvName != "":
+		env = Value{Value: in.EnvName, Origin: OriginFlag, Source: i18n.T("フラグ --env")}
+		explicit = true
+	case os.Getenv(EnvNameEnv) != "":
+		env = Value{Value: os.Getenv(EnvNameEnv), Origin: OriginEnvVar, Source: i18n.T("環境変数 ") + EnvNameEnv}
+		explicit = true
+	case c.DefaultEnv != "":
+		env = Value{Value: c.DefaultEnv, Origin: OriginFile, Source: i18n.T("設定ファイル ") + c.defaultEnvFile + i18n.T("（defaultEnv）")}
+	default:
+		return Value{}, false, nil
+	}
+
+	if _, ok := c.Environments[env.Value]; !ok {
+		available := i18n.T("（定義なし）")
+		if names := c.EnvNames(); len(names) > 0 {
+			available = strings.Join(names, ", ")
+		}
+		return Value{}, false, fmt.Errorf(i18n.T("環境 '%s' が設定ファイルに定義されていません（%s、定義済み: %s）"), env.Value, env.Source, available)
+	}
+	return env, explicit, nil
+}
@@ -0,0 +1,147 @@
+package config
+
+import (
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+)
+
+// Resolve はフラグ・環境変数・設定ファイル・デフォルト値から有効な設定を解決する
+//
+// 優先順位は次のとおり:
+//  1. コマンドラインフラグ
+//  2. --env / AWSTK_ENV で明示的に選択した環境の設定
+//  3. 環境変数（AWS_PROFILE, AWS_STACK_NAME）
+//  4. defaultEnv で選択された環境の設定
+//  5. デフォルト値
+//
+// 明示的に選択した環境がシェルに残った AWS_PROFILE 等で上書きされて
+// 意図しないアカウントを操作することがないよう、明示選択時のみ設定ファイルを環境変数より優先する
+func Resolve(cfg *Config, in Inputs) (*Resolved, error) {
+	resolved := &Resolved{Files: cfg.Files}
+
+	env, explicit, err := cfg.selectEnv(in)
+	if err != nil {
+		return nil, err
+	}
+	resolved.Env = env
+	if env.IsSet() {
+		resolved.Environment = cfg.Environments[env.Value]
+	}
+	e := resolved.Environment
+
+	fromFile := func(key, value string) Value {
+		if value == "" {
+			return Value{}
+		}
+		return Value{Value: value, Origin: OriginFile, Source: cfg.fileSource(env.Value, key)}
+	}
+	fromEnvVar := func(name string) Value {
+		if value := os.Getenv(name); value != "" {
+			return Value{Value: value, Origin: OriginEnvVar, Source: i18n.T("環境変数 ") + name}
+		}
+		return Value{}
+	}
+	fromFlag := func(set bool, value, name string) Value {
+		if set && value != "" {
+			return Value{Value: value, Origin: OriginFlag, Source: i18n.T("フラグ ") + name}
+		}
+		return Value{}
+	}
+
+	resolved.Profile = resolveLayered(explicit,
+		fromFlag(in.ProfileSet, in.Profile, "--profile"),
+		fromFile("profile", e.Profile),
+		fromEnvVar("AWS_PROFILE"),
+	)
+	resolved.StackName = resolveLayered(explicit,
+		fromFlag(in.StackNameSet, in.StackName, "--stack-name"),
+		fromFile("stackName", e.StackName),
+		fromEnvVar("AWS_STACK_NAME"),
+	)
+
+	resolved.Region = first(
+		fromFlag(in.RegionSet, in.Region, "--region"),
+		fromFile("region", e.Region),
+		Value{Value: DefaultRegion, Origin: OriginDefault, Source: i18n.T("デフォルト値")},
+	)
+
+	resolved.Search = fromFile("filters.search", e.Filters.Search)
+	if e.Filters.Exact != nil {
+		resolved.Exact = fromFile("filters.exact", strconv.FormatBool(*e.Filters.Exact))
+	}
+	if in.Concurrency > 0 {
+		resolved.Concurrency = fromFlag(true, strconv.Itoa(in.Concurrency), "--concurrency")
+	} else if e.Concurrency > 0 {
+		resolved.Concurrency = fromFile("concurrency", strconv.Itoa(e.Concurrency))
+	}
+	if len(e.Protected) > 0 {
+		resolved.Protected = fromFile("protected", strings.Join(e.Protected, ", "))
+	}
+
+	resolved.Guardrail = cfg.Guardrail
+	guardrailSource := i18n.T("設定ファイル ") + strings.Join(cfg.guardrailFiles, ", ")
+	if len(cfg.Guardrail.Deny) > 0 {
+		resolved.GuardrailDeny = Value{Value: strings.Join(cfg.Guardrail.Deny, ", "), Origin: OriginFile, Source: guardrailSource}
+	}
+	if len(cfg.Guardrail.RequiredTags) > 0 {
+		keys := make([]string, 0, len(cfg.Guardrail.RequiredTags))
+		for key := range cfg.Guardrail.RequiredTags {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		tags := make([]string, len(keys))
+		for i, key := range keys {
+			tags[i] = key
+			if v := cfg.Guardrail.RequiredTags[key]; v != "" {
+				tags[i] += "=" + v
+			}
+		}
+		resolved.GuardrailRequiredTags = Value{Value: strings.Join(tags, ", "), Origin: OriginFile, Source: guardrailSource}
+	}
+
+	resolved.Mcp = cfg.Mcp
+	if len(cfg.Mcp.AllowTools) > 0 {
+		resolved.McpAllowTools = Value{Value: strings.Join(cfg.Mcp.AllowTools, ", "), Origin: OriginFile, Source: i18n.T("設定ファイル ") + cfg.mcpFile}
+	}
+
+	if len(e.Accounts) > 0 {
+		resolved.Accounts = fromFile("accounts", strings.Join(e.Accounts, ", "))
+	}
+	resolved.RoleName = first(
+		fromFlag(in.RoleNameSet, in.RoleName, "--role-name"),
+		fromFile("assumeRole.roleName", e.AssumeRole.RoleName),
+		Value{Value: DefaultRoleName, Origin: OriginDefault, Source: i18n.T("デフォルト値")},
+	)
+	resolved.ExternalId = first(
+		fromFlag(in.ExternalIdSet, in.ExternalId, "--external-id"),
+		fromFile("assumeRole.externalId", e.AssumeRole.ExternalId),
+	)
+	resolved.MfaSerial = first(
+		fromFlag(in.MfaSerialSet, in.MfaSerial, "--mfa-serial"),
+		fromFile("assumeRole.mfaSerial", e.AssumeRole.MfaSerial),
+	)
+
+	return resolved, nil
+}
+
+// resolveLayered はフラグ・設定ファイル・環境変数の順位を環境の選択方法に応じて切り替えて解決する
+func resolveLayered(explicit bool, flag, file, envVar Value) Value {
+	if explicit {
+		return first(flag, file, envVar)
+	}
+	return first(flag, envVar, file)
+}
+
+// first は設定されている最初の値を返す
+func first(values ...Value) Value {
+	for _, v := range values {
+		if v.IsSet() {
+			return v
+		}
+	}
+	return Value{}
+}
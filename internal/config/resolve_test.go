@@ -0,0 +1,188 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+// loadConfig はユーザー設定とプロジェクト設定を一時ディレクトリに書き出して読み込む（空の場合は書き出さない）
+// 実行環境の AWS_PROFILE 等の影響を受けないよう、関連する環境変数を空にする
+func loadConfig(t *testing.T, user, project string) *Config {
+	t.Helper()
+	dir := t.TempDir()
+	t.Setenv("XDG_CONFIG_HOME", filepath.Join(dir, "config"))
+	t.Setenv("HOME", dir)
+	for _, name := range []string{EnvNameEnv, "AWS_PROFILE", "AWS_STACK_NAME"} {
+		t.Setenv(name, "")
+	}
+
+	if user != "" {
+		path := filepath.Join(dir, "config", "awstk", UserFileName)
+		if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(path, []byte(user), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	projectPath := filepath.Join(dir, FileName)
+	if project != "" {
+		if err := os.WriteFile(projectPath, []byte(project), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	t.Setenv(PathEnv, projectPath)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cfg
+}
+
+const projectConfig = `
+defaultEnv: dev
+environments:
+  dev:
+    profile: dev-profile
+    region: us-west-2
+    stackName: dev-stack
+  stg:
+    profile: stg-profile
+`
+
+func TestResolvePrecedence(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          Inputs
+		envVars     map[string]string
+		wantEnv     string
+		wantProfile string
+		wantOrigin  Origin
+		wantRegion  string
+		wantErr     bool
+	}{
+		{
+			name:        "defaultEnv の環境の設定",
+			wantEnv:     "dev",
+			wantProfile: "dev-profile",
+			wantOrigin:  OriginFile,
+			wantRegion:  "us-west-2",
+		},
+		{
+			name:        "defaultEnv より環境変数を優先",
+			envVars:     map[string]string{"AWS_PROFILE": "shell-profile"},
+			wantEnv:     "dev",
+			wantProfile: "shell-profile",
+			wantOrigin:  OriginEnvVar,
+			wantRegion:  "us-west-2",
+		},
+		{
+			name:        "--env で明示的に選択した環境は環境変数より優先",
+			in:          Inputs{EnvName: "stg", EnvNameSet: true},
+			envVars:     map[string]string{"AWS_PROFILE": "shell-profile"},
+			wantEnv:     "stg",
+			wantProfile: "stg-profile",
+			wantOrigin:  OriginFile,
+			wantRegion:  DefaultRegion,
+		},
+		{
+			name:        "AWSTK_ENV も明示的な選択として扱う",
+			envVars:     map[string]string{EnvNameEnv: "stg", "AWS_PROFILE": "shell-profile"},
+			wantEnv:     "stg",
+			wantProfile: "stg-profile",
+			wantOrigin:  OriginFile,
+			wantRegion:  DefaultRegion,
+		},
+		{
+			name:        "フラグが最優先",
+			in:          Inputs{EnvName: "stg", EnvNameSet: true, Profile: "flag-profile", ProfileSet: true, Region: "eu-west-1", RegionSet: true},
+			envVars:     map[string]string{"AWS_PROFILE": "shell-profile"},
+			wantEnv:     "stg",
+			wantProfile: "flag-profile",
+			wantOrigin:  OriginFlag,
+			wantRegion:  "eu-west-1",
+		},
+		{
+			name:    "定義されていない環境はエラー",
+			in:      Inputs{EnvName: "prd", EnvNameSet: true},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := loadConfig(t, "", projectConfig)
+			for k, v := range tt.envVars {
+				t.Setenv(k, v)
+			}
+
+			resolved, err := Resolve(cfg, tt.in)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if resolved.Env.Value != tt.wantEnv {
+				t.Errorf("Env = %q, want %q", resolved.Env.Value, tt.wantEnv)
+			}
+			if resolved.Profile.Value != tt.wantProfile || resolved.Profile.Origin != tt.wantOrigin {
+				t.Errorf("Profile = %+v, want %q (origin %d)", resolved.Profile, tt.wantProfile, tt.wantOrigin)
+			}
+			if resolved.Region.Value != tt.wantRegion {
+				t.Errorf("Region = %+v, want %q", resolved.Region, tt.wantRegion)
+			}
+		})
+	}
+}
+
+func TestLoadMerge(t *testing.T) {
+	user := `
+defaultEnv: stg
+environments:
+  dev:
+    profile: user-profile
+    region: eu-central-1
+`
+	cfg := loadConfig(t, user, projectConfig)
+
+	// プロジェクト設定の値を項目単位で優先する
+	if len(cfg.Files) != 2 || filepath.Base(cfg.Files[1]) != FileName {
+		t.Errorf("Files = %v, want user config then project config", cfg.Files)
+	}
+	dev := cfg.Environments["dev"]
+	if dev.Profile != "dev-profile" || dev.Region != "us-west-2" || dev.StackName != "dev-stack" {
+		t.Errorf("dev = %+v", dev)
+	}
+	if cfg.DefaultEnv != "dev" {
+		t.Errorf("DefaultEnv = %q, want dev", cfg.DefaultEnv)
+	}
+	if names := cfg.EnvNames(); !slices.Equal(names, []string{"dev", "stg"}) {
+		t.Errorf("EnvNames() = %v", names)
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	tests := map[string]string{
+		"未知のキー":   "environments:\n  dev:\n    profle: typo\n",
+		"負の並列数":   "environments:\n  dev:\n    concurrency: -1\n",
+		"不正なパターン": "environments:\n  dev:\n    protected: ['[unclosed']\n",
+	}
+	for name, content := range tests {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			path := filepath.Join(dir, FileName)
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatal(err)
+			}
+			t.Setenv("XDG_CONFIG_HOME", filepath.Join(dir, "config"))
+			t.Setenv(PathEnv, path)
+			if _, err := Load(); err == nil {
+				t.Errorf("Load() accepted %q", content)
+			}
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package config
+
+const (
+	// FileName はリポジトリ（プロジェクト）ごとの設定ファイル名
+	FileName = ".awstk.yaml"
+	// UserFileName はユーザー設定ディレクトリ配下（awstk/）の設定ファイル名
+	UserFileName = "config.yaml"
+	// PathEnv はプロジェクト設定ファイルのパスを明示する環境変数（指定時はカレントディレクトリからの探索を行わない）
+	PathEnv = "AWSTK_CONFIG"
+	// EnvNameEnv は使用する環境名を指定する環境変数（--env と同等）
+	EnvNameEnv = "AWSTK_ENV"
+	// DefaultRegion は何も指定されていない場合のリージョン
+	DefaultRegion = "ap-northeast-1"
+	// DefaultRoleName は複数アカウント実行時に各アカウントで引き受けるロール名の既定値（AWS Organizations の既定ロール）
+	DefaultRoleName = "OrganizationAccountAccessRole"
+)
+
+// File は設定ファイルの内容
+type File struct {
+	DefaultEnv   string                 `yaml:"defaultEnv"`
+	Guardrail    Guardrail              `yaml:"guardrail"`
+	Mcp          Mcp                    `yaml:"mcp"`
+	Environments map[string]Environment `yaml:"environments"`
+}
+
+// Guardrail は削除系コマンドのガードレール（環境によらず常に適用する）
+type Guardrail struct {
+	Deny            []string          `yaml:"deny"`            // 削除を拒否するリソース名のパターン（--search と同じく部分一致またはワイルドカード）
+	RequiredTags    map[string]string `yaml:"requiredTags"`    // 削除に必要なタグ（値が空の場合はキーが付いていればよい）
+	DisableDefaults bool              `yaml:"disableDefaults"` // 組み込みの拒否パターンを使用しない
+}
+
+// Mcp は mcp serve の設定（環境によらず常に適用する）
+type Mcp struct {
+	AllowTools []string `yaml:"allowTools"` // MCPクライアントに公開する変更系ツール名（読み取り系のツールは常に公開）
+}
+
+// Environment は名前付き環境の設定
+type Environment struct {
+	Profile     string     `yaml:"profile"`
+	Region      string     `yaml:"region"`
+	StackName   string     `yaml:"stackName"`
+	Filters     Filters    `yaml:"filters"`
+	Concurrency int        `yaml:"concurrency"` // 並列処理の最大同時実行数
+	Protected   []string   `yaml:"protected"`   // 削除対象から除外するリソース名のパターン（--search と同じく部分一致またはワイルドカード）
+	Accounts    []string   `yaml:"accounts"`    // --accounts all で対象とするアカウント（アカウントIDまたはロールARN）
+	AssumeRole  AssumeRole `yaml:"assumeRole"`
+}
+
+// AssumeRole は複数アカウント実行時に各アカウントで引き受けるロールの設定
+type AssumeRole struct {
+	RoleName   string `yaml:"roleName"`
+	ExternalId string `yaml:"externalId"`
+	MfaSerial  string `yaml:"mfaSerial"`
+}
+
+// Filters は検索フラグ（-s/--search, --exact）を持つコマンドの既定値
+type Filters struct {
+	Search string `yaml:"search"`
+	Exact  *bool  `yaml:"exact"`
+}
+
+// Config は読み込んだ全設定ファイルをマージした結果
+// 後から読み込んだファイル（プロジェクト設定）の値がユーザー設定より優先される
+type Config struct {
+	Files        []string // 読み込んだ設定ファイル（優先度の低い順）
+	DefaultEnv   string
+	Guardrail    Guardrail // 全設定ファイルの guardrail をマージしたもの（拒否パターンは和集合）
+	Mcp          Mcp       // 最後に mcp を定義したファイルの設定
+	Environments map[string]Environment
+
+	defaultEnvFile string
+	guardrailFiles []string                     // guardrail を定義したファイル
+	mcpFile        string                       // mcp を定義したファイル
+	sources        map[string]map[string]string // 環境名 → 項目名 → 値を定義したファイル
+}
+
+// Origin は設定値の取得元の種類
+type Origin int
+
+const (
+	OriginNone    Origin = iota // 未設定
+	OriginFlag                  // コマンドラインフラグ
+	OriginEnvVar                // 環境変数
+	OriginFile                  // 設定ファイル
+	OriginDefault               // デフォルト値
+)
+
+// Value は解決済みの設定値と、その取得元
+type Value struct {
+	Value  string
+	Origin Origin
+	Source string // 表示用の取得元（例: "環境変数 AWS_PROFILE"）
+}
+
+// IsSet は値が設定されているかを返す
+func (v Value) IsSet() bool {
+	return v.Value != ""
+}
+
+// Inputs はコマンドラインから指定された値（Set が true のものはフラグで明示指定された値）
+type Inputs struct {
+	EnvName       string
+	EnvNameSet    bool
+	Profile       string
+	ProfileSet    bool
+	Region        string
+	RegionSet     bool
+	StackName     string
+	StackNameSet  bool
+	RoleName      string
+	RoleNameSet   bool
+	ExternalId    string
+	ExternalIdSet bool
+	MfaSerial     string
+	MfaSerialSet  bool
+	Concurrency   int
+}
+
+// Resolved はフラグ・環境変数・設定ファイル・デフォルト値から解決した有効な設定
+type Resolved struct {
+	Files       []string
+	Env         Value
+	Profile     Value
+	Region      Value
+	StackName   Value
+	Search      Value
+	Exact       Value
+	Concurrency Value
+	Protected   Value
+	Accounts    Value
+	RoleName    Value
+	ExternalId  Value
+	MfaSerial   Value
+
+	GuardrailDeny         Value // 設定ファイルの guardrail.deny（組み込みの拒否パターンは含まない）
+	GuardrailRequiredTags Value
+	Guardrail             Guardrail
+
+	McpAllowTools Value // 設定ファイルの mcp.allowTools
+	Mcp           Mcp
+
+	Environment Environment // 選択された環境の設定（未選択の場合はゼロ値）
+}
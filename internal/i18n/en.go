@@ -0,0 +1,1766 @@
+package i18n
+
+// enMessages は英語の翻訳（キーは日本語の原文）
+// コマンドのヘルプ・フラグの説明は cmd.LocalizeCommands で、その他のメッセージは呼び出し元の i18n.T で翻訳する
+var enMessages = map[string]string{
+	// cmd/apply.go
+	"❌ プランのリージョン (%s) と実行リージョン (%s) が一致しません。-R %s を指定してください":               "❌ Plan region (%s) does not match the current region (%s). Specify -R %s",
+	"❌ 実行中のアカウントIDを取得できないため、プラン作成時のアカウント (%s) と一致するか確認できません":               "❌ Could not get the current account ID, so it cannot be checked against the account used to create the plan (%s)",
+	"❌ 実行中のアカウントIDを取得できないため、プランを作成できません（apply 時に同じアカウントか確認するために記録します）: %w": "❌ Could not get the current account ID, so the plan was not created (it is recorded to check the account on apply): %w",
+	"アカウントIDが返されませんでした": "No account ID was returned",
+	"❌ 実行中のアカウントIDを取得できないため、実行 %s のアカウント (%s) と一致するか確認できません: %w": "❌ Could not get the current account ID, so it cannot be checked against the account of run %s (%s): %w",
+	"❌ 実行 %s はアカウント %s の実行のため、アカウント %s では再開できません":                "❌ Run %s was started in account %s and cannot be resumed in account %s",
+	"❌ プラン作成時のアカウント (%s) と実行中のアカウント (%s) が一致しません":                "❌ The account used to create the plan (%s) does not match the current account (%s)",
+	"⚠️  プラン作成時のプロファイル (%s) と異なるプロファイル (%s) で実行します":              "⚠️  The plan was created with profile %s, but it will be applied with a different profile (%s)",
+	"このプロファイルで実行しますか？":                                           "Apply the plan with this profile?",
+	"プランの実行をキャンセルしました":                                           "Plan apply was cancelled",
+	"❌ プランの実行でエラー: %w":                                           "❌ Error while applying the plan: %w",
+	"✅ プランの実行が完了しました":                                            "✅ Plan applied successfully",
+
+	// cmd/aurora.go
+	"❌ CloudFormationスタックからクラスター名の取得に失敗: %w":                   "❌ Failed to get the cluster name from the CloudFormation stack: %w",
+	"✅ CloudFormationスタック '%s' からAuroraクラスター '%s' を検出しました\n":   "✅ Detected Aurora cluster '%[2]s' from CloudFormation stack '%[1]s'\n",
+	"❌ エラー: Auroraクラスター名 (-c) またはスタック名 (-S) を指定してください":         "❌ Error: specify an Aurora cluster name (-c) or a stack name (-S)",
+	"🚀 Aurora DBクラスター (%s) を起動します...\n":                        "🚀 Starting Aurora DB cluster (%s)...\n",
+	"❌ Aurora DBクラスター起動エラー: %w":                                "❌ Failed to start Aurora DB cluster: %w",
+	"✅ Aurora DBクラスター (%s) の起動を開始しました\n":                       "✅ Started Aurora DB cluster (%s)\n",
+	"🛑 Aurora DBクラスター (%s) を停止します...\n":                        "🛑 Stopping Aurora DB cluster (%s)...\n",
+	"❌ Aurora DBクラスター停止エラー: %w":                                "❌ Failed to stop Aurora DB cluster: %w",
+	"✅ Aurora DBクラスター (%s) の停止を開始しました\n":                       "✅ Stopping Aurora DB cluster (%s) has begun\n",
+	"❌ Acu情報取得でエラー: %w":                                        "❌ Failed to get ACU information: %w",
+	"✅ CloudFormationスタック '%s' からAuroraクラスター '%s' を検出しました\n\n": "✅ Detected Aurora cluster '%[2]s' from CloudFormation stack '%[1]s'\n\n",
+	"❌ ACU情報取得でエラー: %w":                                        "❌ Failed to get ACU information: %w",
+	"ℹ️ クラスター '%s' はServerless v2ではありません":                      "ℹ️ Cluster '%s' is not Serverless v2",
+	"停止中のAurora DBクラスター":                                       "stopped Aurora DB clusters",
+	"起動中のAurora DBクラスター":                                       "available Aurora DB clusters",
+	"❌ Auroraクラスター一覧の取得に失敗: %w":                                "❌ Failed to list Aurora clusters: %w",
+
+	// cmd/canary.go
+	"オプションが指定されていません":                    "No options specified",
+	"--name または --search のいずれかを指定してください": "Specify either --name or --search",
+
+	// cmd/cfn.go
+	"CloudFormationスタック":   "CloudFormation stacks",
+	"CloudFormationスタック一覧": "CloudFormation stacks",
+	"スタック":                 "stacks",
+	"❌ エラー: スタック名 (-S) を指定してください":                "❌ Error: specify a stack name (-S)",
+	"❌ リソース起動処理でエラー: %w":                         "❌ Error while starting resources: %w",
+	"✅ リソース起動処理が完了しました":                          "✅ Resources started",
+	"❌ リソース停止処理でエラー: %w":                         "❌ Error while stopping resources: %w",
+	"✅ リソース停止処理が完了しました":                          "✅ Resources stopped",
+	"❌ スタック削除処理でエラー: %w":                         "❌ Error while deleting stacks: %w",
+	"❌ 削除保護の更新処理でエラー: %w":                        "❌ Error while updating termination protection: %w",
+	"❌ ドリフト検出処理でエラー: %w":                         "❌ Error during drift detection: %w",
+	"❌ ドリフト状態確認処理でエラー: %w":                       "❌ Error while checking drift status: %w",
+	"❌ エラー: テンプレートファイルパス (--template) を指定してください": "❌ Error: specify a template file path (--template)",
+	"❌ エラー: スタック名 (--stack) を指定してください":           "❌ Error: specify a stack name (--stack)",
+	"❌ デプロイ処理でエラー: %w":                           "❌ Error during deployment: %w",
+
+	// cmd/cleanup.go
+	"❌ エラー: %w": "❌ Error: %w",
+	"❌ クリーンアップ処理でエラー: %w": "❌ Error during cleanup: %w",
+	"✅ クリーンアップが完了しました":    "✅ Cleanup completed",
+
+	// cmd/cloudfront.go
+	"テナント": "tenants",
+	"テナント一覧 (ディストリビューション: %s)": "Tenants (distribution: %s)",
+
+	// cmd/ec2.go
+	"🚀 EC2インスタンス (%s) を起動します...\n":  "🚀 Starting EC2 instance (%s)...\n",
+	"❌ EC2インスタンス起動エラー: %w":          "❌ Failed to start EC2 instance: %w",
+	"✅ EC2インスタンス (%s) の起動を開始しました\n": "✅ Started EC2 instance (%s)\n",
+	"🛑 EC2インスタンス (%s) を停止します...\n":  "🛑 Stopping EC2 instance (%s)...\n",
+	"❌ EC2インスタンス停止エラー: %w":          "❌ Failed to stop EC2 instance: %w",
+	"✅ EC2インスタンス (%s) の停止を開始しました\n": "✅ Stopping EC2 instance (%s) has begun\n",
+	"停止中のEC2インスタンス":                 "stopped EC2 instances",
+	"実行中のEC2インスタンス":                 "running EC2 instances",
+
+	// cmd/ecr.go
+	"検索文字列": "Search string",
+
+	// cmd/ecs.go
+	"ECSサービス": "ECS service",
+	"🚀 ECSサービス (%s) を処理します...\n": "🚀 Processing ECS service (%s)...\n",
+	"🔍 コンテナ '%s' に接続しています...\n":  "🔍 Connecting to container '%s'...\n",
+	"❌ コンテナへの接続に失敗しました: %w":      "❌ Failed to connect to the container: %w",
+	"🚀 ECSタスクを実行します...":          "🚀 Running ECS task...",
+	"❌ タスク実行エラー: %w":             "❌ Task execution error: %w",
+	"✅ タスクが完了しました。終了コード: %d\n":   "✅ Task completed. Exit code: %d\n",
+	"❌ タスクが異常終了しました。終了コード: %d":   "❌ Task exited abnormally. Exit code: %d",
+	"❌ デプロイ完了待機エラー: %w":          "❌ Error while waiting for the deployment to finish: %w",
+
+	// cmd/env.go
+	"❌ エラー: -S (スタック名) または -P (プロファイル) を指定してください": "❌ Error: specify -S (stack name) or -P (profile)",
+	"✅ 以下のコマンドを実行して環境変数を設定してください：":                "✅ Run the following commands to set the environment variables:",
+	"✅ 以下のコマンドを実行して環境変数を削除してください：":                "✅ Run the following commands to unset the environment variables:",
+
+	// cmd/logs.go
+	"CloudWatch Logsグループ": "CloudWatch Logs groups",
+	"空の":                  "empty ",
+	"保存期間未設定の":            "no-retention ",
+	"ログループ":               "log groups",
+	"該当するログループはありませんでした":                   "No matching log groups found",
+	"\n合計: %d個のログループ\n":                    "\nTotal: %d log groups\n",
+	"削除対象のロググループ名、検索パターン、またはタグ条件を指定してください": "Specify log group names, a search pattern or tag conditions to delete",
+	"削除するロググループを選択してください":                  "Select the log groups to delete",
+
+	// cmd/rds.go
+	"🚀 RDSインスタンス (%s) を起動します...\n":                         "🚀 Starting RDS instance (%s)...\n",
+	"❌ RDSインスタンス起動エラー: %w":                                 "❌ Failed to start RDS instance: %w",
+	"✅ RDSインスタンス (%s) の起動を開始しました\n":                        "✅ Started RDS instance (%s)\n",
+	"🚀 RDSインスタンス (%s) を停止します...\n":                         "🚀 Stopping RDS instance (%s)...\n",
+	"❌ RDSインスタンス停止エラー: %w":                                 "❌ Failed to stop RDS instance: %w",
+	"✅ RDSインスタンス (%s) の停止を開始しました\n":                        "✅ Stopping RDS instance (%s) has begun\n",
+	"❌ エラー: RDSインスタンス名 (-i) またはスタック名 (-S) を指定してください":       "❌ Error: specify an RDS instance name (-i) or a stack name (-S)",
+	"❌ CloudFormationスタックからインスタンス名の取得に失敗: %w":              "❌ Failed to get the instance name from the CloudFormation stack: %w",
+	"✅ CloudFormationスタック '%s' からRDSインスタンス '%s' を検出しました\n": "✅ Detected RDS instance '%[2]s' from CloudFormation stack '%[1]s'\n",
+	"停止中のRDSインスタンス":                                        "stopped RDS instances",
+	"起動中のRDSインスタンス":                                        "available RDS instances",
+	"❌ RDSインスタンス一覧の取得に失敗: %w":                              "❌ Failed to list RDS instances: %w",
+
+	// cmd/region.go
+	"リージョン":                  "Region",
+	"AWSリージョン一覧: (全%d件)\n\n": "AWS regions: (%d total)\n\n",
+	"✅ 有効なリージョン (%d件)":       "✅ Enabled regions (%d)",
+	"❌ 無効なリージョン (%d件)":       "❌ Disabled regions (%d)",
+	"利用可能なリージョン一覧":           "Available regions",
+
+	// cmd/root.go
+	"\n⚠️  中断を受け付けました。処理を停止しています...（もう一度 Ctrl-C で強制終了）":                          "\n⚠️  Interrupt received. Stopping...(press Ctrl-C again to force quit)",
+	"%s ジャーナルの記録に失敗しました: %v\n":                                                   "%s Failed to record the journal: %v\n",
+	"🔍 -Pオプションで指定されたプロファイル '%s' を使用します\n":                                        "🔍 Using profile '%s' specified by the -P option\n",
+	"🔍 環境変数 AWS_PROFILE の値 '%s' を使用します\n":                                        "🔍 Using profile '%s' from the AWS_PROFILE environment variable\n",
+	"🔍 環境 '%s' のプロファイル '%s' を使用します\n":                                            "🔍 Using profile '%[2]s' from environment '%[1]s'\n",
+	"❌ エラー: プロファイルが指定されていません。-Pオプション、AWS_PROFILE 環境変数、または設定ファイル（%s）の環境を指定してください": "❌ Error: no profile specified. Use the -P option, the AWS_PROFILE environment variable, or an environment in the config file (%s)",
+	"検索パターンの既定値の設定に失敗: %w":                                                       "Failed to set the default search pattern: %w",
+	"🔍 環境 '%s' の検索パターン '%s' を使用します\n":                                            "🔍 Using search pattern '%[2]s' from environment '%[1]s'\n",
+	"--exact の既定値の設定に失敗: %w":                                                     "Failed to set the default for --exact: %w",
+	"❌ エラー: --concurrency, --rate-limit には0以上の値を指定してください":                        "❌ Error: --concurrency and --rate-limit must be 0 or greater",
+	"❌ エラー: --max-attempts には1以上の値を指定してください（1の場合は再試行しません）":                       "❌ Error: --max-attempts must be 1 or greater (1 disables retries)",
+	"🔍 環境 '%s' のリージョン '%s' を使用します\n":                                             "🔍 Using region '%[2]s' from environment '%[1]s'\n",
+	"🔍 エンドポイントを上書きします: ":                                                         "🔍 Overriding endpoints: ",
+	"aws設定の読み込みエラー: %w":                                                          "Failed to load AWS config: %w",
+
+	// cmd/route53.go
+	"❌ エラー: ドメイン名・ゾーンIDと --tag は同時に指定できません": "❌ Error: a domain name or zone ID and --tag cannot be specified together",
+	"❌ エラー: ドメイン名・ゾーンIDまたは --tag を指定してください": "❌ Error: specify a domain name, a zone ID or --tag",
+
+	// cmd/s3.go
+	"S3バケット": "S3 buckets",
+	"❌ 空バケットのチェックでエラー: %w": "❌ Error while checking for empty buckets: %w",
+	"空のS3バケット一覧":           "Empty S3 buckets",
+	"バケット":                 "buckets",
+	"S3バケット一覧":             "S3 buckets",
+	"S3パス: %s\n出力先: %s\n":  "S3 path: %s\nOutput: %s\n",
+	"❌ gunzip失敗: %w":       "❌ gunzip failed: %w",
+	"❌ S3バケット一覧取得エラー: %w":  "❌ Failed to list S3 buckets: %w",
+	"検索パターン '%s' に一致するS3バケットが見つかりませんでした\n":     "No S3 buckets matched the search pattern '%s'\n",
+	"❌ %d個のS3バケットの削除に失敗しました":                   "❌ Failed to delete %d S3 buckets",
+	"✅ S3バケットの削除が完了しました":                       "✅ S3 bucket deletion completed",
+	"中断した実行 %s を再開します\n":                       "Resuming interrupted run %s\n",
+	"✅ %s %s は前回の実行で削除済みです\n":                  "✅ %[2]s (%[1]s) was already deleted in the previous run\n",
+	"⚠️  実行状態を保存できなかったため、中断した場合は再開できません: %v\n": "⚠️  Could not save the run state, so the run cannot be resumed if interrupted: %v\n",
+	"実行ID: %s\n": "Run ID: %s\n",
+
+	// cmd/schedule.go
+	"スケジュール一覧の取得に失敗: %w":               "Failed to list schedules: %w",
+	"スケジュール名または検索パターンのいずれか一方を指定してください": "Specify either a schedule name or a search pattern",
+	"スケジュール名を指定してください":                 "Specify a schedule name",
+
+	// cmd/secretsmanager.go
+	"🔍 シークレット (%s) の値を取得します...\n":        "🔍 Fetching the value of secret (%s)...\n",
+	"❌ シークレット取得エラー: %w":                  "❌ Failed to get the secret: %w",
+	"❌ JSON変換エラー: %w":                    "❌ JSON conversion error: %w",
+	"シークレット %s は正常に削除されました。\n":           "Secret %s was deleted successfully.\n",
+	"⚠️  以下のシークレットを復旧期間なしで即時削除します:":      "⚠️  The following secrets will be deleted immediately, without a recovery window:",
+	"本当に削除しますか？":                         "Do you really want to delete them?",
+	"❌ エラー: シークレット名を指定してください":            "❌ Error: specify a secret name",
+	"❌ エラー: シークレットIDと --tag は同時に指定できません": "❌ Error: a secret ID and --tag cannot be specified together",
+	"❌ エラー: シークレットIDまたは --tag を指定してください": "❌ Error: specify a secret ID or --tag",
+
+	// cmd/ssm.go
+	"❌ サポートされていないファイル形式です。.csv または .json ファイルを指定してください": "❌ Unsupported file format. Specify a .csv or .json file",
+	"❌ パラメータの登録に失敗しました: %w":                             "❌ Failed to put parameters: %w",
+	"✅ ドライラン完了":                      "✅ Dry run completed",
+	"✅ パラメータの登録が完了しました":              "✅ Parameters put successfully",
+	"❌ パラメータの削除に失敗しました: %w":          "❌ Failed to delete parameters: %w",
+	"✅ パラメータの削除が完了しました":              "✅ Parameters deleted successfully",
+	"❌ エラー: ファイルまたは --tag を指定してください": "❌ Error: specify a file or --tag",
+
+	// cmd/targets.go
+	"❌ エラー: --accounts all を使用するには設定ファイルの環境に accounts を定義してください": "❌ Error: define accounts in the config file environment to use --accounts all",
+	"🔍 %d個のアカウントでロール '%s' を引き受けて実行します\n":                         "🔍 Assuming role '%[2]s' in %[1]d accounts\n",
+	"🔍 %d個のアカウントでロールを引き受けて実行します\n":                               "🔍 Assuming roles in %d accounts\n",
+	"🔐 MFAトークンコードを入力してください (%s): ":                               "🔐 Enter the MFA token code (%s): ",
+	"❌ リージョン一覧の取得に失敗: %w":                                        "❌ Failed to list regions: %w",
+	"❌ エラー: --regions にリージョンを指定してください（all または カンマ区切り）":           "❌ Error: specify regions for --regions (all or comma-separated)",
+	"❌ エラー: --regions / --accounts と -S (スタック名) は同時に指定できません":     "❌ Error: --regions / --accounts cannot be used with -S (stack name)",
+
+	// cmd/utils.go
+	"アカウントIDの取得に失敗: %v\n":                    "Failed to get the account ID: %v\n",
+	"🔍 -Sオプションで指定されたスタック名 '%s' を使用します\n":     "🔍 Using stack name '%s' specified by the -S option\n",
+	"🔍 環境変数 AWS_STACK_NAME の値 '%s' を使用します\n": "🔍 Using stack name '%s' from the AWS_STACK_NAME environment variable\n",
+	"🔍 環境 '%s' のスタック名 '%s' を使用します\n":         "🔍 Using stack name '%[2]s' from environment '%[1]s'\n",
+	"❌ エラー: スタック名またはオプションを指定してください":          "❌ Error: specify a stack name or options",
+	"❌ エラー: スタック名とオプションは同時に指定できません":          "❌ Error: a stack name and options cannot be specified together",
+	"削除対象がないため、プランファイルは作成しませんでした":            "Nothing to delete, so no plan file was written",
+	"\n📝 プランを %s に書き出しました（削除はまだ実行されていません）\n": "\n📝 Wrote the plan to %s (nothing has been deleted yet)\n",
+	"   実行するには: %s apply %s\n":               "   To apply it: %s apply %s\n",
+
+	// internal/aws/assume_role.go
+	"ロールARNの形式が不正です: %s":                   "Invalid role ARN: %s",
+	"アカウントIDは12桁の数字またはロールARNで指定してください: %s": "Specify the account as a 12-digit ID or a role ARN: %s",
+	"アカウントID %s で引き受けるロール名が指定されていません":      "No role name specified to assume in account %s",
+	"対象アカウントが指定されていません":                    "No target accounts specified",
+	"MFAトークンコードの入力に失敗: %w":                 "Failed to read the MFA token code: %w",
+	"MFA認証済みセッションの取得に失敗: %w":               "Failed to get an MFA-authenticated session: %w",
+
+	// internal/aws/endpoint.go
+	"%s の形式が不正です（service=url をカンマ区切りで指定してください）: %s": "Invalid %s format (specify service=url separated by commas): %s",
+	"サービス '%s': %w": "service '%s': %w",
+	"エンドポイントURLが不正です（例: http://localhost:4566）: %s": "Invalid endpoint URL (e.g. http://localhost:4566): %s",
+
+	// internal/config/config.go
+	"環境変数 %s で指定された設定ファイルが見つかりません: %s":              "Config file specified by the %s environment variable not found: %s",
+	"設定ファイルの読み込みに失敗: %w":                            "Failed to read the config file: %w",
+	"設定ファイルの解析に失敗 (%s): %w":                         "Failed to parse the config file (%s): %w",
+	"設定ファイル %s の環境 '%s': concurrency は0以上を指定してください": "Config file %s, environment '%s': concurrency must be 0 or greater",
+	"設定ファイル %s の環境 '%s': protected のパターンが不正です: %s":  "Config file %s, environment '%s': invalid protected pattern: %s",
+	"設定ファイル %s（環境: %s）":                             "config file %s (environment: %s)",
+	"フラグ --env":                                     "flag --env",
+	"環境変数 ":                                         "environment variable ",
+	"設定ファイル ":                                       "config file ",
+	"（defaultEnv）":                                  " (defaultEnv)",
+	"（定義なし）":                                        "(not defined)",
+	"環境 '%s' が設定ファイルに定義されていません（%s、定義済み: %s）":   "Environment '%s' is not defined in the config file (%s, defined: %s)",
+	"設定ファイル %s: guardrail.deny のパターンが不正です: %s": "config file %s: invalid guardrail.deny pattern: %s",
+
+	// internal/config/resolve.go
+	"フラグ ":   "flag ",
+	"デフォルト値": "default",
+
+	// internal/journal/journal.go
+	"ユーザー設定ディレクトリの取得に失敗: %w": "Failed to get the user config directory: %w",
+	"ジャーナルのエンコードに失敗: %w":     "Failed to encode the journal: %w",
+	"ジャーナルディレクトリの作成に失敗: %w":  "Failed to create the journal directory: %w",
+	"ジャーナルファイルを開けません: %w":    "Cannot open the journal file: %w",
+	"ジャーナルへの書き込みに失敗: %w":     "Failed to write to the journal: %w",
+	"ジャーナルの読み込みに失敗: %w":      "Failed to read the journal: %w",
+
+	// internal/service/apply/apply.go
+	"プランに実行するアクションがありません":             "The plan has no actions to run",
+	"実行できるアクションがありません":                "No actions can be run",
+	"プランのリソース":                        "resources in the plan",
+	"未対応のアクションです: %s (%s)":            "Unsupported action: %s (%s)",
+	"未対応のリソース種別です: %s (%s)":           "Unsupported resource type: %s (%s)",
+	"\n%s リソースの現在の状態を確認しています...\n":    "\n%s Checking the current state of resources...\n",
+	"%s の状態確認に失敗: %w":                 "Failed to check the state of %s: %w",
+	"\n%s  %d件のリソースがプラン作成時と一致しません:\n": "\n%s  %d resources no longer match the plan:\n",
+	"プランが現在の状態と一致しないため実行を中止しました（一致するものだけ実行するには --skip-changed を指定、または新しいプランを作成してください）": "Aborted because the plan does not match the current state (specify --skip-changed to run only the matching ones, or create a new plan)",
+	"--skip-changed により、一致しないリソースをスキップして実行します":                                         "Skipping mismatched resources because of --skip-changed",
+	"\n%s %s の削除を開始...\n": "\n%s Deleting %s...\n",
+	"プランの実行が中断されました: %w":  "Plan execution was interrupted: %w",
+	"%d件のアクションが失敗しました":    "%d actions failed",
+
+	// internal/service/aurora/acu.go
+	"クラスター情報の取得に失敗: %w":                        "Failed to get cluster information: %w",
+	"クラスター '%s' が見つかりません":                      "Cluster '%s' not found",
+	"Acuメトリクスが取得できません":                         "ACU metrics are not available",
+	"Aurora Serverless v2クラスターが見つかりませんでした":     "No Aurora Serverless v2 clusters found",
+	"Aurora Serverless v2 Acu使用状況: (全%d件)\n\n": "Aurora Serverless v2 ACU usage: (%d total)\n\n",
+	"   Acu使用量: %.1f (過去5分間の平均 - アイドル状態)\n":    "   ACU usage: %.1f (5-minute average - idle)\n",
+	"   Acu使用量: %.1f (過去5分間の平均値)\n":            "   ACU usage: %.1f (5-minute average)\n",
+	"   設定範囲: %.1f - %.1f Acu\n":               "   Configured range: %.1f - %.1f ACU\n",
+	"   ⚠️  Acu使用量を取得できませんでした":                 "   ⚠️  Could not get ACU usage",
+	"   💡 ヒント: クラスターが停止中、または CloudWatch にメトリクスがまだ記録されていない可能性があります": "   💡 Hint: the cluster may be stopped, or CloudWatch may not have recorded metrics yet",
+	"   ステータス: %s\n": "   Status: %s\n",
+	"クラスターID":        "Cluster ID",
+	"Acu使用量":         "ACU usage",
+	"最小Acu":          "Min ACU",
+	"最大Acu":          "Max ACU",
+	"ステータス":          "Status",
+
+	// internal/service/aurora/ls.go
+	"Auroraクラスター":            "Aurora clusters",
+	"Auroraクラスター一覧":          "Aurora clusters",
+	"Auroraクラスターが見つかりませんでした": "No Aurora clusters found",
+	"エンジン":                   "Engine",
+
+	// internal/service/aurora/stop.go
+	"❌ Auroraクラスター停止エラー: %w": "❌ Failed to stop Aurora cluster: %w",
+
+	// internal/service/canary/common.go
+	"canaryの開始に失敗: %w": "Failed to start canary: %w",
+	"canaryの停止に失敗: %w": "Failed to stop canary: %w",
+	"%s (現在: %s)":      "%s (current: %s)",
+
+	// internal/service/canary/disable.go
+	"Canary '%s' が見つかりませんでした":               "Canary '%s' not found",
+	"ℹ️  %s は既に停止しています\n":                   "ℹ️  %s is already stopped\n",
+	"Canary '%s' は現在の状態(%s)では無効化できません":      "Canary '%s' cannot be disabled in its current state (%s)",
+	"✅ %s を無効化しました\n":                       "✅ Disabled %s\n",
+	"フィルタ '%s' に一致するCanaryが見つかりませんでした":      "No canaries matched the filter '%s'",
+	"ℹ️  全てのCanaryが既に停止しています\n":             "ℹ️  All canaries are already stopped\n",
+	"⚠️  以下のCanaryは現在の状態では無効化できません:\n":      "⚠️  The following canaries cannot be disabled in their current state:\n",
+	"続行しますか？":                               "Continue?",
+	"キャンセルされました":                            "Cancelled",
+	"\nℹ️  既に停止中: %d個\n":                    "\nℹ️  Already stopped: %d\n",
+	"✅ 無効化成功: %d個\n":                        "✅ Disabled: %d\n",
+	"❌ 無効化失敗: %d個\n":                        "❌ Failed to disable: %d\n",
+	"一部のCanaryの無効化に失敗しました":                  "Failed to disable some canaries",
+	"Canaryが見つかりませんでした":                     "No canaries found",
+	"ℹ️  全てのCanaryが既に停止しています (%d個)\n":       "ℹ️  All canaries are already stopped (%d)\n",
+	"本当に続行しますか？":                            "Do you really want to continue?",
+	"\n--- 実行結果 ---\n":                      "\n--- Results ---\n",
+	"ℹ️  既に停止中: %d個\n":                      "ℹ️  Already stopped: %d\n",
+	"⚠️  状態により対象外: %d個\n":                   "⚠️  Skipped due to state: %d\n",
+	"以下の%d個のCanaryを無効化します:":                 "The following %d canaries will be disabled:",
+	"🔴 警告: 全てのCanaryが停止すると、監視が行われなくなります。\n": "🔴 Warning: once all canaries are stopped, nothing will be monitored.\n",
+	"⚠️  以下の%d個のCanaryを無効化します:":             "⚠️  The following %d canaries will be disabled:",
+
+	// internal/service/canary/enable.go
+	"ℹ️  %s は既に実行中です\n":                "ℹ️  %s is already running\n",
+	"Canary '%s' は現在の状態(%s)では有効化できません": "Canary '%s' cannot be enabled in its current state (%s)",
+	"✅ %s を有効化しました\n":                  "✅ Enabled %s\n",
+	"ℹ️  全てのCanaryが既に実行中です\n":          "ℹ️  All canaries are already running\n",
+	"⚠️  以下のCanaryは現在の状態では有効化できません:\n": "⚠️  The following canaries cannot be enabled in their current state:\n",
+	"\nℹ️  既に実行中: %d個\n":               "\nℹ️  Already running: %d\n",
+	"✅ 有効化成功: %d個\n":                   "✅ Enabled: %d\n",
+	"❌ 有効化失敗: %d個\n":                   "❌ Failed to enable: %d\n",
+	"一部のCanaryの有効化に失敗しました":             "Failed to enable some canaries",
+	"ℹ️  全てのCanaryが既に実行中です (%d個)\n":    "ℹ️  All canaries are already running (%d)\n",
+	"ℹ️  既に実行中: %d個\n":                 "ℹ️  Already running: %d\n",
+	"以下の%d個のCanaryを有効化します:":            "The following %d canaries will be enabled:",
+
+	// internal/service/canary/ls.go
+	"Canary一覧":           "Canaries",
+	"canary一覧の取得に失敗: %w": "Failed to list canaries: %w",
+	"名前":                 "Name",
+	"状態":                 "State",
+	"スケジュール":             "Schedule",
+	"成功率":                "Success rate",
+	"最終実行":               "Last run",
+	"最終結果":               "Last result",
+	"[実行中]":              "[RUNNING]",
+	"[停止中]":              "[STOPPED]",
+	"[エラー]":              "[ERROR]",
+	"[開始中]":              "[STARTING]",
+	"なし":                 "None",
+	"定期: ":               "Recurring: ",
+	"未実行":                "Never run",
+	"[成功]":               "[PASSED]",
+	"[失敗]":               "[FAILED]",
+
+	// internal/service/canary/run.go
+	"Canary '%s' を実行中...\n":               "Running canary '%s'...\n",
+	"canary '%s' の実行に失敗: %w":              "Failed to run canary '%s': %w",
+	"✓ Canary '%s' を開始しました\n":             "✓ Started canary '%s'\n",
+	"Canary '%s' を実行中（ドライランモード）...\n":     "Running canary '%s' (dry-run mode)...\n",
+	"フィルターが指定されていません":                     "No filter specified",
+	"フィルター '%s' に一致するCanaryが見つかりませんでした\n": "No canaries matched the filter '%s'\n",
+	"実行":                     "run",
+	"ドライラン実行":                "dry-run",
+	"\n合計: %d個のCanary\n":     "\nTotal: %d canaries\n",
+	"%d個のCanaryを%sしますか？":     "Proceed to %[2]s %[1]d canaries?",
+	"キャンセルしました":              "Cancelled",
+	"\n実行中...\n":             "\nRunning...\n",
+	"\n=== 実行結果 ===\n":       "\n=== Results ===\n",
+	"成功: %d個\n":              "Succeeded: %d\n",
+	"失敗: %d個\n":              "Failed: %d\n",
+	"\n失敗詳細:\n":              "\nFailure details:\n",
+	"%d個のCanaryの実行に失敗しました":   "Failed to run %d canaries",
+	"全てのCanaryの実行に成功しました！\n": "All canaries ran successfully!\n",
+	"\n以下のCanaryを%sします:":     "\nThe following canaries will %s:",
+
+	// internal/service/cfn/cleanup.go
+	"削除対象のスタックが見つかりませんでした":               "No stacks found to delete",
+	"🔍 削除対象のスタック:":                       "🔍 Stacks to delete:",
+	"\n合計 %d 個のスタックが削除されます\n":            "\n%d stacks will be deleted in total\n",
+	"削除をキャンセルしました":                       "Deletion cancelled",
+	"\n削除を開始します...":                      "\nStarting deletion...",
+	"\n✅ %d 個のスタックの削除リクエストを送信しました\n":     "\n✅ Sent delete requests for %d stacks\n",
+	"⚠️  %d 個のスタックはスキップされました\n":          "⚠️  %d stacks were skipped\n",
+	"スタック %s を削除中...":                    "Deleting stack %s...",
+	"\n⚠️  スタック %s は削除保護が有効です。スキップします\n": "\n⚠️  Stack %s has termination protection enabled. Skipping\n",
+	"削除保護が有効です":                          "termination protection is enabled",
+	"\n❌ スタック %s の削除に失敗しました: %v\n":       "\n❌ Failed to delete stack %s: %v\n",
+	"スタック一覧の取得に失敗しました: %w":               "Failed to list stacks: %w",
+	"\n本当に削除しますか？":                       "\nDo you really want to delete them?",
+
+	// internal/service/cfn/common.go
+	"🔍 スタック '%s' からリソースを検索中...\n":      "🔍 Searching resources in stack '%s'...\n",
+	"cloudFormationスタックのリソース取得に失敗: %w": "Failed to get CloudFormation stack resources: %w",
+	"スタック '%s' にリソースが見つかりませんでした":       "No resources found in stack '%s'",
+	"🔍 検出されたS3バケット: %s\n":              "🔍 Detected S3 buckets: %s\n",
+	"🔍 検出されたECRリポジトリ: %s\n":            "🔍 Detected ECR repositories: %s\n",
+	"🔍 検出されたロググループ: %s\n":              "🔍 Detected log groups: %s\n",
+	"📋 検出されたリソース:":                     "📋 Detected resources:",
+	"  EC2インスタンス:":                     "  EC2 instances:",
+	"  RDSインスタンス:":                     "  RDS instances:",
+	"  Aurora DBクラスター:":                "  Aurora DB clusters:",
+	"  ECSサービス:":                       "  ECS services:",
+	"  操作可能なリソースは見つかりませんでした":           "  No operable resources found",
+
+	// internal/service/cfn/deploy.go
+	"テンプレートファイルが見つかりません: %s":          "Template file not found: %s",
+	"🚀 CloudFormationスタックをデプロイ中...\n": "🚀 Deploying CloudFormation stack...\n",
+	"   スタック名: %s\n":                  "   Stack name: %s\n",
+	"   テンプレート: %s\n":                 "   Template: %s\n",
+	"\n📋 エラーの詳細:\n\n":                 "\n📋 Error details:\n\n",
+	"⚠️  イベント情報の取得に失敗しました: %v\n":      "⚠️  Failed to get event information: %v\n",
+	"デプロイに失敗しました: %w":                 "Deployment failed: %w",
+	"\n✅ スタックは最新の状態です（変更なし）\n":        "\n✅ The stack is up to date (no changes)\n",
+	"\n✅ Change Setの作成が完了しました\n":      "\n✅ Change set created\n",
+	"\n✅ デプロイが完了しました\n":               "\n✅ Deployment completed\n",
+	"AWS設定の読み込みに失敗: %w":               "Failed to load AWS config: %w",
+	"スタックイベントの取得に失敗: %w":              "Failed to get stack events: %w",
+	"⚠️  失敗イベントが見つかりませんでした\n":         "⚠️  No failure events found\n",
+	"📍 リソース: %s\n":                    "📍 Resource: %s\n",
+	"⏰ 時刻: %s\n":                      "⏰ Time: %s\n",
+	"❌ ステータス: %s\n":                   "❌ Status: %s\n",
+	"💬 理由:\n":                         "💬 Reason:\n",
+	"パラメータファイルは.json形式である必要があります: %s": "The parameter file must be in .json format: %s",
+	"パラメータファイルが見つかりません: %s":           "Parameter file not found: %s",
+	"パラメータファイルの読み込みに失敗しました: %w":       "Failed to read the parameter file: %w",
+	"パラメータファイルのJSON解析に失敗しました: %w":     "Failed to parse the parameter file as JSON: %w",
+
+	// internal/service/cfn/drift.go
+	"ドリフトあり": "Drifted",
+	"同期中":    "In sync",
+	"未確認":    "Not checked",
+	"対象のスタックが見つかりませんでした":                 "No target stacks found",
+	"🔍 ドリフト検出を実行するスタック:\n":               "🔍 Stacks to run drift detection on:\n",
+	"\n合計 %d 個のスタックでドリフト検出を実行します\n":      "\nDrift detection will run on %d stacks in total\n",
+	"\nドリフト検出を開始します...":                  "\nStarting drift detection...",
+	"スタック %s のドリフト検出を開始中...":             "Starting drift detection for stack %s...",
+	"\n❌ スタック %s のドリフト検出開始に失敗しました: %v\n": "\n❌ Failed to start drift detection for stack %s: %v\n",
+	" ✅ (検出ID: %s)\n": " ✅ (detection ID: %s)\n",
+	"\n✅ %d 個のスタックでドリフト検出を開始しました\n":                  "\n✅ Started drift detection on %d stacks\n",
+	"ℹ️  検出結果は 'awstk cfn drift-status' コマンドで確認できます": "ℹ️  Check the results with the 'awstk cfn drift-status' command",
+	"🔍 スタックのドリフト状態を確認中...":                           "🔍 Checking stack drift status...",
+	" (最終チェック: %s)":                " (last checked: %s)",
+	"CloudFormationスタックのドリフト状態":    "CloudFormation stack drift status",
+	"❌ スタック %s の情報取得に失敗しました: %v\n": "❌ Failed to get information for stack %s: %v\n",
+	"スタック名":                 "Stack name",
+	"ドリフト状態":                "Drift status",
+	"最終チェック":                "Last checked",
+	"\n📊 サマリー:\n":           "\n📊 Summary:\n",
+	"  - 合計: %d スタック\n":     "  - Total: %d stacks\n",
+	"  - ドリフトあり: %d スタック\n": "  - Drifted: %d stacks\n",
+	"  - 未確認: %d スタック\n":    "  - Not checked: %d stacks\n",
+	"\nℹ️  未確認のスタックがあります。'awstk cfn drift-detect' でドリフト検出を実行してください": "\nℹ️  Some stacks have not been checked. Run drift detection with 'awstk cfn drift-detect'",
+	"⚠️  スタック %s が見つかりません: %v\n":                                    "⚠️  Stack %s not found: %v\n",
+	"⚠️  スタック %s はドリフト検出できない状態です (Status: %s)\n":                    "⚠️  Stack %s is in a state that does not allow drift detection (Status: %s)\n",
+
+	// internal/service/cfn/ls.go
+	"スタック一覧取得エラー: %w": "Failed to list stacks: %w",
+
+	// internal/service/cfn/orphans.go
+	"🔍 削除済みスタック %d件のリソースを確認中...\n":     "🔍 Checking resources of %d deleted stacks...\n",
+	"スタック '%s' のリソース取得エラー: %w":         "Failed to get resources of stack '%s': %w",
+	"📦 スタック '%s' に残されたリソース: %s (%s)\n": "📦 Resource left behind by stack '%s': %s (%s)\n",
+
+	// internal/service/cfn/protect.go
+	"有効":  "enabled",
+	"無効":  "disabled",
+	"有効化": "enable",
+	"無効化": "disable",
+	"🔍 削除保護を%sするスタック:\n":                   "🔍 Stacks to %s termination protection for:\n",
+	"  - %s (現在の削除保護: %s)\n":               "  - %s (current termination protection: %s)\n",
+	"\n合計 %d 個のスタックの削除保護を%sします\n":          "\nTermination protection will be updated (%[2]s) on %[1]d stacks in total\n",
+	"\n削除保護の%sを開始します...\n":                 "\nStarting to %s termination protection...\n",
+	"⏭️  スタック %s は既に削除保護が%s状態です。スキップします\n": "⏭️  Stack %s already has termination protection %s. Skipping\n",
+	"スタック %s の削除保護を%s中...":                 "Updating termination protection of stack %s (%s)...",
+	"\n❌ スタック %s の削除保護更新に失敗しました: %v\n":     "\n❌ Failed to update termination protection of stack %s: %v\n",
+	"\n✅ %d 個のスタックの削除保護を%sしました\n":          "\n✅ Updated termination protection (%[2]s) on %[1]d stacks\n",
+	"ℹ️  %d 個のスタックは既に希望の状態のためスキップされました\n":  "ℹ️  %d stacks were skipped because they are already in the desired state\n",
+
+	// internal/service/cfn/resources.go
+	"スタック '%s' にEC2インスタンスが見つかりませんでした":              "No EC2 instances found in stack '%s'",
+	"🔍 検出されたEC2インスタンス: %s\n":                       "🔍 Detected EC2 instances: %s\n",
+	"スタック '%s' にRDSインスタンスが見つかりませんでした":              "No RDS instances found in stack '%s'",
+	"🔍 検出されたRDSインスタンス: %s\n":                       "🔍 Detected RDS instances: %s\n",
+	"スタック '%s' にAuroraクラスターが見つかりませんでした":            "No Aurora clusters found in stack '%s'",
+	"🔍 検出されたAuroraクラスター: %s\n":                     "🔍 Detected Aurora clusters: %s\n",
+	"スタック '%s' にECSサービスが見つかりませんでした":                "No ECS services found in stack '%s'",
+	"🔍 検出されたECSクラスター: %s\n":                        "🔍 Detected ECS clusters: %s\n",
+	"スタック '%s' からECSクラスターを検出できませんでした":              "Could not detect an ECS cluster from stack '%s'",
+	"🔍 スタック '%s' からECSサービスを検索中...\n":               "🔍 Searching ECS services in stack '%s'...\n",
+	"スタック '%s' からECSサービスを検出できませんでした":               "Could not detect ECS services from stack '%s'",
+	"🔍 検出されたECSサービス: %s/%s\n":                      "🔍 Detected ECS service: %s/%s\n",
+	"⚠️ 警告: サービス %s のクラスター %s がスタック内で見つかりませんでした\n": "⚠️ Warning: cluster %[2]s of service %[1]s was not found in the stack\n",
+	"スタック '%s' から有効なECSサービスを検出できませんでした":            "Could not detect a valid ECS service from stack '%s'",
+	"スタック '%s' にCloudFrontディストリビューションが見つかりませんでした":  "No CloudFront distributions found in stack '%s'",
+	"🔍 検出されたCloudFrontディストリビューション: %s\n":           "🔍 Detected CloudFront distributions: %s\n",
+
+	// internal/service/cfn/start.go
+	"❌ EC2インスタンス (%s) の起動中にエラーが発生しました: %v\n":      "❌ Error while starting EC2 instance (%s): %v\n",
+	"❌ RDSインスタンス (%s) の起動中にエラーが発生しました: %v\n":      "❌ Error while starting RDS instance (%s): %v\n",
+	"❌ Aurora DBクラスター (%s) の起動中にエラーが発生しました: %v\n": "❌ Error while starting Aurora DB cluster (%s): %v\n",
+	"🚀 ECSサービス (%s/%s) を起動します...\n":               "🚀 Starting ECS service (%s/%s)...\n",
+	"❌ ECSサービス (%s/%s) の起動中にエラーが発生しました: %v\n":     "❌ Error while starting ECS service (%s/%s): %v\n",
+	"✅ ECSサービス (%s/%s) の起動を開始しました\n":              "✅ Started ECS service (%s/%s)\n",
+	"一部のリソースの起動中にエラーが発生しました":                      "Errors occurred while starting some resources",
+	"EC2インスタンス起動エラー: %w":                          "Failed to start EC2 instance: %w",
+	"RDSインスタンス起動エラー: %w":                          "Failed to start RDS instance: %w",
+	"auroraクラスター起動エラー: %w":                        "Failed to start Aurora cluster: %w",
+	"スケーラブルターゲット登録でエラー: %w":                       "Failed to register the scalable target: %w",
+
+	// internal/service/cfn/stop.go
+	"❌ EC2インスタンス (%s) の停止中にエラーが発生しました: %v\n":      "❌ Error while stopping EC2 instance (%s): %v\n",
+	"🛑 RDSインスタンス (%s) を停止します...\n":                "🛑 Stopping RDS instance (%s)...\n",
+	"❌ RDSインスタンス (%s) の停止中にエラーが発生しました: %v\n":      "❌ Error while stopping RDS instance (%s): %v\n",
+	"❌ Aurora DBクラスター (%s) の停止中にエラーが発生しました: %v\n": "❌ Error while stopping Aurora DB cluster (%s): %v\n",
+	"🛑 ECSサービス (%s/%s) を停止します...\n":               "🛑 Stopping ECS service (%s/%s)...\n",
+	"❌ ECSサービス (%s/%s) の停止中にエラーが発生しました: %v\n":     "❌ Error while stopping ECS service (%s/%s): %v\n",
+	"✅ ECSサービス (%s/%s) の停止を開始しました\n":              "✅ Stopping ECS service (%s/%s) has begun\n",
+	"一部のリソースの停止中にエラーが発生しました":                      "Errors occurred while stopping some resources",
+	"EC2インスタンス停止エラー: %w":                          "Failed to stop EC2 instance: %w",
+	"RDSインスタンス停止エラー: %w":                          "Failed to stop RDS instance: %w",
+	"auroraクラスター停止エラー: %w":                        "Failed to stop Aurora cluster: %w",
+
+	// internal/service/cleanup/all.go
+	"CloudFormationスタックID: %s\n": "CloudFormation stack ID: %s\n",
+	"スタックに関連するリソースの削除を開始します...":  "Starting deletion of resources related to the stack...",
+	"スタックからのリソース取得エラー: %w":       "Failed to get resources from the stack: %w",
+	"CloudFormationスタック: %s\n":   "CloudFormation stack: %s\n",
+	"複数アカウントでのクリーンアップは再開できません":   "Cleanup across multiple accounts cannot be resumed",
+	"中断した実行を再開する場合は、検索キーワード・タグ条件・経過時間条件・スタックを指定できません（前回検出した対象を削除します）": "When resuming an interrupted run, a search keyword, tag conditions, age conditions or a stack cannot be specified (the targets detected in the previous run are deleted)",
+	"中断した実行を再開する場合は、プランを作成できません":                                      "A plan cannot be created when resuming an interrupted run",
+	"❌ スタック '%s' は存在しません。\n":                                          "❌ Stack '%s' does not exist.\n",
+	"ℹ️ 削除済みスタックの履歴が90日以内にある場合、--stack-id に削除済みスタックのID(ARN)を指定してください": "ℹ️ If the deleted stack's history is within 90 days, specify the deleted stack ID (ARN) with --stack-id",
+	"スタック '%s' が見つかりません":                                              "Stack '%s' not found",
+	"検索文字列: %s\n": "Search string: %s\n",
+	"❌ %s一覧取得中にエラーが発生しました: %v\n": "❌ Error while listing %s: %v\n",
+	"%sの削除を開始...\n":              "Deleting %s...\n",
+	"  削除対象の%sはありません\n":          "  No %s to delete\n",
+	"🔍 検出された%s: %s\n":            "🔍 Detected %s: %s\n",
+	"プランの作成に対応していない種類が対象に含まれています: %s（プランを作成できる種類: %s）": "The targeted types include types that do not support plans: %s (types that support plans: %s)",
+	"%sのクライアントが指定されていません":                              "No client specified for %s",
+	"検索文字列に一致するリソースの削除を開始します...":                       "Starting deletion of resources matching the search string...",
+	"クリーンアップが中断されました: %w":                              "Cleanup was interrupted: %w",
+	"削除できなかったリソースがあります（削除失敗 %d件、参照元を削除できず未実行 %d件）":     "Some resources were not deleted (%d failed, %d not run because a referencing resource could not be deleted)",
+	"❌ %s のクリーンアップでエラー: %v\n":                          "❌ Error during cleanup of %s: %v\n",
+	"%d個のアカウントでクリーンアップに失敗しました: %s":                     "Cleanup failed in %d accounts: %s",
+	"複数アカウントでのクリーンアップではプランを作成できません":                    "A plan cannot be created for a multi-account cleanup",
+	"スタックIDはアカウント固有のため、複数アカウントでのクリーンアップでは指定できません":      "A stack ID is account-specific and cannot be used for a multi-account cleanup",
+	"cloudFormationクライアントが指定されていません":                   "No CloudFormation client specified",
+	"検索キーワード、スタック名、スタックIDは同時に指定できません。いずれか一つのみ指定してください": "A search keyword, a stack name and a stack ID cannot be combined. Specify only one of them",
+	"タグ条件: %s\n": "Tag conditions: %s\n",
+	"検索キーワード、タグ条件、スタック名、またはスタックIDのいずれかを指定してください": "Specify a search keyword, tag conditions, a stack name or a stack ID",
+	"タグ条件はスタック名・スタックIDと同時に指定できません":               "Tag conditions cannot be combined with a stack name or stack ID",
+	"経過時間条件はスタック名・スタックIDと同時に指定できません":             "Age conditions cannot be combined with a stack name or stack ID",
+	"\n⚠️  以下の%d件のリソースを削除します:":                   "\n⚠️  The following %d resources will be deleted:",
+
+	// internal/service/cleanup/registry.go
+	"DynamoDBテーブル": "DynamoDB tables",
+	"SQSキュー":       "SQS queues",
+	"SNSトピック":      "SNS topics",
+	"Lambda関数":     "Lambda functions",
+	"KMSキー":        "KMS keys",
+	"EBSスナップショット":  "EBS snapshots",
+	"不明なリソースの種類です: %s（指定できる値: %s）": "Unknown resource type: %s (valid values: %s)",
+	"対象にするリソースの種類がありません":           "No resource types to target",
+	"ターゲットグループ":                    "target groups",
+	"ネットワークインターフェース":               "network interfaces",
+	"セキュリティグループ":                   "security groups",
+
+	// internal/service/cleanup/orphans.go
+	"削除できる残されたリソースはありません":              "There are no left-behind resources that can be deleted",
+	"スタック '%s' に残された%s %s は既に存在しません\n": "%[2]s %[3]s left behind by stack '%[1]s' no longer exists\n",
+	"削除済みスタックに残されたリソース":                "Resources left behind by deleted stacks",
+	"削除日時":       "Deleted at",
+	"論理ID":       "Logical ID",
+	"リソースタイプ":    "Resource type",
+	"クリーンアップの種類": "Cleanup type",
+	"対象外（手動で確認してください）":             "Not supported (check manually)",
+	"削除済みスタックに残されたリソースは見つかりませんでした": "No resources left behind by deleted stacks were found",
+
+	// internal/service/cleanup/graph.go
+	"⚠️  %sの依存関係を取得できなかったため、依存関係を考慮せずに削除します: %v\n": "⚠️  Could not get the dependencies of %s, deleting them without considering dependencies: %v\n",
+	"🔗 %s %s を削除してから %s %s を削除します\n":               "🔗 Deleting %[4]s (%[3]s) after %[2]s (%[1]s)\n",
+	"⚠️  循環参照があるため、%d件のリソースは依存関係を考慮せずに最後に削除します\n":  "⚠️  Because of circular references, %d resources are deleted last without considering dependencies\n",
+	"\n📶 削除順序 %d/%d（%d件）\n":                        "\n📶 Deletion step %d/%d (%d resources)\n",
+	"先に削除する必要がある%s %s を削除できませんでした":                 "%[2]s (%[1]s), which must be deleted first, could not be deleted",
+	"⛔ %s %s を削除しません: %v\n":                        "⛔ Not deleting %[2]s (%[1]s): %[3]v\n",
+
+	// internal/service/dynamodb/cleanup.go
+	"dynamoDBテーブル一覧取得エラー: %w":       "Failed to list DynamoDB tables: %w",
+	"dynamoDBテーブルの情報取得エラー (%s): %w": "Failed to describe DynamoDB table (%s): %w",
+	"🔍 検出されたDynamoDBテーブル: %s\n":     "🔍 Detected DynamoDB table: %s\n",
+
+	// internal/service/sqs/cleanup.go
+	"sqsキュー一覧取得エラー: %w":       "Failed to list SQS queues: %w",
+	"sqsキューの属性取得エラー (%s): %w": "Failed to get SQS queue attributes (%s): %w",
+	"🔍 検出されたSQSキュー: %s\n":     "🔍 Detected SQS queue: %s\n",
+
+	// internal/service/sns/cleanup.go
+	"snsトピック一覧取得エラー: %w":   "Failed to list SNS topics: %w",
+	"🔍 検出されたSNSトピック: %s\n": "🔍 Detected SNS topic: %s\n",
+	"トピック %s が見つかりません":     "Topic %s not found",
+
+	// internal/service/lambda/cleanup.go
+	"lambda関数一覧取得エラー: %w":   "Failed to list Lambda functions: %w",
+	"🔍 検出されたLambda関数: %s\n": "🔍 Detected Lambda function: %s\n",
+
+	// internal/service/secretsmanager/cleanup.go
+	"🔍 検出されたシークレット: %s\n": "🔍 Detected secret: %s\n",
+
+	// internal/service/ssm/cleanup.go
+	"ssmパラメータ一覧取得エラー: %w":   "Failed to list SSM parameters: %w",
+	"🔍 検出されたSSMパラメータ: %s\n": "🔍 Detected SSM parameter: %s\n",
+
+	// internal/service/kms/cleanup.go
+	"kmsキー一覧取得エラー: %w":                     "Failed to list KMS keys: %w",
+	"kmsキーの情報取得エラー (%s): %w":               "Failed to describe KMS key (%s): %w",
+	"🔍 検出されたKMSキー: %s\n":                   "🔍 Detected KMS key: %s\n",
+	"kmsエイリアス一覧取得エラー: %w":                  "Failed to list KMS aliases: %w",
+	"ℹ️ KMSキーは即時に削除できないため、%d日後の削除を予約します\n": "ℹ️ KMS keys cannot be deleted immediately, so their deletion is scheduled in %d days\n",
+
+	// internal/service/ec2/snapshot.go
+	"ebsスナップショット一覧取得エラー: %w":   "Failed to list EBS snapshots: %w",
+	"🔍 検出されたEBSスナップショット: %s\n": "🔍 Detected EBS snapshot: %s\n",
+
+	// internal/service/ec2/network.go
+	"セキュリティグループ一覧取得エラー: %w":       "Failed to list security groups: %w",
+	"🔍 検出されたセキュリティグループ: %s\n":     "🔍 Detected security group: %s\n",
+	"ネットワークインターフェース一覧取得エラー: %w":   "Failed to list network interfaces: %w",
+	"🔍 検出されたネットワークインターフェース: %s\n": "🔍 Detected network interface: %s\n",
+
+	// internal/service/elb/cleanup.go
+	"🔍 検出されたロードバランサー: %s\n":  "🔍 Detected load balancer: %s\n",
+	"ロードバランサー %s が見つかりません":   "Load balancer %s not found",
+	"ターゲットグループ一覧取得エラー: %w":   "Failed to list target groups: %w",
+	"🔍 検出されたターゲットグループ: %s\n": "🔍 Detected target group: %s\n",
+	"ターゲットグループ %s が見つかりません":  "Target group %s not found",
+
+	// internal/service/cloudfront/invalidate.go
+	"   現在のステータス: %s\n": "   Current status: %s\n",
+	"⚠️  待機を中断しました（最終確認時のステータス: %s）。無効化自体はAWS側で継続されます\n": "⚠️  Stopped waiting (last status: %s). The invalidation continues on the AWS side\n",
+	"   無効化ID: %s\n": "   Invalidation ID: %s\n",
+	"🚀 CloudFrontディストリビューション (%s) のキャッシュを無効化します...\n": "🚀 Invalidating the cache of CloudFront distribution (%s)...\n",
+	"   対象パス: %v\n":                                                    "   Paths: %v\n",
+	"キャッシュ無効化エラー: %w":                                                  "Cache invalidation error: %w",
+	"✅ キャッシュ無効化を開始しました (ID: %s)\n":                                     "✅ Cache invalidation started (ID: %s)\n",
+	"⏳ 無効化の完了を待機しています...":                                              "⏳ Waiting for the invalidation to complete...",
+	"無効化待機エラー: %w":                                                     "Error while waiting for the invalidation: %w",
+	"✅ キャッシュ無効化が完了しました":                                                "✅ Cache invalidation completed",
+	"テナント選択エラー: %w":                                                    "Tenant selection error: %w",
+	"テナントID、--all、または --list オプションを指定してください":                           "Specify a tenant ID, --all, or --list",
+	"🚀 テナント (%s) のキャッシュを無効化します...\n":                                   "🚀 Invalidating the cache of tenant (%s)...\n",
+	"✅ テナント '%s' のキャッシュ無効化を開始しました\n":                                   "✅ Cache invalidation started for tenant '%s'\n",
+	"🚀 CloudFrontディストリビューション (%s) の全テナントのキャッシュを無効化します...\n":            "🚀 Invalidating the cache of all tenants of CloudFront distribution (%s)...\n",
+	"全テナントキャッシュ無効化エラー: %w":                                             "All-tenant cache invalidation error: %w",
+	"✅ 全テナントのキャッシュ無効化を開始しました":                                          "✅ Cache invalidation started for all tenants",
+	"ディストリビューションID またはスタック名 (-S) を指定してください":                            "Specify a distribution ID or a stack name (-S)",
+	"cloudFormationスタックからディストリビューションの取得に失敗: %w":                        "Failed to get the distribution from the CloudFormation stack: %w",
+	"✅ CloudFormationスタック '%s' からCloudFrontディストリビューション '%s' を検出しました\n": "✅ Detected CloudFront distribution '%[2]s' from CloudFormation stack '%[1]s'\n",
+
+	// internal/service/cloudfront/select.go
+	"%s (詳細情報の取得に失敗)": "%s (failed to get details)",
+	"複数のCloudFrontディストリビューションが見つかりました。選択してください:": "Multiple CloudFront distributions were found. Select one:",
+
+	// internal/service/cloudfront/tenant/invalidate.go
+	"   無効化ID: %s (待機機能は未実装)\n": "   Invalidation ID: %s (waiting is not implemented)\n",
+	"テナント一覧の取得に失敗: %w":          "Failed to list tenants: %w",
+	"テナントが見つかりませんでした":           "No tenants found",
+	"   対象テナント数: %d\n":          "   Target tenants: %d\n",
+	"   テナント %s を無効化中...\n":     "   Invalidating tenant %s...\n",
+	"テナント %s の無効化に失敗: %w":       "Failed to invalidate tenant %s: %w",
+
+	// internal/service/cloudfront/tenant/select.go
+	"テナントを選択してください:": "Select a tenant:",
+
+	// internal/service/common/ls.go
+	"不明":               "Unknown",
+	"%s一覧":             "%s",
+	"%s%s一覧":           "%s%s",
+	"❌ %s一覧取得でエラー: %w": "❌ Error while listing %s: %w",
+	"%sが見つかりませんでした":    "No %s found",
+	"該当する%sはありませんでした\n": "No matching %s found\n",
+	"\n合計: %d個の%s\n":    "\nTotal: %d %s\n",
+	"%s: (全%d件)\n":      "%s: (%d total)\n",
+	"%sが見つかりませんでした\n":   "No %s found\n",
+	"リソースが見つかりませんでした":   "No resources found",
+	"\n合計: %d件\n":       "\nTotal: %d\n",
+
+	// internal/service/common/output.go
+	"%s 未対応の出力形式です: %s（指定可能: %s）": "%s Unsupported output format: %s (available: %s)",
+	"%s 出力の書き込みに失敗: %v\n":         "%s Failed to write output: %v\n",
+
+	// internal/service/common/parallel.go
+	"\n【%s】\n": "\n[%s]\n",
+	"\n%s %s中断: 成功 %d個, 失敗 %d個, 未実行 %d個\n": "\n%s %s interrupted: %d succeeded, %d failed, %d not run\n",
+	"\n%s %s完了: 成功 %d個, 失敗 %d個\n":          "\n%s %s completed: %d succeeded, %d failed\n",
+	"                    クリーンアップ サマリー":     "                    Cleanup summary",
+	"  ✅ 削除成功: %d件\n":                      "  ✅ Deleted: %d\n",
+	"  ❌ 削除失敗: %d件\n":                      "  ❌ Failed to delete: %d\n",
+	"  ⚠️  中断により未実行: %d件\n":                "  ⚠️  Not run due to interruption: %d\n",
+	"  ⛔ 参照元のリソースを削除できなかったため未実行: %d件\n":    "  ⛔ Not run because a referencing resource could not be deleted: %d\n",
+	"  🛡️  ガードレールにより未実行: %d件\n":            "  🛡️  Not run because of the guardrail: %d\n",
+	"合計: 削除成功 %d件 / 削除失敗 %d件 / 未実行 %d件\n":  "Total: %d deleted / %d failed / %d not run\n",
+	"合計: 削除成功 %d件 / 削除失敗 %d件\n":            "Total: %d deleted / %d failed\n",
+	"🚀 %d個の%sを最大%d並列で削除します...\n\n":         "🚀 Deleting %d %s with up to %d in parallel...\n\n",
+	"%s %s を削除中...\n":                      "Deleting %[2]s (%[1]s)...\n",
+	"❌ %s %s の削除に失敗しました: %v\n":             "❌ Failed to delete %[2]s (%[1]s): %[3]v\n",
+	"✅ %s %s を削除しました\n":                    "✅ Deleted %[2]s (%[1]s)\n",
+
+	// internal/service/common/retry.go
+	"一時的なエラー": "a transient error",
+	"スロットリング": "throttling",
+	"⏳ %s: %sのため %.1f秒後に再試行します (%d/%d)\n":      "⏳ %[1]s: retrying in %[3].1f seconds due to %[2]s (%[4]d/%[5]d)\n",
+	"⏳ %s: 依存するリソースが解放されるまで待機しています（最大%s）...\n": "⏳ %s: waiting for dependent resources to be released (up to %s)...\n",
+	"%s: 前回の削除が完了していたため成功として扱います\n":            "%s: treated as deleted because the previous attempt had already completed\n",
+
+	// internal/service/common/targets.go
+	"アカウント":                    "Account",
+	"%s %s の一覧取得でエラー: %v\n":    "%s Error while listing %s: %v\n",
+	"❌ %d個の対象で一覧取得に失敗しました: %s": "❌ Failed to list %d targets: %s",
+
+	// internal/service/ec2/ls.go
+	"EC2インスタンス":                "EC2 instances",
+	"EC2インスタンス一覧":              "EC2 instances",
+	"EC2インスタンスが見つかりませんでした":     "No EC2 instances found",
+	"EC2インスタンス一覧の取得に失敗: %w":    "Failed to list EC2 instances: %w",
+	"（名前なし）":                   "(no name)",
+	"インスタンスID":                 "Instance ID",
+	"インスタンス名":                  "Instance name",
+	"EC2インスタンス一覧を取得中...":       "Fetching EC2 instances...",
+	"❌ EC2インスタンス一覧の取得に失敗: %w":  "❌ Failed to list EC2 instances: %w",
+	"❌ 利用可能なEC2インスタンスが見つかりません": "❌ No available EC2 instances found",
+	"操作するインスタンスを選択してください":      "Select the instance to operate on",
+
+	// internal/service/ecr/cleanup.go
+	"ecrリポジトリ一覧取得エラー: %w":             "Failed to list ECR repositories: %w",
+	"ECRリポジトリ":                        "ECR repositories",
+	"🚀 %d個のリポジトリを最大%d並列で削除します...\n\n": "🚀 Deleting %d repositories with up to %d in parallel...\n\n",
+	"リポジトリ %s を削除中...\n":              "Deleting repository %s...\n",
+	"❌ リポジトリ %s の削除に失敗しました: %v\n":     "❌ Failed to delete repository %s: %v\n",
+	"✅ リポジトリ %s を削除しました\n":            "✅ Deleted repository %s\n",
+	"削除": "Deletion",
+	"❌ ECRリポジトリ一覧取得エラー: %w":                 "❌ Failed to list ECR repositories: %w",
+	"フィルター '%s' に一致するECRリポジトリが見つかりませんでした\n": "No ECR repositories matched the filter '%s'\n",
+	"❌ %d個のECRリポジトリの削除に失敗しました":              "❌ Failed to delete %d ECR repositories",
+	"✅ ECRリポジトリの削除が完了しました":                  "✅ ECR repository deletion completed",
+	"イメージ一覧取得エラー (%s): %w":                  "Failed to list images (%s): %w",
+
+	// internal/service/ecr/ls.go
+	"リポジトリ一覧取得エラー: %w":          "Failed to list repositories: %w",
+	"イメージ数取得エラー (%s): %w":       "Failed to get the image count (%s): %w",
+	"ライフサイクルポリシー確認エラー (%s): %w": "Failed to check the lifecycle policy (%s): %w",
+	"イメージ詳細取得エラー: %w":           "Failed to get image details: %w",
+	"    イメージ数: %d個\n":          "    Images: %d\n",
+	"    サイズ: %s\n":             "    Size: %s\n",
+	"    作成日: %s\n":             "    Created: %s\n",
+	"設定済み":                      "Configured",
+	"未設定":                       "Not configured",
+	"    ライフサイクルポリシー: %s\n":     "    Lifecycle policy: %s\n",
+	"❌ 空リポジトリチェックでエラー: %w":      "❌ Error while checking for empty repositories: %w",
+	"❌ ライフサイクルポリシーチェックでエラー: %w": "❌ Error while checking lifecycle policies: %w",
+	"ライフサイクルポリシー未設定の":           "no-lifecycle-policy ",
+	"リポジトリ":                     "repositories",
+	"該当するリポジトリはありませんでした":        "No matching repositories found",
+	"  - %s (詳細取得エラー: %v)\n":    "  - %s (failed to get details: %v)\n",
+	"\n合計: %d個のリポジトリ\n":         "\nTotal: %d repositories\n",
+	"%s %s の詳細取得エラー: %v\n":      "%s Failed to get details of %s: %v\n",
+	"リポジトリ名":                    "Repository name",
+	"イメージ数":                     "Images",
+	"サイズ(バイト)":                  "Size (bytes)",
+	"作成日":                       "Created",
+	"ライフサイクルポリシー":               "Lifecycle policy",
+
+	// internal/service/ecs/common.go
+	"サービス情報の取得に失敗しました: %w":                                     "Failed to get service information: %w",
+	"サービス '%s' が見つかりません":                                       "Service '%s' not found",
+	"🔍 🚀 Fargate (ECSサービス: %s) のDesiredCountを%d～%dに設定します...\n": "🔍 🚀 Setting the DesiredCount of Fargate (ECS service: %s) to %d-%d...\n",
+	"✅ Fargate (ECSサービス) のDesiredCountを%d～%dに設定しました。\n":        "✅ Set the DesiredCount of Fargate (ECS service) to %d-%d.\n",
+	"停止": "stop",
+	"起動": "start",
+	"⏳ サービスが%s状態になるまで待機しています...\n":                           "⏳ Waiting for the service to %s...\n",
+	"⚠️  サービスの%s待機を中断しました（経過時間: %s）。キャパシティの変更はAWS側で継続されます\n": "⚠️  Stopped waiting for the service to %s (elapsed: %s). The capacity change continues on the AWS side\n",
+	"サービスの%s待機が中断されました: %w":                                  "Waiting for the service to %s was interrupted: %w",
+	"⏱️ 経過時間: %s - 実行中タスク: %d / 希望タスク数: %d\n":                "⏱️ Elapsed: %s - running tasks: %d / desired tasks: %d\n",
+	"✅ サービスが完全に停止しました":                                       "✅ The service has fully stopped",
+	"✅ サービスが完全に起動しました":                                       "✅ The service has fully started",
+	"タイムアウト: %d秒経過しましたがサービスは目標状態に達していません":                    "Timeout: the service did not reach the target state after %d seconds",
+	"❌ -S(--stack)と-c(--cluster)/-s(--service)は同時に指定できません":   "❌ -S (--stack) cannot be used with -c (--cluster)/-s (--service)",
+	"❌ -c(--cluster)と-s(--service)は両方指定してください":               "❌ Specify both -c (--cluster) and -s (--service)",
+	"❌ CloudFormationスタックからECSサービス情報の取得に失敗: %w":              "❌ Failed to get ECS service information from the CloudFormation stack: %w",
+
+	// internal/service/ecs/exec.go
+	"🔍 実行中のタスクを検索中...": "🔍 Searching for running tasks...",
+	"タスク一覧取得エラー: %w":   "Failed to list tasks: %w",
+	"クラスター '%s' のサービス '%s' で実行中のタスクが見つかりませんでした": "No running tasks found for service '%[2]s' in cluster '%[1]s'",
+	"✅ 実行中のタスク '%s' を検出しました\n":                  "✅ Found running task '%s'\n",
+
+	// internal/service/ecs/redeploy.go
+	"🚀 ECSサービス '%s' を強制再デプロイします...\n":                      "🚀 Forcing a new deployment of ECS service '%s'...\n",
+	"サービスの強制再デプロイに失敗しました: %w":                              "Failed to force a new deployment of the service: %w",
+	"✅ 強制再デプロイを開始しました":                                     "✅ Forced deployment started",
+	"⏳ デプロイ完了を待機しています...":                                  "⏳ Waiting for the deployment to complete...",
+	"⚠️  デプロイ完了の待機を中断しました（経過時間: %s）。デプロイ自体はAWS側で継続されます\n":  "⚠️  Stopped waiting for the deployment (elapsed: %s). The deployment continues on the AWS side\n",
+	"デプロイ完了の待機が中断されました: %w":                                "Waiting for the deployment was interrupted: %w",
+	"プライマリデプロイメントが見つかりません":                                 "Primary deployment not found",
+	"⏱️ 経過時間: %s - デプロイ状況: %s - 実行中タスク: %d / 希望タスク数: %d\n": "⏱️ Elapsed: %s - rollout: %s - running tasks: %d / desired tasks: %d\n",
+	"✅ デプロイが完了しました":                                        "✅ Deployment completed",
+	"タイムアウト: %d秒経過しましたがデプロイは完了していません":                      "Timeout: the deployment did not complete after %d seconds",
+
+	// internal/service/ecs/run.go
+	"⏳ タスクの完了を待機中...": "⏳ Waiting for the task to finish...",
+	"⚠️  待機を中断しました（経過時間: %s - 最終確認時のタスク状態: %s）\n": "⚠️  Stopped waiting (elapsed: %s - last task status: %s)\n",
+	"   タスク '%s' は停止されていないため、必要に応じて手動で停止してください\n": "   Task '%s' has not been stopped; stop it manually if needed\n",
+	"タスクの完了待機が中断されました: %w":                        "Waiting for the task was interrupted: %w",
+	"タスク情報の取得に失敗しました: %w":                         "Failed to get task information: %w",
+	"タスク '%s' が見つかりません":                           "Task '%s' not found",
+	"⏱️ 経過時間: %s - タスク状態: %s\n":                   "⏱️ Elapsed: %s - task status: %s\n",
+	"コンテナ '%s' の終了コードが取得できませんでした":                 "Could not get the exit code of container '%s'",
+	"コンテナ '%s' がタスク内に見つかりません。利用可能なコンテナ: %s":       "Container '%s' not found in the task. Available containers: %s",
+	"タイムアウト: %d秒経過しましたがタスクは停止していません":              "Timeout: the task did not stop after %d seconds",
+	"🔍 指定されたタスク定義を使用します: ":                        "🔍 Using the specified task definition: ",
+	"🔍 サービスの情報を取得中...":                            "🔍 Fetching service information...",
+	"🔍 サービスのタスク定義を使用します: ":                        "🔍 Using the service's task definition: ",
+	"🔍 コンテナ '%s' で実行するコマンド: %s\n":                 "🔍 Command to run in container '%s': %s\n",
+	"🚀 タスクを実行中...":                                "🚀 Running the task...",
+	"タスクの実行に失敗しました: %w":                           "Failed to run the task: %w",
+	"タスクの実行に失敗しました: タスクが作成されませんでした":               "Failed to run the task: no task was created",
+	"✅ タスクが開始されました: ":                             "✅ Task started: ",
+
+	// internal/service/ecs/start.go
+	"🚀 サービスの起動を開始します...": "🚀 Starting the service...",
+	"❌ サービス起動監視エラー: %w":  "❌ Error while monitoring the service start: %w",
+
+	// internal/service/ecs/status.go
+	"ℹ️  Auto Scaling情報の取得に失敗しました（設定されていない可能性があります）: %v\n": "ℹ️  Failed to get Auto Scaling information (it may not be configured): %v\n",
+	"🔍 ECSサービス状態: %s/%s\n\n":  "🔍 ECS service status: %s/%s\n\n",
+	"📊 サービス情報:\n":             "📊 Service information:\n",
+	"  状態:           %s\n":    "  Status:          %s\n",
+	"  タスク定義:      %s\n":      "  Task definition: %s\n",
+	"  期待数:         %d\n":     "  Desired:         %d\n",
+	"  実行中:         %d\n":     "  Running:         %d\n",
+	"  起動中:         %d\n":     "  Pending:         %d\n",
+	"\n⚖️  Auto Scaling設定:\n": "\n⚖️  Auto Scaling settings:\n",
+	"  最小キャパシティ: %d\n":        "  Min capacity: %d\n",
+	"  最大キャパシティ: %d\n":        "  Max capacity: %d\n",
+	"\n📋 タスク詳細:\n":            "\n📋 Task details:\n",
+	"  実行中のタスクはありません":         "  No running tasks",
+	"  %d. タスクID: %s\n":       "  %d. Task ID: %s\n",
+	"     状態:     %s\n":       "     Status:  %s\n",
+	"     ヘルス:   %s\n":        "     Health:  %s\n",
+	"     作成日時: %s\n":         "     Created: %s\n",
+
+	// internal/service/ecs/stop.go
+	"🛑 サービスの停止を開始します...": "🛑 Stopping the service...",
+	"❌ サービス停止監視エラー: %w":  "❌ Error while monitoring the service stop: %w",
+
+	// internal/service/elb/delete.go
+	"ロードバランサー一覧取得エラー: %w":             "Failed to list load balancers: %w",
+	"ロードバランサー":                        "load balancers",
+	"%sタイプのロードバランサー":                  "%s load balancers",
+	"フィルター '%s' に一致する%sが見つかりませんでした\n": "No %[2]s matched the filter '%[1]s'\n",
+	"\n🎯 削除対象のロードバランサー（%d件）:\n":       "\n🎯 Load balancers to delete (%d):\n",
+	"削除保護状態の確認エラー: %w":                "Failed to check deletion protection: %w",
+	"🔒有効":                      "🔒enabled",
+	"%d. %s [%s] (削除保護: %s)\n": "%d. %s [%s] (deletion protection: %s)\n",
+	"\n⚠️  %d件のロードバランサーで削除保護が有効です。\n":                       "\n⚠️  Deletion protection is enabled on %d load balancers.\n",
+	"削除保護を解除して削除するには --force オプションを指定してください":                "Specify --force to disable deletion protection and delete them",
+	"削除保護が有効なロードバランサーがあります: %v":                             "Some load balancers have deletion protection enabled: %v",
+	"\n⚠️  %d件のロードバランサーで削除保護が有効です。--force により削除前に解除されます。\n": "\n⚠️  Deletion protection is enabled on %d load balancers. --force will disable it before deletion.\n",
+	"\n📌 関連するターゲットグループも削除されます":                              "\n📌 Related target groups will also be deleted",
+	"\n✨ ロードバランサーの削除が完了しました":                                "\n✨ Load balancer deletion completed",
+	"  %s [%s] を処理中...\n":                 "  Processing %s [%s]...\n",
+	"❌ %s の削除に失敗: %v\n":                   "❌ Failed to delete %s: %v\n",
+	"✅ %s を削除しました\n":                      "✅ Deleted %s\n",
+	"    🔓 削除保護を解除中...\n":                 "    🔓 Disabling deletion protection...\n",
+	"削除保護の解除エラー: %w":                      "Failed to disable deletion protection: %w",
+	"    ⚠️  ターゲットグループ削除エラー: %v\n":        "    ⚠️  Failed to delete target groups: %v\n",
+	"    ⚠️  削除保護の復元に失敗しました: %v\n":        "    ⚠️  Failed to restore deletion protection: %v\n",
+	"    🔒 削除保護を元に戻しました\n":                "    🔒 Restored deletion protection\n",
+	"    🗑️  関連するターゲットグループ（%d件）を削除中...\n": "    🗑️  Deleting related target groups (%d)...\n",
+	"      ⚠️  %s の削除に失敗: %v\n":           "      ⚠️  Failed to delete %s: %v\n",
+	"      ✓ %s を削除しました\n":                "      ✓ Deleted %s\n",
+
+	// internal/service/elb/ls.go
+	"ロードバランサー一覧":            "Load balancers",
+	"ロードバランサーが見つかりませんでした":   "No load balancers found",
+	"ロードバランサー情報取得エラー: %w":   "Failed to get load balancer information: %w",
+	"属性取得エラー: %w":           "Failed to get attributes: %w",
+	"\n🔍 ロードバランサー一覧（%d件）\n": "\n🔍 Load balancers (%d)\n",
+	"    状態: %s\n":          "    State: %s\n",
+	"    スキーマ: %s\n":        "    Scheme: %s\n",
+	"    DNS名: %s\n":        "    DNS name: %s\n",
+	"    削除保護: %s\n":        "    Deletion protection: %s\n",
+	"    リスナー数: %d\n":       "    Listeners: %d\n",
+	"    ターゲットグループ数: %d\n":  "    Target groups: %d\n",
+	"    作成日時: %s\n":        "    Created: %s\n",
+	"タイプ":                   "Type",
+	"スキーマ":                  "Scheme",
+	"削除保護":                  "Deletion protection",
+	"TG数":                   "TGs",
+	"ﾘｽﾅｰ":                  "Listeners",
+	"リスナー数":                 "Listeners",
+	"DNS名":                  "DNS name",
+	"作成日時":                  "Created",
+
+	// internal/service/env/common.go
+	"❌ エラー: '%s' はサポートされていない変数です。stack または profile を指定してください": "❌ Error: '%s' is not a supported variable. Specify stack or profile",
+
+	// internal/service/env/show.go
+	"📋 AWS関連の環境変数の状態:": "📋 Status of AWS-related environment variables:",
+	"  %s (%s): 未設定\n": "  %s (%s): not set\n",
+	"環境":               "Environment",
+	"プロファイル":           "Profile",
+	"検索パターンの既定値":       "Default search pattern",
+	"大文字小文字の区別":        "Case sensitivity",
+	"最大同時実行数":          "Max concurrency",
+	"保護対象パターン":         "Protected patterns",
+	"対象アカウント":          "Target accounts",
+	"引き受けるロール名":        "Role name to assume",
+	"外部ID":             "External ID",
+	"MFAデバイス":          "MFA device",
+	"📋 読み込んだ設定ファイル:":   "📋 Loaded config files:",
+	"  なし（%s またはユーザー設定ディレクトリの awstk/%s を作成してください）\n": "  None (create %s or awstk/%s in the user config directory)\n",
+	"有効な設定": "Effective settings",
+	"項目":    "Setting",
+	"値":     "Value",
+	"取得元":   "Source",
+	"ガードレールの拒否パターン": "Guardrail deny patterns",
+	"ガードレールの必須タグ":   "Guardrail required tags",
+
+	// internal/service/history/history.go
+	"❌ ジャーナルは無効化されています（環境変数 %s=off）":    "❌ The journal is disabled (environment variable %s=off)",
+	"実行履歴が見つかりませんでした":                   "No history found",
+	"実行履歴（リソース別）":                       "History (by resource)",
+	"実行履歴":                              "History",
+	"❌ 期間の指定が不正です: %s（例: 30m, 12h, 7d）": "❌ Invalid duration: %s (e.g. 30m, 12h, 7d)",
+	"成功":     "Succeeded",
+	"中断":     "Interrupted",
+	"エラー":    "Error",
+	"日時":     "Time",
+	"結果":     "Result",
+	"失敗":     "Failed",
+	"所要時間":   "Duration",
+	"コマンド":   "Command",
+	"リソース種別": "Resource type",
+	"リソース":   "Resource",
+	"操作":     "Operation",
+
+	// internal/service/iam/policy/delete.go
+	"フィルターパターンまたはタグ条件は必須です":                "A filter pattern or tag conditions are required",
+	"フィルター '%s' に一致するIAMポリシーが見つかりませんでした\n": "No IAM policies matched the filter '%s'\n",
+	"ポリシーの削除 が中断されました: %w":                 "Deleting policies was interrupted: %w",
+	"IAMポリシー": "IAM policies",
+	"🚀 %d個のポリシーを最大%d並列で削除します...\n\n":   "🚀 Deleting %d policies with up to %d in parallel...\n\n",
+	"ポリシー %s を削除中...\n":                "Deleting policy %s...\n",
+	"❌ ポリシー %s の削除に失敗しました: %v\n":       "❌ Failed to delete policy %s: %v\n",
+	"✅ ポリシー %s を削除しました\n":              "✅ Deleted policy %s\n",
+	"🔍 検出されたIAMポリシー: %s (未アタッチ)\n":     "🔍 Detected IAM policy: %s (not attached)\n",
+	"🔍 検出されたIAMポリシー: %s (アタッチ数: %d)\n": "🔍 Detected IAM policy: %s (attachments: %d)\n",
+	"ユーザーからのポリシーデタッチエラー: %w":           "Failed to detach the policy from users: %w",
+	"グループからのポリシーデタッチエラー: %w":           "Failed to detach the policy from groups: %w",
+	"ロールからのポリシーデタッチエラー: %w":            "Failed to detach the policy from roles: %w",
+	"ポリシーバージョン削除エラー: %w":               "Failed to delete policy versions: %w",
+	"ポリシー削除エラー: %w":                    "Failed to delete the policy: %w",
+
+	// internal/service/iam/policy/ls.go
+	"未使用のカスタマー管理ポリシー": "unused customer managed policies",
+	"カスタマー管理ポリシー一覧":   "Customer managed policies",
+	"カスタマー管理ポリシー":     "customer managed policies",
+	"未アタッチ":           "Not attached",
+	"ポリシー名":           "Policy name",
+	"理由":              "Reason",
+
+	// internal/service/iam/policy/plan.go
+	"IAMポリシー ": "IAM policy ",
+
+	// internal/service/iam/role/delete.go
+	"フィルター '%s' に一致するIAMロールが見つかりませんでした\n": "No IAM roles matched the filter '%s'\n",
+	"ロールの削除 が中断されました: %w":                 "Deleting roles was interrupted: %w",
+	"IAMロール": "IAM roles",
+	"🚀 %d個のロールを最大%d並列で削除します...\n\n":  "🚀 Deleting %d roles with up to %d in parallel...\n\n",
+	"ロール %s を削除中...\n":               "Deleting role %s...\n",
+	"❌ ロール %s の削除に失敗しました: %v\n":      "❌ Failed to delete role %s: %v\n",
+	"✅ ロール %s を削除しました\n":             "✅ Deleted role %s\n",
+	"🔍 検出されたIAMロール: %s\n":            "🔍 Detected IAM role: %s\n",
+	"🔍 検出されたIAMロール: %s (未使用)\n":      "🔍 Detected IAM role: %s (unused)\n",
+	"🔍 検出されたIAMロール: %s (最終使用: %s)\n": "🔍 Detected IAM role: %s (last used: %s)\n",
+	"インスタンスプロファイルからのロール削除エラー: %w":    "Failed to remove the role from instance profiles: %w",
+	"ポリシーのデタッチエラー: %w":               "Failed to detach policies: %w",
+	"インラインポリシーの削除エラー: %w":            "Failed to delete inline policies: %w",
+	"ロール削除エラー: %w":                   "Failed to delete the role: %w",
+
+	// internal/service/iam/role/ls.go
+	"未使用のIAMロール": "unused IAM roles",
+	"IAMロール一覧":   "IAM roles",
+	"ロール名":       "Role name",
+	"最終使用":       "Last used",
+	"備考":         "Note",
+
+	// internal/service/iam/role/plan.go
+	"IAMロール ": "IAM role ",
+
+	// internal/service/logs/delete.go
+	"サブスクリプションフィルター一覧取得エラー: %w":                           "Failed to list subscription filters: %w",
+	"削除対象の収集に失敗: %w":                                      "Failed to collect deletion targets: %w",
+	"削除対象のロググループがありません":                                   "No log groups to delete",
+	"削除保護状態の確認エラー (%s): %w":                               "Failed to check deletion protection (%s): %w",
+	"⚠️  %d件のロググループで削除保護が有効です:\n":                         "⚠️  Deletion protection is enabled on %d log groups:\n",
+	"\n削除保護を解除して削除するには --force オプションを指定してください":            "\nSpecify --force to disable deletion protection and delete them",
+	"削除保護が有効なロググループがあります":                                 "Some log groups have deletion protection enabled",
+	"⚠️  %d件のロググループで削除保護が有効です。--force により削除前に解除されます。\n\n": "⚠️  Deletion protection is enabled on %d log groups. --force will disable it before deletion.\n\n",
+	"ロググループの削除が中断されました: %w":                               "Deleting log groups was interrupted: %w",
+	"%d個のロググループの削除に失敗しました":                                "Failed to delete %d log groups",
+	"🔓 %s ... 削除保護を解除中\n":                                 "🔓 %s ... disabling deletion protection\n",
+	"⚠️  %s の削除保護の復元に失敗しました: %v\n":                        "⚠️  Failed to restore deletion protection of %s: %v\n",
+	"🔒 %s の削除保護を元に戻しました\n":                                "🔒 Restored deletion protection of %s\n",
+	"ロググループ一覧取得エラー: %w":                                   "Failed to list log groups: %w",
+	"ロググループ": "log groups",
+	"🗑️  %d個のロググループを最大%d並列で削除します...\n\n": "🗑️  Deleting %d log groups with up to %d in parallel...\n\n",
+	"❌ %s ... 失敗 (%v)\n":      "❌ %s ... failed (%v)\n",
+	"✅ %s ... 完了\n":           "✅ %s ... done\n",
+	"最終イベント日時の取得エラー (%s): %w": "Failed to get the last event time (%s): %w",
+
+	// internal/service/logs/ls.go
+	"ログループ一覧取得エラー: %w":      "Failed to list log groups: %w",
+	"    サイズ: 0 B (空)\n":    "    Size: 0 B (empty)\n",
+	"    保存期間: %d日\n":       "    Retention: %d days\n",
+	"    保存期間: 無期限\n":       "    Retention: never expire\n",
+	"    メトリクスフィルター: %d個\n": "    Metric filters: %d\n",
+	"ロググループ名":               "Log group name",
+	"保存期間(日)":               "Retention (days)",
+	"メトリクスフィルター数":           "Metric filters",
+
+	// internal/service/plan/plan.go
+	"プランのエンコードに失敗: %w":                              "Failed to encode the plan: %w",
+	"プランファイルの書き込みに失敗: %w":                           "Failed to write the plan file: %w",
+	"プランファイルの読み込みに失敗: %w":                           "Failed to read the plan file: %w",
+	"プランファイルの解析に失敗: %w":                             "Failed to parse the plan file: %w",
+	"未対応のプランファイルのバージョンです: %d（対応: %d）":               "Unsupported plan file version: %d (supported: %d)",
+	"プランファイルの%d番目のアクションが不正です（type/action/name は必須）": "Action #%d in the plan file is invalid (type/action/name are required)",
+	"リソースが存在しません":                                   "The resource does not exist",
+	"IDが変わっています（プラン: %s, 現在: %s）":                   "The ID has changed (plan: %s, current: %s)",
+	"プラン作成後に変更されています（%s）":                           "Changed since the plan was created (%s)",
+	"%s プラン（作成: %s, リージョン: %s）\n":                   "%s Plan (created: %s, region: %s)\n",
+	"   コマンド: %s\n":                                 "   Command: %s\n",
+	"\n【%s】(%d件)\n":                                 "\n[%s] (%d)\n",
+	"\n合計: %d件のアクション\n":                             "\nTotal: %d actions\n",
+
+	// internal/service/rds/ls.go
+	"RDSインスタンス":             "RDS instances",
+	"RDSインスタンス一覧":           "RDS instances",
+	"RDSインスタンスが見つかりませんでした":  "No RDS instances found",
+	"RDSインスタンス一覧の取得に失敗: %w": "Failed to list RDS instances: %w",
+
+	// internal/service/region/ls.go
+	"オプトイン状態":           "Opt-in status",
+	"リージョン一覧の取得に失敗: %w": "Failed to list regions: %w",
+
+	// internal/service/route53/delete.go
+	"ホストゾーン詳細の取得エラー: %w":                "Failed to get hosted zone details: %w",
+	"🔍 ホストゾーンが見つかりました: %s (ID: %s)\n":   "🔍 Found hosted zone: %s (ID: %s)\n",
+	"レコード一覧の取得エラー: %w":                  "Failed to list records: %w",
+	"\n[ドライラン] 以下を削除します:":               "\n[Dry run] The following will be deleted:",
+	"- ホストゾーン: %s (ID: %s)\n":           "- Hosted zone: %s (ID: %s)\n",
+	"- %d個のリソースレコードセット:\n":              "- %d resource record sets:\n",
+	"\n本当に続行しますか？":                      "\nDo you really want to continue?",
+	"削除がキャンセルされました。":                    "Deletion was cancelled.",
+	"\n🗑️  %d個のレコードを削除中...\n":           "\n🗑️  Deleting %d records...\n",
+	"⚠️  %d個のレコードの削除に失敗しました\n":          "⚠️  Failed to delete %d records\n",
+	"✅ %d個のレコードを削除しました\n":               "✅ Deleted %d records\n",
+	"\n🗑️  ホストゾーンを削除中...\n":             "\n🗑️  Deleting the hosted zone...\n",
+	"ホストゾーンの削除エラー: %w":                  "Failed to delete the hosted zone: %w",
+	"✅ ホストゾーンを正常に削除しました: %s (ID: %s)\n": "✅ Deleted the hosted zone: %s (ID: %s)\n",
+	"リソースレコードセット一覧の取得エラー: %w":           "Failed to list resource record sets: %w",
+	"  ❌ %d個のレコードのバッチ削除に失敗: %v\n":       "  ❌ Failed to batch-delete %d records: %v\n",
+	"  ✓ %d個のレコードをバッチ削除\n":              "  ✓ Batch-deleted %d records\n",
+	"ホストゾーン":              "Hosted zone",
+	"\n⚠️  以下を完全に削除します:":  "\n⚠️  The following will be permanently deleted:",
+	"ホストゾーン: %s (ID: %s)": "Hosted zone: %s (ID: %s)",
+	"%d個のリソースレコードセット":     "%d resource record sets",
+
+	// internal/service/route53/ls.go
+	"ホストゾーン一覧の取得エラー: %w":        "Failed to list hosted zones: %w",
+	"ホストゾーンが見つかりませんでした。":        "No hosted zones found.",
+	"🔍 %d個のホストゾーンが見つかりました:\n\n": "🔍 Found %d hosted zones:\n\n",
+	"ドメイン名":  "Domain name",
+	"ゾーンID":  "Zone ID",
+	"レコード数":  "Records",
+	"コメント":   "Comment",
+	"パブリック":  "Public",
+	"プライベート": "Private",
+	"ドメイン %s のホストゾーンが見つかりませんでした": "No hosted zone found for domain %s",
+
+	// internal/service/s3/avail.go
+	"利用不可（すでに存在）": "Unavailable (already exists)",
+	"利用可能":        "Available",
+	"利用不可（存在するがアクセス権限なし）":     "Unavailable (exists but access denied)",
+	"利用不可（リージョン不一致）":          "Unavailable (region mismatch)",
+	"利用不可（エラー: %v）":           "Unavailable (error: %v)",
+	"%s バケット名「%s」: %s [%d]\n": "%s Bucket name \"%s\": %s [%d]\n",
+
+	// internal/service/s3/cleanup.go
+	"バケット %s の通知設定取得エラー: %w":                     "Failed to get the notification configuration of bucket %s: %w",
+	"s3バケット一覧取得エラー: %w":                          "Failed to list S3 buckets: %w",
+	"🚀 %d個のバケットを最大%d並列で削除します...\n\n":             "🚀 Deleting %d buckets with up to %d in parallel...\n\n",
+	"バケット %s を空にして削除中...\n":                      "Emptying and deleting bucket %s...\n",
+	"❌ バケット %s を空にするのに失敗しました: %v\n":              "❌ Failed to empty bucket %s: %v\n",
+	"  バケット削除中: %s\n":                            "  Deleting bucket: %s\n",
+	"❌ バケット %s の削除に失敗しました: %v\n":                 "❌ Failed to delete bucket %s: %v\n",
+	"✅ バケット %s を削除しました\n":                        "✅ Deleted bucket %s\n",
+	"バケット内のオブジェクトバージョン一覧取得エラー: %w":               "Failed to list object versions in the bucket: %w",
+	"  %d件のオブジェクトを削除中...\n":                      "  Deleting %d objects...\n",
+	"オブジェクトの一括削除エラー: %w":                         "Failed to batch-delete objects: %w",
+	"  ⚠️  オブジェクト削除エラー: %s (バージョンID: %s) - %s\n": "  ⚠️  Failed to delete object: %s (version ID: %s) - %s\n",
+	"  バケットを空にしました。":                             "  Emptied the bucket.",
+	"  前回の続き（キー %s）からオブジェクトを削除します\n":             "  Resuming object deletion from where the previous run stopped (key %s)\n",
+
+	// internal/service/s3/common.go
+	"バケット名が空です": "The bucket name is empty",
+
+	// internal/service/s3/gunzip.go
+	"s3リスト取得失敗: %w": "Failed to list S3 objects: %w",
+	"指定されたパス配下に .gz ファイルが見つかりませんでした": "No .gz files found under the specified path",
+	"出力ディレクトリの作成に失敗: %w":             "Failed to create the output directory: %w",
+	"📦 %s をダウンロード中...\n":             "📦 Downloading %s...\n",
+	"❌ %s のダウンロードに失敗: %v\n":          "❌ Failed to download %s: %v\n",
+	"❌ %s のgzip解凍に失敗: %v\n":          "❌ Failed to gunzip %s: %v\n",
+	"⚠️  S3レスポンスボディのクローズに失敗: %v\n":   "⚠️  Failed to close the S3 response body: %v\n",
+	"❌ %s のファイル作成に失敗: %v\n":          "❌ Failed to create the file for %s: %v\n",
+	"⚠️  %s のgzipリーダーのクローズに失敗: %v\n": "⚠️  Failed to close the gzip reader for %s: %v\n",
+	"⚠️  %s のファイルクローズに失敗: %v\n":      "⚠️  Failed to close the file for %s: %v\n",
+	"❌ %s の書き込みに失敗: %v\n":            "❌ Failed to write %s: %v\n",
+	"🎉 %d個の .gz ファイルの処理が完了しました\n":    "🎉 Processed %d .gz files\n",
+
+	// internal/service/s3/ls.go
+	"s3オブジェクト一覧取得エラー: %w":   "Failed to list S3 objects: %w",
+	"🔍 %s には何も見つかりませんでした\n": "🔍 Nothing found in %s\n",
+
+	// internal/service/schedule/common.go
+	"\n📅 スケジュール一覧\n":    "\n📅 Schedules\n",
+	"スケジュールが見つかりませんでした": "No schedules found",
+	"\n合計: %d個のスケジュール":  "\nTotal: %d schedules",
+
+	// internal/service/schedule/disable.go
+	"フィルター '%s' にマッチするスケジュールを検索中...\n":   "Searching for schedules matching the filter '%s'...\n",
+	"EventBridge Rulesの取得に失敗: %w":        "Failed to get EventBridge rules: %w",
+	"  ⚠️  %s (Rule) の無効化に失敗: %v\n":      "  ⚠️  Failed to disable %s (Rule): %v\n",
+	"EventBridge Schedulersの取得に失敗: %w":   "Failed to get EventBridge schedules: %w",
+	"  ⚠️  %s (Scheduler) の無効化に失敗: %v\n": "  ⚠️  Failed to disable %s (Scheduler): %v\n",
+	"\n✅ %d 個のスケジュールを無効化しました\n":          "\n✅ Disabled %d schedules\n",
+	"  ✓ %s (Rule) を無効化中...\n":           "  ✓ Disabling %s (Rule)...\n",
+	"    → 無効化完了\n":                      "    → Disabled\n",
+	"  ✓ %s (Scheduler) を無効化中...\n":      "  ✓ Disabling %s (Scheduler)...\n",
+
+	// internal/service/schedule/enable.go
+	"  %s %s (Rule) の有効化に失敗: %v\n":       "  %s Failed to enable %s (Rule): %v\n",
+	"  ⚠️  %s (Scheduler) の有効化に失敗: %v\n": "  ⚠️  Failed to enable %s (Scheduler): %v\n",
+	"\n✅ %d 個のスケジュールを有効化しました\n":          "\n✅ Enabled %d schedules\n",
+	"  ✓ %s (Rule) を有効化中...\n":           "  ✓ Enabling %s (Rule)...\n",
+	"    → 有効化完了\n":                      "    → Enabled\n",
+	"  ✓ %s (Scheduler) を有効化中...\n":      "  ✓ Enabling %s (Scheduler)...\n",
+
+	// internal/service/schedule/ls.go
+	"EventBridge Rules取得エラー: %w":     "Failed to get EventBridge rules: %w",
+	"EventBridge Scheduler取得エラー: %w": "Failed to get EventBridge schedules: %w",
+	"スケジュール一覧":                       "Schedules",
+	"ルール %s のターゲット取得エラー: %w":         "Failed to get targets of rule %s: %w",
+	"スケジュール %s の詳細取得エラー: %w":         "Failed to get details of schedule %s: %w",
+
+	// internal/service/schedule/trigger.go
+	"スケジュール '%s' が見つかりません":                               "Schedule '%s' not found",
+	"\n⚠️  '%s' はEventBridge RuleとSchedulerの両方に存在します。\n": "\n⚠️  '%s' exists as both an EventBridge Rule and a Scheduler schedule.\n",
+	"どちらを操作しますか？":                                        "Which one do you want to operate on?",
+	"無効な選択です: %s":                                        "Invalid selection: %s",
+	"📝 現在のスケジュール設定を取得中...\n":                             "📝 Fetching the current schedule settings...\n",
+	"ルールの取得に失敗: %w":                                      "Failed to get the rule: %w",
+	"'%s' はスケジュールルールではありません":                             "'%s' is not a scheduled rule",
+	"  └─ 現在の設定: %s\n":                                   "  └─ Current setting: %s\n",
+	"\n🔄 元のスケジュールに復元中...":                                "\n🔄 Restoring the original schedule...",
+	"⚠️  スケジュールの復元に失敗: %v\n":                             "⚠️  Failed to restore the schedule: %v\n",
+	"  └─ 復元後: %s\n":                                     "  └─ Restored: %s\n",
+	"\n🔄 スケジュールを1分後実行に変更中...":                            "\n🔄 Changing the schedule to run in 1 minute...",
+	"スケジュール変更に失敗: %w":                                    "Failed to change the schedule: %w",
+	"  └─ 新しい設定: %s\n":                                   "  └─ New setting: %s\n",
+	"\n⚠️  --no-waitが指定されました。スケジュールは自動的に復元されません。":        "\n⚠️  --no-wait was specified. The schedule will not be restored automatically.",
+	"\n✅ 処理が完了しました":                                      "\n✅ Done",
+	"スケジュールの取得に失敗: %w":                                   "Failed to get the schedule: %w",
+	"\n⚠️  最低待機時間%d秒に調整しました\n":                           "\n⚠️  Adjusted to the minimum wait time of %d seconds\n",
+	"\n⏳ スケジュール実行を待機中（%d秒）...\n":                         "\n⏳ Waiting for the scheduled run (%d seconds)...\n",
+	"待機中...": "Waiting...",
+	"\n⚠️  待機を中断しました（経過: %d秒 / %d秒）\n": "\n⚠️  Stopped waiting (elapsed: %d / %d seconds)\n",
+	"スケジュール '%s' の実行待機が中断されました: %w":    "Waiting for schedule '%s' to run was interrupted: %w",
+	"⚠️  プログレスバー更新エラー: %v\n":           "⚠️  Failed to update the progress bar: %v\n",
+	"⚠️  プログレスバー終了エラー: %v\n":           "⚠️  Failed to finish the progress bar: %v\n",
+	"\n✓ 実行待機完了":                       "\n✓ Finished waiting for the run",
+
+	// internal/service/secretsmanager/get.go
+	"シークレット取得に失敗: %w":      "Failed to get the secret: %w",
+	"シークレットのJSON解析に失敗: %w": "Failed to parse the secret as JSON: %w",
+
+	// internal/service/ses/verify.go
+	"ファイル読み込みエラー: %w":                   "Failed to read the file: %w",
+	"ファイルにメールアドレスが見つかりませんでした":           "No email addresses found in the file",
+	"SES検証エラー: %w":                      "SES verification error: %w",
+	"⚠️  ファイルのクローズに失敗: %v\n":            "⚠️  Failed to close the file: %v\n",
+	"🚀 %d件のメールアドレスを最大%d並列で検証します...\n\n": "🚀 Verifying %d email addresses with up to %d in parallel...\n\n",
+	"✅ 検証成功: %d件\n":                     "✅ Verified: %d\n",
+	"\n❌ 検証失敗: %d件\n":                   "\n❌ Failed to verify: %d\n",
+
+	// internal/service/ssm/delete_params.go
+	"ファイルが見つかりません: %s":                             "File not found: %s",
+	"ファイルの読み込みに失敗しました: %w":                         "Failed to read the file: %w",
+	"削除するパラメータが見つかりません":                            "No parameters found to delete",
+	"🗑️  以下のパラメータが削除されます:":                         "🗑️  The following parameters will be deleted:",
+	"📊 合計: %d 件\n":                                 "📊 Total: %d\n",
+	"削除をキャンセルしました。":                                "Deletion cancelled.",
+	"⚠️  %s は存在しません（スキップ）\n":                       "⚠️  %s does not exist (skipped)\n",
+	"❌ %s の削除に失敗しました: %v\n":                        "❌ Failed to delete %s: %v\n",
+	"SSMパラメータ":                                     "SSM parameters",
+	"\n📊 削除結果: 成功 %d / 失敗 %d / 存在しない %d / 合計 %d\n": "\n📊 Deletion results: succeeded %d / failed %d / not found %d / total %d\n",
+	"%d 件のパラメータ削除に失敗しました":                          "Failed to delete %d parameters",
+	"ファイルを開けません: %w":                               "Cannot open the file: %w",
+	"⚠️  行 %d: 無効なパラメータ名をスキップ: %s\n":               "⚠️  Line %d: skipping invalid parameter name: %s\n",
+	"ファイルの読み込みエラー: %w":                             "Failed to read the file: %w",
+	"⚠️  %d 件のパラメータを削除しようとしています。":                  "⚠️  About to delete %d parameters.",
+
+	// internal/service/ssm/put_params.go
+	"登録するパラメータが見つかりません":                        "No parameters found to put",
+	"📋 以下のパラメータが登録されます:":                       "📋 The following parameters will be put:",
+	"🚀 %d個のパラメータを最大%d並列で登録します...\n\n":          "🚀 Putting %d parameters with up to %d in parallel...\n\n",
+	"❌ %s の登録に失敗しました: %v\n":                    "❌ Failed to put %s: %v\n",
+	"✅ %s を登録しました\n":                           "✅ Put %s\n",
+	"\n📊 登録結果: 成功 %d / 失敗 %d / 合計 %d\n":        "\n📊 Put results: succeeded %d / failed %d / total %d\n",
+	"⚠️  中断により %d 件のパラメータは登録していません\n":          "⚠️  %d parameters were not put because of the interruption\n",
+	"パラメータ登録が中断されました: %w":                      "Putting parameters was interrupted: %w",
+	"%d 件のパラメータ登録に失敗しました":                      "Failed to put %d parameters",
+	"サポートされていないファイル形式: %s":                     "Unsupported file format: %s",
+	"JSONの解析に失敗しました: %w":                       "Failed to parse JSON: %w",
+	"パラメータ[%d]のバリデーションエラー: %w":                 "Validation error in parameter[%d]: %w",
+	"CSVヘッダーの読み込みに失敗しました: %w":                  "Failed to read the CSV header: %w",
+	"CSVヘッダーが不正です。最低限 name, value, type が必要です": "Invalid CSV header. At least name, value and type are required",
+	"CSVヘッダーが不正です。期待: %s, 実際: %s":              "Invalid CSV header. Expected: %s, actual: %s",
+	"CSV行 %d の読み込みに失敗しました: %w":                 "Failed to read CSV line %d: %w",
+	"CSV行 %d のカラム数が不足しています":                    "CSV line %d does not have enough columns",
+	"CSV行 %d のバリデーションエラー: %w":                  "Validation error on CSV line %d: %w",
+	"nameが空です":               "name is empty",
+	"valueが空です":              "value is empty",
+	"typeが空です":               "type is empty",
+	"無効なtype: %s (有効な値: %s)": "Invalid type: %s (valid values: %s)",
+	"パラメータ名は / で始まる必要があります: %s": "The parameter name must start with /: %s",
+
+	// internal/service/ssm/session.go
+	"🖥️  利用可能なEC2インスタンスから選択してください:":  "🖥️  Choose from the available EC2 instances:",
+	"❌ インスタンス選択でエラー: %w":             "❌ Error while selecting an instance: %w",
+	"EC2インスタンス (%s) にSSMで接続します...\n": "Connecting to EC2 instance (%s) via SSM...\n",
+	"❌ SSMセッションの開始に失敗しました: %w":       "❌ Failed to start the SSM session: %w",
+	"✅ SSMセッションを開始しました。":             "✅ SSM session started.",
+
+	// internal/service/common/errors.go
+	"%s %s一覧の取得に失敗: %w": "%s Failed to list %s: %w",
+	"%s %s の有効化に失敗: %w": "%s Failed to enable %s: %w",
+	"%s %s の無効化に失敗: %w": "%s Failed to disable %s: %w",
+	"%s %s の起動に失敗: %w":  "%s Failed to start %s: %w",
+	"%s %s の停止に失敗: %w":  "%s Failed to stop %s: %w",
+	"%s %s の削除に失敗: %w":  "%s Failed to delete %s: %w",
+	"%s %s の作成に失敗: %w":  "%s Failed to create %s: %w",
+	"%s %s の更新に失敗: %w":  "%s Failed to update %s: %w",
+	"%s %s の取得に失敗: %w":  "%s Failed to get %s: %w",
+	"%s %s を有効化しました":    "%s Enabled %s",
+	"%s %s を無効化しました":    "%s Disabled %s",
+	"%s %s を起動しました":     "%s Started %s",
+	"%s %s を停止しました":     "%s Stopped %s",
+	"%s %s を削除しました":     "%s Deleted %s",
+	"%s %s を作成しました":     "%s Created %s",
+	"%s %s を更新しました":     "%s Updated %s",
+	"%s %s を処理中...":     "%s Processing %s...",
+	"%s %s を検索中...":     "%s Searching %s...",
+
+	// cmd/tags.go
+	"タグ条件に一致する%sが見つかりませんでした\n":   "No %s matching the tag conditions were found\n",
+	"🔍 タグ条件に一致する%sが%d件見つかりました\n": "🔍 Found %[2]d %[1]s matching the tag conditions\n",
+	"❌ %d件の%sで失敗しました: %s":        "❌ Failed for %d %s: %s",
+
+	// internal/service/tagging/tagging.go
+	"--tag の指定が不正です: %s（key=value、key、!key、key!=value のいずれかで指定してください）": "Invalid --tag value: %s (use key=value, key, !key or key!=value)",
+	"タグの取得に失敗: %w": "Failed to get tags: %w",
+
+	// cmd/age.go
+	"経過時間条件: %s\n": "Age conditions: %s\n",
+
+	// internal/service/common/age.go
+	"--age-by の指定が不正です: %s（created または last-activity を指定してください）": "Invalid --age-by value: %s (specify created or last-activity)",
+	"--newer-than には --older-than より長い期間を指定してください":               "--newer-than must be longer than --older-than",
+	"期間の指定が不正です: %s（例: 14d、2w、36h）":                              "Invalid duration: %s (e.g. 14d, 2w, 36h)",
+	"作成":               "created",
+	"最終アクティビティ":        "last activity",
+	"%s (%s: %s, %s前)": "%s (%s: %s, %s ago)",
+	"最終アクティビティ日時":      "Last activity",
+	"%s以上前":            "%s or more ago",
+	"%s以内":             "within %s",
+	"%sが%s":            "%s %s",
+	"かつ":               " and ",
+	"%d日":              "%dd",
+	"%d時間":             "%dh",
+	"%d分":              "%dm",
+
+	// internal/service/guardrail/guardrail.go
+	"🛡️  %s %s はガードレールにより削除しません（%s）\n": "🛡️  %s %s is blocked by the guardrail and will not be deleted (%s)\n",
+	"ガードレールにより削除しません（%s）":              "Not deleted because of the guardrail (%s)",
+	"ℹ️  ガードレールを上書きして削除するには --override-guardrail を指定してください（リソースごとに名前の入力が必要です）": "ℹ️  To override the guardrail and delete, specify --override-guardrail (you will be asked to type each resource name)",
+	"%s %s はガードレールにより削除できません":                    "%s %s cannot be deleted because of the guardrail",
+	"拒否パターン %s に一致":                              "matches deny pattern %s",
+	"%s が拒否パターン %s に一致":                          "%s matches deny pattern %s",
+	"%s  %sの名前を取得できないため、ガードレールはIDのみで確認します: %v\n": "%s  Could not get the names of the %s, so the guardrail checks IDs only: %v\n",
+	"必須タグを確認できません: %v":                           "cannot verify required tags: %v",
+	"必須タグ %s がありません":                             "missing required tags %s",
+	"タグ取得用のクライアントが設定されていません":                     "no client is configured for fetching tags",
+	"⚠️  %s %s はガードレールの対象です（%s）":                 "⚠️  %s %s is protected by the guardrail (%s)",
+
+	// internal/service/secretsmanager/delete.go
+	"シークレット": "Secret",
+
+	// internal/service/confirm/confirm.go
+	"ℹ️  --yes が指定されたため確認を省略します":                        "ℹ️  Skipping confirmation because --yes was specified",
+	"標準入力が端末ではないため確認できません（確認せずに実行するには --yes を指定してください）": "cannot ask for confirmation because stdin is not a terminal (specify --yes to run without confirmation)",
+	"標準入力が端末ではないため確認できません":                              "cannot ask for confirmation because stdin is not a terminal",
+	"\n⚠️  上記の%d件の%sを削除します":                             "\n⚠️  The %d %s listed above will be deleted",
+	"続行するには%s %s を入力してください: ":                           "To continue, enter %s \"%s\": ",
+	"入力が一致しません":                                         "Input does not match",
+	"本当に実行しますか？":                                        "Do you really want to proceed?",
+
+	// internal/service/aurora/select.go
+	"❌ Auroraクラスターが見つかりません": "❌ No Aurora clusters found",
+	"Auroraクラスターを選択してください":  "Select an Aurora cluster",
+
+	// internal/service/cfn/select.go
+	"❌ CloudFormationスタックが見つかりません": "❌ No CloudFormation stacks found",
+	"CloudFormationスタックを選択してください":  "Select a CloudFormation stack",
+
+	// internal/service/ecs/select.go
+	"❌ ECSクラスター一覧の取得に失敗: %w":        "❌ Failed to list ECS clusters: %w",
+	"❌ ECSクラスターが見つかりません":            "❌ No ECS clusters found",
+	"ECSクラスターを選択してください":             "Select an ECS cluster",
+	"❌ ECSサービス一覧の取得に失敗: %w":         "❌ Failed to list ECS services: %w",
+	"❌ クラスター '%s' にECSサービスが見つかりません": "❌ No ECS services found in cluster '%s'",
+	"ECSサービスを選択してください":              "Select an ECS service",
+
+	// internal/service/logs/select.go
+	"ロググループが見つかりませんでした": "No log groups found",
+
+	// internal/service/rds/select.go
+	"❌ RDSインスタンスが見つかりません": "❌ No RDS instances found",
+	"RDSインスタンスを選択してください":  "Select an RDS instance",
+
+	// internal/service/schedule/select.go
+	"実行するスケジュールを選択してください": "Select the schedule to run",
+
+	// internal/service/secretsmanager/select.go
+	"シークレット一覧の取得に失敗: %w": "Failed to list secrets: %w",
+	"シークレットが見つかりませんでした":  "No secrets found",
+	"シークレットを選択してください":    "Select a secret",
+
+	// internal/service/selector/finder.go
+	"↑↓: 移動  Enter: 決定  Esc: キャンセル":          "↑↓: move  Enter: select  Esc: cancel",
+	"↑↓: 移動  Tab: 選択  Enter: 決定  Esc: キャンセル": "↑↓: move  Tab: toggle  Enter: confirm  Esc: cancel",
+
+	// internal/service/selector/selector.go
+	"選択できる項目がありません":                      "There are no items to select",
+	"✅ %s を選択しました\n":                     "✅ Selected %s\n",
+	"選択をキャンセルしました":                       "Selection canceled",
+	"番号を入力してください（カンマ区切りで複数指定可） (1-%d): ": "Enter numbers (comma-separated for multiple) (1-%d): ",
+	"番号を入力してください (1-%d): ":               "Enter a number (1-%d): ",
+	"入力の読み取りに失敗しました: %w":                 "Failed to read input: %w",
+
+	// internal/service/cloudfront/ls.go
+	"ディストリビューション一覧の取得に失敗: %w": "Failed to list distributions: %w",
+
+	// internal/service/mcp/server.go
+	"mcp.allowTools に未知の変更系ツール '%s' が指定されています（指定可能: %s）": "mcp.allowTools contains an unknown mutating tool '%s' (available: %s)",
+
+	// internal/service/mcp/tools.go
+	"CloudFormationスタックの一覧（名前・ID・ステータス）を返します":                                                  "Returns CloudFormation stacks (name, ID and status)",
+	"削除済みなどを含む全ステータスのスタックを返す（省略時はアクティブなスタックのみ）":                                                "Return stacks in every status, including deleted ones (active stacks only when omitted)",
+	"CloudFormationスタックの最新のドリフト状態を返します（ドリフト検出は実行しません）":                                         "Returns the latest drift status of CloudFormation stacks (does not run drift detection)",
+	"ドリフトしているスタックのみ返す":                                                                         "Return drifted stacks only",
+	"ECSサービスの状態（タスク数・タスク定義・Auto Scaling設定・タスク一覧）を返します":                                         "Returns the status of an ECS service (task counts, task definition, Auto Scaling settings and tasks)",
+	"Aurora Serverless v2クラスターのACU使用量（過去5分間の平均）と設定範囲を返します。クラスターを省略すると全Serverless v2クラスターを返します": "Returns ACU usage (5-minute average) and the configured range of Aurora Serverless v2 clusters. Returns every Serverless v2 cluster when no cluster is given",
+	"Auroraクラスター識別子":                                              "Aurora cluster identifier",
+	"Auroraクラスターを含むCloudFormationスタック名":                           "Name of the CloudFormation stack containing the Aurora cluster",
+	"CloudWatch Logsのロググループ一覧（サイズ・作成日・保存期間）を返します":                 "Returns CloudWatch Logs log groups (size, creation date and retention)",
+	"ロググループ名の検索パターン（部分一致、* ? のワイルドカード可）":                          "Log group name pattern (partial match, * and ? wildcards allowed)",
+	"空のロググループのみ返す":                                                "Return empty log groups only",
+	"保存期間が未設定のロググループのみ返す":                                         "Return log groups without a retention period only",
+	"CloudFormationスタックのドリフト検出を開始します。結果は cfn_drift_status で確認します": "Starts drift detection on CloudFormation stacks. Check the result with cfn_drift_status",
+	"ドリフト検出を開始しました":                                               "Drift detection started",
+	"一部のスタックでドリフト検出の開始に失敗しました: %s":                                "Failed to start drift detection on some stacks: %s",
+	"ECSサービスを強制再デプロイします（デプロイ完了は待機しません）":                           "Forces a new deployment of an ECS service (does not wait for completion)",
+	"強制再デプロイを開始しました":                                              "Forced redeployment started",
+	"Aurora DBクラスターを起動します":                                        "Starts an Aurora DB cluster",
+	"Aurora DBクラスターを停止します":                                        "Stops an Aurora DB cluster",
+	"RDSインスタンスを起動します":                                             "Starts an RDS instance",
+	"RDSインスタンスを停止します":                                             "Stops an RDS instance",
+	"RDSインスタンス識別子":                                                "RDS instance identifier",
+	"引数 %s を指定してください":                                             "Specify the %s argument",
+	"リクエストを受け付けました":                                               "Request accepted",
+	"stacks, filter, all のいずれか1つを指定してください":                        "Specify exactly one of stacks, filter or all",
+	"対象のスタック名":                                                    "Target stack names",
+	"ECSサービスを含むCloudFormationスタック名（cluster/service の代わりに指定）":      "Name of the CloudFormation stack containing the ECS service (instead of cluster/service)",
+	"ECSクラスター名":                                                   "ECS cluster name",
+	"ECSサービス名":                                                    "ECS service name",
+	"引数が不正です: %w":                                                 "Invalid arguments: %w",
+
+	// cmd/mcp.go
+	"🔌 MCPサーバーを標準入出力で起動しました": "🔌 MCP server started on stdio",
+
+	// internal/service/env/show.go
+	"MCPで公開する変更系ツール": "Mutating tools exposed over MCP",
+
+	// cmd/root.go（ログ）
+	"❌ エラー: -q と -v は同時に指定できません":              "❌ Error: -q and -v cannot be used together",
+	"📄 設定ファイル %s を読み込みました\n":                  "📄 Loaded config file %s\n",
+	"📄 環境 '%s' を使用します（%s）\n":                  "📄 Using environment '%s' (%s)\n",
+	"📄 AWS設定を読み込みました（プロファイル: %s、リージョン: %s）\n": "📄 Loaded AWS config (profile: %s, region: %s)\n",
+
+	// internal/aws/trace.go
+	"🔎 %s.%s %dms（再試行 %d回）":         "🔎 %s.%s %dms (%d retries)",
+	"🔎 %s.%s %dms（HTTP %d、再試行 %d回）": "🔎 %s.%s %dms (HTTP %d, %d retries)",
+
+	// cmd/report.go
+	"❌ --report-format は --report と一緒に指定してください": "❌ --report-format must be specified together with --report",
+	"📝 レポートを %s に書き出しました\n":                     "📝 Wrote the report to %s\n",
+
+	// internal/service/report/report.go
+	"未対応のレポートの形式です: %s（%s のいずれかを指定してください）":                          "Unsupported report format: %s (specify one of %s)",
+	"拡張子からレポートの形式を判別できません: %s（--report-format で %s のいずれかを指定してください）": "Cannot determine the report format from the file extension: %s (specify one of %s with --report-format)",
+	"レポートのエンコードに失敗: %w":    "Failed to encode the report: %w",
+	"レポートファイルの書き込みに失敗: %w": "Failed to write the report file: %w",
+
+	// internal/service/report/format.go
+	"- %s: %s（%s）\n\n":                    "- %s: %s (%s)\n\n",
+	"✅ 削除成功":                              "✅ Deleted",
+	"❌ 削除失敗":                              "❌ Failed",
+	"⚠️ 中断により未実行":                         "⚠️ Not run (interrupted)",
+	"⛔ 参照元を削除できず未実行":                      "⛔ Not run (a referencing resource could not be deleted)",
+	"🛡️ ガードレールにより未実行":                     "🛡️ Not run (guardrail)",
+	"オブジェクト %d件（%s）を削除":                   "Removed %d objects (%s)",
+	"クリーンアップ レポート":                        "Cleanup report",
+	"実行日時":                                "Run at",
+	"**削除成功 %d件 / 削除失敗 %d件 / 未実行 %d件**\n": "**Deleted %d / Failed %d / Not run %d**\n",
+	"削除対象のリソースはありませんでした":                  "There were no resources to delete",
+	"種類": "Type",
+	"詳細": "Details",
+
+	// cmd/resume.go
+	"%s 実行状態を保存できないため、中断した場合は再開できません: %v\n":         "%s Cannot save the run state, so the run cannot be resumed if interrupted: %v\n",
+	"❌ 実行 %s はリージョン %s の実行のため、リージョン %s では再開できません":   "❌ Run %s was run in region %s and cannot be resumed in region %s",
+	"❌ 実行 %s はプロファイル %s の実行のため、プロファイル %s では再開できません": "❌ Run %s was run with profile %s and cannot be resumed with profile %s",
+
+	// internal/runstate/runstate.go
+	"実行IDが不正です: %s": "Invalid run ID: %s",
+	"実行状態の保存は無効化されています（環境変数 %s=off）":                   "Saving run state is disabled (environment variable %s=off)",
+	"実行 %s の状態ファイルが見つかりません（完了した実行の状態ファイルは削除されます）: %s":  "The state file of run %s was not found (state files of completed runs are deleted): %s",
+	"実行状態ファイルの読み込みに失敗: %w":                             "Failed to read the run state file: %w",
+	"実行状態ファイルの解析に失敗: %w":                               "Failed to parse the run state file: %w",
+	"実行 %s は %s の実行のため、%s では再開できません":                   "Run %s is a run of %s and cannot be resumed with %s",
+	"実行IDの作成に失敗: %w":                                   "Failed to create a run ID: %w",
+	"\n⏸️  未完了のリソースが%d件あります。--resume %s で続きから再開できます\n": "\n⏸️  %d resources are not completed. Resume with --resume %s\n",
+	"%s 実行状態ファイルの削除に失敗しました: %v\n":                      "%s Failed to delete the run state file: %v\n",
+	"実行状態のエンコードに失敗: %w":                                "Failed to encode the run state: %w",
+	"実行状態ディレクトリの作成に失敗: %w":                             "Failed to create the run state directory: %w",
+	"実行状態ファイルの書き込みに失敗: %w":                             "Failed to write the run state file: %w",
+	"%s 実行状態の保存に失敗しました（中断した場合は再開できません）: %v\n":          "%s Failed to save the run state (the run cannot be resumed if interrupted): %v\n",
+
+	// コマンドのヘルプ・フラグの説明
+	"AWS リソース管理用 CLI ツール": "CLI tool for managing AWS resources",
+	"awstk は AWS リソースを効率的に管理するための CLI ツールです。\n\nS3、ECR、ECS、CloudFormation などの各種 AWS サービスに対して、\n一括削除や状態確認などの便利な操作を提供します。\n\n使用例:\n  awstk cleanup all -k \"test\"    # \"test\"を含むS3/ECRを一括削除\n  awstk s3 gunzip my-bucket/logs # S3の.gzファイルを一括ダウンロード&解凍\n  awstk ecs exec -s my-service   # Fargateコンテナへシェル接続\n  awstk ec2 ls --output json     # 一覧をJSONで出力（jq等と連携）\n  awstk s3 ls --endpoint-url http://localhost:4566  # LocalStack等のエミュレーターに接続": "awstk is a CLI tool for managing AWS resources efficiently.\n\nIt provides handy operations such as bulk deletion and status checks\nfor AWS services including S3, ECR, ECS and CloudFormation.\n\nExamples:\n  awstk cleanup all -k \"test\"    # Bulk-delete S3/ECR resources containing \"test\"\n  awstk s3 gunzip my-bucket/logs # Bulk-download and decompress .gz files from S3\n  awstk ecs exec -s my-service   # Open a shell in a Fargate container\n  awstk ec2 ls --output json     # Output the list as JSON (for jq and similar tools)\n  awstk s3 ls --endpoint-url http://localhost:4566  # Connect to an emulator such as LocalStack",
+	"並列処理の最大同時実行数（未指定時は設定ファイルの concurrency または各処理の既定値）":               "Maximum concurrency for parallel processing (defaults to concurrency in the config file or each operation's default)",
+	"全サービス共通のエンドポイントURL（LocalStack等、環境変数 AWSTK_ENDPOINT_URL でも指定可）":   "Endpoint URL for all services (LocalStack etc.; can also be set with the AWSTK_ENDPOINT_URL environment variable)",
+	"使用する環境名（設定ファイル .awstk.yaml の environments、環境変数 AWSTK_ENV でも指定可）": "Environment name to use (environments in the .awstk.yaml config file; can also be set with the AWSTK_ENV environment variable)",
+	"表示言語 (ja|en、環境変数 AWSTK_LANG やロケールでも指定可)":                         "Display language (ja|en; can also be set with the AWSTK_LANG environment variable or the locale)",
+	"スロットリング等で失敗した処理の最大試行回数（1以上。1の場合は再試行しない）":                         "Maximum attempts for operations that fail due to throttling etc. (1 or greater; 1 disables retries)",
+	"一覧の出力形式 (table|json|yaml|csv|tsv)":                               "Output format for lists (table|json|yaml|csv|tsv)",
+	"AWSプロファイル": "AWS profile",
+	"進捗メッセージを表示せず、警告とエラーのみ標準エラー出力に表示":                                                         "Hide progress messages and show only warnings and errors on stderr",
+	"詳細なログを表示（-vv でAWS API呼び出しごとの所要時間・再試行回数も表示）":                                              "Show verbose logs (-vv also shows latency and retry count for each AWS API call)",
+	"標準エラー出力に書き出すログの形式 (text|json)":                                                           "Format of logs written to stderr (text|json)",
+	"並列処理全体で共有する1秒あたりの最大リクエスト数（0は無制限、スロットリング検出時は自動で減速）":                                       "Maximum requests per second shared across parallel processing (0 means unlimited; slows down automatically when throttled)",
+	"AWSリージョン（未指定時は設定ファイルの環境の値）":                                                              "AWS region (defaults to the value of the config file environment)",
+	"サービスごとのエンドポイントURL（例: s3=http://localhost:4566、複数指定可、環境変数 AWSTK_SERVICE_ENDPOINTS でも指定可）": "Per-service endpoint URL (e.g. s3=http://localhost:4566; repeatable; can also be set with the AWSTK_SERVICE_ENDPOINTS environment variable)",
+	"--plan-out で作成したプランを実行するコマンド":                                                            "Apply a plan created with --plan-out",
+	"破壊的コマンドの --plan-out で書き出したプランファイルを実行します。\n実行前に各リソースが存在し、プラン作成時から変更されていないことを再確認し、\nプランに記録されたリソースだけを削除します。\n削除の前に実行してよいかを確認します（--yes で省略）。\nプラン作成時とアカウントが異なる場合は実行せず、プロファイルが異なる場合は実行してよいかを確認します（--yes で省略）。\n\n例:\n  awstk cleanup all -s \"test\" --plan-out plan.json   # プランを作成（削除しない）\n  awstk apply plan.json                              # プランを実行\n  awstk apply plan.json --skip-changed               # 変更されたリソースを除いて実行": "Applies a plan file written by the --plan-out option of a destructive command.\nBefore running, it re-checks that each resource exists and has not changed since the plan was created,\nand deletes only the resources recorded in the plan.\nIt asks for confirmation before deleting (skipped with --yes).\nIf the account differs from the one used to create the plan, it refuses to run; if the profile differs, it asks for confirmation (skipped with --yes).\n\nExamples:\n  awstk cleanup all -s \"test\" --plan-out plan.json   # Create a plan (nothing is deleted)\n  awstk apply plan.json                              # Apply the plan\n  awstk apply plan.json --skip-changed               # Apply, skipping changed resources",
+	"プラン作成後に変更・削除されたリソースをスキップして残りを実行": "Skip resources changed or deleted since the plan was created and apply the rest",
+	"Aurora DBクラスター操作コマンド":            "Aurora DB cluster commands",
+	"Aurora DBクラスターを操作するためのコマンド群です。":  "Commands for operating Aurora DB clusters.",
+	"Aurora Serverless v2のAcu使用状況を表示": "Show Aurora Serverless v2 ACU usage",
+	"Aurora Serverless v2クラスターの現在のAcu（Aurora Capacity Units）使用状況を表示します。\n\n例:\n  awstk aurora acu -P my-profile -S my-stack\n  awstk aurora acu -P my-profile -c my-cluster\n  awstk aurora acu -P my-profile --all": "Shows the current ACU (Aurora Capacity Units) usage of Aurora Serverless v2 clusters.\n\nExamples:\n  awstk aurora acu -P my-profile -S my-stack\n  awstk aurora acu -P my-profile -c my-cluster\n  awstk aurora acu -P my-profile --all",
+	"全てのServerless v2クラスターを表示": "Show all Serverless v2 clusters",
+	"Aurora DBクラスター名":          "Aurora DB cluster name",
+	"CloudFormationスタック名":      "CloudFormation stack name",
+	"Auroraクラスター一覧を表示するコマンド":   "List Aurora clusters",
+	"ロールを引き受けて複数アカウントで実行（アカウントIDまたはロールARNをカンマ区切り、all で設定ファイルの環境の accounts）": "Assume a role and run across multiple accounts (comma-separated account IDs or role ARNs; all uses the accounts of the config file environment)",
+	"ロールの引き受けに使用する外部ID":                                                       "External ID used when assuming the role",
+	"MFAデバイスのARN（指定時は実行前に一度だけトークンコードを入力）":                                     "ARN of the MFA device (prompts once for a token code before running)",
+	"複数リージョンを並列に検索してリージョン列付きで表示（all または ap-northeast-1,us-east-1 のようにカンマ区切り）": "Search multiple regions in parallel and show a region column (all, or comma-separated like ap-northeast-1,us-east-1)",
+	"各アカウントで引き受けるロール名（デフォルト: OrganizationAccountAccessRole）":                  "Role name to assume in each account (default: OrganizationAccountAccessRole)",
+	"Aurora DBクラスターを起動するコマンド":                                                 "Start an Aurora DB cluster",
+	"Aurora DBクラスターを停止するコマンド":                                                 "Stop an Aurora DB cluster",
+	"AWS Synthetics Canary操作コマンド":                                             "AWS Synthetics Canary commands",
+	"AWS Synthetics Canaryの一覧表示、有効化/無効化、手動実行を行います。\n\n使用例:\n  awstk canary ls                          # Canary一覧を表示\n  awstk canary enable --name my-canary     # 特定のCanaryを有効化\n  awstk canary disable --search \"test-*\"   # パターンに一致するCanaryを無効化\n  awstk canary enable --all                # 全てのCanaryを有効化\n  awstk canary run --name my-canary        # 特定のCanaryを手動実行\n  awstk canary run --search \"api-*\" --yes  # パターンに一致するCanaryを一括実行": "Lists, enables/disables and manually runs AWS Synthetics Canaries.\n\nExamples:\n  awstk canary ls                          # List canaries\n  awstk canary enable --name my-canary     # Enable a specific canary\n  awstk canary disable --search \"test-*\"   # Disable canaries matching a pattern\n  awstk canary enable --all                # Enable all canaries\n  awstk canary run --name my-canary        # Run a specific canary manually\n  awstk canary run --search \"api-*\" --yes  # Run all canaries matching a pattern",
+	"Canaryを無効化するコマンド": "Disable canaries",
+	"指定したCanaryを無効化（停止）します。\n    --name, --search, --all のいずれかを指定してください。": "Disables (stops) the specified canaries.\n    Specify one of --name, --search or --all.",
+	"全てのCanaryを対象": "Target all canaries",
+	"Canary名":      "Canary name",
+	"名前パターン（ワイルドカード対応）": "Name pattern (wildcards supported)",
+	"確認なしで実行":           "Run without confirmation",
+	"Canaryを有効化するコマンド":  "Enable canaries",
+	"指定したCanaryを有効化（開始）します。\n    --name, --search, --all のいずれかを指定してください。": "Enables (starts) the specified canaries.\n    Specify one of --name, --search or --all.",
+	"Canary一覧を表示するコマンド": "List canaries",
+	"Canaryを手動実行するコマンド": "Run canaries manually",
+	"指定したCanaryを手動で実行します。\n    --name または --search を指定してください。": "Runs the specified canaries manually.\n    Specify --name or --search.",
+	"名前パターン（複数指定可能、ワイルドカード対応）":                                 "Name pattern (repeatable, wildcards supported)",
+	"CloudFrontリソース操作コマンド":                                     "CloudFront resource commands",
+	"CloudFrontのキャッシュを無効化するコマンド":                               "Invalidate the CloudFront cache",
+	"CloudFrontディストリビューションのキャッシュを無効化します。\nディストリビューションIDを直接指定するか、CloudFormationスタック名から自動検出できます。\n\n【使い方】\n  awstk cf invalidate ABCD1234EFGH                    # 全体を無効化（/*）\n  awstk cf invalidate ABCD1234EFGH -p \"/images/*\"     # 特定パスを無効化\n  awstk cf invalidate -S my-stack                      # スタックから自動検出\n  awstk cf invalidate -S my-stack -p \"/api/*\" -w       # 完了まで待機\n\n【例】\n  awstk cf invalidate E2ABC123DEF456 -p \"/images/*\" -p \"/api/*\"\n  → 複数のパスを同時に無効化します": "Invalidates the cache of a CloudFront distribution.\nSpecify the distribution ID directly, or detect it automatically from a CloudFormation stack name.\n\n[Usage]\n  awstk cf invalidate ABCD1234EFGH                    # Invalidate everything (/*)\n  awstk cf invalidate ABCD1234EFGH -p \"/images/*\"     # Invalidate a specific path\n  awstk cf invalidate -S my-stack                      # Detect from the stack\n  awstk cf invalidate -S my-stack -p \"/api/*\" -w       # Wait until complete\n\n[Example]\n  awstk cf invalidate E2ABC123DEF456 -p \"/images/*\" -p \"/api/*\"\n  → Invalidates multiple paths at once",
+	"無効化するパス（デフォルト: /*）":             "Paths to invalidate (default: /*)",
+	"無効化完了まで待機":                      "Wait until the invalidation completes",
+	"CloudFrontマルチテナントディストリビューション操作": "CloudFront multi-tenant distribution commands",
+	"CloudFrontマルチテナントディストリビューションのテナントを操作するためのコマンド群です。": "Commands for operating tenants of CloudFront multi-tenant distributions.",
+	"マルチテナントディストリビューションのキャッシュを無効化":                      "Invalidate the cache of a multi-tenant distribution",
+	"CloudFrontマルチテナントディストリビューションの特定テナントまたは全テナントのキャッシュを無効化します。\n\n【使い方】\n  awstk cf tenant invalidate ABCD1234EFGH tenant-123     # 特定テナント\n  awstk cf tenant invalidate ABCD1234EFGH --all          # 全テナント\n  awstk cf tenant invalidate ABCD1234EFGH --list        # テナント一覧から選択\n\n【例】\n  awstk cf tenant invalidate E2ABC123DEF456 --all -p \"/api/*\"\n  → 全テナントの /api/* パスを無効化します": "Invalidates the cache of a specific tenant or all tenants of a CloudFront multi-tenant distribution.\n\n[Usage]\n  awstk cf tenant invalidate ABCD1234EFGH tenant-123     # A specific tenant\n  awstk cf tenant invalidate ABCD1234EFGH --all          # All tenants\n  awstk cf tenant invalidate ABCD1234EFGH --list        # Choose from the tenant list\n\n[Example]\n  awstk cf tenant invalidate E2ABC123DEF456 --all -p \"/api/*\"\n  → Invalidates the /api/* path of all tenants",
+	"全テナントを無効化":  "Invalidate all tenants",
+	"テナント一覧から選択": "Choose from the tenant list",
+	"マルチテナントディストリビューションのテナント一覧を表示":       "List tenants of a multi-tenant distribution",
+	"CloudFormationリソース操作コマンド":           "CloudFormation resource commands",
+	"CloudFormationリソースを操作するためのコマンド群です。": "Commands for operating CloudFormation resources.",
+	"CloudFormationスタックを一括削除するコマンド":      "Bulk-delete CloudFormation stacks",
+	"大文字小文字を区別してマッチ":                     "Match case-sensitively",
+	"スタック名のフィルター（部分一致）":                  "Stack name filter (partial match)",
+	"削除済みスタックに残されたリソースを削除するコマンド":         "Delete resources left behind by deleted stacks",
+	"削除済み（DELETE_COMPLETE）のCloudFormationスタックから、DeletionPolicy: Retain 等により削除されずに残されたリソース（DELETE_SKIPPED）を検出し、\n現在も存在するものを awstk cleanup all と同じ手順で削除します。\n削除済みスタックの履歴は削除から90日間のみ参照できるため、それより前に削除されたスタックは対象外です。\ncleanup all の対象にできる種類のうち対象にした種類（既定は s3・ecr・logs。--types で指定）のみ削除し、それ以外の種類は一覧に表示するのみです。\n削除前に対象の一覧を表示し、アカウントエイリアス（未設定の場合はアカウントID）の入力で確認します（--yes で省略）。\n--report を指定すると、cleanup all と同様にリソースごとの削除結果をJSON・Markdown・JUnit XMLで書き出します。\n削除に失敗したリソース、または参照元を削除できず未実行のリソースがある場合は、サマリーとレポートを出力したうえでエラー（終了コード1）で終了します。\n\n例:\n  # すべての削除済みスタックに残されたリソースを削除\n  awstk cfn orphans\n\n  # 名前に \"test-\" を含む削除済みスタックに残されたリソースを表示するのみ\n  awstk cfn orphans --filter test- --dry-run\n\n  # S3バケットとCloudWatch Logsグループのみを削除\n  awstk cfn orphans --filter test- --types s3,logs\n\n  # 削除せずにプランを作成（awstk apply で実行）\n  awstk cfn orphans --filter test- --plan-out plan.json": "Finds resources left behind by deleted (DELETE_COMPLETE) CloudFormation stacks because of DeletionPolicy: Retain or similar (DELETE_SKIPPED),\nand deletes those that still exist using the same procedure as awstk cleanup all.\nThe history of a deleted stack is only available for 90 days after deletion, so stacks deleted earlier are not covered.\nOnly the targeted resource types supported by cleanup all (s3, ecr and logs by default; set with --types) are deleted; other types are only listed.\nBefore deleting, the targets are listed and you are asked to type the account alias (or the account ID if no alias is set) to confirm (skip with --yes).\nWith --report, the per-resource results are written as JSON, Markdown or JUnit XML, as with cleanup all.\nIf any resource failed to delete, or was not run because a referencing resource could not be deleted, the command exits with an error (exit code 1) after printing the summary and writing the report.\n\nExamples:\n  # Delete resources left behind by all deleted stacks\n  awstk cfn orphans\n\n  # Only list resources left behind by deleted stacks whose names contain \"test-\"\n  awstk cfn orphans --filter test- --dry-run\n\n  # Delete only S3 buckets and CloudWatch Logs groups\n  awstk cfn orphans --filter test- --types s3,logs\n\n  # Create a plan instead of deleting (run it with awstk apply)\n  awstk cfn orphans --filter test- --plan-out plan.json",
+	"削除済みスタック名のフィルター（部分一致）":                    "Deleted stack name filter (partial match)",
+	"残されたリソースを表示するのみ（実際には削除しない）":               "Only list the resources left behind (do not delete them)",
+	"確認プロンプトをスキップ":                             "Skip the confirmation prompt",
+	"削除せずに実行計画をJSONファイルに書き出す（awstk apply で実行）": "Write an execution plan to a JSON file instead of deleting (run it with awstk apply)",
+	"削除対象のステータス（カンマ区切り）":                       "Statuses to delete (comma-separated)",
+	"CloudFormationスタックをデプロイするコマンド":            "Deploy a CloudFormation stack",
+	"指定したテンプレートファイルからCloudFormationスタックをデプロイします。\n内部的に aws cloudformation deploy コマンドを実行します。\n\n例:\n  # 基本的なデプロイ\n  awstk cfn deploy -t template.yaml -S my-stack\n\n  # パラメータを指定してデプロイ（key=value形式）\n  awstk cfn deploy -t template.yaml -S my-stack -p KeyName=mykey,InstanceType=t3.micro\n\n  # パラメータをJSONファイルから読み込み\n  awstk cfn deploy -t template.yaml -S my-stack -p params.json\n\n  # Change Setの作成のみ（実行は手動）\n  awstk cfn deploy -t template.yaml -S my-stack -n": "Deploys a CloudFormation stack from the specified template file.\nInternally runs the aws cloudformation deploy command.\n\nExamples:\n  # Basic deployment\n  awstk cfn deploy -t template.yaml -S my-stack\n\n  # Deploy with parameters (key=value format)\n  awstk cfn deploy -t template.yaml -S my-stack -p KeyName=mykey,InstanceType=t3.micro\n\n  # Read parameters from a JSON file\n  awstk cfn deploy -t template.yaml -S my-stack -p params.json\n\n  # Only create a change set (execute it manually)\n  awstk cfn deploy -t template.yaml -S my-stack -n",
+	"Change Setの作成のみで実行しない":                "Only create a change set without executing it",
+	"パラメータ（key=value形式またはJSONファイルパス）":      "Parameters (key=value format or a JSON file path)",
+	"テンプレートファイルのパス":                        "Path to the template file",
+	"CloudFormationスタックのドリフト検出を一括実行するコマンド": "Run drift detection on CloudFormation stacks in bulk",
+	"指定した条件に一致するCloudFormationスタックのドリフト検出を一括で実行します。\nフィルターによる名前の部分一致検索、または全スタックを対象にできます。\n\n例:\n  # 名前に \"prod-\" を含むスタックのドリフト検出\n  awstk cfn drift-detect --filter prod-\n\n  # すべてのスタックのドリフト検出\n  awstk cfn drift-detect --all\n\n  # 特定のスタックを指定\n  awstk cfn drift-detect stack-a stack-b stack-c\n\n  # 実行例\n  awstk cfn drift-detect --filter test-": "Runs drift detection in bulk on CloudFormation stacks matching the given conditions.\nStacks can be selected by a partial name match with a filter, or all stacks can be targeted.\n\nExamples:\n  # Detect drift on stacks whose names contain \"prod-\"\n  awstk cfn drift-detect --filter prod-\n\n  # Detect drift on all stacks\n  awstk cfn drift-detect --all\n\n  # Specify stacks\n  awstk cfn drift-detect stack-a stack-b stack-c\n\n  # Example run\n  awstk cfn drift-detect --filter test-",
+	"すべてのスタックを対象":                          "Target all stacks",
+	"CloudFormationスタックのドリフト状態を一括確認するコマンド": "Check the drift status of CloudFormation stacks in bulk",
+	"指定した条件に一致するCloudFormationスタックのドリフト状態を一括で確認します。\nフィルターによる名前の部分一致検索、または全スタックを対象にできます。\n\n例:\n  # 名前に \"prod-\" を含むスタックのドリフト状態確認\n  awstk cfn drift-status --filter prod-\n\n  # すべてのスタックのドリフト状態確認\n  awstk cfn drift-status --all\n\n  # 特定のスタックを指定\n  awstk cfn drift-status stack-a stack-b\n\n  # ドリフトしているスタックのみ表示\n  awstk cfn drift-status --filter prod- --drifted-only\n\n  # 複数アカウントのドリフト状態をアカウント列付きで表示\n  awstk cfn drift-status --all --accounts 111111111111,222222222222 --role-name ReadOnlyRole": "Checks the drift status of CloudFormation stacks matching the given conditions in bulk.\nStacks can be selected by a partial name match with a filter, or all stacks can be targeted.\n\nExamples:\n  # Check the drift status of stacks whose names contain \"prod-\"\n  awstk cfn drift-status --filter prod-\n\n  # Check the drift status of all stacks\n  awstk cfn drift-status --all\n\n  # Specify stacks\n  awstk cfn drift-status stack-a stack-b\n\n  # Show only drifted stacks\n  awstk cfn drift-status --filter prod- --drifted-only\n\n  # Show the drift status of multiple accounts with an account column\n  awstk cfn drift-status --all --accounts 111111111111,222222222222 --role-name ReadOnlyRole",
+	"ドリフトしているスタックのみ表示":                   "Show only drifted stacks",
+	"CloudFormationスタック一覧を表示するコマンド":      "List CloudFormation stacks",
+	"全てのステータスのスタックを表示":                   "Show stacks in all statuses",
+	"CloudFormationスタックの削除保護を一括設定するコマンド": "Set termination protection on CloudFormation stacks in bulk",
+	"指定した条件に一致するCloudFormationスタックの削除保護を一括で有効化または無効化します。\nフィルターによる名前の部分一致検索、またはステータスによる絞り込みが可能です。\n\n例:\n  # 名前に \"prod-\" を含むスタックの削除保護を有効化\n  awstk cfn protect --filter prod- --enable\n\n  # 特定ステータスのスタックの削除保護を無効化\n  awstk cfn protect --status CREATE_COMPLETE --disable\n\n  # 両方の条件を組み合わせ\n  awstk cfn protect --filter dev- --status UPDATE_COMPLETE --enable\n\n  # 特定のスタックを指定\n  awstk cfn protect stack-a stack-b --enable": "Enables or disables termination protection in bulk on CloudFormation stacks matching the given conditions.\nStacks can be selected by a partial name match with a filter, or by status.\n\nExamples:\n  # Enable termination protection on stacks whose names contain \"prod-\"\n  awstk cfn protect --filter prod- --enable\n\n  # Disable termination protection on stacks in a specific status\n  awstk cfn protect --status CREATE_COMPLETE --disable\n\n  # Combine both conditions\n  awstk cfn protect --filter dev- --status UPDATE_COMPLETE --enable\n\n  # Specify stacks\n  awstk cfn protect stack-a stack-b --enable",
+	"削除保護を無効化":         "Disable termination protection",
+	"削除保護を有効化":         "Enable termination protection",
+	"対象のステータス（カンマ区切り）": "Target statuses (comma-separated)",
+	"CloudFormationスタック内のリソースを一括起動するコマンド":                                           "Start all resources in a CloudFormation stack",
+	"CloudFormationスタック内のリソースを一括停止するコマンド":                                           "Stop all resources in a CloudFormation stack",
+	"AWSリソースのクリーンアップコマンド":                                                           "AWS resource cleanup commands",
+	"AWSリソースを削除するためのコマンド群です。":                                                       "Commands for deleting AWS resources.",
+	"S3バケット、ECRリポジトリ、CloudWatch Logs等を横断削除":                                         "Delete S3 buckets, ECR repositories, CloudWatch Logs and more across services",
+	"対象にするリソースの種類（カンマ区切り。既定は s3,ecr,logs。all ですべて、!種類 で除外。例: s3,dynamodb、all,!kms）": "Resource types to target (comma-separated; s3,ecr,logs by default; all for every type, exclude with !type, e.g. s3,dynamodb or all,!kms)",
+	"削除対象の検索パターン":                                                                   "Search pattern for resources to delete",
+	"CloudFormationスタックID(ARN可)":                                                    "CloudFormation stack ID (ARN accepted)",
+	"EC2インスタンス操作コマンド":                                                               "EC2 instance commands",
+	"EC2インスタンスを操作するためのコマンド群です。":                                                     "Commands for operating EC2 instances.",
+	"EC2インスタンス一覧を表示するコマンド":                                                          "List EC2 instances",
+	"EC2インスタンスを起動するコマンド":                                                            "Start an EC2 instance",
+	"EC2インスタンスID":                                                                   "EC2 instance ID",
+	"EC2インスタンスを停止するコマンド":                                                            "Stop an EC2 instance",
+	"ECRリソース操作コマンド":                                                                 "ECR resource commands",
+	"ECR（Elastic Container Registry）を操作するためのコマンド群です。":                               "Commands for operating ECR (Elastic Container Registry).",
+	"ECRリポジトリを削除するコマンド":                                                             "Delete ECR repositories",
+	"ECRリポジトリ一覧を表示するコマンド":                                                           "List ECR repositories",
+	"詳細情報を表示":                                                                       "Show details",
+	"空のリポジトリのみを表示":                                                                  "Show only empty repositories",
+	"ライフサイクルポリシー未設定のリポジトリのみを表示":                                                     "Show only repositories without a lifecycle policy",
+	"ECSリソース操作コマンド":                                                                 "ECS resource commands",
+	"ECSリソースを操作するためのコマンド群です。":                                                       "Commands for operating ECS resources.",
+	"Fargateコンテナに接続するコマンド":                                                          "Connect to a Fargate container",
+	"ECSクラスター名 (-Sが指定されていない場合に必須)":                                                  "ECS cluster name (required unless -S is specified)",
+	"接続するコンテナ名":                                                                     "Name of the container to connect to",
+	"ECSサービス名 (-Sが指定されていない場合に必須)":                                                   "ECS service name (required unless -S is specified)",
+	"ECSサービスを強制再デプロイするコマンド":                                                         "Force a new deployment of an ECS service",
+	"ECSサービスを強制再デプロイするコマンドです。\nパラメータストアの値を更新した後などに、新しい設定でタスクを再起動したい場合に使用します。\nCloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。\nデフォルトでデプロイ完了まで待機します。--no-waitフラグを指定すると、待機せずに即座に終了します。\n\n例:\n  awstk ecs redeploy -P my-profile -S my-stack\n  awstk ecs redeploy -P my-profile -c my-cluster -s my-service\n  awstk ecs redeploy -P my-profile -S my-stack --no-wait": "Forces a new deployment of an ECS service.\nUse it to restart tasks with new settings, for example after updating Parameter Store values.\nSpecify either a CloudFormation stack name or the cluster and service names directly.\nBy default it waits until the deployment completes. With the --no-wait flag it exits immediately without waiting.\n\nExamples:\n  awstk ecs redeploy -P my-profile -S my-stack\n  awstk ecs redeploy -P my-profile -c my-cluster -s my-service\n  awstk ecs redeploy -P my-profile -S my-stack --no-wait",
+	"デプロイ完了を待機せずに即座に終了する": "Exit immediately without waiting for the deployment to complete",
+	"待機タイムアウト（秒）":         "Wait timeout (seconds)",
+	"ECSタスクを実行するコマンド":     "Run an ECS task",
+	"ECSタスクを実行してその完了を待機するコマンドです。\nCloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。\nタスク定義は指定されていない場合、サービスで使用されている最新のタスク定義が使用されます。\n待機タイムアウトは--timeoutで秒数指定できます（デフォルト: 300秒）。\n\n例:\n  awstk ecs run -P my-profile -S my-stack -t app -C \"echo hello\"\n  awstk ecs run -P my-profile -c my-cluster -s my-service -t app -C \"echo hello\"\n  awstk ecs run -P my-profile -S my-stack -t app -d my-task-def:1 -C \"echo hello\"": "Runs an ECS task and waits for it to finish.\nSpecify either a CloudFormation stack name or the cluster and service names directly.\nIf no task definition is specified, the latest task definition used by the service is used.\nThe wait timeout can be set in seconds with --timeout (default: 300 seconds).\n\nExamples:\n  awstk ecs run -P my-profile -S my-stack -t app -C \"echo hello\"\n  awstk ecs run -P my-profile -c my-cluster -s my-service -t app -C \"echo hello\"\n  awstk ecs run -P my-profile -S my-stack -t app -d my-task-def:1 -C \"echo hello\"",
+	"実行するコマンド":  "Command to run",
+	"実行するコンテナ名": "Name of the container to run in",
+	"タスク定義 (指定しない場合はサービスのタスク定義を使用)": "Task definition (uses the service's task definition if omitted)",
+	"ECSサービスのキャパシティを設定して起動するコマンド":   "Set the capacity of an ECS service and start it",
+	"ECSサービスの最小・最大キャパシティを設定して起動するコマンドです。\nCloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。\n--tag を指定すると、全クラスターのうちタグ条件に一致するサービスをまとめて起動できます。\nサービスが指定したキャパシティになるまで必ず待機します。待機タイムアウトは-t/--timeoutで秒数指定できます（デフォルト: 300秒）。\n\n例:\n  awstk ecs start -P my-profile -S my-stack -m 1 -M 2\n  awstk ecs start -P my-profile -c my-cluster -s my-service -m 1 -M 3\n  awstk ecs start -P my-profile -S my-stack -m 1 -M 2\n  awstk ecs start -P my-profile --tag env=dev -m 1 -M 2": "Sets the minimum and maximum capacity of an ECS service and starts it.\nSpecify either a CloudFormation stack name or the cluster and service names directly.\nWith --tag, starts all services across all clusters that match the tag conditions at once.\nIt always waits until the service reaches the specified capacity. The wait timeout can be set in seconds with -t/--timeout (default: 300 seconds).\n\nExamples:\n  awstk ecs start -P my-profile -S my-stack -m 1 -M 2\n  awstk ecs start -P my-profile -c my-cluster -s my-service -m 1 -M 3\n  awstk ecs start -P my-profile -S my-stack -m 1 -M 2\n  awstk ecs start -P my-profile --tag env=dev -m 1 -M 2",
+	"最大キャパシティ":            "Maximum capacity",
+	"最小キャパシティ":            "Minimum capacity",
+	"ECSサービスの状態を表示するコマンド": "Show the status of an ECS service",
+	"ECSサービスのタスク稼働状況を表示するコマンドです。\nCloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。\n\n例:\n  awstk ecs status -P my-profile -S my-stack\n  awstk ecs status -P my-profile -c my-cluster -s my-service": "Shows the task status of an ECS service.\nSpecify either a CloudFormation stack name or the cluster and service names directly.\n\nExamples:\n  awstk ecs status -P my-profile -S my-stack\n  awstk ecs status -P my-profile -c my-cluster -s my-service",
+	"ECSサービスを停止するコマンド": "Stop an ECS service",
+	"ECSサービスの最小・最大キャパシティを0に設定して停止するコマンドです。\nCloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。\n--tag を指定すると、全クラスターのうちタグ条件に一致するサービスをまとめて停止できます。\nサービスが完全に停止するまで必ず待機します。待機タイムアウトは-t/--timeoutで秒数指定できます（デフォルト: 300秒）。\n\n例:\n  awstk ecs stop -P my-profile -S my-stack\n  awstk ecs stop -P my-profile -c my-cluster -s my-service\n  awstk ecs stop -P my-profile -S my-stack\n  awstk ecs stop -P my-profile --tag env=dev": "Stops an ECS service by setting its minimum and maximum capacity to 0.\nSpecify either a CloudFormation stack name or the cluster and service names directly.\nWith --tag, stops all services across all clusters that match the tag conditions at once.\nIt always waits until the service has fully stopped. The wait timeout can be set in seconds with -t/--timeout (default: 300 seconds).\n\nExamples:\n  awstk ecs stop -P my-profile -S my-stack\n  awstk ecs stop -P my-profile -c my-cluster -s my-service\n  awstk ecs stop -P my-profile -S my-stack\n  awstk ecs stop -P my-profile --tag env=dev",
+	"ELBリソース操作コマンド": "ELB resource commands",
+	"ELB（Elastic Load Balancing - ALB/NLB/GWLB）を操作するためのコマンド群です。": "Commands for operating ELB (Elastic Load Balancing - ALB/NLB/GWLB).",
+	"ロードバランサーを削除するコマンド":                                          "Delete load balancers",
+	"削除保護を解除して削除":                                                "Disable deletion protection and delete",
+	"ロードバランサータイプでフィルタ (alb, nlb, gwlb)":                          "Filter by load balancer type (alb, nlb, gwlb)",
+	"関連するターゲットグループも削除":                                           "Also delete related target groups",
+	"ロードバランサー一覧を表示するコマンド":                                        "List load balancers",
+	"削除保護が有効なもののみを表示":                                            "Show only those with deletion protection enabled",
+	"AWS環境変数の管理コマンド":                                             "AWS environment variable commands",
+	"AWS関連の環境変数を管理するためのコマンド群です。\nスタック名(AWS_STACK_NAME)やプロファイル(AWS_PROFILE)などの環境変数を設定・表示・削除できます。": "Commands for managing AWS-related environment variables.\nSets, shows and unsets environment variables such as the stack name (AWS_STACK_NAME) and profile (AWS_PROFILE).",
+	"環境変数の設定方法を表示": "Show how to set environment variables",
+	"指定した環境変数を設定するためのexportコマンドを表示します。\n\n例:\n  awstk env set -S my-stack\n  awstk env set -P my-profile\n  awstk env set -S my-stack -P my-profile": "Shows export commands for setting the specified environment variables.\n\nExamples:\n  awstk env set -S my-stack\n  awstk env set -P my-profile\n  awstk env set -S my-stack -P my-profile",
+	"設定するプロファイル名":   "Profile name to set",
+	"設定するスタック名":     "Stack name to set",
+	"環境変数と有効な設定を表示": "Show environment variables and effective settings",
+	"現在設定されているAWS関連の環境変数と、フラグ・環境変数・設定ファイルから\n解決した有効な設定（プロファイル、リージョン、スタック名など）とその取得元を表示します。\n\n設定ファイルはユーザー設定ディレクトリの awstk/config.yaml と、\nカレントディレクトリから親ディレクトリへ探索した .awstk.yaml を読み込みます。\n\n例:\n  awstk env show\n  awstk env show --env dev\n  awstk env show --output json": "Shows the AWS-related environment variables currently set, and the effective settings (profile, region, stack name, etc.)\nresolved from flags, environment variables and config files, together with their sources.\n\nConfig files are read from awstk/config.yaml in the user config directory and\nfrom .awstk.yaml found by searching from the current directory up through its parents.\n\nExamples:\n  awstk env show\n  awstk env show --env dev\n  awstk env show --output json",
+	"環境変数の削除方法を表示": "Show how to unset environment variables",
+	"指定した環境変数を削除するためのunsetコマンドを表示します。\n\n例:\n  awstk env unset -S\n  awstk env unset -P\n  awstk env unset -S -P": "Shows unset commands for removing the specified environment variables.\n\nExamples:\n  awstk env unset -S\n  awstk env unset -P\n  awstk env unset -S -P",
+	"プロファイル名を削除":            "Unset the profile name",
+	"スタック名を削除":              "Unset the stack name",
+	"変更系コマンドの実行履歴を表示するコマンド": "Show the history of mutating commands",
+	"削除・起動・停止などの変更系コマンドの実行履歴を新しい順に表示します。\n実行履歴はユーザー設定ディレクトリ配下の awstk/journal.jsonl に追記形式で記録されます。\n記録先は環境変数 AWSTK_JOURNAL_PATH で変更でき、off を指定すると記録しません。\n\n例:\n  awstk history                       # 直近20件の実行履歴を表示\n  awstk history -s test --since 7d    # 直近7日間で \"test\" を含む実行を表示\n  awstk history --failed -d           # 失敗を含む実行をリソース別に表示\n  awstk history -n 0 --output json    # 全件をJSONで出力": "Shows the history of mutating commands such as delete, start and stop, newest first.\nThe history is appended to awstk/journal.jsonl in the user config directory.\nThe location can be changed with the AWSTK_JOURNAL_PATH environment variable; set it to off to disable recording.\n\nExamples:\n  awstk history                       # Show the last 20 runs\n  awstk history -s test --since 7d    # Show runs containing \"test\" in the last 7 days\n  awstk history --failed -d           # Show runs with failures, per resource\n  awstk history -n 0 --output json    # Output everything as JSON",
+	"リソースごとの処理結果を表示":                "Show the result for each resource",
+	"失敗・中断を含む実行のみ表示":                "Show only runs with failures or interruptions",
+	"表示する最大件数（0で無制限）":               "Maximum number of entries to show (0 for unlimited)",
+	"コマンドラインまたはリソース名に含まれる文字列で絞り込み":  "Filter by a string contained in the command line or resource names",
+	"指定期間内の実行のみ表示（例: 30m, 12h, 7d）": "Show only runs within the given period (e.g. 30m, 12h, 7d)",
+	"IAMリソース操作コマンド":                 "IAM resource commands",
+	"IAMリソース（ユーザー/グループ/ロール/ポリシー）に関する操作コマンド群です。未使用のロール/ポリシーの一覧表示に対応しています。": "Commands for IAM resources (users/groups/roles/policies). Supports listing unused roles/policies.",
+	"IAMポリシー操作":  "IAM policy commands",
+	"IAMポリシーを削除": "Delete IAM policies",
+	"検索パターン・タグ条件に一致するカスタマー管理ポリシーを削除します。\n\n例:\n  awstk iam policy delete -s \"test-*\"              # パターンマッチで削除\n  awstk iam policy delete -s \"test\" --unattached   # 未アタッチ AND \"test\"含む\n  awstk iam policy delete -s \"test\" -x AWSReserved # 除外パターン指定\n  awstk iam policy delete --tag env=dev            # タグ条件に一致するポリシーを削除\n  awstk iam policy delete -s \"test\" --plan-out plan.json # 削除せずにプランを作成": "Deletes customer managed policies matching the search pattern and tag conditions.\n\nExamples:\n  awstk iam policy delete -s \"test-*\"              # Delete by pattern match\n  awstk iam policy delete -s \"test\" --unattached   # Unattached AND containing \"test\"\n  awstk iam policy delete -s \"test\" -x AWSReserved # With an exclusion pattern\n  awstk iam policy delete --tag env=dev            # Delete policies matching the tag conditions\n  awstk iam policy delete -s \"test\" --plan-out plan.json # Create a plan without deleting",
+	"除外パターン（名前に含む文字列、複数指定可）": "Exclusion pattern (string contained in the name; repeatable)",
+	"削除対象の検索パターン（必須）":        "Search pattern for resources to delete (required)",
+	"未アタッチのポリシーのみ削除":         "Delete only unattached policies",
+	"カスタマー管理ポリシー一覧を表示":       "List customer managed policies",
+	"カスタマー管理ポリシーの一覧を表示します。\n\n例:\n  awstk iam policy ls               # 全カスタマー管理ポリシー\n  awstk iam policy ls --unattached  # 未アタッチのみ\n  awstk iam policy ls -x AWSReserved\n  awstk iam policy ls --tag env=dev # タグで絞り込み": "Lists customer managed policies.\n\nExamples:\n  awstk iam policy ls               # All customer managed policies\n  awstk iam policy ls --unattached  # Unattached only\n  awstk iam policy ls -x AWSReserved\n  awstk iam policy ls --tag env=dev # Filter by tags",
+	"未アタッチのポリシーのみ表示": "Show only unattached policies",
+	"IAMロール操作":       "IAM role commands",
+	"IAMロールを削除":      "Delete IAM roles",
+	"検索パターン・タグ条件に一致するIAMロールを削除します。\n\n例:\n  awstk iam role delete -s \"test-*\"              # パターンマッチで削除\n  awstk iam role delete -s \"test\" -u 180         # 180日未使用 AND \"test\"含む\n  awstk iam role delete -s \"test\" -u             # 一度も未使用 AND \"test\"含む\n  awstk iam role delete -s \"test\" -x AWSReserved # 除外パターン指定\n  awstk iam role delete --tag env=dev --tag '!keep' # タグ条件に一致するロールを削除\n  awstk iam role delete -s \"test\" --plan-out plan.json # 削除せずにプランを作成": "Deletes IAM roles matching the search pattern and tag conditions.\n\nExamples:\n  awstk iam role delete -s \"test-*\"              # Delete by pattern match\n  awstk iam role delete -s \"test\" -u 180         # Unused for 180 days AND containing \"test\"\n  awstk iam role delete -s \"test\" -u             # Never used AND containing \"test\"\n  awstk iam role delete -s \"test\" -x AWSReserved # With an exclusion pattern\n  awstk iam role delete --tag env=dev --tag '!keep' # Delete roles matching the tag conditions\n  awstk iam role delete -s \"test\" --plan-out plan.json # Create a plan without deleting",
+	"未使用とみなす経過日数（引数なし=一度も使用なし、数値指定=指定日数以上未使用、0=全件）": "Days without use to consider a role unused (no value = never used, a number = unused for at least that many days, 0 = all)",
+	"IAMロール一覧を表示": "List IAM roles",
+	"IAMロールの一覧を表示します。\n\n例:\n  awstk iam role ls                 # 全ロール（最終使用日時つき）\n  awstk iam role ls -u               # 一度も使用されていないロールのみ\n  awstk iam role ls -u 180          # 180日以上未使用のロールのみ\n  awstk iam role ls -x AWSServiceRoleFor -x AWSReservedSSO\n  awstk iam role ls --tag env=dev     # タグで絞り込み": "Lists IAM roles.\n\nExamples:\n  awstk iam role ls                 # All roles (with last used time)\n  awstk iam role ls -u               # Only roles that have never been used\n  awstk iam role ls -u 180          # Only roles unused for 180 days or more\n  awstk iam role ls -x AWSServiceRoleFor -x AWSReservedSSO\n  awstk iam role ls --tag env=dev     # Filter by tags",
+	"CloudWatch Logsリソース操作コマンド":      "CloudWatch Logs resource commands",
+	"CloudWatch Logsグループを削除するコマンド":   "Delete CloudWatch Logs groups",
+	"空のログループのみを削除":                   "Delete only empty log groups",
+	"保存期間が未設定のログのみを削除":               "Delete only logs without a retention period",
+	"削除対象の検索パターン（ワイルドカード対応）":         "Search pattern for resources to delete (wildcards supported)",
+	"CloudWatch Logsグループ一覧を表示するコマンド": "List CloudWatch Logs groups",
+	"空のログループのみを表示":                   "Show only empty log groups",
+	"保存期間が未設定のログのみを表示":               "Show only logs without a retention period",
+	"RDSリソース操作コマンド":                  "RDS resource commands",
+	"RDSインスタンスを操作するためのコマンド群です。":      "Commands for operating RDS instances.",
+	"RDSインスタンス名":                     "RDS instance name",
+	"RDSインスタンス一覧を表示するコマンド":           "List RDS instances",
+	"RDSインスタンスを起動するコマンド":             "Start an RDS instance",
+	"RDSインスタンスを停止するコマンド":             "Stop an RDS instance",
+	"リージョン関連の操作":                     "Region commands",
+	"AWSリージョンに関する情報を取得します。\n\n使用例:\n  awstk region ls # サブコマンドでリージョン一覧を表示\n  awstk regions # エイリアスで直接リージョン一覧を表示": "Gets information about AWS regions.\n\nExamples:\n  awstk region ls # List regions with the subcommand\n  awstk regions # List regions directly with the alias",
+	"無効なリージョンも含めて全てのリージョンを表示": "Show all regions, including disabled ones",
+	"利用可能なAWSリージョンを一覧表示":      "List available AWS regions",
+	"利用可能なAWSリージョンの一覧を表示します。\n\nデフォルトでは有効なリージョン（opt-in-not-required と opted-in）のみを表示します。\n--all フラグを使用すると、無効なリージョンも含めて全てのリージョンを表示します。\n\n使用例:\n  awstk region ls\n  awstk region ls --all": "Lists available AWS regions.\n\nBy default only enabled regions (opt-in-not-required and opted-in) are shown.\nWith the --all flag, all regions including disabled ones are shown.\n\nExamples:\n  awstk region ls\n  awstk region ls --all",
+	"Route53ホストゾーン操作コマンド": "Route53 hosted zone commands",
+	"Route53のホストゾーンを管理するコマンドです。ホストゾーンの一覧表示や削除が可能です。": "Commands for managing Route53 hosted zones. Supports listing and deleting hosted zones.",
+	"delete [ドメイン名またはゾーンID]": "delete [domain name or zone ID]",
+	"ホストゾーンを削除":              "Delete a hosted zone",
+	"Route53のホストゾーンを削除します。デフォルトではドメイン名を指定します。\nホストゾーンIDを指定する場合は --id フラグを使用してください。\n--tag を指定すると、タグ条件に一致するホストゾーンをまとめて削除できます。\n\nこのコマンドは以下の処理を実行します：\n1. すべてのリソースレコードセットを削除（NSとSOAレコードを除く）\n2. ホストゾーン自体を削除\n\n【使用例】\n  awstk route53 delete example.com\n  awstk route53 delete --id Z1234567890ABC\n  awstk route53 delete --tag env=dev --tag '!keep'": "Deletes a Route53 hosted zone. By default a domain name is expected.\nTo specify a hosted zone ID, use the --id flag.\nWith --tag, deletes all hosted zones matching the tag conditions at once.\n\nThis command performs the following steps:\n1. Deletes all resource record sets (except NS and SOA records)\n2. Deletes the hosted zone itself\n\n[Examples]\n  awstk route53 delete example.com\n  awstk route53 delete --id Z1234567890ABC\n  awstk route53 delete --tag env=dev --tag '!keep'",
+	"削除対象を表示するのみ（実際には削除しない）":        "Only show what would be deleted (nothing is actually deleted)",
+	"引数をホストゾーンIDとして扱う（デフォルト：ドメイン名）": "Treat the argument as a hosted zone ID (default: domain name)",
+	"ホストゾーン一覧を表示":                   "List hosted zones",
+	"アカウント内のすべてのRoute53ホストゾーンを一覧表示します。\n\n例:\n  awstk route53 ls\n  awstk route53 ls --tag env=dev   # タグで絞り込み": "Lists all Route53 hosted zones in the account.\n\nExamples:\n  awstk route53 ls\n  awstk route53 ls --tag env=dev   # Filter by tags",
+	"S3リソース操作コマンド":          "S3 resource commands",
+	"指定したS3バケット名が利用可能かチェック": "Check whether S3 bucket names are available",
+	"指定した複数のS3バケット名が利用可能か（未作成か）を判定します。\\n\\n【使い方】\\n  awstk s3 avail bucket1 bucket2 ...\\n\\n【出力例】\\n  [404] my-bucket-1: 利用可能\\n  [200] my-bucket-2: 利用不可（すでに存在）\\n  [403] my-bucket-3: 利用不可（存在するがアクセス権限なし）": "Checks whether the given S3 bucket names are available (not yet created).\\n\\n[Usage]\\n  awstk s3 avail bucket1 bucket2 ...\\n\\n[Example output]\\n  [404] my-bucket-1: Available\\n  [200] my-bucket-2: Unavailable (already exists)\\n  [403] my-bucket-3: Unavailable (exists but access denied)",
+	"S3バケットを削除するコマンド":              "Delete S3 buckets",
+	"gunzip [バケット名/プレフィックス]":       "gunzip [bucket/prefix]",
+	"S3の.gzファイルを一括ダウンロード＆解凍するコマンド": "Bulk-download and decompress .gz files from S3",
+	"S3バケット内の指定prefix配下に存在する全ての.gzファイルを一括でダウンロードし、解凍してローカルに保存するコマンドです。\n\n【使い方】\n  awstk s3 gunzip <バケット名>[/プレフィックス] [-o 出力先ディレクトリ]\n\n【例】\n  awstk s3 gunzip my-bucket/logs/ -o ./logs/\n  awstk s3 gunzip my-bucket -o ./data/\n  → my-bucket/logs/ 配下の .gz ファイルを全部ダウンロード＆解凍して指定ディレクトリに保存します。\n\n出力先ディレクトリを省略した場合は ./outputs/ に保存されます。": "Downloads all .gz files under the given prefix of an S3 bucket, decompresses them and saves them locally.\n\n[Usage]\n  awstk s3 gunzip <bucket>[/prefix] [-o output directory]\n\n[Examples]\n  awstk s3 gunzip my-bucket/logs/ -o ./logs/\n  awstk s3 gunzip my-bucket -o ./data/\n  → Downloads and decompresses all .gz files under my-bucket/logs/ into the given directory.\n\nIf the output directory is omitted, files are saved to ./outputs/.",
+	"解凍ファイルの出力先ディレクトリ (デフォルト: ./outputs/)":                           "Output directory for decompressed files (default: ./outputs/)",
+	"S3バケット一覧、または指定S3パスをツリー形式で表示するコマンド":                              "List S3 buckets, or show an S3 path as a tree",
+	"空のバケットのみを表示":                                                    "Show only empty buckets",
+	"ファイルの更新日時も一緒に表示":                                                "Also show file last modified times",
+	"EventBridgeスケジュール管理コマンド":                                        "EventBridge schedule commands",
+	"EventBridge RulesとEventBridge Schedulerのスケジュールを管理するためのコマンド群です。": "Commands for managing EventBridge Rules and EventBridge Scheduler schedules.",
+	"スケジュールを無効化":                                                     "Disable schedules",
+	"EventBridge RuleまたはEventBridge Schedulerを無効化します。\n\n例:\n  awstk schedule disable my-rule               # 単一のスケジュールを無効化\n  awstk schedule disable --search \"test-*\"     # test-で始まる全てを無効化\n  awstk schedule disable --search \"Dev\"        # Devを含む全てを無効化": "Disables EventBridge Rules or EventBridge Scheduler schedules.\n\nExamples:\n  awstk schedule disable my-rule               # Disable a single schedule\n  awstk schedule disable --search \"test-*\"     # Disable everything starting with test-\n  awstk schedule disable --search \"Dev\"        # Disable everything containing Dev",
+	"無効化するスケジュールの検索パターン": "Search pattern for schedules to disable",
+	"スケジュールを有効化":         "Enable schedules",
+	"EventBridge RuleまたはEventBridge Schedulerを有効化します。\n\n例:\n  awstk schedule enable my-rule                # 単一のスケジュールを有効化\n  awstk schedule enable --search \"batch-*\"     # batch-で始まる全てを有効化\n  awstk schedule enable --search \"Scheduled\"   # Scheduledを含む全てを有効化": "Enables EventBridge Rules or EventBridge Scheduler schedules.\n\nExamples:\n  awstk schedule enable my-rule                # Enable a single schedule\n  awstk schedule enable --search \"batch-*\"     # Enable everything starting with batch-\n  awstk schedule enable --search \"Scheduled\"   # Enable everything containing Scheduled",
+	"有効化するスケジュールの検索パターン": "Search pattern for schedules to enable",
+	"スケジュール一覧を表示":        "List schedules",
+	"EventBridge Rules（スケジュールタイプ）とEventBridge Schedulerの一覧を表示します。\n\n例:\n  awstk schedule ls                    # 両方のスケジュールを表示\n  awstk schedule ls --type rule       # EventBridge Rulesのみ表示\n  awstk schedule ls --type scheduler  # EventBridge Schedulerのみ表示\n  awstk schedule ls --regions all     # 有効な全リージョンを検索\n  awstk schedule ls --tag env=dev     # タグで絞り込み（Schedulerはスケジュールグループのタグで判定）": "Lists EventBridge Rules (schedule type) and EventBridge Scheduler schedules.\n\nExamples:\n  awstk schedule ls                    # Show both kinds of schedules\n  awstk schedule ls --type rule       # Show only EventBridge Rules\n  awstk schedule ls --type scheduler  # Show only EventBridge Scheduler\n  awstk schedule ls --regions all     # Search all enabled regions\n  awstk schedule ls --tag env=dev     # Filter by tags (Scheduler schedules are matched by their schedule group tags)",
+	"表示タイプ (all|rule|scheduler)":    "Type to show (all|rule|scheduler)",
+	"スケジュールを手動実行":                   "Trigger a schedule manually",
+	"実行を待たずに終了":                     "Exit without waiting for the run",
+	"実行待機時間（秒）":                     "Time to wait for the run (seconds)",
+	"AWS Secrets Managerリソース操作コマンド": "AWS Secrets Manager resource commands",
+	"AWS Secrets Managerのシークレットを操作するためのコマンド群です。": "Commands for operating AWS Secrets Manager secrets.",
+	"Secrets Managerのシークレットを即時削除します。":            "Delete a Secrets Manager secret immediately.",
+	"Secrets Managerからシークレット値を取得するコマンド":          "Get a secret value from Secrets Manager",
+	"SESリソース操作コマンド":                              "SES resource commands",
+	"SES（Simple Email Service）を操作するためのコマンド群です。":  "Commands for operating SES (Simple Email Service).",
+	"SESメールアドレス検証コマンド":                           "Verify SES email addresses",
+	"指定されたファイルからメールアドレス一覧を読み込み、SESで検証リクエストを送信します。\n\n例:\n  awstk ses verify -f emails.txt": "Reads a list of email addresses from the given file and sends verification requests with SES.\n\nExample:\n  awstk ses verify -f emails.txt",
+	"メールアドレス一覧ファイル（1行1メールアドレス）":                                                            "File with email addresses (one per line)",
+	"SSM関連の操作を行うコマンド群": "SSM commands",
+	"AWS SSMセッションマネージャーを利用したEC2インスタンスへの接続やParameter Storeの操作を行うCLIコマンド群です。": "CLI commands for connecting to EC2 instances with AWS SSM Session Manager and operating Parameter Store.",
+	"ファイルからParameter Storeを一括削除": "Bulk-delete Parameter Store parameters from a file",
+	"テキストファイルに記載されたパラメータ名のリストから、AWS Systems Manager Parameter Storeのパラメータを一括削除します。\n\nファイル形式:\n  - 1行に1つのパラメータ名を記載\n  - 空行と#で始まるコメント行は無視されます\n\n--tag を指定すると、タグ条件に一致するパラメータのみを削除します。\nファイルを省略した場合は、タグ条件に一致するすべてのパラメータ（--prefix 指定時はその配下のみ）が対象です。\n\n例:\n  awstk ssm delete-params params.txt\n  awstk ssm delete-params params.txt --force\n  awstk ssm delete-params params.txt --dry-run\n  awstk ssm delete-params params.txt --prefix /myapp/  # 削除対象パラメータ名に/myapp/を付加\n  awstk ssm delete-params --tag env=dev --prefix /myapp/ --dry-run\n": "Bulk-deletes AWS Systems Manager Parameter Store parameters listed by name in a text file.\n\nFile format:\n  - One parameter name per line\n  - Empty lines and comment lines starting with # are ignored\n\nWith --tag, only parameters matching the tag conditions are deleted.\nIf the file is omitted, all parameters matching the tag conditions (under --prefix, if given) are deleted.\n\nExamples:\n  awstk ssm delete-params params.txt\n  awstk ssm delete-params params.txt --force\n  awstk ssm delete-params params.txt --dry-run\n  awstk ssm delete-params params.txt --prefix /myapp/  # Prepend /myapp/ to the parameter names\n  awstk ssm delete-params --tag env=dev --prefix /myapp/ --dry-run\n",
+	"実際には削除せず、削除対象を確認":           "Only show what would be deleted, without deleting",
+	"パラメータ名のプレフィックス":             "Parameter name prefix",
+	"ファイルからParameter Storeに一括登録": "Bulk-put Parameter Store parameters from a file",
+	"CSV/JSONファイルからAWS Systems Manager Parameter Storeにパラメータを一括登録します。\n\n対応ファイル形式:\n  - CSV (.csv): name,value,type,description の形式\n  - JSON (.json): {\"parameters\": [{\"name\": \"...\", \"value\": \"...\", \"type\": \"...\", \"description\": \"...\"}]}\n\n例:\n  awstk ssm put-params params.csv\n  awstk ssm put-params params.json --prefix /myapp/\n  awstk ssm put-params params.csv --dry-run\n": "Bulk-puts parameters into AWS Systems Manager Parameter Store from a CSV/JSON file.\n\nSupported file formats:\n  - CSV (.csv): name,value,type,description\n  - JSON (.json): {\"parameters\": [{\"name\": \"...\", \"value\": \"...\", \"type\": \"...\", \"description\": \"...\"}]}\n\nExamples:\n  awstk ssm put-params params.csv\n  awstk ssm put-params params.json --prefix /myapp/\n  awstk ssm put-params params.csv --dry-run\n",
+	"実際には登録せず、登録内容を確認":   "Only show what would be put, without putting",
+	"EC2インスタンスにSSMで接続する": "Connect to an EC2 instance via SSM",
+	"指定したEC2インスタンスIDにSSMセッションで接続します。\n\n例:\n  awstk ssm session -i <ec2-instance-id> [-P <aws-profile>]\n  awstk ssm session [-P <aws-profile>]  # インスタンス一覧から選択\n": "Connects to the specified EC2 instance ID with an SSM session.\n\nExamples:\n  awstk ssm session -i <ec2-instance-id> [-P <aws-profile>]\n  awstk ssm session [-P <aws-profile>]  # Choose from the instance list\n",
+	"EC2インスタンスID（省略時は一覧から選択）": "EC2 instance ID (choose from a list if omitted)",
+	"バージョン情報を表示":              "Show version information",
+	"awstkのバージョン情報を表示します。":    "Shows the version information of awstk.",
+	"Auroraクラスター一覧を表示します。\n\n例:\n  awstk aurora ls\n  awstk aurora ls --regions all   # 有効な全リージョンを検索\n  awstk aurora ls --tag env=dev   # タグで絞り込み":                                                                                                                                                                  "Lists Aurora clusters.\n\nExamples:\n  awstk aurora ls\n  awstk aurora ls --regions all   # Search all enabled regions\n  awstk aurora ls --tag env=dev   # Filter by tag",
+	"タグで絞り込み（key=value、key、!key、key!=value。複数指定時はすべてに一致するもの）":                                                                                                                                                                                                                                                       "Filter by tag (key=value, key, !key, key!=value; when repeated, all must match)",
+	"AWS Synthetics Canaryの一覧を表示します。\n\n例:\n  awstk canary ls\n  awstk canary ls --regions all   # 有効な全リージョンを検索\n  awstk canary ls --tag env=dev   # タグで絞り込み":                                                                                                                                                       "Lists AWS Synthetics Canaries.\n\nExamples:\n  awstk canary ls\n  awstk canary ls --regions all   # Search all enabled regions\n  awstk canary ls --tag env=dev   # Filter by tag",
+	"CloudFormationスタック一覧を表示します。\n\n例:\n  awstk cfn ls\n  awstk cfn ls --regions all   # 有効な全リージョンを検索\n  awstk cfn ls --tag env=dev   # タグで絞り込み":                                                                                                                                                                    "Lists CloudFormation stacks.\n\nExamples:\n  awstk cfn ls\n  awstk cfn ls --regions all   # Search all enabled regions\n  awstk cfn ls --tag env=dev   # Filter by tag",
+	"EC2インスタンス一覧を表示します。\n\n例:\n  awstk ec2 ls\n  awstk ec2 ls --regions all                     # 有効な全リージョンを検索\n  awstk ec2 ls --regions ap-northeast-1,us-east-1\n  awstk ec2 ls --accounts 111111111111,222222222222   # 各アカウントのロールを引き受けてアカウント列付きで表示\n  awstk ec2 ls --tag env=dev --tag '!keep'       # タグで絞り込み": "Lists EC2 instances.\n\nExamples:\n  awstk ec2 ls\n  awstk ec2 ls --regions all                     # Search all enabled regions\n  awstk ec2 ls --regions ap-northeast-1,us-east-1\n  awstk ec2 ls --accounts 111111111111,222222222222   # Assume a role in each account and show an account column\n  awstk ec2 ls --tag env=dev --tag '!keep'       # Filter by tag",
+	"EC2インスタンスを起動します。\nインスタンスIDを直接指定するか、--tag でタグ条件に一致する停止中のインスタンスをまとめて起動できます。\n\n例:\n  awstk ec2 start -i i-1234567890abcdef0\n  awstk ec2 start --tag env=dev --tag '!always-on'":                                                                                                                                 "Starts an EC2 instance.\nSpecify the instance ID directly, or use --tag to start all stopped instances matching the tag conditions.\n\nExamples:\n  awstk ec2 start -i i-1234567890abcdef0\n  awstk ec2 start --tag env=dev --tag '!always-on'",
+	"EC2インスタンスを停止します。\nインスタンスIDを直接指定するか、--tag でタグ条件に一致する実行中のインスタンスをまとめて停止できます。\n\n例:\n  awstk ec2 stop -i i-1234567890abcdef0\n  awstk ec2 stop --tag env=dev --tag '!always-on'":                                                                                                                                   "Stops an EC2 instance.\nSpecify the instance ID directly, or use --tag to stop all running instances matching the tag conditions.\n\nExamples:\n  awstk ec2 stop -i i-1234567890abcdef0\n  awstk ec2 stop --tag env=dev --tag '!always-on'",
+	"ECRリポジトリの一覧を表示します。\nイメージ数、サイズ、ライフサイクルポリシーの有無などの情報も含めて表示します。\n\n【使い方】\n  awstk ecr ls                    # リポジトリ一覧を表示\n  awstk ecr ls -e                 # 空のリポジトリのみを表示\n  awstk ecr ls -n                 # ライフサイクルポリシー未設定のリポジトリのみを表示\n  awstk ecr ls --details          # 詳細情報付きで表示\n  awstk ecr ls -e -n              # 空かつポリシー未設定のリポジトリを表示\n  awstk ecr ls --regions all      # 有効な全リージョンを検索\n  awstk ecr ls --tag env=dev      # タグが一致するリポジトリのみを表示\n\n【例】\n  awstk ecr ls -n\n  → ライフサイクルポリシーが未設定のECRリポジトリのみを一覧表示します。\n  \n  awstk ecr ls -e -d\n  → 空のリポジトリを詳細情報付きで表示します。":    "Lists ECR repositories.\nAlso shows information such as the image count, size and whether a lifecycle policy is configured.\n\n[Usage]\n  awstk ecr ls                    # List repositories\n  awstk ecr ls -e                 # Show only empty repositories\n  awstk ecr ls -n                 # Show only repositories without a lifecycle policy\n  awstk ecr ls --details          # Show with details\n  awstk ecr ls -e -n              # Show empty repositories without a policy\n  awstk ecr ls --regions all      # Search all enabled regions\n  awstk ecr ls --tag env=dev      # Show only repositories with matching tags\n\n[Examples]\n  awstk ecr ls -n\n  → Lists only ECR repositories without a lifecycle policy.\n  \n  awstk ecr ls -e -d\n  → Shows empty repositories with details.",
+	"指定したキーワードを含むロードバランサー（ALB/NLB/GWLB）を削除します。\n--tag を指定すると、タグ条件にも一致するロードバランサーのみを削除します。\n削除保護が有効な場合は --force オプションで保護を解除して削除できます。\n\n例:\n  awstk elb delete -s \"test-\" -P my-profile\n  awstk elb delete -s \"dev\" --type alb\n  awstk elb delete -s \"stg\" --with-target-groups\n  awstk elb delete -s \"prod\" --force    # 削除保護を解除して削除\n  awstk elb delete --tag env=dev --tag '!keep'   # タグで指定\n  awstk elb delete -s \"test-\" --plan-out plan.json   # 削除せずにプランを作成":                                                                                                         "Deletes load balancers (ALB/NLB/GWLB) containing the given keyword.\nWith --tag, only load balancers that also match the tag conditions are deleted.\nIf deletion protection is enabled, the --force option disables it before deleting.\n\nExamples:\n  awstk elb delete -s \"test-\" -P my-profile\n  awstk elb delete -s \"dev\" --type alb\n  awstk elb delete -s \"stg\" --with-target-groups\n  awstk elb delete -s \"prod\" --force    # Disable deletion protection and delete\n  awstk elb delete --tag env=dev --tag '!keep'   # Select by tag\n  awstk elb delete -s \"test-\" --plan-out plan.json   # Create a plan without deleting",
+	"ロードバランサー（ALB/NLB/GWLB）の一覧を表示します。\n削除保護の状態やターゲットグループ数などの情報も含めて表示します。\n\n【使い方】\n  awstk elb ls                    # 全ロードバランサー一覧を表示\n  awstk elb ls --type alb         # ALBのみを表示\n  awstk elb ls --type nlb         # NLBのみを表示\n  awstk elb ls --type gwlb        # GWLBのみを表示\n  awstk elb ls -p                 # 削除保護が有効なもののみを表示\n  awstk elb ls --details          # 詳細情報付きで表示\n  awstk elb ls --regions all      # 有効な全リージョンを検索\n  awstk elb ls --tag env=dev      # タグが一致するもののみを表示\n\n【例】\n  awstk elb ls --type nlb -p\n  → 削除保護が有効なNLBのみを一覧表示します。":                            "Lists load balancers (ALB/NLB/GWLB).\nAlso shows information such as the deletion protection status and the number of target groups.\n\n[Usage]\n  awstk elb ls                    # List all load balancers\n  awstk elb ls --type alb         # Show only ALBs\n  awstk elb ls --type nlb         # Show only NLBs\n  awstk elb ls --type gwlb        # Show only GWLBs\n  awstk elb ls -p                 # Show only those with deletion protection enabled\n  awstk elb ls --details          # Show with details\n  awstk elb ls --regions all      # Search all enabled regions\n  awstk elb ls --tag env=dev      # Show only those with matching tags\n\n[Example]\n  awstk elb ls --type nlb -p\n  → Lists only NLBs with deletion protection enabled.",
+	"CloudWatch Logsグループの一覧を表示します。\nログサイズやストリーム数、保存期間などの情報も含めて表示します。\n\n【使い方】\n  awstk logs ls                    # ログループ一覧を表示\n  awstk logs ls -e                 # 空のログループのみを表示\n  awstk logs ls -n                 # 保存期間が未設定のログのみを表示\n  awstk logs ls --details          # 詳細情報付きで表示\n  awstk logs ls -e -n              # 空かつ保存期間未設定のログを表示\n  awstk logs ls --regions all      # 有効な全リージョンを検索\n  awstk logs ls --tag env=dev      # タグが一致するロググループのみを表示\n\n【例】\n  awstk logs ls -e\n  → 空のCloudWatch Logsグループのみを一覧表示します。\n  \n  awstk logs ls -n -d\n  → 保存期間が未設定のログを詳細情報付きで表示します。": "Lists CloudWatch Logs groups.\nAlso shows information such as the log size, stream count and retention period.\n\n[Usage]\n  awstk logs ls                    # List log groups\n  awstk logs ls -e                 # Show only empty log groups\n  awstk logs ls -n                 # Show only logs without a retention period\n  awstk logs ls --details          # Show with details\n  awstk logs ls -e -n              # Show empty logs without a retention period\n  awstk logs ls --regions all      # Search all enabled regions\n  awstk logs ls --tag env=dev      # Show only log groups with matching tags\n\n[Examples]\n  awstk logs ls -e\n  → Lists only empty CloudWatch Logs groups.\n  \n  awstk logs ls -n -d\n  → Shows logs without a retention period, with details.",
+	"RDSインスタンス一覧を表示します。\n\n例:\n  awstk rds ls\n  awstk rds ls --regions all   # 有効な全リージョンを検索\n  awstk rds ls --tag env=dev   # タグで絞り込み": "Lists RDS instances.\n\nExamples:\n  awstk rds ls\n  awstk rds ls --regions all   # Search all enabled regions\n  awstk rds ls --tag env=dev   # Filter by tag",
+	"S3バケット一覧または指定されたS3パス以下のオブジェクトをツリー形式で表示します。\nS3パスを指定した場合、デフォルトでファイルサイズが表示されます。\n\n【使い方】\n  awstk s3 ls                          # バケット一覧を表示\n  awstk s3 ls -e                       # 空のバケットのみを表示\n  awstk s3 ls --tag env=dev            # タグが一致するバケットのみを表示\n  awstk s3 ls my-bucket                # バケット内をツリー形式で表示（サイズ付き）\n  awstk s3 ls my-bucket/prefix/        # 指定プレフィックス以下をツリー形式で表示（サイズ付き）\n  awstk s3 ls my-bucket -t             # 更新日時も一緒に表示\n\n【例】\n  awstk s3 ls -e\n  → 空のS3バケットのみを一覧表示します。\n  \n  awstk s3 ls my-bucket/logs/ -t\n  → my-bucket/logs/ 配下のオブジェクトをツリー形式でサイズ + 更新日時付きで表示します。": "Lists S3 buckets, or shows the objects under the given S3 path as a tree.\nWhen an S3 path is given, file sizes are shown by default.\n\n[Usage]\n  awstk s3 ls                          # List buckets\n  awstk s3 ls -e                       # Show only empty buckets\n  awstk s3 ls --tag env=dev            # Show only buckets with matching tags\n  awstk s3 ls my-bucket                # Show the bucket as a tree (with sizes)\n  awstk s3 ls my-bucket/prefix/        # Show the given prefix as a tree (with sizes)\n  awstk s3 ls my-bucket -t             # Also show last modified times\n\n[Examples]\n  awstk s3 ls -e\n  → Lists only empty S3 buckets.\n  \n  awstk s3 ls my-bucket/logs/ -t\n  → Shows the objects under my-bucket/logs/ as a tree with sizes and last modified times.",
+	"--older-than / --newer-than の判定に使用する日時（created または last-activity）":           "Timestamp used by --older-than / --newer-than (created or last-activity)",
+	"指定した期間以内に作成（--age-by last-activity の場合は最終アクティビティ）されたものに絞り込み（例: 1d、12h）":      "Only resources created (last active with --age-by last-activity) within the given period (e.g. 1d, 12h)",
+	"指定した期間より前に作成（--age-by last-activity の場合は最終アクティビティ）されたものに絞り込み（例: 14d、2w、36h）": "Only resources created (last active with --age-by last-activity) before the given period (e.g. 14d, 2w, 36h)",
+	"ガードレールに違反するリソースも、リソース名を入力して確認したうえで削除する":                                      "Also delete resources that violate the guardrail, after confirming by typing each resource name",
+	"中断した実行を実行IDを指定して再開する（前回検出した対象のうち未完了のものを削除）":                                  "Resume an interrupted run by its run ID (deletes the targets detected in the previous run that are not completed yet)",
+	"削除結果のレポートをファイルに書き出す（形式は拡張子 .json・.md・.xml から判別）":                             "Write a report of the deletion results to a file (the format is determined by the .json, .md or .xml extension)",
+	"レポートの形式（json、markdown、junit）":                                                "Report format (json, markdown or junit)",
+	"確認プロンプトを省略して実行（標準入力が端末でない場合に確認が必要な操作では必須）":                                   "Skip confirmation prompts (required for operations that ask for confirmation when stdin is not a terminal)",
+	"指定した条件に一致するCloudFormationスタックを一括削除します。\nフィルターによる名前の部分一致検索、ステータスやタグによる絞り込みが可能です。\n--older-than / --newer-than を指定すると、作成日時（--age-by last-activity の場合は最終更新日時）でも絞り込みます。\n削除前に、スタック名（複数のスタックの場合はアカウントエイリアスまたはアカウントID）の入力で確認します（--force または --yes で省略）。\n\n例:\n  # 名前に \"test-\" を含むスタックを削除\n  awstk cfn cleanup --filter test-\n\n  # 削除失敗状態のスタックをクリーンアップ\n  awstk cfn cleanup --status DELETE_FAILED,ROLLBACK_COMPLETE\n\n  # 両方の条件を組み合わせ\n  awstk cfn cleanup --filter dev- --status CREATE_FAILED\n\n  # タグで絞り込み（名前・ステータスの条件と組み合わせ可能）\n  awstk cfn cleanup --tag env=dev --tag '!keep'\n\n  # 14日以上前に作成されたテスト用スタックを削除\n  awstk cfn cleanup --filter test- --older-than 14d\n\n  # 30日以上更新されていないスタックを削除\n  awstk cfn cleanup --filter dev- --older-than 30d --age-by last-activity\n\n  # 確認プロンプトをスキップ\n  awstk cfn cleanup --filter test- --force\n\n  # 削除せずにプランを作成（awstk apply で実行）\n  awstk cfn cleanup --filter test- --plan-out plan.json": "Bulk-deletes CloudFormation stacks matching the given conditions.\nStacks can be selected by a partial name match with a filter, by status, or by tags.\nWith --older-than / --newer-than, stacks are also filtered by creation time (last update time with --age-by last-activity).\nBefore deleting, you are asked to type the stack name (the account alias or account ID for multiple stacks); skip with --force or --yes.\n\nExamples:\n  # Delete stacks whose names contain \"test-\"\n  awstk cfn cleanup --filter test-\n\n  # Clean up stacks that failed to delete\n  awstk cfn cleanup --status DELETE_FAILED,ROLLBACK_COMPLETE\n\n  # Combine both conditions\n  awstk cfn cleanup --filter dev- --status CREATE_FAILED\n\n  # Filter by tag (can be combined with name and status conditions)\n  awstk cfn cleanup --tag env=dev --tag '!keep'\n\n  # Delete test stacks created 14 or more days ago\n  awstk cfn cleanup --filter test- --older-than 14d\n\n  # Delete stacks not updated for 30 days or more\n  awstk cfn cleanup --filter dev- --older-than 30d --age-by last-activity\n\n  # Skip the confirmation prompt\n  awstk cfn cleanup --filter test- --force\n\n  # Create a plan without deleting (run it with awstk apply)\n  awstk cfn cleanup --filter test- --plan-out plan.json",
+	"指定した文字列を含むAWSリソースを一括削除するコマンドです。\n対象にできるリソースの種類は次のとおりです（既定は s3・ecr・logs のみ。それ以外の種類は --types で指定した場合のみ対象。--types all ですべて）:\n  s3 (S3バケット)、ecr (ECRリポジトリ)、logs (CloudWatch Logsグループ)、dynamodb (DynamoDBテーブル)、\n  sqs (SQSキュー)、sns (SNSトピック)、lambda (Lambda関数)、secrets (Secrets Managerのシークレット)、\n  ssm (SSMパラメータ)、kms (KMSキー)、ebs-snapshot (EBSスナップショット)、elb (ロードバランサー)、\n  target-group (ターゲットグループ)、eni (未使用のネットワークインターフェース)、security-group (セキュリティグループ)\nKMSキーはエイリアス・説明、EBSスナップショットはNameタグ・説明に検索文字列を含むものも対象にします。\nKMSキーは即時に削除できないため、7日後の削除を予約します。シークレットは7日間の復旧期間を設けて削除します（期間内は復元可能）。\nリソース間の参照（ロードバランサー→ターゲットグループ・セキュリティグループ、ENI・Lambda関数→セキュリティグループ、\nLambda関数→ロググループ、ロググループ→サブスクリプション先、S3バケット→イベント通知先）を調べ、参照しているリソースから順に削除します。\n削除できなかったリソースが参照しているリソースは削除せず、サマリーに未実行として表示します。削除保護が有効なロードバランサーは削除しません。\n--tag を指定すると、タグ条件にも一致するリソースのみを対象にします。\n--older-than / --newer-than を指定すると、作成日時（Lambda関数・SSMパラメータは最終更新日時、日時を取得できないSNSトピック・ターゲットグループ・ENI・セキュリティグループは対象外。--age-by last-activity の場合はECRは最後のイメージのプッシュ・プル、ロググループは最終イベント、シークレットは最終アクセスの日時）でも絞り込みます。\nCloudFormationスタック名またはスタックIDを指定することで、スタック内のリソースを対象にすることもできます。\n--plan-out でプランを作成できるのは s3・ecr・logs・elb のみで、それ以外の種類を対象にした場合は --plan-out を指定できません。\n削除前に対象の一覧を表示し、アカウントエイリアス（未設定の場合はアカウントID）の入力で確認します（--yes で省略）。\n--report を指定すると、リソースごとの削除結果（ARN・所要時間・エラー・削除したオブジェクト数等）をJSON・Markdown・JUnit XMLで書き出します。\n削除に失敗したリソース、または参照元を削除できず未実行のリソースがある場合は、サマリーとレポートを出力したうえでエラー（終了コード1）で終了します。\n削除を開始すると実行IDを表示し、検出した対象と進捗を実行状態ファイルに記録します。中断した場合は --resume に実行IDを指定すると、\n再検出せずに未完了のリソースから（S3バケットはオブジェクトバージョン一覧の続きから）削除を再開します。すべて完了すると実行状態ファイルは削除されます。\n\n例:\n  awstk cleanup all -s \"test\" -P my-profile\n  awstk cleanup all -S my-stack -P my-profile\n  awstk cleanup all --stack-id arn:aws:cloudformation:... -P my-profile\n  awstk cleanup all -s \"test\" --tag env=dev   # 検索文字列とタグの両方に一致するリソース\n  awstk cleanup all --tag purpose=poc --tag '!keep'\n  awstk cleanup all -s \"test\" --older-than 14d   # 14日以上前に作成されたリソース\n  awstk cleanup all -s \"test\" --types s3,dynamodb   # S3バケットとDynamoDBテーブルのみ\n  awstk cleanup all -s \"test\" --types all   # すべての種類\n  awstk cleanup all -s \"test\" --types 'all,!kms,!secrets'   # KMSキーとシークレット以外のすべての種類\n  awstk cleanup all -s \"test\" --types elb,target-group,security-group   # ロードバランサーと関連するVPCリソース\n  awstk cleanup all -s \"test\" --plan-out plan.json   # 削除せずにプランを作成\n  awstk cleanup all -s \"test\" --yes   # 確認せずに削除（CI等で実行する場合）\n  awstk cleanup all -s \"pr-123\" --yes --report report.xml   # 削除結果をJUnit XMLで書き出す（CIのテスト結果として表示）\n  awstk cleanup all --resume 20260101-120000-1a2b3c   # 中断した実行を再開\n  awstk cleanup all -s \"test\" --accounts 111111111111,222222222222   # 複数アカウントで順に削除": "Bulk-deletes AWS resources containing the given string.\nThe following resource types can be targeted (s3, ecr and logs only by default; other types are targeted only when given with --types, and --types all targets every type):\n  s3 (S3 buckets), ecr (ECR repositories), logs (CloudWatch Logs groups), dynamodb (DynamoDB tables),\n  sqs (SQS queues), sns (SNS topics), lambda (Lambda functions), secrets (Secrets Manager secrets),\n  ssm (SSM parameters), kms (KMS keys), ebs-snapshot (EBS snapshots), elb (load balancers),\n  target-group (target groups), eni (unused network interfaces), security-group (security groups)\nKMS keys whose alias or description, and EBS snapshots whose Name tag or description contain the search string are also targeted.\nKMS keys cannot be deleted immediately, so their deletion is scheduled in 7 days. Secrets are deleted with a 7-day recovery window (they can be restored within it).\nReferences between resources (load balancer → target groups and security groups, ENI and Lambda function → security groups,\nLambda function → log group, log group → subscription destination, S3 bucket → event notification destinations) are inspected, and referencing resources are deleted first.\nResources referenced by a resource that could not be deleted are not deleted and are shown as not run in the summary. Load balancers with deletion protection enabled are not deleted.\nWith --tag, only resources that also match the tag conditions are targeted.\nWith --older-than / --newer-than, resources are also filtered by creation time (the last modified time for Lambda functions and SSM parameters; SNS topics, target groups, ENIs and security groups are excluded because their time is unavailable. With --age-by last-activity: the last image push/pull for ECR, the last event for log groups and the last access for secrets).\nResources in a stack can also be targeted by specifying a CloudFormation stack name or stack ID.\nPlans can only be created with --plan-out for s3, ecr, logs and elb; --plan-out cannot be used when other types are targeted.\nBefore deleting, the targets are listed and you are asked to type the account alias (the account ID if no alias is set); skip with --yes.\nWith --report, the per-resource results (ARN, duration, error, number of objects removed, etc.) are written as JSON, Markdown or JUnit XML.\nIf any resource failed to delete, or was not run because a referencing resource could not be deleted, the command exits with an error (exit code 1) after printing the summary and writing the report.\nWhen deletion starts, a run ID is shown and the detected targets and progress are recorded in a run state file. If interrupted, pass the run ID to --resume\nto resume from the resources not completed yet without detecting them again (S3 buckets resume from where the object version listing stopped). The run state file is deleted once everything is completed.\n\nExamples:\n  awstk cleanup all -s \"test\" -P my-profile\n  awstk cleanup all -S my-stack -P my-profile\n  awstk cleanup all --stack-id arn:aws:cloudformation:... -P my-profile\n  awstk cleanup all -s \"test\" --tag env=dev   # Resources matching both the search string and the tags\n  awstk cleanup all --tag purpose=poc --tag '!keep'\n  awstk cleanup all -s \"test\" --older-than 14d   # Resources created 14 or more days ago\n  awstk cleanup all -s \"test\" --types s3,dynamodb   # Only S3 buckets and DynamoDB tables\n  awstk cleanup all -s \"test\" --types all   # All types\n  awstk cleanup all -s \"test\" --types 'all,!kms,!secrets'   # All types except KMS keys and secrets\n  awstk cleanup all -s \"test\" --types elb,target-group,security-group   # Load balancers and related VPC resources\n  awstk cleanup all -s \"test\" --plan-out plan.json   # Create a plan without deleting\n  awstk cleanup all -s \"test\" --yes   # Delete without confirmation (e.g. in CI)\n  awstk cleanup all -s \"pr-123\" --yes --report report.xml   # Write the results as JUnit XML (shown as test results in CI)\n  awstk cleanup all --resume 20260101-120000-1a2b3c   # Resume an interrupted run\n  awstk cleanup all -s \"test\" --accounts 111111111111,222222222222   # Delete in multiple accounts in turn",
+	"指定したキーワードを含むECRリポジトリを削除します。\n--tag を指定すると、タグ条件にも一致するリポジトリのみを削除します。\n--older-than / --newer-than を指定すると、作成日時（--age-by last-activity の場合は最後にイメージをプッシュ・プルした日時）でも絞り込みます。\n削除前に確認を求めます（--yes で省略。標準入力が端末でない場合は --yes が必要です）。\n\n例:\n  awstk ecr cleanup -s \"test-repo\" -P my-profile\n  awstk ecr cleanup -s \"Test\" --exact    # 大文字小文字を区別\n  awstk ecr cleanup -s \"test\" --tag env=dev   # 検索パターンとタグの両方に一致するもの\n  awstk ecr cleanup -s \"test\" --older-than 30d   # 30日以上前に作成されたもの\n  awstk ecr cleanup -s \"test\" --older-than 14d --age-by last-activity   # 14日以上プッシュ・プルされていないもの\n  awstk ecr cleanup -s \"test\" --plan-out plan.json   # 削除せずにプランを作成\n  awstk ecr cleanup -s \"test\" --yes   # 確認せずに削除":                                                                "Deletes ECR repositories containing the given keyword.\nWith --tag, only repositories that also match the tag conditions are deleted.\nWith --older-than / --newer-than, repositories are also filtered by creation time (the last image push/pull with --age-by last-activity).\nAsks for confirmation before deleting (skip with --yes; --yes is required when stdin is not a terminal).\n\nExamples:\n  awstk ecr cleanup -s \"test-repo\" -P my-profile\n  awstk ecr cleanup -s \"Test\" --exact    # Case-sensitive\n  awstk ecr cleanup -s \"test\" --tag env=dev   # Matching both the search pattern and the tags\n  awstk ecr cleanup -s \"test\" --older-than 30d   # Created 30 or more days ago\n  awstk ecr cleanup -s \"test\" --older-than 14d --age-by last-activity   # Not pushed or pulled for 14 days or more\n  awstk ecr cleanup -s \"test\" --plan-out plan.json   # Create a plan without deleting\n  awstk ecr cleanup -s \"test\" --yes   # Delete without confirmation",
+	"指定したキーワードを含むS3バケットを削除します。\n--tag を指定すると、タグ条件にも一致するバケットのみを削除します。\n--older-than / --newer-than を指定すると、作成日時でも絞り込みます（S3バケットは最終アクティビティ日時を取得できないため、--age-by last-activity でも作成日時で判定します）。\n削除前に確認を求めます（--yes で省略。標準入力が端末でない場合は --yes が必要です）。\n削除を開始すると実行IDを表示します。中断した場合は --resume に実行IDを指定すると、再検出せずに未完了のバケットから\n（オブジェクトバージョン一覧の続きから）削除を再開します。\n\n例:\n  awstk s3 cleanup -s \"test-bucket\" -P my-profile\n  awstk s3 cleanup -s \"Test\" --exact    # 大文字小文字を区別\n  awstk s3 cleanup --tag env=dev --tag '!keep'   # タグで指定\n  awstk s3 cleanup -s \"test\" --older-than 14d   # 14日以上前に作成されたもの\n  awstk s3 cleanup -s \"test\" --plan-out plan.json   # 削除せずにプランを作成\n  awstk s3 cleanup -s \"test\" --yes   # 確認せずに削除\n  awstk s3 cleanup --resume 20260101-120000-1a2b3c   # 中断した実行を再開": "Deletes S3 buckets containing the given keyword.\nWith --tag, only buckets that also match the tag conditions are deleted.\nWith --older-than / --newer-than, buckets are also filtered by creation time (S3 has no last activity time, so the creation time is used even with --age-by last-activity).\nAsks for confirmation before deleting (skip with --yes; --yes is required when stdin is not a terminal).\nWhen deletion starts, a run ID is shown. If interrupted, pass the run ID to --resume to resume from the buckets not completed yet\nwithout detecting them again (from where the object version listing stopped).\n\nExamples:\n  awstk s3 cleanup -s \"test-bucket\" -P my-profile\n  awstk s3 cleanup -s \"Test\" --exact    # Case-sensitive\n  awstk s3 cleanup --tag env=dev --tag '!keep'   # Select by tag\n  awstk s3 cleanup -s \"test\" --older-than 14d   # Created 14 or more days ago\n  awstk s3 cleanup -s \"test\" --plan-out plan.json   # Create a plan without deleting\n  awstk s3 cleanup -s \"test\" --yes   # Delete without confirmation\n  awstk s3 cleanup --resume 20260101-120000-1a2b3c   # Resume an interrupted run",
+	"指定したシークレットを復旧期間なしで即時削除します。\n--tag を指定すると、タグ条件に一致するシークレットをまとめて削除できます。\n\nこの操作は元に戻すことができません。削除前に確認を求めます（--yes で省略）。\n\n例:\n  awstk secrets delete my-secret-name\n  awstk secrets delete my-secret-name --yes   # 確認せずに削除\n  awstk secrets delete --tag env=dev --tag '!keep'":                                         "Deletes the specified secret immediately, without a recovery window.\nWith --tag, deletes all secrets matching the tag conditions at once.\n\nThis operation cannot be undone. Asks for confirmation before deleting (skip with --yes).\n\nExamples:\n  awstk secrets delete my-secret-name\n  awstk secrets delete my-secret-name --yes   # Delete without confirmation\n  awstk secrets delete --tag env=dev --tag '!keep'",
+	"Aurora DBクラスターを起動します。\nCloudFormationスタック名またはクラスター名を指定するか、--tag でタグ条件に一致する停止中のクラスターをまとめて起動できます。\nいずれも指定しない場合は、クラスター一覧から対話的に選択できます。\n\n例:\n  awstk aurora start -P my-profile -S my-stack\n  awstk aurora start -P my-profile -c my-cluster\n  awstk aurora start -P my-profile --tag env=dev":                        "Starts an Aurora DB cluster.\nSpecify either a CloudFormation stack name or the cluster name, or use --tag to start all stopped clusters matching the tag conditions.\nIf neither is specified, you can select a cluster interactively from a list.\n\nExamples:\n  awstk aurora start -P my-profile -S my-stack\n  awstk aurora start -P my-profile -c my-cluster\n  awstk aurora start -P my-profile --tag env=dev",
+	"Aurora DBクラスターを停止します。\nCloudFormationスタック名またはクラスター名を指定するか、--tag でタグ条件に一致する起動中のクラスターをまとめて停止できます。\nいずれも指定しない場合は、クラスター一覧から対話的に選択できます。\n\n例:\n  awstk aurora stop -P my-profile -S my-stack\n  awstk aurora stop -P my-profile -c my-cluster\n  awstk aurora stop -P my-profile --tag env=dev":                           "Stops an Aurora DB cluster.\nSpecify either a CloudFormation stack name or the cluster name, or use --tag to stop all available clusters matching the tag conditions.\nIf neither is specified, you can select a cluster interactively from a list.\n\nExamples:\n  awstk aurora stop -P my-profile -S my-stack\n  awstk aurora stop -P my-profile -c my-cluster\n  awstk aurora stop -P my-profile --tag env=dev",
+	"CloudFormationスタック内の起動・停止可能なリソースを一括起動します。\n対象リソース: EC2インスタンス、RDSインスタンス、Aurora DBクラスター、ECSサービス\n--tag を指定すると、タグ条件に一致するスタックのリソースをまとめて起動できます。\nスタック名と --tag のいずれも指定しない場合は、スタック一覧から対話的に選択できます。\n\n例:\n  awstk cfn start -S my-stack -P my-profile\n  awstk cfn start --tag env=dev -P my-profile":                        "Starts all startable/stoppable resources in a CloudFormation stack.\nTarget resources: EC2 instances, RDS instances, Aurora DB clusters, ECS services\nWith --tag, starts the resources of all stacks matching the tag conditions at once.\nIf neither a stack name nor --tag is given, you can select a stack interactively from the stack list.\n\nExample:\n  awstk cfn start -S my-stack -P my-profile\n  awstk cfn start --tag env=dev -P my-profile",
+	"CloudFormationスタック内の起動・停止可能なリソースを一括停止します。\n対象リソース: EC2インスタンス、RDSインスタンス、Aurora DBクラスター、ECSサービス\n--tag を指定すると、タグ条件に一致するスタックのリソースをまとめて停止できます。\nスタック名と --tag のいずれも指定しない場合は、スタック一覧から対話的に選択できます。\n\n例:\n  awstk cfn stop -S my-stack -P my-profile\n  awstk cfn stop --tag env=dev -P my-profile":                          "Stops all startable/stoppable resources in a CloudFormation stack.\nTarget resources: EC2 instances, RDS instances, Aurora DB clusters, ECS services\nWith --tag, stops the resources of all stacks matching the tag conditions at once.\nIf neither a stack name nor --tag is given, you can select a stack interactively from the stack list.\n\nExample:\n  awstk cfn stop -S my-stack -P my-profile\n  awstk cfn stop --tag env=dev -P my-profile",
+	"Fargateコンテナにシェル接続するコマンドです。\nCloudFormationスタック名を指定するか、クラスター名とサービス名を直接指定することができます。\nサービス名を省略した場合は、クラスター・サービスを一覧から対話的に選択できます。\n\n例:\n  awstk ecs exec -P my-profile -S my-stack\n  awstk ecs exec -P my-profile -c my-cluster -s my-service -t app\n  awstk ecs exec -P my-profile                # クラスター・サービスを一覧から選択": "Opens a shell in a Fargate container.\nSpecify either a CloudFormation stack name or the cluster and service names directly.\nIf the service name is omitted, you can select the cluster and service interactively from a list.\n\nExamples:\n  awstk ecs exec -P my-profile -S my-stack\n  awstk ecs exec -P my-profile -c my-cluster -s my-service -t app\n  awstk ecs exec -P my-profile                # Select the cluster and service from a list",
+	"指定したCloudWatch Logsグループを削除します。\nロググループ名の直接指定と検索パターン・タグ条件による指定に対応しています。\n検索パターン・タグ条件で指定したロググループは、--older-than / --newer-than で作成日時（--age-by last-activity の場合は最終イベント日時）による絞り込みもできます。\n削除保護が有効な場合は --force オプションで保護を解除して削除できます。\nいずれも指定しない場合は、ロググループ一覧から対話的に選択できます（Tab で複数選択）。\n\n【使い方】\n  awstk logs delete my-log-group                    # 単一のロググループを削除\n  awstk logs delete log1 log2 log3                  # 複数のロググループを削除\n  awstk logs delete --search \"/aws/lambda/*\"        # パターンに一致するロググループを削除\n  awstk logs delete --search \"test-*\" prod-log      # 検索パターンと直接指定の組み合わせ\n  awstk logs delete --search \"*\" --empty-only       # 空のロググループをすべて削除\n  awstk logs delete --search \"*\" --no-retention     # 保存期間未設定のロググループを削除\n  awstk logs delete -s \"prod-*\" --force             # 削除保護を解除して削除\n  awstk logs delete --tag env=dev --tag '!keep'     # タグ条件に一致するロググループを削除\n  awstk logs delete -s \"/aws/lambda/*\" --older-than 30d --age-by last-activity  # 30日以上ログが出力されていないものを削除\n  awstk logs delete -s \"test-*\" --plan-out plan.json # 削除せずにプランを作成\n\n【例】\n  awstk logs delete /aws/lambda/my-function\n  → 指定したLambda関数のロググループを削除します。\n\n  awstk logs delete --search \"test-*\" --empty-only\n  → test-で始まる空のロググループのみを削除します。": "Deletes the specified CloudWatch Logs groups.\nSupports log group names as well as search patterns and tag conditions.\nLog groups selected by a search pattern or tag conditions can also be filtered by creation time (the last event time with --age-by last-activity) using --older-than / --newer-than.\nIf deletion protection is enabled, the --force option disables it before deleting.\nIf none of them is specified, you can select log groups interactively from a list (Tab selects multiple).\n\n[Usage]\n  awstk logs delete my-log-group                    # Delete a single log group\n  awstk logs delete log1 log2 log3                  # Delete multiple log groups\n  awstk logs delete --search \"/aws/lambda/*\"        # Delete log groups matching a pattern\n  awstk logs delete --search \"test-*\" prod-log      # Combine a search pattern and names\n  awstk logs delete --search \"*\" --empty-only       # Delete all empty log groups\n  awstk logs delete --search \"*\" --no-retention     # Delete log groups without a retention period\n  awstk logs delete -s \"prod-*\" --force             # Disable deletion protection and delete\n  awstk logs delete --tag env=dev --tag '!keep'     # Delete log groups matching the tag conditions\n  awstk logs delete -s \"/aws/lambda/*\" --older-than 30d --age-by last-activity  # Delete those with no log events for 30 days or more\n  awstk logs delete -s \"test-*\" --plan-out plan.json # Create a plan without deleting\n\n[Examples]\n  awstk logs delete /aws/lambda/my-function\n  → Deletes the log group of the specified Lambda function.\n\n  awstk logs delete --search \"test-*\" --empty-only\n  → Deletes only empty log groups starting with test-.",
+	"RDSインスタンスを起動します。\nCloudFormationスタック名またはインスタンス名を指定するか、--tag でタグ条件に一致する停止中のインスタンスをまとめて起動できます。\nいずれも指定しない場合は、インスタンス一覧から対話的に選択できます。\n\n例:\n  awstk rds start -P my-profile -S my-stack\n  awstk rds start -P my-profile -i my-instance\n  awstk rds start -P my-profile --tag env=dev":                                           "Starts an RDS instance.\nSpecify either a CloudFormation stack name or the instance name, or use --tag to start all stopped instances matching the tag conditions.\nIf neither is specified, you can select an instance interactively from a list.\n\nExamples:\n  awstk rds start -P my-profile -S my-stack\n  awstk rds start -P my-profile -i my-instance\n  awstk rds start -P my-profile --tag env=dev",
+	"RDSインスタンスを停止します。\nCloudFormationスタック名またはインスタンス名を指定するか、--tag でタグ条件に一致する起動中のインスタンスをまとめて停止できます。\nいずれも指定しない場合は、インスタンス一覧から対話的に選択できます。\n\n例:\n  awstk rds stop -P my-profile -S my-stack\n  awstk rds stop -P my-profile -i my-instance\n  awstk rds stop -P my-profile --tag env=dev":                                              "Stops an RDS instance.\nSpecify either a CloudFormation stack name or the instance name, or use --tag to stop all available instances matching the tag conditions.\nIf neither is specified, you can select an instance interactively from a list.\n\nExamples:\n  awstk rds stop -P my-profile -S my-stack\n  awstk rds stop -P my-profile -i my-instance\n  awstk rds stop -P my-profile --tag env=dev",
+	"EventBridge RuleまたはEventBridge Schedulerを手動で実行します。\nスケジュールを一時的に\"rate(1 minute)\"に変更し、実行後に元に戻します。\nスケジュール名を省略した場合は、スケジュール一覧から対話的に選択できます。\n\n例:\n  awstk schedule trigger my-rule              # 自動でタイプを判別\n  awstk schedule trigger                      # 一覧から選択\n  awstk schedule trigger my-scheduler --no-wait # 待機せずに終了": "Runs an EventBridge Rule or EventBridge Scheduler schedule manually.\nThe schedule is temporarily changed to \"rate(1 minute)\" and restored after it runs.\nIf the schedule name is omitted, you can select a schedule interactively from the schedule list.\n\nExamples:\n  awstk schedule trigger my-rule              # Detect the type automatically\n  awstk schedule trigger                      # Select from a list\n  awstk schedule trigger my-scheduler --no-wait # Exit without waiting",
+	"指定したSecrets Managerのシークレット名またはARNから値を取得し、JSON形式で出力します。\nシークレット名を省略した場合は、シークレット一覧から対話的に選択できます。\n\n例:\n  awstk secrets get my-secret-name\n  awstk secrets get                  # 一覧から選択\n  awstk secrets get arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:my-secret-abc123":                                       "Gets the value of the given Secrets Manager secret name or ARN and outputs it as JSON.\nIf the secret name is omitted, you can select a secret interactively from the secret list.\n\nExamples:\n  awstk secrets get my-secret-name\n  awstk secrets get                  # Select from a list\n  awstk secrets get arn:aws:secretsmanager:ap-northeast-1:123456789012:secret:my-secret-abc123",
+	"MCP（Model Context Protocol）サーバー関連のコマンド":            "MCP (Model Context Protocol) server commands",
+	"awstk の操作を MCP のツールとして AI アシスタントから利用するためのコマンド群です。": "Commands for using awstk operations from AI assistants as MCP tools.",
+	"標準入出力でMCPサーバーを起動するコマンド":                            "Start an MCP server on stdio",
+	"標準入出力で MCP（Model Context Protocol）サーバーを起動し、awstk の操作をツールとして公開します。\nスタック一覧・ECSサービス状態・ACU使用状況・ドリフト状態・ロググループ一覧などの読み取り系ツールは常に公開されます。\n起動・停止・再デプロイ・ドリフト検出などの変更系ツールは、設定ファイル（.awstk.yaml）の mcp.allowTools に\n列挙したものだけが公開され、実行結果は実行履歴（awstk history）に記録されます。\n\n標準出力はMCPのメッセージ専用となり、進捗などの表示は標準エラー出力に書き出されます。\n\n例:\n  awstk mcp serve -P my-profile\n  awstk mcp serve --env dev\n\n  # MCPクライアントの設定（.mcp.json）の例\n  {\"mcpServers\": {\"awstk\": {\"command\": \"awstk\", \"args\": [\"mcp\", \"serve\", \"-P\", \"my-profile\"]}}}": "Starts an MCP (Model Context Protocol) server on stdio and exposes awstk operations as tools.\nRead-only tools such as stack lists, ECS service status, ACU usage, drift status and log group lists are always exposed.\nMutating tools such as start, stop, redeploy and drift detection are exposed only when listed in mcp.allowTools\nof the config file (.awstk.yaml), and their results are recorded in the history (awstk history).\n\nStdout is reserved for MCP messages; progress and other output is written to stderr.\n\nExamples:\n  awstk mcp serve -P my-profile\n  awstk mcp serve --env dev\n\n  # Example MCP client configuration (.mcp.json)\n  {\"mcpServers\": {\"awstk\": {\"command\": \"awstk\", \"args\": [\"mcp\", \"serve\", \"-P\", \"my-profile\"]}}}",
+}
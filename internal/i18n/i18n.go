@@ -0,0 +1,90 @@
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// Lang は表示言語
+type Lang string
+
+const (
+	Ja Lang = "ja"
+	En Lang = "en"
+)
+
+// LangEnv は表示言語を指定する環境変数（--lang と同等）
+const LangEnv = "AWSTK_LANG"
+
+var (
+	current = Ja
+	mu      sync.RWMutex
+)
+
+// catalogs は日本語の原文をキーとした言語ごとの翻訳
+// 日本語はソースコード上の文字列をそのまま使用するため、カタログを持たない
+var catalogs = map[Lang]map[string]string{
+	En: enMessages,
+}
+
+// ParseLang は言語の指定を解釈する（"ja", "en", "en_US.UTF-8" 等）
+func ParseLang(value string) (Lang, error) {
+	v := strings.ToLower(strings.TrimSpace(value))
+	switch {
+	case strings.HasPrefix(v, "ja"):
+		return Ja, nil
+	case strings.HasPrefix(v, "en"):
+		return En, nil
+	}
+	return "", fmt.Errorf("unsupported language: %s (ja|en)", value)
+}
+
+// Detect は表示言語を決定する
+// 優先順位は --lang、AWSTK_LANG、ロケール（LC_ALL, LC_MESSAGES, LANG）の順で、
+// ロケールが英語の場合のみ英語、それ以外（未設定、C、POSIX 等）は日本語とする
+func Detect(flagValue string) (Lang, error) {
+	if flagValue != "" {
+		return ParseLang(flagValue)
+	}
+	if v := os.Getenv(LangEnv); v != "" {
+		return ParseLang(v)
+	}
+	for _, name := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if v := os.Getenv(name); v != "" {
+			if strings.HasPrefix(strings.ToLower(v), "en") {
+				return En, nil
+			}
+			return Ja, nil
+		}
+	}
+	return Ja, nil
+}
+
+// SetLang は表示言語を設定する
+func SetLang(lang Lang) {
+	mu.Lock()
+	defer mu.Unlock()
+	current = lang
+}
+
+// Current は現在の表示言語を返す
+func Current() Lang {
+	mu.RLock()
+	defer mu.RUnlock()
+	return current
+}
+
+// T は日本語の原文を現在の表示言語に翻訳する
+// 翻訳が登録されていない場合は原文をそのまま返す
+func T(message string) string {
+	lang := Current()
+	if lang == Ja {
+		return message
+	}
+	if translated, ok := catalogs[lang][message]; ok {
+		return translated
+	}
+	return message
+}
@@ -0,0 +1,159 @@
+package journal
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+)
+
+// PathEnv はジャーナルファイルのパスを上書きする環境変数（"off" で記録を無効化）
+const PathEnv = "AWSTK_JOURNAL_PATH"
+
+// fileName はユーザー設定ディレクトリ配下のジャーナルファイル名
+const fileName = "journal.jsonl"
+
+// Path はジャーナルファイルのパスを返す
+// 環境変数で無効化されている場合は空文字を返す
+func Path() (string, error) {
+	if p := os.Getenv(PathEnv); p != "" {
+		if strings.EqualFold(p, "off") {
+			return "", nil
+		}
+		return p, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("ユーザー設定ディレクトリの取得に失敗: %w"), err)
+	}
+	return filepath.Join(dir, "awstk", fileName), nil
+}
+
+// Run は記録中のコマンド実行
+type Run struct {
+	mu    sync.Mutex
+	entry Entry
+	start time.Time
+}
+
+// Start はコマンド実行の記録を開始する
+func Start(command, profile, region string) *Run {
+	now := time.Now()
+	return &Run{
+		start: now,
+		entry: Entry{
+			Timestamp: now.UTC(),
+			Profile:   profile,
+			Region:    region,
+			Command:   command,
+		},
+	}
+}
+
+// Record は並列処理の結果を記録する（common.ResultRecorder として使用）
+func (r *Run) Record(resourceType, action string, results []common.ProcessResult) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, res := range results {
+		item := Result{
+			ResourceType: resourceType,
+			Resource:     res.Item,
+			Action:       action,
+			Success:      res.Success,
+			Skipped:      res.Skipped,
+		}
+		if res.Error != nil {
+			item.Error = res.Error.Error()
+		}
+		r.entry.Results = append(r.entry.Results, item)
+	}
+}
+
+// Finish はコマンドの終了結果を付けてジャーナルに追記する
+func (r *Run) Finish(err error) error {
+	r.mu.Lock()
+	entry := r.entry
+	r.mu.Unlock()
+
+	entry.DurationMs = time.Since(r.start).Milliseconds()
+	switch {
+	case err == nil:
+		entry.Status = StatusSuccess
+	case errors.Is(err, context.Canceled):
+		entry.Status = StatusCanceled
+		entry.Error = err.Error()
+	default:
+		entry.Status = StatusError
+		entry.Error = err.Error()
+	}
+
+	path, pathErr := Path()
+	if pathErr != nil {
+		return pathErr
+	}
+	if path == "" {
+		return nil
+	}
+	return appendEntry(path, entry)
+}
+
+// appendEntry はエントリを1行のJSONとしてファイル末尾に追記する
+func appendEntry(path string, entry Entry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ジャーナルのエンコードに失敗: %w"), err)
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return fmt.Errorf(i18n.T("ジャーナルディレクトリの作成に失敗: %w"), err)
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600)
+	if err != nil {
+		return fmt.Errorf(i18n.T("ジャーナルファイルを開けません: %w"), err)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf(i18n.T("ジャーナルへの書き込みに失敗: %w"), err)
+	}
+	return nil
+}
+
+// ReadAll はジャーナルの全エントリを古い順に読み込む
+// ファイルが存在しない場合は空のスライスを返す。壊れた行は読み飛ばす
+func ReadAll(path string) ([]Entry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return []Entry{}, nil
+		}
+		return nil, fmt.Errorf(i18n.T("ジャーナルファイルを開けません: %w"), err)
+	}
+	defer f.Close()
+
+	entries := []Entry{}
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(make([]byte, 64*1024), 16*1024*1024)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		var entry Entry
+		if err := json.Unmarshal([]byte(line), &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf(i18n.T("ジャーナルの読み込みに失敗: %w"), err)
+	}
+	return entries, nil
+}
@@ -0,0 +1,47 @@
+package journal
+
+import "time"
+
+// 実行結果のステータス
+const (
+	StatusSuccess  = "success"
+	StatusError    = "error"
+	StatusCanceled = "canceled"
+)
+
+// Entry はジャーナルに記録する1回のコマンド実行
+type Entry struct {
+	Timestamp  time.Time `json:"timestamp"`
+	Profile    string    `json:"profile,omitempty"`
+	Region     string    `json:"region,omitempty"`
+	Command    string    `json:"command"`
+	Status     string    `json:"status"`
+	Error      string    `json:"error,omitempty"`
+	DurationMs int64     `json:"durationMs"`
+	Results    []Result  `json:"results,omitempty"`
+}
+
+// Result はコマンド実行中の個々のリソースに対する処理結果
+type Result struct {
+	ResourceType string `json:"resourceType"`
+	Resource     string `json:"resource"`
+	Action       string `json:"action"`
+	Success      bool   `json:"success"`
+	Skipped      bool   `json:"skipped,omitempty"`
+	Error        string `json:"error,omitempty"`
+}
+
+// Counts はエントリ内の処理結果を成功・失敗・未実行で集計する
+func (e Entry) Counts() (success, failed, skipped int) {
+	for _, r := range e.Results {
+		switch {
+		case r.Skipped:
+			skipped++
+		case r.Success:
+			success++
+		default:
+			failed++
+		}
+	}
+	return
+}
@@ -0,0 +1,175 @@
+package logging
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Level はログの詳細度
+type Level int
+
+const (
+	LevelError Level = iota // 失敗のみ
+	LevelWarn               // 警告以上（-q）
+	LevelInfo               // 進捗・バナー（既定）
+	LevelDebug              // 設定の解決過程などの詳細（-v）
+	LevelTrace              // AWS API呼び出しのトレース（-vv）
+)
+
+// String はJSON形式で出力するレベル名を返す
+func (l Level) String() string {
+	switch l {
+	case LevelError:
+		return "error"
+	case LevelWarn:
+		return "warn"
+	case LevelInfo:
+		return "info"
+	case LevelDebug:
+		return "debug"
+	default:
+		return "trace"
+	}
+}
+
+// Format はログの出力形式
+type Format string
+
+const (
+	FormatText Format = "text" // メッセージをそのまま出力
+	FormatJson Format = "json" // 1行1オブジェクトのJSON
+)
+
+// Record はログの1件
+type Record struct {
+	Level    Level
+	Kind     string         // 進捗イベントの種類（JSON形式でのみ出力）
+	Resource string         // 対象のリソース名（JSON形式でのみ出力）
+	Text     string         // メッセージ（テキスト形式ではそのまま、JSON形式では前後の空白・改行を除いて出力）
+	Err      error          // 失敗の原因（JSON形式でのみ出力）
+	Fields   map[string]any // JSON形式で追加するフィールド
+}
+
+var (
+	level  = LevelInfo
+	format = FormatText
+	mu     sync.Mutex
+	// output はログの出力先（nil の場合は呼び出し時点の os.Stderr）
+	output io.Writer
+)
+
+// ParseFormat はログ形式の指定を解釈する
+func ParseFormat(value string) (Format, error) {
+	switch Format(strings.ToLower(value)) {
+	case FormatText:
+		return FormatText, nil
+	case FormatJson:
+		return FormatJson, nil
+	}
+	return "", fmt.Errorf("unsupported log format: %s (text|json)", value)
+}
+
+// Configure は出力するログのレベルと形式を設定する
+func Configure(l Level, f Format) {
+	mu.Lock()
+	defer mu.Unlock()
+	level = l
+	format = f
+}
+
+// SetOutput はログの出力先を設定する（nilで標準エラー出力に戻す）
+func SetOutput(w io.Writer) {
+	mu.Lock()
+	defer mu.Unlock()
+	output = w
+}
+
+// Enabled は指定したレベルのログが出力されるかを返す
+func Enabled(l Level) bool {
+	mu.Lock()
+	defer mu.Unlock()
+	return l <= level
+}
+
+// Log はレベルが有効な場合にログを出力する
+func Log(r Record) {
+	mu.Lock()
+	defer mu.Unlock()
+	if r.Level > level {
+		return
+	}
+	w := output
+	if w == nil {
+		w = os.Stderr
+	}
+	if format == FormatText {
+		fmt.Fprint(w, r.Text)
+		return
+	}
+
+	msg := strings.TrimSpace(r.Text)
+	if msg == "" && r.Err == nil && len(r.Fields) == 0 {
+		return // 空行（表示上の区切り）は出力しない
+	}
+	entry := map[string]any{
+		"time":  time.Now().Format(time.RFC3339Nano),
+		"level": r.Level.String(),
+		"msg":   msg,
+	}
+	if r.Kind != "" {
+		entry["kind"] = r.Kind
+	}
+	if r.Resource != "" {
+		entry["resource"] = r.Resource
+	}
+	if r.Err != nil {
+		entry["error"] = r.Err.Error()
+	}
+	for k, v := range r.Fields {
+		entry[k] = v
+	}
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	fmt.Fprintln(w, string(line))
+}
+
+// Errorf は失敗のログを出力する
+func Errorf(format string, args ...any) {
+	Log(Record{Level: LevelError, Text: fmt.Sprintf(format, args...)})
+}
+
+// Warnf は警告のログを出力する
+func Warnf(format string, args ...any) {
+	Log(Record{Level: LevelWarn, Text: fmt.Sprintf(format, args...)})
+}
+
+// Infof は進捗・バナーのログを出力する
+func Infof(format string, args ...any) {
+	Log(Record{Level: LevelInfo, Text: fmt.Sprintf(format, args...)})
+}
+
+// Debugf は詳細のログを出力する（-v 以上）
+func Debugf(format string, args ...any) {
+	Log(Record{Level: LevelDebug, Text: fmt.Sprintf(format, args...)})
+}
+
+// ProgressWriter はプログレスバーなど端末向けの表示の出力先を返す
+// -q の場合やJSON形式の場合は表示しない
+func ProgressWriter() io.Writer {
+	mu.Lock()
+	defer mu.Unlock()
+	if level < LevelInfo || format != FormatText {
+		return io.Discard
+	}
+	if output != nil {
+		return output
+	}
+	return os.Stderr
+}
@@ -0,0 +1,114 @@
+package logging
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"testing"
+)
+
+// useLogging はログのレベル・形式・出力先を設定する（テスト終了時に元に戻す）
+func useLogging(t *testing.T, l Level, f Format) *bytes.Buffer {
+	t.Helper()
+	mu.Lock()
+	previousLevel, previousFormat, previousOutput := level, format, output
+	mu.Unlock()
+	t.Cleanup(func() {
+		Configure(previousLevel, previousFormat)
+		SetOutput(previousOutput)
+	})
+
+	var buf bytes.Buffer
+	Configure(l, f)
+	SetOutput(&buf)
+	return &buf
+}
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    Format
+		wantErr bool
+	}{
+		{value: "text", want: FormatText},
+		{value: "JSON", want: FormatJson},
+		{value: "", wantErr: true},
+		{value: "logfmt", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseFormat(tt.value)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseFormat(%q) = %q, %v, want %q (wantErr %v)", tt.value, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestLevels(t *testing.T) {
+	tests := []struct {
+		name  string
+		level Level
+		want  string
+	}{
+		{name: "-q は警告以上", level: LevelWarn, want: "error\nwarn\n"},
+		{name: "既定は情報以上", level: LevelInfo, want: "error\nwarn\ninfo\n"},
+		{name: "-v は詳細を含む", level: LevelDebug, want: "error\nwarn\ninfo\ndebug\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			buf := useLogging(t, tt.level, FormatText)
+			Errorf("error\n")
+			Warnf("warn\n")
+			Infof("info\n")
+			Debugf("debug\n")
+			if got := buf.String(); got != tt.want {
+				t.Errorf("output = %q, want %q", got, tt.want)
+			}
+			if Enabled(LevelTrace) {
+				t.Error("trace must be disabled below -vv")
+			}
+		})
+	}
+}
+
+func TestJsonFormat(t *testing.T) {
+	buf := useLogging(t, LevelInfo, FormatJson)
+	Log(Record{Level: LevelWarn, Kind: "failure", Resource: "app-bucket", Text: "  ❌ 削除に失敗しました\n", Err: errors.New("AccessDenied"), Fields: map[string]any{"attempt": 2}})
+	Infof("\n") // 空行は出力しない
+	Debugf("hidden\n")
+
+	var entry map[string]any
+	if err := json.Unmarshal(buf.Bytes(), &entry); err != nil {
+		t.Fatalf("output is not a single JSON line: %q (%v)", buf.String(), err)
+	}
+	want := map[string]any{"level": "warn", "kind": "failure", "resource": "app-bucket", "msg": "❌ 削除に失敗しました", "error": "AccessDenied", "attempt": float64(2)}
+	for k, v := range want {
+		if entry[k] != v {
+			t.Errorf("%s = %v, want %v", k, entry[k], v)
+		}
+	}
+	if _, ok := entry["time"]; !ok {
+		t.Error("time is missing")
+	}
+}
+
+func TestProgressWriter(t *testing.T) {
+	tests := []struct {
+		name    string
+		level   Level
+		format  Format
+		discard bool
+	}{
+		{name: "既定は表示", level: LevelInfo, format: FormatText},
+		{name: "-q では表示しない", level: LevelWarn, format: FormatText, discard: true},
+		{name: "JSON形式では表示しない", level: LevelInfo, format: FormatJson, discard: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useLogging(t, tt.level, tt.format)
+			if got := ProgressWriter() == io.Discard; got != tt.discard {
+				t.Errorf("ProgressWriter() discards = %v, want %v", got, tt.discard)
+			}
+		})
+	}
+}
@@ -0,0 +1,388 @@
+package runstate
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+)
+
+// DirEnv は実行状態ファイルの保存先ディレクトリを上書きする環境変数（"off" で保存を無効化）
+const DirEnv = "AWSTK_RUN_STATE_DIR"
+
+// dirName はユーザー設定ディレクトリ配下の実行状態ファイルの保存先ディレクトリ名
+const dirName = "runs"
+
+// Dir は実行状態ファイルの保存先ディレクトリを返す
+// 環境変数で無効化されている場合は空文字を返す
+func Dir() (string, error) {
+	if d := os.Getenv(DirEnv); d != "" {
+		if strings.EqualFold(d, "off") {
+			return "", nil
+		}
+		return d, nil
+	}
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("ユーザー設定ディレクトリの取得に失敗: %w"), err)
+	}
+	return filepath.Join(dir, "awstk", dirName), nil
+}
+
+// Run は再開できるクリーンアップの実行状態
+// 検出した削除対象を保存した後は、処理の完了・再開位置を記録するたびにファイルに書き出す
+// nil の Run に対する操作は何もしない（状態を保存しない実行として扱う）
+type Run struct {
+	mu      sync.Mutex
+	path    string
+	state   State
+	saveErr error // 書き出しの失敗（失敗した後は書き出さない）
+}
+
+// New は新しい実行状態を作成する（削除対象を保存するまではファイルに書き出さない）
+// 環境変数で保存が無効化されている場合は nil を返す
+func New(command, account, profile, region string) (*Run, error) {
+	dir, err := Dir()
+	if err != nil || dir == "" {
+		return nil, err
+	}
+	id, err := newId()
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now().UTC()
+	return &Run{
+		path: filepath.Join(dir, id+".json"),
+		state: State{
+			Id:        id,
+			Command:   command,
+			Account:   account,
+			Profile:   profile,
+			Region:    region,
+			CreatedAt: now,
+			UpdatedAt: now,
+			Targets:   map[string][]string{},
+			Completed: map[string][]string{},
+			Markers:   map[string]map[string]string{},
+		},
+	}, nil
+}
+
+// Open は実行IDを指定して中断した実行の状態を読み込む
+// command が保存されたコマンドと異なる場合はエラーを返す
+func Open(id, command string) (*Run, error) {
+	if id == "" || filepath.Base(id) != id || strings.HasPrefix(id, ".") {
+		return nil, fmt.Errorf(i18n.T("実行IDが不正です: %s"), id)
+	}
+	dir, err := Dir()
+	if err != nil {
+		return nil, err
+	}
+	if dir == "" {
+		return nil, fmt.Errorf(i18n.T("実行状態の保存は無効化されています（環境変数 %s=off）"), DirEnv)
+	}
+
+	path := filepath.Join(dir, id+".json")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf(i18n.T("実行 %s の状態ファイルが見つかりません（完了した実行の状態ファイルは削除されます）: %s"), id, path)
+		}
+		return nil, fmt.Errorf(i18n.T("実行状態ファイルの読み込みに失敗: %w"), err)
+	}
+	var state State
+	if err := json.Unmarshal(data, &state); err != nil {
+		return nil, fmt.Errorf(i18n.T("実行状態ファイルの解析に失敗: %w"), err)
+	}
+	if state.Command != command {
+		return nil, fmt.Errorf(i18n.T("実行 %s は %s の実行のため、%s では再開できません"), id, state.Command, command)
+	}
+	if state.Targets == nil {
+		state.Targets = map[string][]string{}
+	}
+	if state.Completed == nil {
+		state.Completed = map[string][]string{}
+	}
+	if state.Markers == nil {
+		state.Markers = map[string]map[string]string{}
+	}
+	return &Run{path: path, state: state}, nil
+}
+
+// newId は作成日時とランダムな文字列から実行IDを作成する（例: 20260101-120000-1a2b3c）
+func newId() (string, error) {
+	b := make([]byte, 3)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf(i18n.T("実行IDの作成に失敗: %w"), err)
+	}
+	return time.Now().Format("20060102-150405") + "-" + hex.EncodeToString(b), nil
+}
+
+// Id は実行IDを返す
+func (r *Run) Id() string {
+	if r == nil {
+		return ""
+	}
+	return r.state.Id
+}
+
+// Account は実行時のアカウントIDを返す（取得できなかった場合は空文字）
+func (r *Run) Account() string {
+	if r == nil {
+		return ""
+	}
+	return r.state.Account
+}
+
+// Profile は実行時のプロファイルを返す
+func (r *Run) Profile() string {
+	if r == nil {
+		return ""
+	}
+	return r.state.Profile
+}
+
+// Region は実行時のリージョンを返す
+func (r *Run) Region() string {
+	if r == nil {
+		return ""
+	}
+	return r.state.Region
+}
+
+// HasTargets は削除対象が保存されているか（中断した実行の再開か）を返す
+func (r *Run) HasTargets() bool {
+	if r == nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return len(r.state.Targets) > 0
+}
+
+// Targets は保存した削除対象をリソースの種類ごとに返す
+func (r *Run) Targets() map[string][]string {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	targets := make(map[string][]string, len(r.state.Targets))
+	for resourceType, names := range r.state.Targets {
+		targets[resourceType] = slices.Clone(names)
+	}
+	return targets
+}
+
+// SetTargets は検出した削除対象を保存し、実行状態ファイルを作成する
+func (r *Run) SetTargets(targets map[string][]string) error {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.state.Targets = make(map[string][]string, len(targets))
+	for resourceType, names := range targets {
+		if len(names) > 0 {
+			r.state.Targets[resourceType] = slices.Clone(names)
+		}
+	}
+	if err := r.save(); err != nil {
+		r.saveErr = err
+		return err
+	}
+	return nil
+}
+
+// IsCompleted は前回までの実行で処理が完了したリソースかを返す
+func (r *Run) IsCompleted(resourceType, name string) bool {
+	if r == nil {
+		return false
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return slices.Contains(r.state.Completed[resourceType], name)
+}
+
+// Complete はリソースの処理が完了したことを記録する
+func (r *Run) Complete(resourceType string, names ...string) {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	changed := false
+	for _, name := range names {
+		if !slices.Contains(r.state.Completed[resourceType], name) {
+			r.state.Completed[resourceType] = append(r.state.Completed[resourceType], name)
+			changed = true
+		}
+		if _, ok := r.state.Markers[markerKey(resourceType, name)]; ok {
+			delete(r.state.Markers, markerKey(resourceType, name))
+			changed = true
+		}
+	}
+	if changed {
+		r.saveOrWarn()
+	}
+}
+
+// Remaining は処理が完了していない削除対象の数を返す
+func (r *Run) Remaining() int {
+	if r == nil {
+		return 0
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	count := 0
+	for resourceType, names := range r.state.Targets {
+		for _, name := range names {
+			if !slices.Contains(r.state.Completed[resourceType], name) {
+				count++
+			}
+		}
+	}
+	return count
+}
+
+// Checkpoint は指定したリソースの種類の進捗の記録先を返す（サービス層の処理に common.WithCheckpoint で渡す）
+func (r *Run) Checkpoint(resourceType string) common.Checkpoint {
+	return &checkpoint{run: r, resourceType: resourceType}
+}
+
+// Finish は実行を終了する
+// すべての削除対象の処理が完了した場合は実行状態ファイルを削除し、未完了の場合は再開方法を表示する
+// 実行状態を保存できなかった場合は何もしない（保存の失敗時に警告を表示済み）
+func (r *Run) Finish(ctx context.Context) {
+	if r == nil || r.failedToSave() {
+		return
+	}
+	if remaining := r.Remaining(); remaining > 0 {
+		common.Infof(ctx, i18n.T("\n⏸️  未完了のリソースが%d件あります。--resume %s で続きから再開できます\n"), remaining, r.Id())
+		return
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if err := os.Remove(r.path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		logging.Warnf(i18n.T("%s 実行状態ファイルの削除に失敗しました: %v\n"), common.WarningIcon, err)
+	}
+}
+
+// save は実行状態をファイルに書き出す（途中で中断されてもファイルが壊れないよう、一時ファイルに書き出してから置き換える）
+// 呼び出し側でロックを取得していること
+func (r *Run) save() error {
+	r.state.UpdatedAt = time.Now().UTC()
+	data, err := json.MarshalIndent(r.state, "", "  ")
+	if err != nil {
+		return fmt.Errorf(i18n.T("実行状態のエンコードに失敗: %w"), err)
+	}
+	if err := os.MkdirAll(filepath.Dir(r.path), 0o700); err != nil {
+		return fmt.Errorf(i18n.T("実行状態ディレクトリの作成に失敗: %w"), err)
+	}
+	tmp := r.path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0o600); err != nil {
+		return fmt.Errorf(i18n.T("実行状態ファイルの書き込みに失敗: %w"), err)
+	}
+	if err := os.Rename(tmp, r.path); err != nil {
+		return fmt.Errorf(i18n.T("実行状態ファイルの書き込みに失敗: %w"), err)
+	}
+	return nil
+}
+
+// failedToSave は実行状態の書き出しに失敗したことがあるかを返す
+func (r *Run) failedToSave() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.saveErr != nil
+}
+
+// saveOrWarn は実行状態をファイルに書き出し、失敗した場合は警告を表示して以降は書き出さない
+// 書き出しに失敗しても削除処理は継続する
+// 呼び出し側でロックを取得していること
+func (r *Run) saveOrWarn() {
+	if r.saveErr != nil {
+		return
+	}
+	if err := r.save(); err != nil {
+		r.saveErr = err
+		logging.Warnf(i18n.T("%s 実行状態の保存に失敗しました（中断した場合は再開できません）: %v\n"), common.WarningIcon, err)
+	}
+}
+
+// markerKey は再開位置を記録するキー（リソースの種類/リソース名）を返す
+func markerKey(resourceType, name string) string {
+	return resourceType + "/" + name
+}
+
+// checkpoint は1つのリソースの種類の進捗を実行状態に記録する common.Checkpoint の実装
+type checkpoint struct {
+	run          *Run
+	resourceType string
+}
+
+// Done はリソースの処理が完了したことを記録する
+func (c *checkpoint) Done(item string) {
+	c.run.Complete(c.resourceType, item)
+}
+
+// Marker はリソースの処理の再開位置を返す
+func (c *checkpoint) Marker(item string) map[string]string {
+	if c.run == nil {
+		return nil
+	}
+	c.run.mu.Lock()
+	defer c.run.mu.Unlock()
+	return c.run.state.Markers[markerKey(c.resourceType, item)]
+}
+
+// SetMarker はリソースの処理の再開位置を記録する
+func (c *checkpoint) SetMarker(item string, marker map[string]string) {
+	if c.run == nil {
+		return
+	}
+	c.run.mu.Lock()
+	defer c.run.mu.Unlock()
+	key := markerKey(c.resourceType, item)
+	if marker == nil {
+		if _, ok := c.run.state.Markers[key]; !ok {
+			return
+		}
+		delete(c.run.state.Markers, key)
+	} else {
+		c.run.state.Markers[key] = marker
+	}
+	c.run.saveOrWarn()
+}
+
+// Ids は保存されている（中断した）実行の実行IDを新しい順に返す
+func Ids() []string {
+	dir, err := Dir()
+	if err != nil || dir == "" {
+		return nil
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil
+	}
+	var ids []string
+	for _, e := range entries {
+		if id, ok := strings.CutSuffix(e.Name(), ".json"); ok && !e.IsDir() {
+			ids = append(ids, id)
+		}
+	}
+	slices.Sort(ids)
+	slices.Reverse(ids)
+	return ids
+}
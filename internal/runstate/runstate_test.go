@@ -0,0 +1,102 @@
+package runstate
+
+import (
+	"context"
+	"maps"
+	"os"
+	"slices"
+	"testing"
+
+	"github.com/ngsw877/awstk/internal/service/common"
+)
+
+func TestResumeMarkers(t *testing.T) {
+	t.Setenv(DirEnv, t.TempDir())
+	ctx := context.Background()
+
+	run, err := New("cleanup all", "123456789012", "dev", "ap-northeast-1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := run.SetTargets(map[string][]string{"s3": {"bucket-a", "bucket-b"}}); err != nil {
+		t.Fatal(err)
+	}
+	if ids := Ids(); !slices.Equal(ids, []string{run.Id()}) {
+		t.Fatalf("Ids() = %v, want [%s]", ids, run.Id())
+	}
+
+	// サービス層の処理と同じく、コンテキスト経由で進捗を記録する
+	cpCtx := common.WithCheckpoint(ctx, run.Checkpoint("s3"))
+	marker := map[string]string{"keyMarker": "logs/2026/01.gz", "versionIdMarker": "v3"}
+	common.SaveMarker(cpCtx, "bucket-a", marker)
+	common.MarkDone(cpCtx, "bucket-b")
+
+	// 中断後に再開した実行で、再開位置と完了したリソースを引き継ぐ
+	resumed, err := Open(run.Id(), "cleanup all")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resumed.Account() != "123456789012" || resumed.Profile() != "dev" || resumed.Region() != "ap-northeast-1" {
+		t.Errorf("resumed account/profile/region = %s/%s/%s", resumed.Account(), resumed.Profile(), resumed.Region())
+	}
+	resumedCtx := common.WithCheckpoint(ctx, resumed.Checkpoint("s3"))
+	if got := common.ResumeMarker(resumedCtx, "bucket-a"); !maps.Equal(got, marker) {
+		t.Errorf("ResumeMarker = %v, want %v", got, marker)
+	}
+	if !resumed.IsCompleted("s3", "bucket-b") || resumed.IsCompleted("s3", "bucket-a") {
+		t.Error("completed resources were not restored")
+	}
+	if got := resumed.Remaining(); got != 1 {
+		t.Errorf("Remaining() = %d, want 1", got)
+	}
+	if _, err := Open(run.Id(), "cfn orphans"); err == nil {
+		t.Error("Open() resumed a run of another command")
+	}
+
+	// 完了したリソースの再開位置は削除し、すべて完了したら状態ファイルを削除する
+	resumed.Complete("s3", "bucket-a")
+	if got := common.ResumeMarker(resumedCtx, "bucket-a"); got != nil {
+		t.Errorf("marker after Complete = %v, want nil", got)
+	}
+	resumed.Finish(ctx)
+	if _, err := os.Stat(resumed.path); !os.IsNotExist(err) {
+		t.Errorf("state file still exists after Finish: %v", err)
+	}
+	if _, err := Open(run.Id(), "cleanup all"); err == nil {
+		t.Error("Open() succeeded for a finished run")
+	}
+}
+
+func TestUnfinishedRunKeepsState(t *testing.T) {
+	t.Setenv(DirEnv, t.TempDir())
+
+	run, err := New("cleanup all", "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := run.SetTargets(map[string][]string{"logs": {"/aws/lambda/app"}}); err != nil {
+		t.Fatal(err)
+	}
+	run.Finish(context.Background())
+	if _, err := os.Stat(run.path); err != nil {
+		t.Errorf("state file of an unfinished run was removed: %v", err)
+	}
+}
+
+func TestDisabled(t *testing.T) {
+	t.Setenv(DirEnv, "off")
+
+	run, err := New("cleanup all", "", "", "")
+	if err != nil || run != nil {
+		t.Fatalf("New() = %v, %v, want nil run when disabled", run, err)
+	}
+	// nil の Run に対する操作は何もしない
+	if err := run.SetTargets(map[string][]string{"s3": {"bucket-a"}}); err != nil {
+		t.Error(err)
+	}
+	run.Complete("s3", "bucket-a")
+	run.Finish(context.Background())
+	if _, err := Open("20260101-000000-abcdef", "cleanup all"); err == nil {
+		t.Error("Open() succeeded while disabled")
+	}
+}
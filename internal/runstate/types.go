@@ -0,0 +1,17 @@
+package runstate
+
+import "time"
+
+// State は実行状態ファイルに保存する内容
+type State struct {
+	Id        string                       `json:"id"`
+	Command   string                       `json:"command"` // 例: "cleanup all"
+	Account   string                       `json:"account,omitempty"`
+	Profile   string                       `json:"profile,omitempty"`
+	Region    string                       `json:"region,omitempty"`
+	CreatedAt time.Time                    `json:"createdAt"`
+	UpdatedAt time.Time                    `json:"updatedAt"`
+	Targets   map[string][]string          `json:"targets"`           // リソースの種類ごとの削除対象
+	Completed map[string][]string          `json:"completed"`         // リソースの種類ごとの処理が完了したリソース
+	Markers   map[string]map[string]string `json:"markers,omitempty"` // 処理の途中のリソースの再開位置（キーは「種類/名前」。例: S3のオブジェクトバージョン一覧のマーカー）
+}
@@ -0,0 +1,209 @@
+package apply
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/confirm"
+	ecrsvc "github.com/ngsw877/awstk/internal/service/ecr"
+	elbsvc "github.com/ngsw877/awstk/internal/service/elb"
+	iampolicy "github.com/ngsw877/awstk/internal/service/iam/policy"
+	iamrole "github.com/ngsw877/awstk/internal/service/iam/role"
+	logssvc "github.com/ngsw877/awstk/internal/service/logs"
+	"github.com/ngsw877/awstk/internal/service/plan"
+	s3svc "github.com/ngsw877/awstk/internal/service/s3"
+)
+
+// handler はリソース種別ごとの再検証・実行処理
+type handler struct {
+	// current はプランのアクションに対応するリソースの現在の状態をアクションとして返す
+	current func(ctx context.Context, actions []plan.Action) ([]plan.Action, error)
+	// apply はアクションを実行する
+	apply func(ctx context.Context, actions []plan.Action) common.CleanupResult
+}
+
+// newHandlers はリソース種別ごとのハンドラーを作成する
+func newHandlers(clients ClientSet) map[string]handler {
+	return map[string]handler{
+		plan.ResourceS3Bucket: {
+			current: func(ctx context.Context, actions []plan.Action) ([]plan.Action, error) {
+				return s3svc.PlanBucketDeletion(ctx, clients.S3Client, plan.Names(actions))
+			},
+			apply: func(ctx context.Context, actions []plan.Action) common.CleanupResult {
+				return s3svc.ApplyBucketDeletion(ctx, clients.S3Client, actions)
+			},
+		},
+		plan.ResourceEcrRepository: {
+			current: func(ctx context.Context, actions []plan.Action) ([]plan.Action, error) {
+				return ecrsvc.PlanRepositoryDeletion(ctx, clients.EcrClient, plan.Names(actions))
+			},
+			apply: func(ctx context.Context, actions []plan.Action) common.CleanupResult {
+				return ecrsvc.ApplyRepositoryDeletion(ctx, clients.EcrClient, actions)
+			},
+		},
+		plan.ResourceLogGroup: {
+			current: func(ctx context.Context, actions []plan.Action) ([]plan.Action, error) {
+				return logssvc.PlanLogGroupDeletion(ctx, clients.LogsClient, plan.Names(actions), false)
+			},
+			apply: func(ctx context.Context, actions []plan.Action) common.CleanupResult {
+				return logssvc.ApplyLogGroupDeletion(ctx, clients.LogsClient, actions)
+			},
+		},
+		plan.ResourceIamRole: {
+			current: func(ctx context.Context, actions []plan.Action) ([]plan.Action, error) {
+				return iamrole.PlanRoleDeletion(ctx, clients.IamClient, plan.Names(actions))
+			},
+			apply: func(ctx context.Context, actions []plan.Action) common.CleanupResult {
+				return iamrole.ApplyRoleDeletion(ctx, clients.IamClient, actions)
+			},
+		},
+		plan.ResourceIamPolicy: {
+			current: func(ctx context.Context, actions []plan.Action) ([]plan.Action, error) {
+				return iampolicy.PlanPolicyDeletion(ctx, clients.IamClient, iampolicy.PolicyItemsFromActions(actions))
+			},
+			apply: func(ctx context.Context, actions []plan.Action) common.CleanupResult {
+				return iampolicy.ApplyPolicyDeletion(ctx, clients.IamClient, actions)
+			},
+		},
+		plan.ResourceLoadBalancer: {
+			current: func(ctx context.Context, actions []plan.Action) ([]plan.Action, error) {
+				return elbsvc.PlanLoadBalancerDeletion(ctx, clients.ElbClient, plan.Names(actions), false, false)
+			},
+			apply: func(ctx context.Context, actions []plan.Action) common.CleanupResult {
+				return elbsvc.ApplyLoadBalancerDeletion(ctx, clients.ElbClient, actions)
+			},
+		},
+		plan.ResourceCfnStack: {
+			current: func(ctx context.Context, actions []plan.Action) ([]plan.Action, error) {
+				return cfn.PlanStackDeletion(ctx, clients.CfnClient, plan.Names(actions))
+			},
+			apply: func(ctx context.Context, actions []plan.Action) common.CleanupResult {
+				return cfn.ApplyStackDeletion(ctx, clients.CfnClient, actions)
+			},
+		},
+	}
+}
+
+// Run はプランを再検証したうえで、記録されたアクションをそのまま実行する
+// プラン作成後にリソースが削除・変更されていた場合は、SkipChanged が指定されない限り何も実行しない
+// 実行前に削除してよいかを確認する（--yes が指定されている場合は省略）
+// 実行した場合は種類ごとの削除結果を返す（実行しなかった場合は nil）
+func Run(ctx context.Context, clients ClientSet, p *plan.Plan, opts Options) ([]common.CleanupResult, error) {
+	if len(p.Actions) == 0 {
+		common.Infof(ctx, "%s\n", i18n.T("プランに実行するアクションがありません"))
+		return nil, nil
+	}
+
+	plan.Print(p)
+
+	handlers := newHandlers(clients)
+	for _, a := range p.Actions {
+		if a.Action != plan.ActionDelete {
+			return nil, fmt.Errorf(i18n.T("未対応のアクションです: %s (%s)"), a.Action, a.Name)
+		}
+		if _, ok := handlers[a.Type]; !ok {
+			return nil, fmt.Errorf(i18n.T("未対応のリソース種別です: %s (%s)"), a.Type, a.Name)
+		}
+	}
+
+	// 再検証（リソースが存在し、プラン作成時から変わっていないこと）
+	common.Infof(ctx, i18n.T("\n%s リソースの現在の状態を確認しています...\n"), common.SearchIcon)
+	valid := make(map[string][]plan.Action)
+	var mismatches []plan.Mismatch
+	for _, t := range p.Types() {
+		planned := p.ActionsOf(t)
+		current, err := handlers[t].current(ctx, planned)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("%s の状態確認に失敗: %w"), t, err)
+		}
+		typeMismatches := plan.Verify(planned, current)
+		mismatches = append(mismatches, typeMismatches...)
+		valid[t] = excludeMismatched(planned, typeMismatches)
+	}
+
+	if len(mismatches) > 0 {
+		common.Warnf(ctx, i18n.T("\n%s  %d件のリソースがプラン作成時と一致しません:\n"), common.WarningIcon, len(mismatches))
+		for _, m := range mismatches {
+			common.Warnf(ctx, "   - [%s] %s: %s\n", m.Action.Type, m.Action.Name, m.Reason)
+		}
+		if !opts.SkipChanged {
+			return nil, errors.New(i18n.T("プランが現在の状態と一致しないため実行を中止しました（一致するものだけ実行するには --skip-changed を指定、または新しいプランを作成してください）"))
+		}
+		common.Infof(ctx, "%s\n", i18n.T("--skip-changed により、一致しないリソースをスキップして実行します"))
+	}
+
+	// 実行前の確認（--yes で省略）
+	count := 0
+	for _, actions := range valid {
+		count += len(actions)
+	}
+	if count == 0 {
+		common.Infof(ctx, "%s\n", i18n.T("実行できるアクションがありません"))
+		return nil, nil
+	}
+	ok, err := confirm.AskDeletion(i18n.T("プランのリソース"), count)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, nil
+	}
+
+	// 実行
+	var results []common.CleanupResult
+	for _, t := range p.Types() {
+		actions := valid[t]
+		if len(actions) == 0 {
+			continue
+		}
+		if ctx.Err() != nil {
+			results = append(results, skippedResult(t, actions, ctx.Err()))
+			continue
+		}
+		common.Infof(ctx, i18n.T("\n%s %s の削除を開始...\n"), common.ProcessIcon, t)
+		results = append(results, handlers[t].apply(ctx, actions))
+	}
+
+	if err := ctx.Err(); err != nil {
+		return results, fmt.Errorf(i18n.T("プランの実行が中断されました: %w"), err)
+	}
+	failed := 0
+	for _, r := range results {
+		failed += len(r.Failed)
+	}
+	if failed > 0 {
+		return results, fmt.Errorf(i18n.T("%d件のアクションが失敗しました"), failed)
+	}
+	return results, nil
+}
+
+// excludeMismatched は再検証で一致しなかったアクションを除外する
+func excludeMismatched(actions []plan.Action, mismatches []plan.Mismatch) []plan.Action {
+	if len(mismatches) == 0 {
+		return actions
+	}
+	excluded := make(map[string]bool, len(mismatches))
+	for _, m := range mismatches {
+		excluded[m.Action.Name] = true
+	}
+	var result []plan.Action
+	for _, a := range actions {
+		if !excluded[a.Name] {
+			result = append(result, a)
+		}
+	}
+	return result
+}
+
+// skippedResult は中断により実行しなかったリソース種別の結果を作成する
+func skippedResult(resourceType string, actions []plan.Action, err error) common.CleanupResult {
+	results := make([]common.ProcessResult, len(actions))
+	for i, a := range actions {
+		results[i] = common.SkippedResult(a.Name, err)
+	}
+	return common.CollectCleanupResult(resourceType, results)
+}
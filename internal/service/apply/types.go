@@ -0,0 +1,26 @@
+package apply
+
+import (
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	ecrsvc "github.com/ngsw877/awstk/internal/service/ecr"
+	logssvc "github.com/ngsw877/awstk/internal/service/logs"
+	s3svc "github.com/ngsw877/awstk/internal/service/s3"
+
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	sdkiam "github.com/aws/aws-sdk-go-v2/service/iam"
+)
+
+// ClientSet はプランの実行に必要なクライアントをまとめた構造体
+type ClientSet struct {
+	S3Client   s3svc.S3Api
+	EcrClient  ecrsvc.EcrApi
+	CfnClient  cfn.CfnApi
+	LogsClient logssvc.LogsApi
+	IamClient  *sdkiam.Client
+	ElbClient  *elasticloadbalancingv2.Client
+}
+
+// Options はプラン実行時のオプション
+type Options struct {
+	SkipChanged bool // プラン作成後に変更・削除されたリソースをスキップして残りを実行する
+}
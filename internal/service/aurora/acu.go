@@ -2,9 +2,14 @@ package aurora
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
 	"time"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatch/types"
@@ -12,19 +17,19 @@ import (
 )
 
 // GetAuroraCapacityInfo Aurora Serverless v2のAcu情報を取得
-func GetAuroraCapacityInfo(rdsClient *rds.Client, cwClient *cloudwatch.Client, clusterName string) (*CapacityInfo, error) {
+func GetAuroraCapacityInfo(ctx context.Context, rdsClient *rds.Client, cwClient *cloudwatch.Client, clusterName string) (*CapacityInfo, error) {
 	// まずクラスター情報を取得
 	describeInput := &rds.DescribeDBClustersInput{
 		DBClusterIdentifier: aws.String(clusterName),
 	}
 
-	result, err := rdsClient.DescribeDBClusters(context.Background(), describeInput)
+	result, err := rdsClient.DescribeDBClusters(ctx, describeInput)
 	if err != nil {
-		return nil, fmt.Errorf("クラスター情報の取得に失敗: %w", err)
+		return nil, fmt.Errorf(i18n.T("クラスター情報の取得に失敗: %w"), err)
 	}
 
 	if len(result.DBClusters) == 0 {
-		return nil, fmt.Errorf("クラスター '%s' が見つかりません", clusterName)
+		return nil, fmt.Errorf(i18n.T("クラスター '%s' が見つかりません"), clusterName)
 	}
 
 	cluster := result.DBClusters[0]
@@ -45,7 +50,7 @@ func GetAuroraCapacityInfo(rdsClient *rds.Client, cwClient *cloudwatch.Client, c
 	info.MaxAcu = aws.ToFloat64(scaling.MaxCapacity)
 
 	// CloudWatchから現在のAcuを取得
-	currentAcu, err := getCurrentAcuFromCloudWatch(cwClient, clusterName)
+	currentAcu, err := getCurrentAcuFromCloudWatch(ctx, cwClient, clusterName)
 	if err != nil {
 		// エラーがあっても部分的な情報は返す（エラーメッセージは表示しない）
 		// CloudWatchにデータがない場合やアクセス権限がない場合がある
@@ -58,7 +63,7 @@ func GetAuroraCapacityInfo(rdsClient *rds.Client, cwClient *cloudwatch.Client, c
 }
 
 // getCurrentAcuFromCloudWatch CloudWatchから現在のAcu値を取得
-func getCurrentAcuFromCloudWatch(cwClient *cloudwatch.Client, clusterName string) (float64, error) {
+func getCurrentAcuFromCloudWatch(ctx context.Context, cwClient *cloudwatch.Client, clusterName string) (float64, error) {
 	now := time.Now()
 	startTime := now.Add(-5 * time.Minute) // 過去5分間に拡大（データがない可能性を考慮）
 
@@ -77,13 +82,13 @@ func getCurrentAcuFromCloudWatch(cwClient *cloudwatch.Client, clusterName string
 		Statistics: []types.Statistic{types.StatisticAverage},
 	}
 
-	result, err := cwClient.GetMetricStatistics(context.Background(), input)
+	result, err := cwClient.GetMetricStatistics(ctx, input)
 	if err != nil {
 		return 0, err
 	}
 
 	if len(result.Datapoints) == 0 {
-		return 0, fmt.Errorf("Acuメトリクスが取得できません")
+		return 0, errors.New(i18n.T("Acuメトリクスが取得できません"))
 	}
 
 	// 最新のデータポイントを探す
@@ -99,16 +104,16 @@ func getCurrentAcuFromCloudWatch(cwClient *cloudwatch.Client, clusterName string
 }
 
 // ListAuroraCapacityInfo 複数クラスターのAcu情報を取得
-func ListAuroraCapacityInfo(rdsClient *rds.Client, cwClient *cloudwatch.Client) ([]CapacityInfo, error) {
+func ListAuroraCapacityInfo(ctx context.Context, rdsClient *rds.Client, cwClient *cloudwatch.Client) ([]CapacityInfo, error) {
 	// 全クラスターを取得
-	clusters, err := getAllAuroraClusters(rdsClient)
+	clusters, err := getAllAuroraClusters(ctx, rdsClient)
 	if err != nil {
 		return nil, err
 	}
 
 	var capacityInfos []CapacityInfo
 	for _, cluster := range clusters {
-		info, err := GetAuroraCapacityInfo(rdsClient, cwClient, cluster.ClusterId)
+		info, err := GetAuroraCapacityInfo(ctx, rdsClient, cwClient, cluster.ClusterId)
 		if err != nil {
 			// エラーがあっても続行（部分的な情報を含む）
 			if info != nil {
@@ -124,20 +129,74 @@ func ListAuroraCapacityInfo(rdsClient *rds.Client, cwClient *cloudwatch.Client)
 	return capacityInfos, nil
 }
 
+// DisplayCapacityInfoList は複数クラスターのAcu使用状況を表示する
+func DisplayCapacityInfoList(infos []CapacityInfo) {
+	if common.IsStructuredOutput() {
+		columns, data := capacityInfosToRecordData(infos)
+		common.PrintTable("", columns, data)
+		return
+	}
+
+	if len(infos) == 0 {
+		fmt.Println(i18n.T("Aurora Serverless v2クラスターが見つかりませんでした"))
+		return
+	}
+
+	fmt.Printf(i18n.T("Aurora Serverless v2 Acu使用状況: (全%d件)\n\n"), len(infos))
+	for _, info := range infos {
+		DisplayCapacityInfo(&info)
+		fmt.Println()
+	}
+}
+
 // DisplayCapacityInfo はAcu使用状況を表示する
 func DisplayCapacityInfo(info *CapacityInfo) {
+	if common.IsStructuredOutput() {
+		columns, data := capacityInfosToRecordData([]CapacityInfo{*info})
+		common.PrintTable("", columns, data)
+		return
+	}
+
 	fmt.Printf("📊 %s\n", info.ClusterId)
 	if info.CurrentAcu >= 0 {
 		if info.CurrentAcu == 0 {
-			fmt.Printf("   Acu使用量: %.1f (過去5分間の平均 - アイドル状態)\n", info.CurrentAcu)
+			fmt.Printf(i18n.T("   Acu使用量: %.1f (過去5分間の平均 - アイドル状態)\n"), info.CurrentAcu)
 		} else {
-			fmt.Printf("   Acu使用量: %.1f (過去5分間の平均値)\n", info.CurrentAcu)
+			fmt.Printf(i18n.T("   Acu使用量: %.1f (過去5分間の平均値)\n"), info.CurrentAcu)
 		}
-		fmt.Printf("   設定範囲: %.1f - %.1f Acu\n", info.MinAcu, info.MaxAcu)
+		fmt.Printf(i18n.T("   設定範囲: %.1f - %.1f Acu\n"), info.MinAcu, info.MaxAcu)
 	} else {
-		fmt.Printf("   設定範囲: %.1f - %.1f Acu\n", info.MinAcu, info.MaxAcu)
-		fmt.Println("   ⚠️  Acu使用量を取得できませんでした")
-		fmt.Println("   💡 ヒント: クラスターが停止中、または CloudWatch にメトリクスがまだ記録されていない可能性があります")
+		fmt.Printf(i18n.T("   設定範囲: %.1f - %.1f Acu\n"), info.MinAcu, info.MaxAcu)
+		fmt.Println(i18n.T("   ⚠️  Acu使用量を取得できませんでした"))
+		fmt.Println(i18n.T("   💡 ヒント: クラスターが停止中、または CloudWatch にメトリクスがまだ記録されていない可能性があります"))
+	}
+	fmt.Printf(i18n.T("   ステータス: %s\n"), info.Status)
+}
+
+// capacityInfosToRecordData はAcu情報を構造化出力用のテーブルデータに変換
+// Acu使用量が取得できなかった場合は currentAcu を空文字にする
+func capacityInfosToRecordData(infos []CapacityInfo) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("クラスターID"), Key: "clusterId"},
+		{Header: i18n.T("Acu使用量"), Key: "currentAcu"},
+		{Header: i18n.T("最小Acu"), Key: "minAcu"},
+		{Header: i18n.T("最大Acu"), Key: "maxAcu"},
+		{Header: i18n.T("ステータス"), Key: "status"},
+	}
+
+	data := make([][]string, len(infos))
+	for i, info := range infos {
+		currentAcu := ""
+		if info.CurrentAcu >= 0 {
+			currentAcu = strconv.FormatFloat(info.CurrentAcu, 'f', -1, 64)
+		}
+		data[i] = []string{
+			info.ClusterId,
+			currentAcu,
+			strconv.FormatFloat(info.MinAcu, 'f', -1, 64),
+			strconv.FormatFloat(info.MaxAcu, 'f', -1, 64),
+			info.Status,
+		}
 	}
-	fmt.Printf("   ステータス: %s\n", info.Status)
+	return columns, data
 }
@@ -4,50 +4,93 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
-
-	"awstk/internal/service/cfn"
-	"awstk/internal/service/common"
 )
 
 // ListAuroraClusters cmdから呼ばれるメイン関数（Get + Display）
-func ListAuroraClusters(rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) error {
+// tags が指定された場合はタグ条件に一致するAuroraクラスターのみを表示する
+func ListAuroraClusters(ctx context.Context, rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string, tags *tagging.Selector) error {
 	// Get: データ取得
-	clusters, err := getAuroraClusters(rdsClient, cfnClient, stackName)
+	clusters, err := getAuroraClusters(ctx, rdsClient, cfnClient, stackName)
 	if err != nil {
 		if stackName != "" {
-			return fmt.Errorf("❌ CloudFormationスタックからクラスター名の取得に失敗: %w", err)
+			return fmt.Errorf(i18n.T("❌ CloudFormationスタックからクラスター名の取得に失敗: %w"), err)
 		}
-		return common.FormatListError("Auroraクラスター", err)
+		return common.FormatListError(i18n.T("Auroraクラスター"), err)
+	}
+	clusters, err = selectAuroraClustersByTags(ctx, tags, common.Target{}, clusters)
+	if err != nil {
+		return common.FormatListError(i18n.T("Auroraクラスター"), err)
 	}
 
 	// Display: 共通表示処理
 	return common.DisplayList(
 		clusters,
-		"Auroraクラスター一覧",
+		i18n.T("Auroraクラスター一覧"),
 		auroraClustersToTableData,
 		&common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: "Auroraクラスターが見つかりませんでした",
+			EmptyMessage: i18n.T("Auroraクラスターが見つかりませんでした"),
 		},
 	)
 }
 
+// ListAuroraClustersAcross は複数のアカウント・リージョンのAuroraクラスターを並列に取得し、アカウント列・リージョン列付きで表示する
+func ListAuroraClustersAcross(ctx context.Context, targets []common.Target, newClient func(target common.Target) *rds.Client, tags *tagging.Selector) error {
+	results := common.FetchAcrossTargets(ctx, targets, func(ctx context.Context, target common.Target) ([]Cluster, error) {
+		clusters, err := getAllAuroraClusters(ctx, newClient(target))
+		if err != nil {
+			return nil, err
+		}
+		return selectAuroraClustersByTags(ctx, tags, target, clusters)
+	})
+	return common.DisplayTargetList(
+		results,
+		i18n.T("Auroraクラスター一覧"),
+		auroraClustersToTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: i18n.T("Auroraクラスターが見つかりませんでした"),
+		},
+	)
+}
+
+// FindAuroraClustersByTags はタグ条件に一致するAuroraクラスターを取得する
+func FindAuroraClustersByTags(ctx context.Context, rdsClient *rds.Client, tags *tagging.Selector) ([]Cluster, error) {
+	clusters, err := getAllAuroraClusters(ctx, rdsClient)
+	if err != nil {
+		return nil, err
+	}
+	return selectAuroraClustersByTags(ctx, tags, common.Target{}, clusters)
+}
+
+// selectAuroraClustersByTags はタグ条件に一致するAuroraクラスターに絞り込む
+func selectAuroraClustersByTags(ctx context.Context, tags *tagging.Selector, target common.Target, clusters []Cluster) ([]Cluster, error) {
+	return tagging.Select(ctx, tags, target, tagging.TypeRdsCluster, clusters, func(c Cluster) string {
+		return "cluster:" + c.ClusterId
+	})
+}
+
 // getAuroraClusters データ取得内部関数
-func getAuroraClusters(rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) ([]Cluster, error) {
+func getAuroraClusters(ctx context.Context, rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) ([]Cluster, error) {
 	if stackName != "" {
-		return getAuroraClustersByStackName(rdsClient, cfnClient, stackName)
+		return getAuroraClustersByStackName(ctx, rdsClient, cfnClient, stackName)
 	}
-	return getAllAuroraClusters(rdsClient)
+	return getAllAuroraClusters(ctx, rdsClient)
 }
 
 // getAllAuroraClusters 現在のリージョンの全Auroraクラスターを取得
-func getAllAuroraClusters(rdsClient *rds.Client) ([]Cluster, error) {
-	resp, err := rdsClient.DescribeDBClusters(context.Background(), &rds.DescribeDBClustersInput{})
+func getAllAuroraClusters(ctx context.Context, rdsClient *rds.Client) ([]Cluster, error) {
+	resp, err := rdsClient.DescribeDBClusters(ctx, &rds.DescribeDBClustersInput{})
 	if err != nil {
-		return nil, fmt.Errorf(common.ListErrorFormat, common.ErrorIcon, "Auroraクラスター", err)
+		return nil, fmt.Errorf(i18n.T(common.ListErrorFormat), common.ErrorIcon, i18n.T("Auroraクラスター"), err)
 	}
 
 	clusters := make([]Cluster, 0, len(resp.DBClusters))
@@ -63,8 +106,8 @@ func getAllAuroraClusters(rdsClient *rds.Client) ([]Cluster, error) {
 }
 
 // getAuroraClustersByStackName 指定されたCloudFormationスタック名でフィルタリングしたAuroraクラスター一覧を取得
-func getAuroraClustersByStackName(rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) ([]Cluster, error) {
-	ids, err := cfn.GetAllAuroraFromStack(cfnClient, stackName)
+func getAuroraClustersByStackName(ctx context.Context, rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) ([]Cluster, error) {
+	ids, err := cfn.GetAllAuroraFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +116,7 @@ func getAuroraClustersByStackName(rdsClient *rds.Client, cfnClient *cloudformati
 		return []Cluster{}, nil
 	}
 
-	all, err := getAllAuroraClusters(rdsClient)
+	all, err := getAllAuroraClusters(ctx, rdsClient)
 	if err != nil {
 		return nil, err
 	}
@@ -96,9 +139,9 @@ func getAuroraClustersByStackName(rdsClient *rds.Client, cfnClient *cloudformati
 // auroraClustersToTableData Auroraクラスター情報をテーブルデータに変換
 func auroraClustersToTableData(clusters []Cluster) ([]common.TableColumn, [][]string) {
 	columns := []common.TableColumn{
-		{Header: "クラスターID"},
-		{Header: "エンジン"},
-		{Header: "ステータス"},
+		{Header: i18n.T("クラスターID"), Key: "clusterId"},
+		{Header: i18n.T("エンジン"), Key: "engine"},
+		{Header: i18n.T("ステータス"), Key: "status"},
 	}
 
 	data := make([][]string, len(clusters))
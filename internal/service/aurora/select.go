@@ -0,0 +1,34 @@
+package aurora
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/selector"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+)
+
+// SelectAuroraCluster 現在のリージョンのAuroraクラスター一覧から対話的に選択させる
+func SelectAuroraCluster(ctx context.Context, rdsClient *rds.Client) (string, error) {
+	clusters, err := getAllAuroraClusters(ctx, rdsClient)
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+	}
+	if len(clusters) == 0 {
+		return "", errors.New(i18n.T("❌ Auroraクラスターが見つかりません"))
+	}
+
+	items := make([]selector.Item, len(clusters))
+	for i, c := range clusters {
+		items[i] = selector.Item{Value: c.ClusterId, Label: fmt.Sprintf("%s  (%s, %s)", c.ClusterId, c.Engine, c.Status)}
+	}
+
+	selected, err := selector.Select(i18n.T("Auroraクラスターを選択してください"), items)
+	if err != nil {
+		return "", err
+	}
+	return selected.Value, nil
+}
@@ -1,22 +1,24 @@
 package aurora
 
 import (
-	"awstk/internal/service/common"
 	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 )
 
 // StartAuroraCluster Auroraクラスターを起動する
-func StartAuroraCluster(rdsClient *rds.Client, clusterId string) error {
+func StartAuroraCluster(ctx context.Context, rdsClient *rds.Client, clusterId string) error {
 	input := &rds.StartDBClusterInput{
 		DBClusterIdentifier: &clusterId,
 	}
 
-	_, err := rdsClient.StartDBCluster(context.Background(), input)
+	_, err := rdsClient.StartDBCluster(ctx, input)
 	if err != nil {
-		return fmt.Errorf(common.StartErrorFormat, common.ErrorIcon, "Auroraクラスター", err)
+		return fmt.Errorf(i18n.T(common.StartErrorFormat), common.ErrorIcon, i18n.T("Auroraクラスター"), err)
 	}
 
 	return nil
@@ -4,18 +4,20 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 )
 
 // StopAuroraCluster Auroraクラスターを停止する
-func StopAuroraCluster(rdsClient *rds.Client, clusterId string) error {
+func StopAuroraCluster(ctx context.Context, rdsClient *rds.Client, clusterId string) error {
 	input := &rds.StopDBClusterInput{
 		DBClusterIdentifier: &clusterId,
 	}
 
-	_, err := rdsClient.StopDBCluster(context.Background(), input)
+	_, err := rdsClient.StopDBCluster(ctx, input)
 	if err != nil {
-		return fmt.Errorf("❌ Auroraクラスター停止エラー: %w", err)
+		return fmt.Errorf(i18n.T("❌ Auroraクラスター停止エラー: %w"), err)
 	}
 
 	return nil
@@ -1,20 +1,20 @@
 package canary
 
 import (
-	"awstk/internal/service/common"
-	"bufio"
 	"context"
 	"fmt"
-	"os"
-	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/confirm"
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/synthetics"
 )
 
 // getCanariesByFilter フィルタパターンに一致するCanaryを取得
-func getCanariesByFilter(client *synthetics.Client, filter string) ([]Canary, error) {
-	allCanaries, err := getAllCanaries(client)
+func getCanariesByFilter(ctx context.Context, client *synthetics.Client, filter string) ([]Canary, error) {
+	allCanaries, err := getAllCanaries(ctx, client)
 	if err != nil {
 		return nil, err
 	}
@@ -29,38 +29,40 @@ func getCanariesByFilter(client *synthetics.Client, filter string) ([]Canary, er
 	return filtered, nil
 }
 
-// confirmAction ユーザーに確認を求める
-func confirmAction(message string) bool {
-	reader := bufio.NewReader(os.Stdin)
-	fmt.Printf("%s [y/N]: ", message)
-
-	response, err := reader.ReadString('\n')
+// confirmCanaries 対象のCanaryを表示してユーザーに確認を求める（--yes 指定時は確認しない）
+func confirmCanaries(message string, canaries []Canary, prompt string) error {
+	targets := make([]string, len(canaries))
+	for i, c := range canaries {
+		targets[i] = fmt.Sprintf(i18n.T("%s (現在: %s)"), c.Name, formatState(c.State))
+	}
+	ok, err := confirm.Ask(confirm.Request{Message: message, Targets: targets, Prompt: prompt})
 	if err != nil {
-		return false
+		return err
 	}
-
-	response = strings.TrimSpace(strings.ToLower(response))
-	return response == "y" || response == "yes"
+	if !ok {
+		return fmt.Errorf("%s", i18n.T("キャンセルされました"))
+	}
+	return nil
 }
 
 // startCanary Canaryを開始
-func startCanary(client *synthetics.Client, name string) error {
-	_, err := client.StartCanary(context.Background(), &synthetics.StartCanaryInput{
+func startCanary(ctx context.Context, client *synthetics.Client, name string) error {
+	_, err := client.StartCanary(ctx, &synthetics.StartCanaryInput{
 		Name: awssdk.String(name),
 	})
 	if err != nil {
-		return fmt.Errorf("canaryの開始に失敗: %w", err)
+		return fmt.Errorf(i18n.T("canaryの開始に失敗: %w"), err)
 	}
 	return nil
 }
 
 // stopCanary Canaryを停止
-func stopCanary(client *synthetics.Client, name string) error {
-	_, err := client.StopCanary(context.Background(), &synthetics.StopCanaryInput{
+func stopCanary(ctx context.Context, client *synthetics.Client, name string) error {
+	_, err := client.StopCanary(ctx, &synthetics.StopCanaryInput{
 		Name: awssdk.String(name),
 	})
 	if err != nil {
-		return fmt.Errorf("canaryの停止に失敗: %w", err)
+		return fmt.Errorf(i18n.T("canaryの停止に失敗: %w"), err)
 	}
 	return nil
 }
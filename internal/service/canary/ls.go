@@ -5,37 +5,81 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/synthetics"
-
-	"awstk/internal/service/common"
 )
 
 // ListCanaries cmdから呼ばれるメイン関数（Get + Display）
-func ListCanaries(client *synthetics.Client) error {
+// tags が指定された場合はタグ条件に一致するCanaryのみを表示する
+func ListCanaries(ctx context.Context, client *synthetics.Client, tags *tagging.Selector) error {
 	// Get: データ取得
-	canaries, err := getAllCanaries(client)
+	canaries, err := GetCanaries(ctx, client, tags)
 	if err != nil {
-		return common.FormatListError("Canary", err)
+		return err
 	}
 
 	// Display: 共通表示処理
 	return common.DisplayList(
 		canaries,
-		"Canary一覧",
+		i18n.T("Canary一覧"),
 		canariesToTableData,
 		&common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: "Canaryが見つかりませんでした",
+			EmptyMessage: i18n.T("Canaryが見つかりませんでした"),
 		},
 	)
 }
 
+// GetCanaries はCanaryの一覧を取得する（成功率・最新の実行結果を含む）
+// tags が指定された場合はタグ条件に一致するCanaryのみを返す
+func GetCanaries(ctx context.Context, client *synthetics.Client, tags *tagging.Selector) ([]Canary, error) {
+	canaries, err := getAllCanaries(ctx, client)
+	if err != nil {
+		return nil, common.FormatListError("Canary", err)
+	}
+	canaries, err = selectCanariesByTags(ctx, tags, common.Target{}, canaries)
+	if err != nil {
+		return nil, common.FormatListError("Canary", err)
+	}
+	return canaries, nil
+}
+
+// ListCanariesAcross は複数のアカウント・リージョンのCanaryを並列に取得し、アカウント列・リージョン列付きで表示する
+func ListCanariesAcross(ctx context.Context, targets []common.Target, newClient func(target common.Target) *synthetics.Client, tags *tagging.Selector) error {
+	results := common.FetchAcrossTargets(ctx, targets, func(ctx context.Context, target common.Target) ([]Canary, error) {
+		canaries, err := getAllCanaries(ctx, newClient(target))
+		if err != nil {
+			return nil, err
+		}
+		return selectCanariesByTags(ctx, tags, target, canaries)
+	})
+	return common.DisplayTargetList(
+		results,
+		i18n.T("Canary一覧"),
+		canariesToTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: i18n.T("Canaryが見つかりませんでした"),
+		},
+	)
+}
+
+// selectCanariesByTags はタグ条件に一致するCanaryに絞り込む
+func selectCanariesByTags(ctx context.Context, tags *tagging.Selector, target common.Target, canaries []Canary) ([]Canary, error) {
+	return tagging.Select(ctx, tags, target, tagging.TypeCanary, canaries, func(c Canary) string {
+		return "canary:" + c.Name
+	})
+}
+
 // getAllCanaries 全てのCanaryを取得
-func getAllCanaries(client *synthetics.Client) ([]Canary, error) {
-	resp, err := client.DescribeCanaries(context.Background(), &synthetics.DescribeCanariesInput{})
+func getAllCanaries(ctx context.Context, client *synthetics.Client) ([]Canary, error) {
+	resp, err := client.DescribeCanaries(ctx, &synthetics.DescribeCanariesInput{})
 	if err != nil {
-		return nil, fmt.Errorf("canary一覧の取得に失敗: %w", err)
+		return nil, fmt.Errorf(i18n.T("canary一覧の取得に失敗: %w"), err)
 	}
 
 	canaries := make([]Canary, 0, len(resp.Canaries))
@@ -58,7 +102,7 @@ func getAllCanaries(client *synthetics.Client) ([]Canary, error) {
 		}
 
 		// 最新の実行結果を取得
-		runs, err := client.DescribeCanariesLastRun(context.Background(), &synthetics.DescribeCanariesLastRunInput{})
+		runs, err := client.DescribeCanariesLastRun(ctx, &synthetics.DescribeCanariesLastRunInput{})
 		if err == nil && len(runs.CanariesLastRun) > 0 {
 			for _, lastRun := range runs.CanariesLastRun {
 				if awssdk.ToString(lastRun.CanaryName) == canary.Name {
@@ -71,7 +115,7 @@ func getAllCanaries(client *synthetics.Client) ([]Canary, error) {
 		}
 
 		// 成功率の計算（直近の実行結果から）
-		canary.SuccessRate = calculateSuccessRate(client, canary.Name)
+		canary.SuccessRate = calculateSuccessRate(ctx, client, canary.Name)
 
 		canaries = append(canaries, canary)
 	}
@@ -80,9 +124,9 @@ func getAllCanaries(client *synthetics.Client) ([]Canary, error) {
 }
 
 // calculateSuccessRate 直近の実行結果から成功率を計算
-func calculateSuccessRate(client *synthetics.Client, canaryName string) float64 {
+func calculateSuccessRate(ctx context.Context, client *synthetics.Client, canaryName string) float64 {
 	// 直近100件の実行結果を取得
-	runs, err := client.GetCanaryRuns(context.Background(), &synthetics.GetCanaryRunsInput{
+	runs, err := client.GetCanaryRuns(ctx, &synthetics.GetCanaryRunsInput{
 		Name:       awssdk.String(canaryName),
 		MaxResults: awssdk.Int32(100),
 	})
@@ -105,12 +149,12 @@ func calculateSuccessRate(client *synthetics.Client, canaryName string) float64
 // canariesToTableData Canary情報をテーブルデータに変換
 func canariesToTableData(canaries []Canary) ([]common.TableColumn, [][]string) {
 	columns := []common.TableColumn{
-		{Header: "名前"},
-		{Header: "状態"},
-		{Header: "スケジュール"},
-		{Header: "成功率"},
-		{Header: "最終実行"},
-		{Header: "最終結果"},
+		{Header: i18n.T("名前"), Key: "name"},
+		{Header: i18n.T("状態"), Key: "state"},
+		{Header: i18n.T("スケジュール"), Key: "schedule"},
+		{Header: i18n.T("成功率"), Key: "successRate"},
+		{Header: i18n.T("最終実行"), Key: "lastRun"},
+		{Header: i18n.T("最終結果"), Key: "lastResult"},
 	}
 
 	data := make([][]string, len(canaries))
@@ -131,15 +175,15 @@ func canariesToTableData(canaries []Canary) ([]common.TableColumn, [][]string) {
 func formatState(state string) string {
 	switch state {
 	case CanaryStateRunning:
-		return "[実行中]"
+		return i18n.T("[実行中]")
 	case CanaryStateStopped:
-		return "[停止中]"
+		return i18n.T("[停止中]")
 	case CanaryStateError:
-		return "[エラー]"
+		return i18n.T("[エラー]")
 	case CanaryStateStarting:
-		return "[開始中]"
+		return i18n.T("[開始中]")
 	case CanaryStateStopping:
-		return "[停止中]"
+		return i18n.T("[停止中]")
 	default:
 		return state
 	}
@@ -148,11 +192,11 @@ func formatState(state string) string {
 // formatSchedule スケジュールを見やすくフォーマット
 func formatSchedule(schedule string) string {
 	if schedule == "" {
-		return "なし"
+		return i18n.T("なし")
 	}
 	// rate(5 minutes) -> 5分ごと
 	if len(schedule) > 5 && schedule[:5] == "rate(" {
-		return "定期: " + schedule[5:len(schedule)-1]
+		return i18n.T("定期: ") + schedule[5:len(schedule)-1]
 	}
 	// cron式はそのまま表示
 	return schedule
@@ -161,7 +205,7 @@ func formatSchedule(schedule string) string {
 // formatLastRunTime 最終実行時刻をフォーマット
 func formatLastRunTime(t time.Time) string {
 	if t.IsZero() {
-		return "未実行"
+		return i18n.T("未実行")
 	}
 	return t.Format("01/02 15:04")
 }
@@ -170,11 +214,11 @@ func formatLastRunTime(t time.Time) string {
 func formatRunStatus(status string) string {
 	switch status {
 	case CanaryRunStatePassed:
-		return "[成功]"
+		return i18n.T("[成功]")
 	case CanaryRunStateFailed:
-		return "[失敗]"
+		return i18n.T("[失敗]")
 	case CanaryRunStateRunning:
-		return "[実行中]"
+		return i18n.T("[実行中]")
 	default:
 		if status == "" {
 			return "-"
@@ -2,48 +2,53 @@ package canary
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/confirm"
+
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/synthetics"
 )
 
 // RunCanary 特定のCanaryを手動実行
-func RunCanary(client *synthetics.Client, name string) error {
-	fmt.Printf("Canary '%s' を実行中...\n", name)
+func RunCanary(ctx context.Context, client *synthetics.Client, name string) error {
+	common.Infof(ctx, i18n.T("Canary '%s' を実行中...\n"), name)
 
-	_, err := client.StartCanary(context.Background(), &synthetics.StartCanaryInput{
+	_, err := client.StartCanary(ctx, &synthetics.StartCanaryInput{
 		Name: awssdk.String(name),
 	})
 	if err != nil {
-		return fmt.Errorf("canary '%s' の実行に失敗: %w", name, err)
+		return fmt.Errorf(i18n.T("canary '%s' の実行に失敗: %w"), name, err)
 	}
 
-	fmt.Printf("✓ Canary '%s' を開始しました\n", name)
+	common.Infof(ctx, i18n.T("✓ Canary '%s' を開始しました\n"), name)
 	return nil
 }
 
 // RunCanaryDryRun Canaryのドライラン実行（将来の拡張用）
 // 注意: 現在はドライラン機能を使わず、通常の実行と同じ動作をします
-func RunCanaryDryRun(client *synthetics.Client, name string) error {
-	fmt.Printf("Canary '%s' を実行中（ドライランモード）...\n", name)
+func RunCanaryDryRun(ctx context.Context, client *synthetics.Client, name string) error {
+	common.Infof(ctx, i18n.T("Canary '%s' を実行中（ドライランモード）...\n"), name)
 
 	// 現在はドライラン専用APIを使わず、通常の実行を行う
 	// 将来的にドライラン機能が必要になったら、この部分を拡張する
-	return RunCanary(client, name)
+	return RunCanary(ctx, client, name)
 }
 
 // RunCanariesByFilter フィルターに一致するCanaryを一括実行
-func RunCanariesByFilter(client *synthetics.Client, filters []string, dryRun bool, skipConfirm bool) error {
+func RunCanariesByFilter(ctx context.Context, client *synthetics.Client, filters []string, dryRun bool) error {
 	if len(filters) == 0 {
-		return fmt.Errorf("フィルターが指定されていません")
+		return errors.New(i18n.T("フィルターが指定されていません"))
 	}
 
 	// フィルターに一致するCanaryを取得
 	var matchedCanaries []Canary
 	for _, filter := range filters {
-		canaries, err := getCanariesByFilter(client, filter)
+		canaries, err := getCanariesByFilter(ctx, client, filter)
 		if err != nil {
 			return err
 		}
@@ -54,73 +59,78 @@ func RunCanariesByFilter(client *synthetics.Client, filters []string, dryRun boo
 	uniqueCanaries := removeDuplicateCanaries(matchedCanaries)
 
 	if len(uniqueCanaries) == 0 {
-		fmt.Printf("フィルター '%s' に一致するCanaryが見つかりませんでした\n", strings.Join(filters, ", "))
+		common.Infof(ctx, i18n.T("フィルター '%s' に一致するCanaryが見つかりませんでした\n"), strings.Join(filters, ", "))
 		return nil
 	}
 
 	// 実行対象を表示
-	actionType := "実行"
+	actionType := i18n.T("実行")
 	if dryRun {
-		actionType = "ドライラン実行"
+		actionType = i18n.T("ドライラン実行")
 	}
 
-	fmt.Printf("\n以下のCanaryを%sします:\n", actionType)
-	for _, canary := range uniqueCanaries {
-		fmt.Printf("  - %s (%s)\n", canary.Name, canary.State)
+	targets := make([]string, len(uniqueCanaries))
+	for i, canary := range uniqueCanaries {
+		targets[i] = fmt.Sprintf("%s (%s)", canary.Name, canary.State)
 	}
-	fmt.Printf("\n合計: %d個のCanary\n", len(uniqueCanaries))
 
 	// 確認
-	if !skipConfirm {
-		if !confirmAction(fmt.Sprintf("%d個のCanaryを%sしますか？", len(uniqueCanaries), actionType)) {
-			fmt.Println("キャンセルしました")
-			return nil
-		}
+	ok, err := confirm.Ask(confirm.Request{
+		Message: fmt.Sprintf(i18n.T("\n以下のCanaryを%sします:"), actionType),
+		Targets: targets,
+		Prompt:  fmt.Sprintf(i18n.T("%d個のCanaryを%sしますか？"), len(uniqueCanaries), actionType),
+	})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		common.Infof(ctx, "%s\n", i18n.T("キャンセルしました"))
+		return nil
 	}
 
 	// 実行
-	return executeCanaries(client, uniqueCanaries, dryRun)
+	return executeCanaries(ctx, client, uniqueCanaries, dryRun)
 }
 
 // executeCanaries Canary群を実行
-func executeCanaries(client *synthetics.Client, canaries []Canary, dryRun bool) error {
+func executeCanaries(ctx context.Context, client *synthetics.Client, canaries []Canary, dryRun bool) error {
 	successCount := 0
 	errorCount := 0
 	var errors []string
 
-	fmt.Printf("\n実行中...\n")
+	common.Infof(ctx, "%s", i18n.T("\n実行中...\n"))
 
 	for _, canary := range canaries {
 		var err error
 		if dryRun {
-			err = RunCanaryDryRun(client, canary.Name)
+			err = RunCanaryDryRun(ctx, client, canary.Name)
 		} else {
-			err = RunCanary(client, canary.Name)
+			err = RunCanary(ctx, client, canary.Name)
 		}
 
 		if err != nil {
 			errorCount++
 			errMsg := fmt.Sprintf("- %s: %v", canary.Name, err)
 			errors = append(errors, errMsg)
-			fmt.Printf("✗ %s\n", errMsg)
+			common.Warnf(ctx, "✗ %s\n", errMsg)
 		} else {
 			successCount++
 		}
 	}
 
 	// 結果サマリー
-	fmt.Printf("\n=== 実行結果 ===\n")
-	fmt.Printf("成功: %d個\n", successCount)
+	common.Infof(ctx, "%s", i18n.T("\n=== 実行結果 ===\n"))
+	common.Infof(ctx, i18n.T("成功: %d個\n"), successCount)
 	if errorCount > 0 {
-		fmt.Printf("失敗: %d個\n", errorCount)
-		fmt.Printf("\n失敗詳細:\n")
+		common.Warnf(ctx, i18n.T("失敗: %d個\n"), errorCount)
+		common.Warnf(ctx, "%s", i18n.T("\n失敗詳細:\n"))
 		for _, errMsg := range errors {
-			fmt.Printf("  %s\n", errMsg)
+			common.Warnf(ctx, "  %s\n", errMsg)
 		}
-		return fmt.Errorf("%d個のCanaryの実行に失敗しました", errorCount)
+		return fmt.Errorf(i18n.T("%d個のCanaryの実行に失敗しました"), errorCount)
 	}
 
-	fmt.Printf("全てのCanaryの実行に成功しました！\n")
+	common.Infof(ctx, "%s", i18n.T("全てのCanaryの実行に成功しました！\n"))
 	return nil
 }
 
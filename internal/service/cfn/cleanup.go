@@ -1,12 +1,17 @@
 package cfn
 
 import (
-	"awstk/internal/service/common"
-	"bufio"
 	"context"
+	"errors"
 	"fmt"
-	"os"
 	"strings"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/confirm"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -14,71 +19,95 @@ import (
 )
 
 // CleanupStacks は指定した条件に一致するスタックを削除します
-func CleanupStacks(cfnClient *cloudformation.Client, opts CleanupOptions) error {
+func CleanupStacks(ctx context.Context, cfnClient CfnApi, opts CleanupOptions) error {
 	// 削除対象のスタックを検索
-	stacks, err := findStacksForCleanup(cfnClient, opts)
+	stacks, err := findStacksForCleanup(ctx, cfnClient, opts)
 	if err != nil {
 		return err
 	}
 
 	if len(stacks) == 0 {
-		fmt.Println("削除対象のスタックが見つかりませんでした")
+		common.Infof(ctx, "%s\n", i18n.T("削除対象のスタックが見つかりませんでした"))
 		return nil
 	}
 
 	// 削除対象のスタック一覧を表示
-	fmt.Println("🔍 削除対象のスタック:")
+	common.Infof(ctx, "%s\n", i18n.T("🔍 削除対象のスタック:"))
 	for _, stack := range stacks {
-		fmt.Printf("  - %s (Status: %s)\n", aws.ToString(stack.StackName), stack.StackStatus)
+		common.Infof(ctx, "  - %s (Status: %s)\n", opts.Age.Annotate(aws.ToString(stack.StackName), stack.CreationTime, stack.LastUpdatedTime), stack.StackStatus)
 	}
-	fmt.Printf("\n合計 %d 個のスタックが削除されます\n", len(stacks))
+	common.Infof(ctx, i18n.T("\n合計 %d 個のスタックが削除されます\n"), len(stacks))
 
-	// 確認プロンプト
+	// プラン作成モードの場合は確認・削除せずにプランへ追加
+	if opts.Plan != nil {
+		opts.Plan.Add(stackDeletionActions(stacks)...)
+		return nil
+	}
+
+	// 確認プロンプト（スタックの削除は取り消せないため、スタック名またはアカウント名の入力で確認する）
 	if !opts.Force {
-		fmt.Print("\n本当に削除しますか？ [y/N]: ")
-		reader := bufio.NewReader(os.Stdin)
-		response, _ := reader.ReadString('\n')
-		response = strings.TrimSpace(strings.ToLower(response))
-		if response != "y" && response != "yes" {
-			fmt.Println("削除をキャンセルしました")
+		req := confirm.Request{Phrase: opts.AccountName, PhraseLabel: i18n.T("アカウント"), Prompt: i18n.T("\n本当に削除しますか？")}
+		if len(stacks) == 1 {
+			req.Phrase = aws.ToString(stacks[0].StackName)
+			req.PhraseLabel = i18n.T("スタック名")
+		}
+		ok, err := confirm.Ask(req)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			common.Infof(ctx, "%s\n", i18n.T("削除をキャンセルしました"))
 			return nil
 		}
 	}
 
 	// スタックを削除
-	fmt.Println("\n削除を開始します...")
-	deleteCount := 0
+	common.Infof(ctx, "%s\n", i18n.T("\n削除を開始します..."))
+	result := deleteStacks(ctx, cfnClient, stacks)
+
+	common.Infof(ctx, i18n.T("\n✅ %d 個のスタックの削除リクエストを送信しました\n"), len(result.Deleted))
+	if len(result.Deleted) < len(stacks) {
+		common.Warnf(ctx, i18n.T("⚠️  %d 個のスタックはスキップされました\n"), len(stacks)-len(result.Deleted))
+	}
+
+	return nil
+}
+
+// deleteStacks はスタックの削除リクエストを順番に送信します（削除保護が有効なスタックはスキップ）
+func deleteStacks(ctx context.Context, cfnClient CfnApi, stacks []types.Stack) common.CleanupResult {
+	var results []common.ProcessResult
+	stacks = guardrail.Exclude(ctx, i18n.T("スタック"), tagging.TypeCfnStack, stacks, func(s types.Stack) string { return aws.ToString(s.StackName) }, func(s types.Stack) string { return aws.ToString(s.StackId) })
 	for _, stack := range stacks {
 		stackName := aws.ToString(stack.StackName)
-		fmt.Printf("スタック %s を削除中...", stackName)
+		if ctx.Err() != nil {
+			results = append(results, common.SkippedResult(stackName, ctx.Err()))
+			continue
+		}
+		common.Infof(ctx, i18n.T("スタック %s を削除中..."), stackName)
 
 		// 削除保護の確認
 		if aws.ToBool(stack.EnableTerminationProtection) {
-			fmt.Printf("\n⚠️  スタック %s は削除保護が有効です。スキップします\n", stackName)
+			common.Warnf(ctx, i18n.T("\n⚠️  スタック %s は削除保護が有効です。スキップします\n"), stackName)
+			results = append(results, common.SkippedResult(stackName, errors.New(i18n.T("削除保護が有効です"))))
 			continue
 		}
 
-		_, err := cfnClient.DeleteStack(context.Background(), &cloudformation.DeleteStackInput{
+		_, err := cfnClient.DeleteStack(ctx, &cloudformation.DeleteStackInput{
 			StackName: aws.String(stackName),
 		})
 		if err != nil {
-			fmt.Printf("\n❌ スタック %s の削除に失敗しました: %v\n", stackName, err)
+			common.Warnf(ctx, i18n.T("\n❌ スタック %s の削除に失敗しました: %v\n"), stackName, err)
+			results = append(results, common.ProcessResult{Item: stackName, Success: false, Error: err})
 			continue
 		}
-		fmt.Printf(" ✅\n")
-		deleteCount++
+		common.Infof(ctx, " ✅\n")
+		results = append(results, common.ProcessResult{Item: stackName, Success: true})
 	}
-
-	fmt.Printf("\n✅ %d 個のスタックの削除リクエストを送信しました\n", deleteCount)
-	if deleteCount < len(stacks) {
-		fmt.Printf("⚠️  %d 個のスタックはスキップされました\n", len(stacks)-deleteCount)
-	}
-
-	return nil
+	return common.CollectCleanupResult(i18n.T("CloudFormationスタック"), results)
 }
 
 // findStacksForCleanup は指定した条件に一致するスタックを検索します
-func findStacksForCleanup(cfnClient *cloudformation.Client, opts CleanupOptions) ([]types.Stack, error) {
+func findStacksForCleanup(ctx context.Context, cfnClient CfnApi, opts CleanupOptions) ([]types.Stack, error) {
 	// ステータスフィルターの解析
 	var targetStatuses []types.StackStatus
 	if opts.Status != "" {
@@ -122,16 +151,16 @@ func findStacksForCleanup(cfnClient *cloudformation.Client, opts CleanupOptions)
 			}
 		}
 
-		output, err := cfnClient.ListStacks(context.Background(), input)
+		output, err := cfnClient.ListStacks(ctx, input)
 		if err != nil {
-			return nil, fmt.Errorf("スタック一覧の取得に失敗しました: %w", err)
+			return nil, fmt.Errorf(i18n.T("スタック一覧の取得に失敗しました: %w"), err)
 		}
 
 		// 名前フィルターを適用
 		for _, summary := range output.StackSummaries {
 			if opts.Filter == "" || common.MatchesFilter(aws.ToString(summary.StackName), opts.Filter, opts.Exact) {
 				// スタックの詳細情報を取得（削除保護の確認のため）
-				describeOutput, err := cfnClient.DescribeStacks(context.Background(), &cloudformation.DescribeStacksInput{
+				describeOutput, err := cfnClient.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
 					StackName: summary.StackName,
 				})
 				if err != nil {
@@ -150,5 +179,12 @@ func findStacksForCleanup(cfnClient *cloudformation.Client, opts CleanupOptions)
 		nextToken = output.NextToken
 	}
 
-	return allStacks, nil
+	// 経過時間の条件を適用（最終アクティビティは最終更新日時、未更新のスタックは作成日時で判定）
+	allStacks = common.SelectByAge(opts.Age, allStacks, func(s types.Stack) (*time.Time, *time.Time) {
+		return s.CreationTime, s.LastUpdatedTime
+	})
+
+	return tagging.Select(ctx, opts.Tags, common.Target{}, tagging.TypeCfnStack, allStacks, func(s types.Stack) string {
+		return aws.ToString(s.StackId)
+	})
 }
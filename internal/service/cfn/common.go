@@ -5,6 +5,9 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
@@ -17,71 +20,58 @@ type EcsServiceInfo struct {
 }
 
 // GetStackResources はスタックからリソース一覧を取得する関数
-func GetStackResources(cfnClient *cloudformation.Client, stackName string) ([]types.StackResource, error) {
-	ctx := context.Background()
+func GetStackResources(ctx context.Context, cfnClient CfnApi, stackName string) ([]types.StackResource, error) {
 
 	// スタックからリソースを取得
-	fmt.Printf("🔍 スタック '%s' からリソースを検索中...\n", stackName)
+	common.Infof(ctx, i18n.T("🔍 スタック '%s' からリソースを検索中...\n"), stackName)
 	resp, err := cfnClient.DescribeStackResources(ctx, &cloudformation.DescribeStackResourcesInput{
 		StackName: awssdk.String(stackName),
 	})
 	if err != nil {
-		return nil, fmt.Errorf("cloudFormationスタックのリソース取得に失敗: %w", err)
+		return nil, fmt.Errorf(i18n.T("cloudFormationスタックのリソース取得に失敗: %w"), err)
 	}
 
 	// スタック存在確認
 	if len(resp.StackResources) == 0 {
-		return nil, fmt.Errorf("スタック '%s' にリソースが見つかりませんでした", stackName)
+		return nil, fmt.Errorf(i18n.T("スタック '%s' にリソースが見つかりませんでした"), stackName)
 	}
 
 	return resp.StackResources, nil
 }
 
-// GetCleanupResourcesFromStack はCloudFormationスタックからS3バケット/ECRリポジトリ/CloudWatch Logsグループを取得します
-func GetCleanupResourcesFromStack(cfnClient *cloudformation.Client, stackName string) ([]string, []string, []string, error) {
+// GetCleanupResourcesFromStack はCloudFormationスタックから指定したリソースタイプ（例: AWS::S3::Bucket）のリソースを取得します
+// 戻り値はリソースタイプごとの物理IDの一覧です
+func GetCleanupResourcesFromStack(ctx context.Context, cfnClient CfnApi, stackName string, resourceTypes []string) (map[string][]string, error) {
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
-		return nil, nil, nil, err
+		return nil, err
 	}
 
-	// S3バケットとECRリポジトリ、ロググループを抽出
-	s3Resources := []string{}
-	ecrResources := []string{}
-	logGroups := []string{}
+	targets := make(map[string]bool, len(resourceTypes))
+	for _, resourceType := range resourceTypes {
+		targets[resourceType] = true
+	}
 
+	// リソースタイプに基づいて振り分け
+	resources := make(map[string][]string)
 	for _, resource := range stackResources {
-		// リソースタイプに基づいて振り分け
-		resourceType := *resource.ResourceType
-
-		// S3バケット
-		if resourceType == "AWS::S3::Bucket" && resource.PhysicalResourceId != nil {
-			s3Resources = append(s3Resources, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたS3バケット: %s\n", *resource.PhysicalResourceId)
-		}
-
-		// ECRリポジトリ
-		if resourceType == "AWS::ECR::Repository" && resource.PhysicalResourceId != nil {
-			ecrResources = append(ecrResources, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたECRリポジトリ: %s\n", *resource.PhysicalResourceId)
-		}
-
-		// CloudWatch Logs ロググループ
-		if resourceType == "AWS::Logs::LogGroup" && resource.PhysicalResourceId != nil {
-			logGroups = append(logGroups, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたロググループ: %s\n", *resource.PhysicalResourceId)
+		resourceType := awssdk.ToString(resource.ResourceType)
+		if !targets[resourceType] || awssdk.ToString(resource.PhysicalResourceId) == "" {
+			continue
 		}
+		resources[resourceType] = append(resources[resourceType], *resource.PhysicalResourceId)
 	}
 
-	return s3Resources, ecrResources, logGroups, nil
+	return resources, nil
 }
 
 // getStartStopResourcesFromStack はCloudFormationスタックから起動・停止可能なリソースの識別子を取得します
-func getStartStopResourcesFromStack(cfnClient *cloudformation.Client, stackName string) (StackResources, error) {
+func getStartStopResourcesFromStack(ctx context.Context, cfnClient CfnApi, stackName string) (StackResources, error) {
 	var result StackResources
 
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
 		return result, err
 	}
@@ -96,18 +86,18 @@ func getStartStopResourcesFromStack(cfnClient *cloudformation.Client, stackName
 		}
 
 		switch *resource.ResourceType {
-		case "AWS::RDS::DBCluster":
+		case ResourceTypeDbCluster:
 			// Aurora DBクラスターを検出した場合、フラグを立てる
 			hasAuroraCluster = true
 			result.AuroraClusterIds = append(result.AuroraClusterIds, *resource.PhysicalResourceId)
-		case "AWS::RDS::DBInstance":
+		case ResourceTypeDbInstance:
 			// Aurora DBクラスターが存在しない場合のみ、純粋なRDSインスタンスとして扱う
 			if !hasAuroraCluster {
 				result.RdsInstanceIds = append(result.RdsInstanceIds, *resource.PhysicalResourceId)
 			}
-		case "AWS::EC2::Instance":
+		case ResourceTypeEc2Instance:
 			result.Ec2InstanceIds = append(result.Ec2InstanceIds, *resource.PhysicalResourceId)
-		case "AWS::ECS::Service":
+		case ResourceTypeEcsService:
 			// ECSサービスARNからクラスター名とサービス名を抽出
 			serviceArn := *resource.PhysicalResourceId
 			parts := strings.Split(serviceArn, "/")
@@ -133,34 +123,34 @@ func getStartStopResourcesFromStack(cfnClient *cloudformation.Client, stackName
 }
 
 // printResourcesSummary はスタック内の検出されたリソースサマリーを表示します
-func printResourcesSummary(resources StackResources) {
-	fmt.Println("📋 検出されたリソース:")
+func printResourcesSummary(ctx context.Context, resources StackResources) {
+	common.Infof(ctx, "%s\n", i18n.T("📋 検出されたリソース:"))
 
 	if len(resources.Ec2InstanceIds) > 0 {
-		fmt.Println("  EC2インスタンス:")
+		common.Infof(ctx, "%s\n", i18n.T("  EC2インスタンス:"))
 		for _, id := range resources.Ec2InstanceIds {
-			fmt.Println("   - " + id)
+			common.Infof(ctx, "   - %s\n", id)
 		}
 	}
 
 	if len(resources.RdsInstanceIds) > 0 {
-		fmt.Println("  RDSインスタンス:")
+		common.Infof(ctx, "%s\n", i18n.T("  RDSインスタンス:"))
 		for _, id := range resources.RdsInstanceIds {
-			fmt.Println("   - " + id)
+			common.Infof(ctx, "   - %s\n", id)
 		}
 	}
 
 	if len(resources.AuroraClusterIds) > 0 {
-		fmt.Println("  Aurora DBクラスター:")
+		common.Infof(ctx, "%s\n", i18n.T("  Aurora DBクラスター:"))
 		for _, id := range resources.AuroraClusterIds {
-			fmt.Println("   - " + id)
+			common.Infof(ctx, "   - %s\n", id)
 		}
 	}
 
 	if len(resources.EcsServiceInfo) > 0 {
-		fmt.Println("  ECSサービス:")
+		common.Infof(ctx, "%s\n", i18n.T("  ECSサービス:"))
 		for _, info := range resources.EcsServiceInfo {
-			fmt.Printf("   - %s/%s\n", info.ClusterName, info.ServiceName)
+			common.Infof(ctx, "   - %s/%s\n", info.ClusterName, info.ServiceName)
 		}
 	}
 
@@ -168,6 +158,6 @@ func printResourcesSummary(resources StackResources) {
 		len(resources.RdsInstanceIds) == 0 &&
 		len(resources.AuroraClusterIds) == 0 &&
 		len(resources.EcsServiceInfo) == 0 {
-		fmt.Println("  操作可能なリソースは見つかりませんでした")
+		common.Infof(ctx, "%s\n", i18n.T("  操作可能なリソースは見つかりませんでした"))
 	}
 }
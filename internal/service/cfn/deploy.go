@@ -1,24 +1,27 @@
 package cfn
 
 import (
-	"awstk/internal/aws"
-	"awstk/internal/cli"
 	"context"
 	"encoding/json"
 	"fmt"
 	"os"
 	"strings"
 
+	"github.com/ngsw877/awstk/internal/aws"
+	"github.com/ngsw877/awstk/internal/cli"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
 )
 
 // DeployStack は指定したテンプレートファイルからCloudFormationスタックをデプロイ
-func DeployStack(ctx aws.Context, opts DeployOptions) error {
+func DeployStack(ctx context.Context, awsCtx aws.Context, opts DeployOptions) error {
 	// テンプレートファイルの存在確認
 	if _, err := os.Stat(opts.TemplatePath); os.IsNotExist(err) {
-		return fmt.Errorf("テンプレートファイルが見つかりません: %s", opts.TemplatePath)
+		return fmt.Errorf(i18n.T("テンプレートファイルが見つかりません: %s"), opts.TemplatePath)
 	}
 
 	// AWS CLIコマンドの引数を構築
@@ -49,22 +52,22 @@ func DeployStack(ctx aws.Context, opts DeployOptions) error {
 		args = append(args, "--no-execute-changeset")
 	}
 
-	fmt.Printf("🚀 CloudFormationスタックをデプロイ中...\n")
-	fmt.Printf("   スタック名: %s\n", opts.StackName)
-	fmt.Printf("   テンプレート: %s\n", opts.TemplatePath)
+	common.Infof(ctx, "%s", i18n.T("🚀 CloudFormationスタックをデプロイ中...\n"))
+	common.Infof(ctx, i18n.T("   スタック名: %s\n"), opts.StackName)
+	common.Infof(ctx, i18n.T("   テンプレート: %s\n"), opts.TemplatePath)
 
 	// AWS CLIコマンドを実行（出力をキャプチャ）
-	result, err := cli.ExecuteAwsCommandWithCapture(ctx, args)
+	result, err := cli.ExecuteAwsCommandWithCapture(ctx, awsCtx, args)
 	if err != nil {
 		// エラー時にスタックイベントを取得して整形表示
-		fmt.Fprintf(os.Stderr, "\n📋 エラーの詳細:\n\n")
+		fmt.Fprint(os.Stderr, i18n.T("\n📋 エラーの詳細:\n\n"))
 
 		// AWS SDKでスタックイベントを取得
-		if displayErr := displayFailedEvents(ctx, opts.StackName); displayErr != nil {
-			fmt.Fprintf(os.Stderr, "⚠️  イベント情報の取得に失敗しました: %v\n", displayErr)
+		if displayErr := displayFailedEvents(ctx, awsCtx, opts.StackName); displayErr != nil {
+			fmt.Fprintf(os.Stderr, i18n.T("⚠️  イベント情報の取得に失敗しました: %v\n"), displayErr)
 		}
 
-		return fmt.Errorf("デプロイに失敗しました: %w", err)
+		return fmt.Errorf(i18n.T("デプロイに失敗しました: %w"), err)
 	}
 
 	// 成功時のメッセージ
@@ -72,24 +75,24 @@ func DeployStack(ctx aws.Context, opts DeployOptions) error {
 
 	if hasNoChanges {
 		// 変更なしの場合
-		fmt.Printf("\n✅ スタックは最新の状態です（変更なし）\n")
+		common.Infof(ctx, "%s", i18n.T("\n✅ スタックは最新の状態です（変更なし）\n"))
 	} else if opts.IsChangeSetOnly {
 		// 変更あり + Change Set作成のみ
-		fmt.Printf("\n✅ Change Setの作成が完了しました\n")
+		common.Infof(ctx, "%s", i18n.T("\n✅ Change Setの作成が完了しました\n"))
 	} else {
 		// 変更あり + デプロイ完了
-		fmt.Printf("\n✅ デプロイが完了しました\n")
+		common.Infof(ctx, "%s", i18n.T("\n✅ デプロイが完了しました\n"))
 	}
 
 	return nil
 }
 
 // displayFailedEvents はスタックの失敗イベントを読みやすく表示する
-func displayFailedEvents(ctx aws.Context, stackName string) error {
+func displayFailedEvents(ctx context.Context, awsCtx aws.Context, stackName string) error {
 	// AWS SDK設定をロード
-	cfg, err := aws.LoadAwsConfig(ctx)
+	cfg, err := aws.LoadAwsConfig(ctx, awsCtx)
 	if err != nil {
-		return fmt.Errorf("AWS設定の読み込みに失敗: %w", err)
+		return fmt.Errorf(i18n.T("AWS設定の読み込みに失敗: %w"), err)
 	}
 
 	// CloudFormation クライアントを作成
@@ -100,9 +103,9 @@ func displayFailedEvents(ctx aws.Context, stackName string) error {
 		StackName: awssdk.String(stackName),
 	}
 
-	result, err := client.DescribeStackEvents(context.Background(), input)
+	result, err := client.DescribeStackEvents(ctx, input)
 	if err != nil {
-		return fmt.Errorf("スタックイベントの取得に失敗: %w", err)
+		return fmt.Errorf(i18n.T("スタックイベントの取得に失敗: %w"), err)
 	}
 
 	// 失敗イベントのみをフィルタ（リソースIDごとに最新のものだけ）
@@ -128,7 +131,7 @@ func displayFailedEvents(ctx aws.Context, stackName string) error {
 	}
 
 	if len(failedEvents) == 0 {
-		fmt.Fprintf(os.Stderr, "⚠️  失敗イベントが見つかりませんでした\n")
+		fmt.Fprint(os.Stderr, i18n.T("⚠️  失敗イベントが見つかりませんでした\n"))
 		return nil
 	}
 
@@ -138,12 +141,12 @@ func displayFailedEvents(ctx aws.Context, stackName string) error {
 			fmt.Fprintf(os.Stderr, "\n")
 		}
 		fmt.Fprintf(os.Stderr, "━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━━\n")
-		fmt.Fprintf(os.Stderr, "📍 リソース: %s\n", awssdk.ToString(event.LogicalResourceId))
-		fmt.Fprintf(os.Stderr, "⏰ 時刻: %s\n", event.Timestamp.Format("2006-01-02 15:04:05"))
-		fmt.Fprintf(os.Stderr, "❌ ステータス: %s\n", event.ResourceStatus)
+		fmt.Fprintf(os.Stderr, i18n.T("📍 リソース: %s\n"), awssdk.ToString(event.LogicalResourceId))
+		fmt.Fprintf(os.Stderr, i18n.T("⏰ 時刻: %s\n"), event.Timestamp.Format("2006-01-02 15:04:05"))
+		fmt.Fprintf(os.Stderr, i18n.T("❌ ステータス: %s\n"), event.ResourceStatus)
 
 		if event.ResourceStatusReason != nil {
-			fmt.Fprintf(os.Stderr, "💬 理由:\n")
+			fmt.Fprint(os.Stderr, i18n.T("💬 理由:\n"))
 			// 長いメッセージを折り返して表示
 			reason := awssdk.ToString(event.ResourceStatusReason)
 			const maxWidth = 70
@@ -177,24 +180,24 @@ func resolveParameters(params map[string]string, paramFile string) (map[string]s
 	if paramFile != "" {
 		// .json拡張子チェック
 		if !strings.HasSuffix(strings.ToLower(paramFile), ".json") {
-			return nil, fmt.Errorf("パラメータファイルは.json形式である必要があります: %s", paramFile)
+			return nil, fmt.Errorf(i18n.T("パラメータファイルは.json形式である必要があります: %s"), paramFile)
 		}
 
 		// ファイル存在確認
 		if _, err := os.Stat(paramFile); os.IsNotExist(err) {
-			return nil, fmt.Errorf("パラメータファイルが見つかりません: %s", paramFile)
+			return nil, fmt.Errorf(i18n.T("パラメータファイルが見つかりません: %s"), paramFile)
 		}
 
 		// JSONファイルを読み込み
 		data, err := os.ReadFile(paramFile)
 		if err != nil {
-			return nil, fmt.Errorf("パラメータファイルの読み込みに失敗しました: %w", err)
+			return nil, fmt.Errorf(i18n.T("パラメータファイルの読み込みに失敗しました: %w"), err)
 		}
 
 		// JSONをパース
 		var fileParams map[string]string
 		if err := json.Unmarshal(data, &fileParams); err != nil {
-			return nil, fmt.Errorf("パラメータファイルのJSON解析に失敗しました: %w", err)
+			return nil, fmt.Errorf(i18n.T("パラメータファイルのJSON解析に失敗しました: %w"), err)
 		}
 
 		return fileParams, nil
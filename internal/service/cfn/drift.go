@@ -1,9 +1,12 @@
 package cfn
 
 import (
-	"awstk/internal/service/common"
 	"context"
 	"fmt"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
@@ -14,11 +17,11 @@ import (
 func driftStatusString(status types.StackDriftStatus) string {
 	switch status {
 	case types.StackDriftStatusDrifted:
-		return "ドリフトあり"
+		return i18n.T("ドリフトあり")
 	case types.StackDriftStatusInSync:
-		return "同期中"
+		return i18n.T("同期中")
 	case types.StackDriftStatusNotChecked:
-		return "未確認"
+		return i18n.T("未確認")
 	default:
 		return string(status)
 	}
@@ -40,58 +43,63 @@ func getDriftDetectableStatuses() []types.StackStatus {
 	}
 }
 
-// DetectDrift は指定した条件に一致するスタックのドリフト検出を実行します
-func DetectDrift(cfnClient *cloudformation.Client, opts DriftOptions) error {
+// DetectDrift は指定した条件に一致するスタックのドリフト検出を開始し、スタックごとの開始結果を返します
+// 一部のスタックで開始に失敗した場合も残りのスタックの処理は継続し、失敗は各結果の Err に格納します
+func DetectDrift(ctx context.Context, cfnClient CfnApi, opts DriftOptions) ([]DriftDetection, error) {
 	// 対象のスタックを検索
-	stacks, err := findStacksForDrift(cfnClient, opts)
+	stacks, err := findStacksForDrift(ctx, cfnClient, opts)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	if len(stacks) == 0 {
-		fmt.Println("対象のスタックが見つかりませんでした")
-		return nil
+		common.Infof(ctx, "%s\n", i18n.T("対象のスタックが見つかりませんでした"))
+		return nil, nil
 	}
 
 	// 検出対象のスタック一覧を表示
-	fmt.Printf("🔍 ドリフト検出を実行するスタック:\n")
+	common.Infof(ctx, "%s", i18n.T("🔍 ドリフト検出を実行するスタック:\n"))
 	for _, stack := range stacks {
-		fmt.Printf("  - %s\n", aws.ToString(stack.StackName))
+		common.Infof(ctx, "  - %s\n", aws.ToString(stack.StackName))
 	}
-	fmt.Printf("\n合計 %d 個のスタックでドリフト検出を実行します\n", len(stacks))
+	common.Infof(ctx, i18n.T("\n合計 %d 個のスタックでドリフト検出を実行します\n"), len(stacks))
 
 	// ドリフト検出を実行
-	fmt.Println("\nドリフト検出を開始します...")
-	detectionIds := make(map[string]string) // stackName -> detectionId
+	common.Infof(ctx, "%s\n", i18n.T("\nドリフト検出を開始します..."))
+	detections := make([]DriftDetection, 0, len(stacks))
+	started := 0
 
 	for _, stack := range stacks {
 		stackName := aws.ToString(stack.StackName)
-		fmt.Printf("スタック %s のドリフト検出を開始中...", stackName)
+		common.Progressf(ctx, common.EventStart, stackName, i18n.T("スタック %s のドリフト検出を開始中..."), stackName)
 
-		output, err := cfnClient.DetectStackDrift(context.Background(), &cloudformation.DetectStackDriftInput{
+		output, err := cfnClient.DetectStackDrift(ctx, &cloudformation.DetectStackDriftInput{
 			StackName: aws.String(stackName),
 		})
 		if err != nil {
-			fmt.Printf("\n❌ スタック %s のドリフト検出開始に失敗しました: %v\n", stackName, err)
+			common.Failuref(ctx, stackName, err, i18n.T("\n❌ スタック %s のドリフト検出開始に失敗しました: %v\n"), stackName, err)
+			detections = append(detections, DriftDetection{StackName: stackName, Err: err})
 			continue
 		}
 
-		detectionIds[stackName] = aws.ToString(output.StackDriftDetectionId)
-		fmt.Printf(" ✅ (検出ID: %s)\n", aws.ToString(output.StackDriftDetectionId))
+		detectionId := aws.ToString(output.StackDriftDetectionId)
+		detections = append(detections, DriftDetection{StackName: stackName, DetectionId: detectionId})
+		started++
+		common.Progressf(ctx, common.EventSuccess, stackName, i18n.T(" ✅ (検出ID: %s)\n"), detectionId)
 	}
 
-	if len(detectionIds) > 0 {
-		fmt.Printf("\n✅ %d 個のスタックでドリフト検出を開始しました\n", len(detectionIds))
-		fmt.Println("ℹ️  検出結果は 'awstk cfn drift-status' コマンドで確認できます")
+	if started > 0 {
+		common.Infof(ctx, i18n.T("\n✅ %d 個のスタックでドリフト検出を開始しました\n"), started)
+		common.Infof(ctx, "%s\n", i18n.T("ℹ️  検出結果は 'awstk cfn drift-status' コマンドで確認できます"))
 	}
 
-	return nil
+	return detections, nil
 }
 
 // ShowDriftStatus は指定した条件に一致するスタックのドリフト状態を表示します
-func ShowDriftStatus(cfnClient *cloudformation.Client, opts DriftStatusOptions) error {
+func ShowDriftStatus(ctx context.Context, cfnClient CfnApi, opts DriftStatusOptions) error {
 	// 対象のスタックを検索
-	stacks, err := findStacksForDrift(cfnClient, DriftOptions{
+	stacks, err := findStacksForDrift(ctx, cfnClient, DriftOptions{
 		Stacks: opts.Stacks,
 		Filter: opts.Filter,
 		All:    opts.All,
@@ -102,24 +110,116 @@ func ShowDriftStatus(cfnClient *cloudformation.Client, opts DriftStatusOptions)
 	}
 
 	if len(stacks) == 0 {
-		fmt.Println("対象のスタックが見つかりませんでした")
+		common.Infof(ctx, "%s\n", i18n.T("対象のスタックが見つかりませんでした"))
 		return nil
 	}
 
 	// ドリフト状態を確認
-	fmt.Println("🔍 スタックのドリフト状態を確認中...")
-	driftedCount := 0
-	notCheckedCount := 0
+	common.Infof(ctx, "%s\n", i18n.T("🔍 スタックのドリフト状態を確認中..."))
+	statuses := getDriftStatuses(ctx, cfnClient, stacks)
+	driftedCount, notCheckedCount := countDriftStatuses(statuses)
+
+	for _, status := range statuses {
+		// --drifted-onlyが指定されている場合、ドリフトしていないスタックはスキップ
+		if opts.DriftedOnly && status.Status != types.StackDriftStatusDrifted {
+			continue
+		}
+
+		// ドリフト状態を表示
+		statusIcon := "✅"
+		switch status.Status {
+		case types.StackDriftStatusDrifted:
+			statusIcon = "⚠️ "
+		case types.StackDriftStatusNotChecked:
+			statusIcon = "❓"
+		}
+
+		fmt.Printf("%s %s: %s", statusIcon, status.StackName, driftStatusString(status.Status))
+
+		// 最終チェック時刻を表示
+		if status.LastCheckTime != nil {
+			fmt.Printf(i18n.T(" (最終チェック: %s)"), formatLastCheckTime(status.LastCheckTime))
+		}
+		fmt.Println()
+	}
+
+	printDriftSummary(len(stacks), driftedCount, notCheckedCount)
+	return nil
+}
+
+// GetDriftStatuses は指定した条件に一致するスタックのドリフト状態を返します
+func GetDriftStatuses(ctx context.Context, cfnClient CfnApi, opts DriftStatusOptions) ([]DriftStatus, error) {
+	stacks, err := findStacksForDrift(ctx, cfnClient, DriftOptions{
+		Stacks: opts.Stacks,
+		Filter: opts.Filter,
+		All:    opts.All,
+		Exact:  opts.Exact,
+	})
+	if err != nil {
+		return nil, err
+	}
+	statuses := getDriftStatuses(ctx, cfnClient, stacks)
+	if opts.DriftedOnly {
+		statuses = filterDrifted(statuses)
+	}
+	return statuses, nil
+}
+
+// ShowDriftStatusAcross は複数のアカウント・リージョンのスタックのドリフト状態を並列に取得し、アカウント列・リージョン列付きで表示します
+func ShowDriftStatusAcross(ctx context.Context, targets []common.Target, newClient func(target common.Target) CfnApi, opts DriftStatusOptions) error {
+	results := common.FetchAcrossTargets(ctx, targets, func(ctx context.Context, target common.Target) ([]DriftStatus, error) {
+		client := newClient(target)
+		stacks, err := findStacksForDrift(ctx, client, DriftOptions{
+			Stacks: opts.Stacks,
+			Filter: opts.Filter,
+			All:    opts.All,
+			Exact:  opts.Exact,
+		})
+		if err != nil {
+			return nil, err
+		}
+		return getDriftStatuses(ctx, client, stacks), nil
+	})
+
+	total, driftedCount, notCheckedCount := 0, 0, 0
+	for i, r := range results {
+		total += len(r.Items)
+		drifted, notChecked := countDriftStatuses(r.Items)
+		driftedCount += drifted
+		notCheckedCount += notChecked
+		if opts.DriftedOnly {
+			results[i].Items = filterDrifted(r.Items)
+		}
+	}
+
+	err := common.DisplayTargetList(
+		results,
+		i18n.T("CloudFormationスタックのドリフト状態"),
+		driftStatusesToTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: i18n.T("対象のスタックが見つかりませんでした"),
+		},
+	)
+	if !common.IsStructuredOutput() && total > 0 {
+		printDriftSummary(total, driftedCount, notCheckedCount)
+	}
+	return err
+}
 
+// getDriftStatuses はスタックごとの最新のドリフト状態を取得します
+// 情報の取得に失敗したスタックはエラーを表示してスキップします
+func getDriftStatuses(ctx context.Context, cfnClient CfnApi, stacks []types.Stack) []DriftStatus {
+	var statuses []DriftStatus
 	for _, stack := range stacks {
 		stackName := aws.ToString(stack.StackName)
 
 		// スタックの詳細情報を取得（ドリフト情報を含む）
-		describeOutput, err := cfnClient.DescribeStacks(context.Background(), &cloudformation.DescribeStacksInput{
+		describeOutput, err := cfnClient.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
 			StackName: aws.String(stackName),
 		})
 		if err != nil {
-			fmt.Printf("❌ スタック %s の情報取得に失敗しました: %v\n", stackName, err)
+			common.Warnf(ctx, i18n.T("❌ スタック %s の情報取得に失敗しました: %v\n"), stackName, err)
 			continue
 		}
 
@@ -127,61 +227,85 @@ func ShowDriftStatus(cfnClient *cloudformation.Client, opts DriftStatusOptions)
 			continue
 		}
 
-		stackInfo := describeOutput.Stacks[0]
-		driftInfo := stackInfo.DriftInformation
+		driftInfo := describeOutput.Stacks[0].DriftInformation
 		if driftInfo == nil {
 			continue
 		}
+		statuses = append(statuses, DriftStatus{
+			StackName:     stackName,
+			Status:        driftInfo.StackDriftStatus,
+			LastCheckTime: driftInfo.LastCheckTimestamp,
+		})
+	}
+	return statuses
+}
 
-		// ドリフト状態を確認
-		driftStatus := driftInfo.StackDriftStatus
-		switch driftStatus {
+// countDriftStatuses はドリフトありと未確認のスタック数を返します
+func countDriftStatuses(statuses []DriftStatus) (int, int) {
+	driftedCount, notCheckedCount := 0, 0
+	for _, status := range statuses {
+		switch status.Status {
 		case types.StackDriftStatusNotChecked:
 			notCheckedCount++
 		case types.StackDriftStatusDrifted:
 			driftedCount++
 		}
+	}
+	return driftedCount, notCheckedCount
+}
 
-		// --drifted-onlyが指定されている場合、ドリフトしていないスタックはスキップ
-		if opts.DriftedOnly && driftStatus != types.StackDriftStatusDrifted {
-			continue
-		}
-
-		// ドリフト状態を表示
-		statusStr := driftStatusString(driftStatus)
-		statusIcon := "✅"
-		switch driftStatus {
-		case types.StackDriftStatusDrifted:
-			statusIcon = "⚠️ "
-		case types.StackDriftStatusNotChecked:
-			statusIcon = "❓"
+// filterDrifted はドリフトしているスタックのみを返します
+func filterDrifted(statuses []DriftStatus) []DriftStatus {
+	var drifted []DriftStatus
+	for _, status := range statuses {
+		if status.Status == types.StackDriftStatusDrifted {
+			drifted = append(drifted, status)
 		}
+	}
+	return drifted
+}
 
-		fmt.Printf("%s %s: %s", statusIcon, stackName, statusStr)
+// driftStatusesToTableData はドリフト状態をテーブルデータに変換します
+func driftStatusesToTableData(statuses []DriftStatus) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("スタック名"), Key: "stackName"},
+		{Header: i18n.T("ドリフト状態"), Key: "driftStatus"},
+		{Header: i18n.T("最終チェック"), Key: "lastCheck"},
+	}
 
-		// 最終チェック時刻を表示
-		if driftInfo.LastCheckTimestamp != nil {
-			checkTime := aws.ToTime(driftInfo.LastCheckTimestamp)
-			fmt.Printf(" (最終チェック: %s)", checkTime.Format("2006-01-02 15:04:05"))
+	data := make([][]string, len(statuses))
+	for i, status := range statuses {
+		driftStatus := driftStatusString(status.Status)
+		if common.IsStructuredOutput() {
+			driftStatus = string(status.Status)
 		}
-		fmt.Println()
+		data[i] = []string{status.StackName, driftStatus, formatLastCheckTime(status.LastCheckTime)}
 	}
+	return columns, data
+}
 
-	// サマリーを表示
-	fmt.Printf("\n📊 サマリー:\n")
-	fmt.Printf("  - 合計: %d スタック\n", len(stacks))
-	fmt.Printf("  - ドリフトあり: %d スタック\n", driftedCount)
-	fmt.Printf("  - 未確認: %d スタック\n", notCheckedCount)
+// formatLastCheckTime は最終チェック時刻を表示用の文字列に変換します
+func formatLastCheckTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return aws.ToTime(t).Format("2006-01-02 15:04:05")
+}
+
+// printDriftSummary はドリフト状態のサマリーを表示します
+func printDriftSummary(total, driftedCount, notCheckedCount int) {
+	fmt.Print(i18n.T("\n📊 サマリー:\n"))
+	fmt.Printf(i18n.T("  - 合計: %d スタック\n"), total)
+	fmt.Printf(i18n.T("  - ドリフトあり: %d スタック\n"), driftedCount)
+	fmt.Printf(i18n.T("  - 未確認: %d スタック\n"), notCheckedCount)
 
 	if notCheckedCount > 0 {
-		fmt.Println("\nℹ️  未確認のスタックがあります。'awstk cfn drift-detect' でドリフト検出を実行してください")
+		fmt.Println(i18n.T("\nℹ️  未確認のスタックがあります。'awstk cfn drift-detect' でドリフト検出を実行してください"))
 	}
-
-	return nil
 }
 
 // findStacksForDrift はドリフト検出対象のスタックを検索します
-func findStacksForDrift(cfnClient *cloudformation.Client, opts DriftOptions) ([]types.Stack, error) {
+func findStacksForDrift(ctx context.Context, cfnClient CfnApi, opts DriftOptions) ([]types.Stack, error) {
 	var allStacks []types.Stack
 
 	// スタック名が指定されている場合
@@ -192,11 +316,11 @@ func findStacksForDrift(cfnClient *cloudformation.Client, opts DriftOptions) ([]
 			}
 
 			// スタックの詳細情報を取得
-			describeOutput, err := cfnClient.DescribeStacks(context.Background(), &cloudformation.DescribeStacksInput{
+			describeOutput, err := cfnClient.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
 				StackName: aws.String(stackName),
 			})
 			if err != nil {
-				fmt.Printf("⚠️  スタック %s が見つかりません: %v\n", stackName, err)
+				common.Warnf(ctx, i18n.T("⚠️  スタック %s が見つかりません: %v\n"), stackName, err)
 				continue
 			}
 			if len(describeOutput.Stacks) > 0 {
@@ -205,7 +329,7 @@ func findStacksForDrift(cfnClient *cloudformation.Client, opts DriftOptions) ([]
 				if isDriftDetectable(stack.StackStatus) {
 					allStacks = append(allStacks, stack)
 				} else {
-					fmt.Printf("⚠️  スタック %s はドリフト検出できない状態です (Status: %s)\n", stackName, stack.StackStatus)
+					common.Warnf(ctx, i18n.T("⚠️  スタック %s はドリフト検出できない状態です (Status: %s)\n"), stackName, stack.StackStatus)
 				}
 			}
 		}
@@ -221,16 +345,16 @@ func findStacksForDrift(cfnClient *cloudformation.Client, opts DriftOptions) ([]
 			StackStatusFilter: getDriftDetectableStatuses(),
 		}
 
-		output, err := cfnClient.ListStacks(context.Background(), input)
+		output, err := cfnClient.ListStacks(ctx, input)
 		if err != nil {
-			return nil, fmt.Errorf("スタック一覧の取得に失敗しました: %w", err)
+			return nil, fmt.Errorf(i18n.T("スタック一覧の取得に失敗しました: %w"), err)
 		}
 
 		// フィルター処理
 		for _, summary := range output.StackSummaries {
 			if opts.All || (opts.Filter != "" && common.MatchesFilter(aws.ToString(summary.StackName), opts.Filter, opts.Exact)) {
 				// スタックの詳細情報を取得
-				describeOutput, err := cfnClient.DescribeStacks(context.Background(), &cloudformation.DescribeStacksInput{
+				describeOutput, err := cfnClient.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
 					StackName: summary.StackName,
 				})
 				if err != nil {
@@ -4,6 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
@@ -12,7 +16,7 @@ import (
 // ListCfnStacks はCloudFormationスタック一覧を返す
 // showAll が true の場合は全てのステータスのスタックを取得する
 // showAll が false の場合はアクティブなスタックのみを取得する
-func ListCfnStacks(cfnClient *cloudformation.Client, showAll bool) ([]Stack, error) {
+func ListCfnStacks(ctx context.Context, cfnClient CfnApi, showAll bool) ([]Stack, error) {
 	activeStatuses := []types.StackStatus{
 		types.StackStatusCreateComplete,
 		types.StackStatusUpdateComplete,
@@ -30,14 +34,15 @@ func ListCfnStacks(cfnClient *cloudformation.Client, showAll bool) ([]Stack, err
 			input.StackStatusFilter = activeStatuses
 		}
 
-		resp, err := cfnClient.ListStacks(context.Background(), input)
+		resp, err := cfnClient.ListStacks(ctx, input)
 		if err != nil {
-			return nil, fmt.Errorf("スタック一覧取得エラー: %w", err)
+			return nil, fmt.Errorf(i18n.T("スタック一覧取得エラー: %w"), err)
 		}
 
 		for _, summary := range resp.StackSummaries {
 			stacks = append(stacks, Stack{
 				Name:   aws.ToString(summary.StackName),
+				Id:     aws.ToString(summary.StackId),
 				Status: string(summary.StackStatus),
 			})
 		}
@@ -50,3 +55,44 @@ func ListCfnStacks(cfnClient *cloudformation.Client, showAll bool) ([]Stack, err
 
 	return stacks, nil
 }
+
+// ListCfnStacksAcross は複数のアカウント・リージョンのCloudFormationスタックを並列に取得し、アカウント列・リージョン列付きで表示する
+func ListCfnStacksAcross(ctx context.Context, targets []common.Target, newClient func(target common.Target) CfnApi, showAll bool, tags *tagging.Selector) error {
+	results := common.FetchAcrossTargets(ctx, targets, func(ctx context.Context, target common.Target) ([]Stack, error) {
+		stacks, err := ListCfnStacks(ctx, newClient(target), showAll)
+		if err != nil {
+			return nil, err
+		}
+		return SelectStacksByTags(ctx, tags, target, stacks)
+	})
+	return common.DisplayTargetList(
+		results,
+		i18n.T("CloudFormationスタック一覧"),
+		stacksToTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("CloudFormationスタック")),
+		},
+	)
+}
+
+// SelectStacksByTags はタグ条件に一致するスタックに絞り込む
+func SelectStacksByTags(ctx context.Context, tags *tagging.Selector, target common.Target, stacks []Stack) ([]Stack, error) {
+	return tagging.Select(ctx, tags, target, tagging.TypeCfnStack, stacks, func(s Stack) string {
+		return s.Id
+	})
+}
+
+// stacksToTableData はスタック一覧をテーブルデータに変換
+func stacksToTableData(stacks []Stack) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("スタック名"), Key: "name"},
+		{Header: i18n.T("ステータス"), Key: "status"},
+	}
+
+	data := make([][]string, len(stacks))
+	for i, stk := range stacks {
+		data[i] = []string{stk.Name, stk.Status}
+	}
+	return columns, data
+}
@@ -0,0 +1,95 @@
+package cfn
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
+// RetainedResource は削除済みスタックに残されたリソース（DeletionPolicy: Retain 等により削除されなかったもの）
+type RetainedResource struct {
+	StackName    string
+	StackId      string
+	DeletedAt    *time.Time // スタックの削除日時
+	LogicalId    string
+	ResourceType string // 例: "AWS::S3::Bucket"
+	PhysicalId   string
+}
+
+// FindRetainedResources は削除済み（DELETE_COMPLETE）のスタックから、削除されずに残されたリソースを取得します
+// 削除済みスタックの履歴は削除から90日間のみ取得できます
+// filter が指定された場合は、スタック名がフィルターに一致するスタックのみを対象にします（exact が true の場合、大文字小文字を区別します）
+// DeletionPolicy が Retain のリソースや削除をスキップしたリソースは、ステータスが DELETE_SKIPPED になります
+func FindRetainedResources(ctx context.Context, cfnClient CfnApi, filter string, exact bool) ([]RetainedResource, error) {
+	var deletedStacks []types.StackSummary
+	paginator := cloudformation.NewListStacksPaginator(cfnClient, &cloudformation.ListStacksInput{
+		StackStatusFilter: []types.StackStatus{types.StackStatusDeleteComplete},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("スタック一覧取得エラー: %w"), err)
+		}
+		for _, summary := range output.StackSummaries {
+			if common.MatchesFilter(aws.ToString(summary.StackName), filter, exact) {
+				deletedStacks = append(deletedStacks, summary)
+			}
+		}
+	}
+	common.Infof(ctx, i18n.T("🔍 削除済みスタック %d件のリソースを確認中...\n"), len(deletedStacks))
+
+	// リソースの取得に失敗したスタックは警告を表示し、残りのスタックの確認を続ける
+	retained := []RetainedResource{}
+	for _, stack := range deletedStacks {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		stackName := aws.ToString(stack.StackName)
+		resources, err := listDeletedStackResources(ctx, cfnClient, aws.ToString(stack.StackId))
+		if err != nil {
+			common.Progressf(ctx, common.EventWarning, stackName, "%s  %v\n", common.WarningIcon, fmt.Errorf(i18n.T("スタック '%s' のリソース取得エラー: %w"), stackName, err))
+			continue
+		}
+		for _, resource := range resources {
+			physicalId := aws.ToString(resource.PhysicalResourceId)
+			if resource.ResourceStatus != types.ResourceStatusDeleteSkipped || physicalId == "" {
+				continue
+			}
+			retained = append(retained, RetainedResource{
+				StackName:    stackName,
+				StackId:      aws.ToString(stack.StackId),
+				DeletedAt:    stack.DeletionTime,
+				LogicalId:    aws.ToString(resource.LogicalResourceId),
+				ResourceType: aws.ToString(resource.ResourceType),
+				PhysicalId:   physicalId,
+			})
+			common.Debugf(ctx, i18n.T("📦 スタック '%s' に残されたリソース: %s (%s)\n"), stackName, physicalId, aws.ToString(resource.ResourceType))
+		}
+	}
+	return retained, nil
+}
+
+// listDeletedStackResources は削除済みスタックのリソースをすべて取得します
+// 削除済みスタックはスタック名では参照できないため、スタックIDで取得します
+// DescribeStackResources は100件までしか返さないため、ページングできる ListStackResources を使用します
+func listDeletedStackResources(ctx context.Context, cfnClient CfnApi, stackId string) ([]types.StackResourceSummary, error) {
+	var resources []types.StackResourceSummary
+	paginator := cloudformation.NewListStackResourcesPaginator(cfnClient, &cloudformation.ListStackResourcesInput{
+		StackName: aws.String(stackId),
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resources = append(resources, output.StackResourceSummaries...)
+	}
+	return resources, nil
+}
@@ -0,0 +1,91 @@
+package cfn
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/plan"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+	"github.com/aws/smithy-go"
+)
+
+// PlanStackDeletion は指定したスタックの削除アクションを作成します
+// 存在しないスタックは結果に含まれません（apply時の再検証でも使用）
+func PlanStackDeletion(ctx context.Context, cfnClient CfnApi, stackNames []string) ([]plan.Action, error) {
+	stacks, err := describeExistingStacks(ctx, cfnClient, stackNames)
+	if err != nil {
+		return nil, err
+	}
+	return stackDeletionActions(stacks), nil
+}
+
+// ApplyStackDeletion はプランのアクションに従ってスタックの削除リクエストを送信します
+func ApplyStackDeletion(ctx context.Context, cfnClient CfnApi, actions []plan.Action) common.CleanupResult {
+	stacks, err := describeExistingStacks(ctx, cfnClient, plan.Names(actions))
+	if err != nil {
+		result := common.CollectCleanupResult(i18n.T("CloudFormationスタック"), nil)
+		result.Failed = plan.Names(actions)
+		return result
+	}
+	return deleteStacks(ctx, cfnClient, stacks)
+}
+
+// stackDeletionActions はスタックの削除アクションを作成します（削除保護が有効なスタックは含めません）
+func stackDeletionActions(stacks []types.Stack) []plan.Action {
+	actions := []plan.Action{}
+	for _, stack := range stacks {
+		if aws.ToBool(stack.EnableTerminationProtection) {
+			continue
+		}
+		lastUpdated := stack.LastUpdatedTime
+		if lastUpdated == nil {
+			lastUpdated = stack.CreationTime
+		}
+		actions = append(actions, plan.Action{
+			Type:   plan.ResourceCfnStack,
+			Action: plan.ActionDelete,
+			Name:   aws.ToString(stack.StackName),
+			Id:     aws.ToString(stack.StackId),
+			Fingerprint: map[string]string{
+				"lastUpdatedTime":       plan.FormatTime(lastUpdated),
+				"terminationProtection": strconv.FormatBool(aws.ToBool(stack.EnableTerminationProtection)),
+			},
+		})
+	}
+	return actions
+}
+
+// describeExistingStacks は指定したスタックの詳細を取得します（存在しない・削除済みのスタックは含めません）
+func describeExistingStacks(ctx context.Context, cfnClient CfnApi, stackNames []string) ([]types.Stack, error) {
+	var stacks []types.Stack
+	for _, name := range stackNames {
+		output, err := cfnClient.DescribeStacks(ctx, &cloudformation.DescribeStacksInput{
+			StackName: aws.String(name),
+		})
+		if err != nil {
+			if isStackNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		for _, stack := range output.Stacks {
+			if stack.StackStatus != types.StackStatusDeleteComplete {
+				stacks = append(stacks, stack)
+			}
+		}
+	}
+	return stacks, nil
+}
+
+// isStackNotFound はスタックが存在しないことを示すエラーかを判定します
+func isStackNotFound(err error) bool {
+	var apiErr smithy.APIError
+	return errors.As(err, &apiErr) && apiErr.ErrorCode() == "ValidationError" && strings.Contains(apiErr.ErrorMessage(), "does not exist")
+}
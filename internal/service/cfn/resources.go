@@ -1,22 +1,23 @@
 package cfn
 
 import (
-	"errors"
+	"context"
 	"fmt"
 	"strings"
 
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
 )
 
 // GetEc2FromStack はCloudFormationスタックからEC2インスタンスIDを取得します
-func GetEc2FromStack(cfnClient *cloudformation.Client, stackName string) (string, error) {
-	allInstances, err := GetAllEc2FromStack(cfnClient, stackName)
+func GetEc2FromStack(ctx context.Context, cfnClient CfnApi, stackName string) (string, error) {
+	allInstances, err := GetAllEc2FromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return "", err
 	}
 
 	if len(allInstances) == 0 {
-		return "", fmt.Errorf("スタック '%s' にEC2インスタンスが見つかりませんでした", stackName)
+		return "", fmt.Errorf(i18n.T("スタック '%s' にEC2インスタンスが見つかりませんでした"), stackName)
 	}
 
 	// 複数のインスタンスがある場合は最初の要素を返す
@@ -24,9 +25,9 @@ func GetEc2FromStack(cfnClient *cloudformation.Client, stackName string) (string
 }
 
 // GetAllEc2FromStack はCloudFormationスタックからすべてのEC2インスタンス識別子を取得します
-func GetAllEc2FromStack(cfnClient *cloudformation.Client, stackName string) ([]string, error) {
+func GetAllEc2FromStack(ctx context.Context, cfnClient CfnApi, stackName string) ([]string, error) {
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
@@ -35,7 +36,7 @@ func GetAllEc2FromStack(cfnClient *cloudformation.Client, stackName string) ([]s
 	for _, resource := range stackResources {
 		if *resource.ResourceType == "AWS::EC2::Instance" && resource.PhysicalResourceId != nil {
 			instanceIds = append(instanceIds, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたEC2インスタンス: %s\n", *resource.PhysicalResourceId)
+			common.Infof(ctx, i18n.T("🔍 検出されたEC2インスタンス: %s\n"), *resource.PhysicalResourceId)
 		}
 	}
 
@@ -43,14 +44,14 @@ func GetAllEc2FromStack(cfnClient *cloudformation.Client, stackName string) ([]s
 }
 
 // GetRdsFromStack はCloudFormationスタックからRDSインスタンス識別子を取得します
-func GetRdsFromStack(cfnClient *cloudformation.Client, stackName string) (string, error) {
-	allInstances, err := GetAllRdsFromStack(cfnClient, stackName)
+func GetRdsFromStack(ctx context.Context, cfnClient CfnApi, stackName string) (string, error) {
+	allInstances, err := GetAllRdsFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return "", err
 	}
 
 	if len(allInstances) == 0 {
-		return "", fmt.Errorf("スタック '%s' にRDSインスタンスが見つかりませんでした", stackName)
+		return "", fmt.Errorf(i18n.T("スタック '%s' にRDSインスタンスが見つかりませんでした"), stackName)
 	}
 
 	// 複数のインスタンスがある場合は最初の要素を返す
@@ -58,9 +59,9 @@ func GetRdsFromStack(cfnClient *cloudformation.Client, stackName string) (string
 }
 
 // GetAllRdsFromStack はCloudFormationスタックからすべてのRDSインスタンス識別子を取得します
-func GetAllRdsFromStack(cfnClient *cloudformation.Client, stackName string) ([]string, error) {
+func GetAllRdsFromStack(ctx context.Context, cfnClient CfnApi, stackName string) ([]string, error) {
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +70,7 @@ func GetAllRdsFromStack(cfnClient *cloudformation.Client, stackName string) ([]s
 	for _, resource := range stackResources {
 		if *resource.ResourceType == "AWS::RDS::DBInstance" && resource.PhysicalResourceId != nil {
 			instanceIds = append(instanceIds, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたRDSインスタンス: %s\n", *resource.PhysicalResourceId)
+			common.Infof(ctx, i18n.T("🔍 検出されたRDSインスタンス: %s\n"), *resource.PhysicalResourceId)
 		}
 	}
 
@@ -77,14 +78,14 @@ func GetAllRdsFromStack(cfnClient *cloudformation.Client, stackName string) ([]s
 }
 
 // GetAuroraFromStack はCloudFormationスタックからAuroraクラスター識別子を取得します
-func GetAuroraFromStack(cfnClient *cloudformation.Client, stackName string) (string, error) {
-	allClusters, err := GetAllAuroraFromStack(cfnClient, stackName)
+func GetAuroraFromStack(ctx context.Context, cfnClient CfnApi, stackName string) (string, error) {
+	allClusters, err := GetAllAuroraFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return "", err
 	}
 
 	if len(allClusters) == 0 {
-		return "", fmt.Errorf("スタック '%s' にAuroraクラスターが見つかりませんでした", stackName)
+		return "", fmt.Errorf(i18n.T("スタック '%s' にAuroraクラスターが見つかりませんでした"), stackName)
 	}
 
 	// 複数のクラスターがある場合は最初の要素を返す
@@ -92,9 +93,9 @@ func GetAuroraFromStack(cfnClient *cloudformation.Client, stackName string) (str
 }
 
 // GetAllAuroraFromStack はCloudFormationスタックからすべてのAuroraクラスター識別子を取得します
-func GetAllAuroraFromStack(cfnClient *cloudformation.Client, stackName string) ([]string, error) {
+func GetAllAuroraFromStack(ctx context.Context, cfnClient CfnApi, stackName string) ([]string, error) {
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
@@ -103,7 +104,7 @@ func GetAllAuroraFromStack(cfnClient *cloudformation.Client, stackName string) (
 	for _, resource := range stackResources {
 		if *resource.ResourceType == "AWS::RDS::DBCluster" && resource.PhysicalResourceId != nil {
 			clusterIds = append(clusterIds, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたAuroraクラスター: %s\n", *resource.PhysicalResourceId)
+			common.Infof(ctx, i18n.T("🔍 検出されたAuroraクラスター: %s\n"), *resource.PhysicalResourceId)
 		}
 	}
 
@@ -111,14 +112,14 @@ func GetAllAuroraFromStack(cfnClient *cloudformation.Client, stackName string) (
 }
 
 // GetEcsFromStack はCloudFormationスタックからECSサービス情報を取得します
-func GetEcsFromStack(cfnClient *cloudformation.Client, stackName string) (EcsServiceInfo, error) {
-	allServices, err := GetAllEcsFromStack(cfnClient, stackName)
+func GetEcsFromStack(ctx context.Context, cfnClient CfnApi, stackName string) (EcsServiceInfo, error) {
+	allServices, err := GetAllEcsFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return EcsServiceInfo{}, err
 	}
 
 	if len(allServices) == 0 {
-		return EcsServiceInfo{}, fmt.Errorf("スタック '%s' にECSサービスが見つかりませんでした", stackName)
+		return EcsServiceInfo{}, fmt.Errorf(i18n.T("スタック '%s' にECSサービスが見つかりませんでした"), stackName)
 	}
 
 	// 複数のサービスがある場合は最初の要素を返す
@@ -126,9 +127,9 @@ func GetEcsFromStack(cfnClient *cloudformation.Client, stackName string) (EcsSer
 }
 
 // GetAllEcsFromStack はCloudFormationスタックからすべてのECSサービス識別子を取得します
-func GetAllEcsFromStack(cfnClient *cloudformation.Client, stackName string) ([]EcsServiceInfo, error) {
+func GetAllEcsFromStack(ctx context.Context, cfnClient CfnApi, stackName string) ([]EcsServiceInfo, error) {
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
@@ -140,16 +141,16 @@ func GetAllEcsFromStack(cfnClient *cloudformation.Client, stackName string) ([]E
 	for _, resource := range stackResources {
 		if *resource.ResourceType == "AWS::ECS::Cluster" {
 			clusterPhysicalIds = append(clusterPhysicalIds, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたECSクラスター: %s\n", *resource.PhysicalResourceId)
+			common.Infof(ctx, i18n.T("🔍 検出されたECSクラスター: %s\n"), *resource.PhysicalResourceId)
 		}
 	}
 
 	if len(clusterPhysicalIds) == 0 {
-		return results, errors.New("スタック '" + stackName + "' からECSクラスターを検出できませんでした")
+		return results, fmt.Errorf(i18n.T("スタック '%s' からECSクラスターを検出できませんでした"), stackName)
 	}
 
 	// サービスリソースをフィルタリング
-	fmt.Println("🔍 スタック '" + stackName + "' からECSサービスを検索中...")
+	common.Infof(ctx, i18n.T("🔍 スタック '%s' からECSサービスを検索中...\n"), stackName)
 	var servicePhysicalIds []string
 	for _, resource := range stackResources {
 		if *resource.ResourceType == "AWS::ECS::Service" {
@@ -158,7 +159,7 @@ func GetAllEcsFromStack(cfnClient *cloudformation.Client, stackName string) ([]E
 	}
 
 	if len(servicePhysicalIds) == 0 {
-		return results, errors.New("スタック '" + stackName + "' からECSサービスを検出できませんでした")
+		return results, fmt.Errorf(i18n.T("スタック '%s' からECSサービスを検出できませんでした"), stackName)
 	}
 
 	// 各サービスについてクラスターとの組み合わせを作成
@@ -195,28 +196,28 @@ func GetAllEcsFromStack(cfnClient *cloudformation.Client, stackName string) ([]E
 				ClusterName: displayClusterName,
 				ServiceName: serviceName,
 			})
-			fmt.Printf("🔍 検出されたECSサービス: %s/%s\n", displayClusterName, serviceName)
+			common.Infof(ctx, i18n.T("🔍 検出されたECSサービス: %s/%s\n"), displayClusterName, serviceName)
 		} else {
-			fmt.Printf("⚠️ 警告: サービス %s のクラスター %s がスタック内で見つかりませんでした\n", serviceName, clusterNameFromArn)
+			common.Warnf(ctx, i18n.T("⚠️ 警告: サービス %s のクラスター %s がスタック内で見つかりませんでした\n"), serviceName, clusterNameFromArn)
 		}
 	}
 
 	if len(results) == 0 {
-		return results, errors.New("スタック '" + stackName + "' から有効なECSサービスを検出できませんでした")
+		return results, fmt.Errorf(i18n.T("スタック '%s' から有効なECSサービスを検出できませんでした"), stackName)
 	}
 
 	return results, nil
 }
 
 // GetCloudFrontFromStack はCloudFormationスタックからCloudFrontディストリビューション識別子を取得します
-func GetCloudFrontFromStack(cfnClient *cloudformation.Client, stackName string) (string, error) {
-	allDistributions, err := GetAllCloudFrontFromStack(cfnClient, stackName)
+func GetCloudFrontFromStack(ctx context.Context, cfnClient CfnApi, stackName string) (string, error) {
+	allDistributions, err := GetAllCloudFrontFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return "", err
 	}
 
 	if len(allDistributions) == 0 {
-		return "", fmt.Errorf("スタック '%s' にCloudFrontディストリビューションが見つかりませんでした", stackName)
+		return "", fmt.Errorf(i18n.T("スタック '%s' にCloudFrontディストリビューションが見つかりませんでした"), stackName)
 	}
 
 	// 複数のディストリビューションがある場合は最初の要素を返す
@@ -224,9 +225,9 @@ func GetCloudFrontFromStack(cfnClient *cloudformation.Client, stackName string)
 }
 
 // GetAllCloudFrontFromStack はCloudFormationスタックからすべてのCloudFrontディストリビューション識別子を取得します
-func GetAllCloudFrontFromStack(cfnClient *cloudformation.Client, stackName string) ([]string, error) {
+func GetAllCloudFrontFromStack(ctx context.Context, cfnClient CfnApi, stackName string) ([]string, error) {
 	// 共通関数を使用してスタックリソースを取得
-	stackResources, err := GetStackResources(cfnClient, stackName)
+	stackResources, err := GetStackResources(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
@@ -235,7 +236,7 @@ func GetAllCloudFrontFromStack(cfnClient *cloudformation.Client, stackName strin
 	for _, resource := range stackResources {
 		if *resource.ResourceType == "AWS::CloudFront::Distribution" && resource.PhysicalResourceId != nil {
 			distributionIds = append(distributionIds, *resource.PhysicalResourceId)
-			fmt.Printf("🔍 検出されたCloudFrontディストリビューション: %s\n", *resource.PhysicalResourceId)
+			common.Infof(ctx, i18n.T("🔍 検出されたCloudFrontディストリビューション: %s\n"), *resource.PhysicalResourceId)
 		}
 	}
 
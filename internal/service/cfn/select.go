@@ -0,0 +1,32 @@
+package cfn
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/selector"
+)
+
+// SelectCfnStack はアクティブなCloudFormationスタック一覧から対話的に選択させる
+func SelectCfnStack(ctx context.Context, cfnClient CfnApi) (string, error) {
+	stacks, err := ListCfnStacks(ctx, cfnClient, false)
+	if err != nil {
+		return "", err
+	}
+	if len(stacks) == 0 {
+		return "", errors.New(i18n.T("❌ CloudFormationスタックが見つかりません"))
+	}
+
+	items := make([]selector.Item, len(stacks))
+	for i, stack := range stacks {
+		items[i] = selector.Item{Value: stack.Name, Label: fmt.Sprintf("%s  (%s)", stack.Name, stack.Status)}
+	}
+
+	selected, err := selector.Select(i18n.T("CloudFormationスタックを選択してください"), items)
+	if err != nil {
+		return "", err
+	}
+	return selected.Value, nil
+}
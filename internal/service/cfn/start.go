@@ -2,92 +2,95 @@ package cfn
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 )
 
 // StartAllStackResources はスタック内のすべてのリソースを起動します
-func StartAllStackResources(cfnClient *cloudformation.Client, ec2Client *ec2.Client, rdsClient *rds.Client, aasClient *applicationautoscaling.Client, stackName string) error {
+// 一部のリソースの起動に失敗した場合も残りのリソースの処理は継続し、結果とともにエラーを返します
+func StartAllStackResources(ctx context.Context, cfnClient CfnApi, ec2Client Ec2Api, rdsClient RdsApi, aasClient AutoScalingApi, stackName string) (StackOperationResult, error) {
+	result := StackOperationResult{StackName: stackName}
+
 	// スタックからリソースを取得
-	resources, err := getStartStopResourcesFromStack(cfnClient, stackName)
+	resources, err := getStartStopResourcesFromStack(ctx, cfnClient, stackName)
 	if err != nil {
-		return err
+		return result, err
 	}
+	result.Resources = resources
 
 	// 検出されたリソースのサマリーを表示
-	printResourcesSummary(resources)
-
-	errorsOccurred := false
+	printResourcesSummary(ctx, resources)
 
 	// EC2インスタンスを起動
-	if len(resources.Ec2InstanceIds) > 0 {
-		for _, instanceId := range resources.Ec2InstanceIds {
-			fmt.Printf("🚀 EC2インスタンス (%s) を起動します...\n", instanceId)
-			if err := startEc2Instance(ec2Client, instanceId); err != nil {
-				fmt.Printf("❌ EC2インスタンス (%s) の起動中にエラーが発生しました: %v\n", instanceId, err)
-				errorsOccurred = true
-			} else {
-				fmt.Printf("✅ EC2インスタンス (%s) の起動を開始しました\n", instanceId)
-			}
+	for _, instanceId := range resources.Ec2InstanceIds {
+		common.Progressf(ctx, common.EventStart, instanceId, i18n.T("🚀 EC2インスタンス (%s) を起動します...\n"), instanceId)
+		err := startEc2Instance(ctx, ec2Client, instanceId)
+		if err != nil {
+			common.Failuref(ctx, instanceId, err, i18n.T("❌ EC2インスタンス (%s) の起動中にエラーが発生しました: %v\n"), instanceId, err)
+		} else {
+			common.Progressf(ctx, common.EventSuccess, instanceId, i18n.T("✅ EC2インスタンス (%s) の起動を開始しました\n"), instanceId)
 		}
+		result.record(ResourceTypeEc2Instance, instanceId, err)
 	}
 
-	// RDSインスタンスとAuroraクラスターを起動
-	if len(resources.RdsInstanceIds) > 0 || len(resources.AuroraClusterIds) > 0 {
-		// RDSインスタンスを起動
-		for _, instanceId := range resources.RdsInstanceIds {
-			fmt.Printf("🚀 RDSインスタンス (%s) を起動します...\n", instanceId)
-			if err := startRdsInstance(rdsClient, instanceId); err != nil {
-				fmt.Printf("❌ RDSインスタンス (%s) の起動中にエラーが発生しました: %v\n", instanceId, err)
-				errorsOccurred = true
-			} else {
-				fmt.Printf("✅ RDSインスタンス (%s) の起動を開始しました\n", instanceId)
-			}
+	// RDSインスタンスを起動
+	for _, instanceId := range resources.RdsInstanceIds {
+		common.Progressf(ctx, common.EventStart, instanceId, i18n.T("🚀 RDSインスタンス (%s) を起動します...\n"), instanceId)
+		err := startRdsInstance(ctx, rdsClient, instanceId)
+		if err != nil {
+			common.Failuref(ctx, instanceId, err, i18n.T("❌ RDSインスタンス (%s) の起動中にエラーが発生しました: %v\n"), instanceId, err)
+		} else {
+			common.Progressf(ctx, common.EventSuccess, instanceId, i18n.T("✅ RDSインスタンス (%s) の起動を開始しました\n"), instanceId)
 		}
+		result.record(ResourceTypeDbInstance, instanceId, err)
+	}
 
-		// Auroraクラスターを起動
-		for _, clusterId := range resources.AuroraClusterIds {
-			fmt.Printf("🚀 Aurora DBクラスター (%s) を起動します...\n", clusterId)
-			if err := startAuroraCluster(rdsClient, clusterId); err != nil {
-				fmt.Printf("❌ Aurora DBクラスター (%s) の起動中にエラーが発生しました: %v\n", clusterId, err)
-				errorsOccurred = true
-			} else {
-				fmt.Printf("✅ Aurora DBクラスター (%s) の起動を開始しました\n", clusterId)
-			}
+	// Auroraクラスターを起動
+	for _, clusterId := range resources.AuroraClusterIds {
+		common.Progressf(ctx, common.EventStart, clusterId, i18n.T("🚀 Aurora DBクラスター (%s) を起動します...\n"), clusterId)
+		err := startAuroraCluster(ctx, rdsClient, clusterId)
+		if err != nil {
+			common.Failuref(ctx, clusterId, err, i18n.T("❌ Aurora DBクラスター (%s) の起動中にエラーが発生しました: %v\n"), clusterId, err)
+		} else {
+			common.Progressf(ctx, common.EventSuccess, clusterId, i18n.T("✅ Aurora DBクラスター (%s) の起動を開始しました\n"), clusterId)
 		}
+		result.record(ResourceTypeDbCluster, clusterId, err)
 	}
 
 	// ECSサービスを起動
-	if len(resources.EcsServiceInfo) > 0 {
-		for _, ecsInfo := range resources.EcsServiceInfo {
-			fmt.Printf("🚀 ECSサービス (%s/%s) を起動します...\n", ecsInfo.ClusterName, ecsInfo.ServiceName)
-			capacityOpts := ServiceCapacityOptions{
-				ClusterName: ecsInfo.ClusterName,
-				ServiceName: ecsInfo.ServiceName,
-				MinCapacity: 1, // デフォルト値として1を使用
-				MaxCapacity: 2, // デフォルト値として2を使用
-			}
-
-			if err := setEcsServiceCapacity(aasClient, capacityOpts); err != nil {
-				fmt.Printf("❌ ECSサービス (%s/%s) の起動中にエラーが発生しました: %v\n",
-					ecsInfo.ClusterName, ecsInfo.ServiceName, err)
-				errorsOccurred = true
-			} else {
-				fmt.Printf("✅ ECSサービス (%s/%s) の起動を開始しました\n",
-					ecsInfo.ClusterName, ecsInfo.ServiceName)
-			}
+	for _, ecsInfo := range resources.EcsServiceInfo {
+		serviceId := ecsInfo.ClusterName + "/" + ecsInfo.ServiceName
+		common.Progressf(ctx, common.EventStart, serviceId, i18n.T("🚀 ECSサービス (%s/%s) を起動します...\n"), ecsInfo.ClusterName, ecsInfo.ServiceName)
+		capacityOpts := ServiceCapacityOptions{
+			ClusterName: ecsInfo.ClusterName,
+			ServiceName: ecsInfo.ServiceName,
+			MinCapacity: 1, // デフォルト値として1を使用
+			MaxCapacity: 2, // デフォルト値として2を使用
 		}
+
+		err := setEcsServiceCapacity(ctx, aasClient, capacityOpts)
+		if err != nil {
+			common.Failuref(ctx, serviceId, err, i18n.T("❌ ECSサービス (%s/%s) の起動中にエラーが発生しました: %v\n"),
+				ecsInfo.ClusterName, ecsInfo.ServiceName, err)
+		} else {
+			common.Progressf(ctx, common.EventSuccess, serviceId, i18n.T("✅ ECSサービス (%s/%s) の起動を開始しました\n"),
+				ecsInfo.ClusterName, ecsInfo.ServiceName)
+		}
+		result.record(ResourceTypeEcsService, serviceId, err)
 	}
 
-	if errorsOccurred {
-		return fmt.Errorf("一部のリソースの起動中にエラーが発生しました")
+	if len(result.Failed()) > 0 {
+		return result, errors.New(i18n.T("一部のリソースの起動中にエラーが発生しました"))
 	}
-	return nil
+	return result, nil
 }
 
 // ServiceCapacityOptions はECSサービスのキャパシティ設定用オプション
@@ -99,54 +102,54 @@ type ServiceCapacityOptions struct {
 }
 
 // startEc2Instance はEC2インスタンスを起動します
-func startEc2Instance(ec2Client *ec2.Client, instanceId string) error {
+func startEc2Instance(ctx context.Context, ec2Client Ec2Api, instanceId string) error {
 	input := &ec2.StartInstancesInput{
 		InstanceIds: []string{instanceId},
 	}
 
-	_, err := ec2Client.StartInstances(context.Background(), input)
+	_, err := ec2Client.StartInstances(ctx, input)
 	if err != nil {
-		return fmt.Errorf("EC2インスタンス起動エラー: %w", err)
+		return fmt.Errorf(i18n.T("EC2インスタンス起動エラー: %w"), err)
 	}
 
 	return nil
 }
 
 // startRdsInstance はRDSインスタンスを起動します
-func startRdsInstance(rdsClient *rds.Client, instanceId string) error {
+func startRdsInstance(ctx context.Context, rdsClient RdsApi, instanceId string) error {
 	input := &rds.StartDBInstanceInput{
 		DBInstanceIdentifier: &instanceId,
 	}
 
-	_, err := rdsClient.StartDBInstance(context.Background(), input)
+	_, err := rdsClient.StartDBInstance(ctx, input)
 	if err != nil {
-		return fmt.Errorf("RDSインスタンス起動エラー: %w", err)
+		return fmt.Errorf(i18n.T("RDSインスタンス起動エラー: %w"), err)
 	}
 
 	return nil
 }
 
 // startAuroraCluster はAuroraクラスターを起動します
-func startAuroraCluster(rdsClient *rds.Client, clusterId string) error {
+func startAuroraCluster(ctx context.Context, rdsClient RdsApi, clusterId string) error {
 	input := &rds.StartDBClusterInput{
 		DBClusterIdentifier: &clusterId,
 	}
 
-	_, err := rdsClient.StartDBCluster(context.Background(), input)
+	_, err := rdsClient.StartDBCluster(ctx, input)
 	if err != nil {
-		return fmt.Errorf("auroraクラスター起動エラー: %w", err)
+		return fmt.Errorf(i18n.T("auroraクラスター起動エラー: %w"), err)
 	}
 
 	return nil
 }
 
 // setEcsServiceCapacity はECSサービスのキャパシティを設定します
-func setEcsServiceCapacity(autoScalingClient *applicationautoscaling.Client, opts ServiceCapacityOptions) error {
+func setEcsServiceCapacity(ctx context.Context, autoScalingClient AutoScalingApi, opts ServiceCapacityOptions) error {
 	// リソースIDを構築
 	resourceId := fmt.Sprintf("service/%s/%s", opts.ClusterName, opts.ServiceName)
 
 	// スケーラブルターゲットを登録
-	_, err := autoScalingClient.RegisterScalableTarget(context.Background(), &applicationautoscaling.RegisterScalableTargetInput{
+	_, err := autoScalingClient.RegisterScalableTarget(ctx, &applicationautoscaling.RegisterScalableTargetInput{
 		ServiceNamespace:  "ecs",
 		ScalableDimension: "ecs:service:DesiredCount",
 		ResourceId:        &resourceId,
@@ -154,7 +157,7 @@ func setEcsServiceCapacity(autoScalingClient *applicationautoscaling.Client, opt
 		MaxCapacity:       aws.Int32(int32(opts.MaxCapacity)),
 	})
 	if err != nil {
-		return fmt.Errorf("スケーラブルターゲット登録でエラー: %w", err)
+		return fmt.Errorf(i18n.T("スケーラブルターゲット登録でエラー: %w"), err)
 	}
 
 	return nil
@@ -0,0 +1,164 @@
+package cfn_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ngsw877/awstk/internal/awsfake"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/aws/smithy-go"
+)
+
+// startFakes は StartAllStackResources に渡すフェイクの組
+type startFakes struct {
+	cfn *awsfake.CloudFormation
+	ec2 *awsfake.Ec2
+	rds *awsfake.Rds
+	aas *awsfake.AutoScaling
+}
+
+func TestStartAllStackResources(t *testing.T) {
+	accessDenied := &smithy.GenericAPIError{Code: "UnauthorizedOperation", Message: "not authorized", Fault: smithy.FaultClient}
+	const ecsServiceArn = "arn:aws:ecs:ap-northeast-1:123456789012:service/app-cluster/app-service"
+
+	tests := []struct {
+		name      string
+		resources []awsfake.StackResource
+		setup     func(f startFakes)
+		wantErr   bool
+		wantOps   []cfn.ResourceOperation // Err は失敗したかどうかのみ比較する
+		check     func(t *testing.T, f startFakes)
+	}{
+		{
+			name: "EC2インスタンスとRDSインスタンスを起動",
+			resources: []awsfake.StackResource{
+				{LogicalId: "Server", PhysicalId: "i-0123456789abcdef0", ResourceType: cfn.ResourceTypeEc2Instance},
+				{LogicalId: "Database", PhysicalId: "app-db", ResourceType: cfn.ResourceTypeDbInstance},
+				{LogicalId: "Bucket", PhysicalId: "app-bucket", ResourceType: "AWS::S3::Bucket"},
+			},
+			setup: func(f startFakes) {
+				f.ec2.AddInstance("i-0123456789abcdef0", ec2types.InstanceStateNameStopped)
+				f.rds.AddInstance("app-db", "stopped")
+			},
+			wantOps: []cfn.ResourceOperation{
+				{ResourceType: cfn.ResourceTypeEc2Instance, Id: "i-0123456789abcdef0"},
+				{ResourceType: cfn.ResourceTypeDbInstance, Id: "app-db"},
+			},
+			check: func(t *testing.T, f startFakes) {
+				if got := f.ec2.InstanceState("i-0123456789abcdef0"); got != ec2types.InstanceStateNameRunning {
+					t.Errorf("EC2 state = %s, want running", got)
+				}
+				if got := f.rds.InstanceStatus("app-db"); got != "available" {
+					t.Errorf("RDS status = %s, want available", got)
+				}
+			},
+		},
+		{
+			name: "Auroraクラスターがある場合はDBインスタンスを個別に起動しない",
+			resources: []awsfake.StackResource{
+				{LogicalId: "Cluster", PhysicalId: "app-aurora", ResourceType: cfn.ResourceTypeDbCluster},
+				{LogicalId: "Writer", PhysicalId: "app-aurora-writer", ResourceType: cfn.ResourceTypeDbInstance},
+			},
+			setup: func(f startFakes) {
+				f.rds.AddCluster("app-aurora", "stopped")
+				f.rds.AddInstance("app-aurora-writer", "stopped")
+			},
+			wantOps: []cfn.ResourceOperation{
+				{ResourceType: cfn.ResourceTypeDbCluster, Id: "app-aurora"},
+			},
+			check: func(t *testing.T, f startFakes) {
+				if got := f.rds.ClusterStatus("app-aurora"); got != "available" {
+					t.Errorf("cluster status = %s, want available", got)
+				}
+				if n := f.rds.CallCount("StartDBInstance"); n != 0 {
+					t.Errorf("StartDBInstance called %d times, want 0", n)
+				}
+			},
+		},
+		{
+			name: "ECSサービスのキャパシティを最小1・最大2に設定",
+			resources: []awsfake.StackResource{
+				{LogicalId: "Service", PhysicalId: ecsServiceArn, ResourceType: cfn.ResourceTypeEcsService},
+			},
+			wantOps: []cfn.ResourceOperation{
+				{ResourceType: cfn.ResourceTypeEcsService, Id: "app-cluster/app-service"},
+			},
+			check: func(t *testing.T, f startFakes) {
+				target, ok := f.aas.Target("service/app-cluster/app-service")
+				if !ok {
+					t.Fatal("scalable target was not registered")
+				}
+				if target.MinCapacity != 1 || target.MaxCapacity != 2 {
+					t.Errorf("capacity = %d-%d, want 1-2", target.MinCapacity, target.MaxCapacity)
+				}
+			},
+		},
+		{
+			name: "起動に失敗したリソースがあっても残りを起動してエラーを返す",
+			resources: []awsfake.StackResource{
+				{LogicalId: "Locked", PhysicalId: "i-0aaaaaaaaaaaaaaa0", ResourceType: cfn.ResourceTypeEc2Instance},
+				{LogicalId: "Server", PhysicalId: "i-0bbbbbbbbbbbbbbb0", ResourceType: cfn.ResourceTypeEc2Instance},
+			},
+			setup: func(f startFakes) {
+				f.ec2.AddInstance("i-0aaaaaaaaaaaaaaa0", ec2types.InstanceStateNameStopped)
+				f.ec2.AddInstance("i-0bbbbbbbbbbbbbbb0", ec2types.InstanceStateNameStopped)
+				f.ec2.FailOn("StartInstances", "i-0aaaaaaaaaaaaaaa0", accessDenied)
+			},
+			wantErr: true,
+			wantOps: []cfn.ResourceOperation{
+				{ResourceType: cfn.ResourceTypeEc2Instance, Id: "i-0aaaaaaaaaaaaaaa0", Err: accessDenied},
+				{ResourceType: cfn.ResourceTypeEc2Instance, Id: "i-0bbbbbbbbbbbbbbb0"},
+			},
+			check: func(t *testing.T, f startFakes) {
+				if got := f.ec2.InstanceState("i-0aaaaaaaaaaaaaaa0"); got != ec2types.InstanceStateNameStopped {
+					t.Errorf("failed instance state = %s, want stopped", got)
+				}
+				if got := f.ec2.InstanceState("i-0bbbbbbbbbbbbbbb0"); got != ec2types.InstanceStateNameRunning {
+					t.Errorf("instance state = %s, want running", got)
+				}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := startFakes{
+				cfn: awsfake.NewCloudFormation(),
+				ec2: awsfake.NewEc2(),
+				rds: awsfake.NewRds(),
+				aas: awsfake.NewAutoScaling(),
+			}
+			f.cfn.AddStack(awsfake.Stack{Name: "app-stack", Resources: tt.resources})
+			if tt.setup != nil {
+				tt.setup(f)
+			}
+
+			result, err := cfn.StartAllStackResources(context.Background(), f.cfn, f.ec2, f.rds, f.aas, "app-stack")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			if len(result.Operations) != len(tt.wantOps) {
+				t.Fatalf("Operations = %+v, want %+v", result.Operations, tt.wantOps)
+			}
+			for i, want := range tt.wantOps {
+				got := result.Operations[i]
+				if got.ResourceType != want.ResourceType || got.Id != want.Id || (got.Err != nil) != (want.Err != nil) {
+					t.Errorf("Operations[%d] = %+v, want %+v", i, got, want)
+				}
+			}
+			if tt.check != nil {
+				tt.check(t, f)
+			}
+		})
+	}
+}
+
+func TestStartAllStackResourcesStackNotFound(t *testing.T) {
+	_, err := cfn.StartAllStackResources(context.Background(), awsfake.NewCloudFormation(), awsfake.NewEc2(), awsfake.NewRds(), awsfake.NewAutoScaling(), "missing-stack")
+	if err == nil {
+		t.Fatal("expected an error for a missing stack")
+	}
+}
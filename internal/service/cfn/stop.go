@@ -2,130 +2,132 @@ package cfn
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
-	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 )
 
 // StopAllStackResources はスタック内のすべてのリソースを停止します
-func StopAllStackResources(cfnClient *cloudformation.Client, ec2Client *ec2.Client, rdsClient *rds.Client, aasClient *applicationautoscaling.Client, stackName string) error {
+// 一部のリソースの停止に失敗した場合も残りのリソースの処理は継続し、結果とともにエラーを返します
+func StopAllStackResources(ctx context.Context, cfnClient CfnApi, ec2Client Ec2Api, rdsClient RdsApi, aasClient AutoScalingApi, stackName string) (StackOperationResult, error) {
+	result := StackOperationResult{StackName: stackName}
+
 	// スタックからリソースを取得
-	resources, err := getStartStopResourcesFromStack(cfnClient, stackName)
+	resources, err := getStartStopResourcesFromStack(ctx, cfnClient, stackName)
 	if err != nil {
-		return err
+		return result, err
 	}
+	result.Resources = resources
 
 	// 検出されたリソースのサマリーを表示
-	printResourcesSummary(resources)
-
-	errorsOccurred := false
+	printResourcesSummary(ctx, resources)
 
 	// EC2インスタンスを停止
-	if len(resources.Ec2InstanceIds) > 0 {
-		for _, instanceId := range resources.Ec2InstanceIds {
-			fmt.Printf("🛑 EC2インスタンス (%s) を停止します...\n", instanceId)
-			if err := stopEc2Instance(ec2Client, instanceId); err != nil {
-				fmt.Printf("❌ EC2インスタンス (%s) の停止中にエラーが発生しました: %v\n", instanceId, err)
-				errorsOccurred = true
-			} else {
-				fmt.Printf("✅ EC2インスタンス (%s) の停止を開始しました\n", instanceId)
-			}
+	for _, instanceId := range resources.Ec2InstanceIds {
+		common.Progressf(ctx, common.EventStart, instanceId, i18n.T("🛑 EC2インスタンス (%s) を停止します...\n"), instanceId)
+		err := stopEc2Instance(ctx, ec2Client, instanceId)
+		if err != nil {
+			common.Failuref(ctx, instanceId, err, i18n.T("❌ EC2インスタンス (%s) の停止中にエラーが発生しました: %v\n"), instanceId, err)
+		} else {
+			common.Progressf(ctx, common.EventSuccess, instanceId, i18n.T("✅ EC2インスタンス (%s) の停止を開始しました\n"), instanceId)
 		}
+		result.record(ResourceTypeEc2Instance, instanceId, err)
 	}
 
-	// RDSインスタンスとAuroraクラスターを停止
-	if len(resources.RdsInstanceIds) > 0 || len(resources.AuroraClusterIds) > 0 {
-		// RDSインスタンスを停止
-		for _, instanceId := range resources.RdsInstanceIds {
-			fmt.Printf("🛑 RDSインスタンス (%s) を停止します...\n", instanceId)
-			if err := stopRdsInstance(rdsClient, instanceId); err != nil {
-				fmt.Printf("❌ RDSインスタンス (%s) の停止中にエラーが発生しました: %v\n", instanceId, err)
-				errorsOccurred = true
-			} else {
-				fmt.Printf("✅ RDSインスタンス (%s) の停止を開始しました\n", instanceId)
-			}
+	// RDSインスタンスを停止
+	for _, instanceId := range resources.RdsInstanceIds {
+		common.Progressf(ctx, common.EventStart, instanceId, i18n.T("🛑 RDSインスタンス (%s) を停止します...\n"), instanceId)
+		err := stopRdsInstance(ctx, rdsClient, instanceId)
+		if err != nil {
+			common.Failuref(ctx, instanceId, err, i18n.T("❌ RDSインスタンス (%s) の停止中にエラーが発生しました: %v\n"), instanceId, err)
+		} else {
+			common.Progressf(ctx, common.EventSuccess, instanceId, i18n.T("✅ RDSインスタンス (%s) の停止を開始しました\n"), instanceId)
 		}
+		result.record(ResourceTypeDbInstance, instanceId, err)
+	}
 
-		// Auroraクラスターを停止
-		for _, clusterId := range resources.AuroraClusterIds {
-			fmt.Printf("🛑 Aurora DBクラスター (%s) を停止します...\n", clusterId)
-			if err := stopAuroraCluster(rdsClient, clusterId); err != nil {
-				fmt.Printf("❌ Aurora DBクラスター (%s) の停止中にエラーが発生しました: %v\n", clusterId, err)
-				errorsOccurred = true
-			} else {
-				fmt.Printf("✅ Aurora DBクラスター (%s) の停止を開始しました\n", clusterId)
-			}
+	// Auroraクラスターを停止
+	for _, clusterId := range resources.AuroraClusterIds {
+		common.Progressf(ctx, common.EventStart, clusterId, i18n.T("🛑 Aurora DBクラスター (%s) を停止します...\n"), clusterId)
+		err := stopAuroraCluster(ctx, rdsClient, clusterId)
+		if err != nil {
+			common.Failuref(ctx, clusterId, err, i18n.T("❌ Aurora DBクラスター (%s) の停止中にエラーが発生しました: %v\n"), clusterId, err)
+		} else {
+			common.Progressf(ctx, common.EventSuccess, clusterId, i18n.T("✅ Aurora DBクラスター (%s) の停止を開始しました\n"), clusterId)
 		}
+		result.record(ResourceTypeDbCluster, clusterId, err)
 	}
 
 	// ECSサービスを停止
-	if len(resources.EcsServiceInfo) > 0 {
-		for _, ecsInfo := range resources.EcsServiceInfo {
-			fmt.Printf("🛑 ECSサービス (%s/%s) を停止します...\n", ecsInfo.ClusterName, ecsInfo.ServiceName)
-			capacityOpts := ServiceCapacityOptions{
-				ClusterName: ecsInfo.ClusterName,
-				ServiceName: ecsInfo.ServiceName,
-				MinCapacity: 0, // 停止するために0に設定
-				MaxCapacity: 0, // 停止するために0に設定
-			}
-
-			if err := setEcsServiceCapacity(aasClient, capacityOpts); err != nil {
-				fmt.Printf("❌ ECSサービス (%s/%s) の停止中にエラーが発生しました: %v\n",
-					ecsInfo.ClusterName, ecsInfo.ServiceName, err)
-				errorsOccurred = true
-			} else {
-				fmt.Printf("✅ ECSサービス (%s/%s) の停止を開始しました\n",
-					ecsInfo.ClusterName, ecsInfo.ServiceName)
-			}
+	for _, ecsInfo := range resources.EcsServiceInfo {
+		serviceId := ecsInfo.ClusterName + "/" + ecsInfo.ServiceName
+		common.Progressf(ctx, common.EventStart, serviceId, i18n.T("🛑 ECSサービス (%s/%s) を停止します...\n"), ecsInfo.ClusterName, ecsInfo.ServiceName)
+		capacityOpts := ServiceCapacityOptions{
+			ClusterName: ecsInfo.ClusterName,
+			ServiceName: ecsInfo.ServiceName,
+			MinCapacity: 0, // 停止するために0に設定
+			MaxCapacity: 0, // 停止するために0に設定
 		}
+
+		err := setEcsServiceCapacity(ctx, aasClient, capacityOpts)
+		if err != nil {
+			common.Failuref(ctx, serviceId, err, i18n.T("❌ ECSサービス (%s/%s) の停止中にエラーが発生しました: %v\n"),
+				ecsInfo.ClusterName, ecsInfo.ServiceName, err)
+		} else {
+			common.Progressf(ctx, common.EventSuccess, serviceId, i18n.T("✅ ECSサービス (%s/%s) の停止を開始しました\n"),
+				ecsInfo.ClusterName, ecsInfo.ServiceName)
+		}
+		result.record(ResourceTypeEcsService, serviceId, err)
 	}
 
-	if errorsOccurred {
-		return fmt.Errorf("一部のリソースの停止中にエラーが発生しました")
+	if len(result.Failed()) > 0 {
+		return result, errors.New(i18n.T("一部のリソースの停止中にエラーが発生しました"))
 	}
-	return nil
+	return result, nil
 }
 
 // stopEc2Instance はEC2インスタンスを停止します
-func stopEc2Instance(ec2Client *ec2.Client, instanceId string) error {
+func stopEc2Instance(ctx context.Context, ec2Client Ec2Api, instanceId string) error {
 	input := &ec2.StopInstancesInput{
 		InstanceIds: []string{instanceId},
 	}
 
-	_, err := ec2Client.StopInstances(context.Background(), input)
+	_, err := ec2Client.StopInstances(ctx, input)
 	if err != nil {
-		return fmt.Errorf("EC2インスタンス停止エラー: %w", err)
+		return fmt.Errorf(i18n.T("EC2インスタンス停止エラー: %w"), err)
 	}
 
 	return nil
 }
 
 // stopRdsInstance はRDSインスタンスを停止します
-func stopRdsInstance(rdsClient *rds.Client, instanceId string) error {
+func stopRdsInstance(ctx context.Context, rdsClient RdsApi, instanceId string) error {
 	input := &rds.StopDBInstanceInput{
 		DBInstanceIdentifier: &instanceId,
 	}
 
-	_, err := rdsClient.StopDBInstance(context.Background(), input)
+	_, err := rdsClient.StopDBInstance(ctx, input)
 	if err != nil {
-		return fmt.Errorf("RDSインスタンス停止エラー: %w", err)
+		return fmt.Errorf(i18n.T("RDSインスタンス停止エラー: %w"), err)
 	}
 
 	return nil
 }
 
 // stopAuroraCluster はAuroraクラスターを停止します
-func stopAuroraCluster(rdsClient *rds.Client, clusterId string) error {
+func stopAuroraCluster(ctx context.Context, rdsClient RdsApi, clusterId string) error {
 	input := &rds.StopDBClusterInput{
 		DBClusterIdentifier: &clusterId,
 	}
 
-	_, err := rdsClient.StopDBCluster(context.Background(), input)
+	_, err := rdsClient.StopDBCluster(ctx, input)
 	if err != nil {
-		return fmt.Errorf("auroraクラスター停止エラー: %w", err)
+		return fmt.Errorf(i18n.T("auroraクラスター停止エラー: %w"), err)
 	}
 
 	return nil
@@ -1,5 +1,15 @@
 package cfn
 
+import (
+	"time"
+
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/plan"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation/types"
+)
+
 // StackResources はCloudFormationスタック内のリソース識別子を格納する構造体
 type StackResources struct {
 	Ec2InstanceIds   []string
@@ -8,18 +18,62 @@ type StackResources struct {
 	EcsServiceInfo   []EcsServiceInfo
 }
 
+// 起動・停止の対象となるCloudFormationのリソースタイプ
+const (
+	ResourceTypeEc2Instance = "AWS::EC2::Instance"
+	ResourceTypeDbInstance  = "AWS::RDS::DBInstance"
+	ResourceTypeDbCluster   = "AWS::RDS::DBCluster"
+	ResourceTypeEcsService  = "AWS::ECS::Service"
+)
+
+// ResourceOperation はスタック内のリソースに対する起動・停止の結果を表す構造体
+type ResourceOperation struct {
+	ResourceType string // CloudFormationのリソースタイプ（例: AWS::EC2::Instance）
+	Id           string // リソースの識別子（ECSサービスは クラスター名/サービス名）
+	Err          error  // 失敗した場合のエラー
+}
+
+// StackOperationResult はスタック内のリソースの起動・停止の結果を表す構造体
+type StackOperationResult struct {
+	StackName  string
+	Resources  StackResources      // スタックから検出した起動・停止可能なリソース
+	Operations []ResourceOperation // 実行した操作（実行順）
+}
+
+// Failed は失敗した操作を返します
+func (r StackOperationResult) Failed() []ResourceOperation {
+	var failed []ResourceOperation
+	for _, op := range r.Operations {
+		if op.Err != nil {
+			failed = append(failed, op)
+		}
+	}
+	return failed
+}
+
+// record は操作の結果を追加します
+func (r *StackOperationResult) record(resourceType, id string, err error) {
+	r.Operations = append(r.Operations, ResourceOperation{ResourceType: resourceType, Id: id, Err: err})
+}
+
 // Stack CfnStack はCloudFormationスタックの名前とステータスを表す構造体
 type Stack struct {
 	Name   string
+	Id     string
 	Status string
 }
 
 // CleanupOptions はクリーンアップコマンドのオプション
 type CleanupOptions struct {
-	Filter string // スタック名のフィルター（部分一致）
-	Status string // 削除対象のステータス（カンマ区切り）
-	Force  bool   // 確認プロンプトをスキップ
-	Exact  bool   // 大文字小文字を区別してマッチ
+	Filter string            // スタック名のフィルター（部分一致）
+	Status string            // 削除対象のステータス（カンマ区切り）
+	Force  bool              // 確認プロンプトをスキップ
+	Exact  bool              // 大文字小文字を区別してマッチ
+	Tags   *tagging.Selector // 指定された場合はタグ条件に一致するスタックのみを対象にする
+	Age    *common.AgeFilter // 指定された場合は作成日時（または最終更新日時）の経過時間に一致するスタックのみを対象にする
+	Plan   *plan.Plan        // 指定された場合は削除せず、プランにアクションを追加する
+
+	AccountName string // 複数のスタックを削除する場合に確認で入力を求めるアカウント名（エイリアスまたはID。空の場合は y/N で確認）
 }
 
 // ProtectOptions は削除保護コマンドのオプション
@@ -39,6 +93,13 @@ type DriftOptions struct {
 	Exact  bool     // 大文字小文字を区別してマッチ
 }
 
+// DriftDetection はスタックのドリフト検出の開始結果
+type DriftDetection struct {
+	StackName   string
+	DetectionId string // ドリフト検出ID（開始に失敗した場合は空）
+	Err         error  // 開始に失敗した場合のエラー
+}
+
 // DriftStatusOptions はドリフト状態確認コマンドのオプション
 type DriftStatusOptions struct {
 	Stacks      []string // スタック名のリスト
@@ -48,6 +109,13 @@ type DriftStatusOptions struct {
 	Exact       bool     // 大文字小文字を区別してマッチ
 }
 
+// DriftStatus はスタックのドリフト状態
+type DriftStatus struct {
+	StackName     string
+	Status        types.StackDriftStatus
+	LastCheckTime *time.Time
+}
+
 // DeployOptions はデプロイコマンドのオプション
 type DeployOptions struct {
 	TemplatePath    string
@@ -1,176 +1,300 @@
 package cleanup
 
 import (
-	"awstk/internal/service/cfn"
-	"awstk/internal/service/common"
-	ecrsvc "awstk/internal/service/ecr"
-	logssvc "awstk/internal/service/logs"
-	s3svc "awstk/internal/service/s3"
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 
+	awsCtx "github.com/ngsw877/awstk/internal/aws"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/confirm"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/plan"
+
 	"github.com/aws/smithy-go"
 )
 
-// CleanupResources は指定した文字列を含むAWSリソースをクリーンアップします
-func CleanupResources(clients ClientSet, opts Options) error {
+// CleanupResources は指定した文字列を含むAWSリソースをクリーンアップし、リソースの種類ごとの削除結果を返します
+// 削除を実行しなかった場合（プランの作成時・確認でキャンセルされた場合）は nil を返します
+func CleanupResources(ctx context.Context, clients ClientSet, opts Options) ([]common.CleanupResult, error) {
 	// 事前条件チェック
-	if err := validateCleanupOptions(clients); err != nil {
-		return err
+	types, err := SelectTypes(opts.Types)
+	if err != nil {
+		return nil, err
 	}
 	if err := validateOptions(opts); err != nil {
-		return err
+		return nil, err
+	}
+	if err := validateCleanupOptions(clients, types, opts); err != nil {
+		return nil, err
 	}
 
-	var s3BucketNames, ecrRepoNames, logGroupNames []string
-	var err error
+	var found map[string][]string
 
 	// 検索方法によって取得ロジックを分岐
-	if opts.StackId != "" {
+	if opts.Run.HasTargets() {
+		// 中断した実行を再開する場合は、前回検出した削除対象を使用する
+		common.Infof(ctx, i18n.T("中断した実行 %s を再開します\n"), opts.Run.Id())
+		found = opts.Run.Targets()
+	} else if opts.StackId != "" {
 		// スタックIDから検索する場合
-		fmt.Printf("CloudFormationスタックID: %s\n", opts.StackId)
-		fmt.Println("スタックに関連するリソースの削除を開始します...")
+		common.Infof(ctx, i18n.T("CloudFormationスタックID: %s\n"), opts.StackId)
+		common.Infof(ctx, "%s\n", i18n.T("スタックに関連するリソースの削除を開始します..."))
 
-		s3BucketNames, ecrRepoNames, logGroupNames, err = cfn.GetCleanupResourcesFromStack(clients.CfnClient, opts.StackId)
+		found, err = findStackResources(ctx, clients, types, opts.StackId)
 		if err != nil {
-			return fmt.Errorf("スタックからのリソース取得エラー: %w", err)
+			return nil, fmt.Errorf(i18n.T("スタックからのリソース取得エラー: %w"), err)
 		}
 	} else if opts.StackName != "" {
 		// スタック名から検索する場合
-		fmt.Printf("CloudFormationスタック: %s\n", opts.StackName)
-		fmt.Println("スタックに関連するリソースの削除を開始します...")
+		common.Infof(ctx, i18n.T("CloudFormationスタック: %s\n"), opts.StackName)
+		common.Infof(ctx, "%s\n", i18n.T("スタックに関連するリソースの削除を開始します..."))
 
-		s3BucketNames, ecrRepoNames, logGroupNames, err = cfn.GetCleanupResourcesFromStack(clients.CfnClient, opts.StackName)
+		found, err = findStackResources(ctx, clients, types, opts.StackName)
 		if err != nil {
 			var apiErr smithy.APIError
 			if errors.As(err, &apiErr) && apiErr.ErrorCode() == "ValidationError" && strings.Contains(apiErr.ErrorMessage(), "does not exist") {
-				fmt.Printf("❌ スタック '%s' は存在しません。\n", opts.StackName)
-				fmt.Println("ℹ️ 削除済みスタックの履歴が90日以内にある場合、--stack-id に削除済みスタックのID(ARN)を指定してください")
-				return fmt.Errorf("スタック '%s' が見つかりません", opts.StackName)
+				common.Failuref(ctx, opts.StackName, err, i18n.T("❌ スタック '%s' は存在しません。\n"), opts.StackName)
+				common.Infof(ctx, "%s\n", i18n.T("ℹ️ 削除済みスタックの履歴が90日以内にある場合、--stack-id に削除済みスタックのID(ARN)を指定してください"))
+				return nil, fmt.Errorf(i18n.T("スタック '%s' が見つかりません"), opts.StackName)
 			}
-			return fmt.Errorf("スタックからのリソース取得エラー: %w", err)
+			return nil, fmt.Errorf(i18n.T("スタックからのリソース取得エラー: %w"), err)
 		}
 	} else {
-		// キーワードから検索する場合
-		fmt.Printf("検索文字列: %s\n", opts.SearchString)
-		fmt.Println("検索文字列に一致するリソースの削除を開始します...")
-
-		s3BucketNames, err = s3svc.GetS3BucketsByFilter(clients.S3Client, opts.SearchString, opts.Exact)
-		if err != nil {
-			fmt.Printf("❌ S3バケット一覧取得中にエラーが発生しました: %v\n", err)
-			s3BucketNames = []string{}
+		// キーワード（とタグ条件）から検索する場合
+		common.Infof(ctx, i18n.T("検索文字列: %s\n"), opts.SearchString)
+		if opts.Tags.Enabled() {
+			common.Infof(ctx, i18n.T("タグ条件: %s\n"), opts.Tags)
 		}
-
-		ecrRepoNames, err = ecrsvc.GetEcrRepositoriesByFilter(clients.EcrClient, opts.SearchString, opts.Exact)
-		if err != nil {
-			fmt.Printf("❌ ECRリポジトリ一覧取得中にエラーが発生しました: %v\n", err)
-			ecrRepoNames = []string{}
+		if opts.Age.Enabled() {
+			common.Infof(ctx, i18n.T("経過時間条件: %s\n"), opts.Age)
 		}
+		common.Infof(ctx, "%s\n", i18n.T("検索文字列に一致するリソースの削除を開始します..."))
 
-		logGroupNames, err = logssvc.GetLogGroupsByFilter(clients.LogsClient, opts.SearchString, opts.Exact)
-		if err != nil {
-			fmt.Printf("❌ CloudWatch Logsグループ一覧取得中にエラーが発生しました: %v\n", err)
-			logGroupNames = []string{}
+		// 一覧の取得に失敗した種類は警告を表示して対象外にする
+		found = make(map[string][]string)
+		for _, t := range types {
+			names, err := t.List(ctx, clients, opts)
+			if err != nil {
+				common.Progressf(ctx, common.EventWarning, "", i18n.T("❌ %s一覧取得中にエラーが発生しました: %v\n"), i18n.T(t.DisplayName), err)
+				continue
+			}
+			found[t.Name] = names
 		}
 	}
 
-	// 結果を格納するスライス
-	var results []common.CleanupResult
+	return cleanupFound(ctx, clients, opts, types, found)
+}
 
-	// S3バケットの削除
-	fmt.Println("S3バケットの削除を開始...")
-	if len(s3BucketNames) > 0 {
-		s3Result := s3svc.CleanupS3Buckets(clients.S3Client, s3BucketNames)
-		results = append(results, s3Result)
-	} else {
-		fmt.Println("  削除対象のS3バケットはありません")
+// cleanupFound は検出したリソースを確認のうえ依存関係の順に削除し、リソースの種類ごとの削除結果を返します
+// 削除に失敗したリソースや参照元を削除できず未実行のリソースがある場合は、結果とともにエラーを返します
+// プランの作成時はプランに削除アクションを追加し、削除を実行しなかった場合は nil を返します
+func cleanupFound(ctx context.Context, clients ClientSet, opts Options, types []ResourceType, found map[string][]string) ([]common.CleanupResult, error) {
+	// プラン作成モードの場合は削除せずにプランへ追加
+	if opts.Plan != nil {
+		return nil, planCleanupResources(ctx, clients, opts.Plan, types, found)
 	}
 
-	// ECRリポジトリの削除
-	fmt.Println("ECRリポジトリの削除を開始...")
-	if len(ecrRepoNames) > 0 {
-		ecrResult := ecrsvc.CleanupEcrRepositories(clients.EcrClient, ecrRepoNames)
-		results = append(results, ecrResult)
-	} else {
-		fmt.Println("  削除対象のECRリポジトリはありません")
+	// 削除対象をまとめて表示し、アカウント名の入力で確認する
+	if !opts.NoConfirm {
+		if ok, err := confirmCleanup(opts, types, found); err != nil || !ok {
+			return nil, err
+		}
 	}
 
-	// CloudWatch Logsグループの削除
-	fmt.Println("CloudWatch Logsグループの削除を開始...")
-	if len(logGroupNames) > 0 {
-		logsResult := logssvc.CleanupLogGroups(clients.LogsClient, logGroupNames)
-		results = append(results, logsResult)
-	} else {
-		fmt.Println("  削除対象のCloudWatch Logsグループはありません")
+	// 中断した場合に再開できるよう、削除対象を保存する（保存に失敗しても削除は継続する）
+	if opts.Run != nil && !opts.Run.HasTargets() && countFound(found) > 0 {
+		if err := opts.Run.SetTargets(found); err != nil {
+			common.Warnf(ctx, i18n.T("⚠️  実行状態を保存できなかったため、中断した場合は再開できません: %v\n"), err)
+		} else {
+			common.Infof(ctx, i18n.T("実行ID: %s\n"), opts.Run.Id())
+		}
 	}
 
-	// サマリー表示
-	printCleanupSummary(results)
+	// 依存関係の順に削除する（参照しているリソースを先に削除する）
+	results := deleteInDependencyOrder(ctx, clients, types, found, opts.Run)
+	opts.Run.Finish(ctx)
+	if opts.Report != nil {
+		setArns(results, types, opts.Report.Region, opts.AccountId)
+		opts.Report.Add(opts.AccountId, results)
+	}
 
-	return nil
+	// 中断された場合や削除できなかったリソースがある場合も、ここまでの結果を返す
+	if err := ctx.Err(); err != nil {
+		return results, fmt.Errorf(i18n.T("クリーンアップが中断されました: %w"), err)
+	}
+	if failed, blocked := countNotDeleted(results); failed+blocked > 0 {
+		return results, fmt.Errorf(i18n.T("削除できなかったリソースがあります（削除失敗 %d件、参照元を削除できず未実行 %d件）"), failed, blocked)
+	}
+	return results, nil
 }
 
-// printCleanupSummary はクリーンアップ結果のサマリーを表示します
-func printCleanupSummary(results []common.CleanupResult) {
-	fmt.Println()
-	fmt.Println("════════════════════════════════════════════════════════════")
-	fmt.Println("                    クリーンアップ サマリー")
-	fmt.Println("════════════════════════════════════════════════════════════")
+// setArns は削除処理でARNを取得できなかったリソースに、リソースの種類ごとの形式で作成したARNを設定します（レポートの出力に使用）
+func setArns(results []common.CleanupResult, types []ResourceType, region, account string) {
+	partition := awsCtx.PartitionFor(region)
+	for i := range results {
+		for _, t := range types {
+			if t.Name == results[i].Type && t.Arn != nil {
+				results[i].SetArns(func(name string) string { return t.Arn(partition, region, account, name) })
+			}
+		}
+	}
+}
 
-	totalDeleted := 0
-	totalFailed := 0
+// countFound は検出したリソースの総数を返します
+func countFound(found map[string][]string) int {
+	count := 0
+	for _, names := range found {
+		count += len(names)
+	}
+	return count
+}
 
-	for _, result := range results {
-		if result.TotalCount() == 0 {
-			continue
+// countNotDeleted は削除に失敗したリソースと、参照元を削除できなかったため削除しなかったリソースの数を返します
+func countNotDeleted(results []common.CleanupResult) (failed, blocked int) {
+	for _, r := range results {
+		failed += len(r.Failed)
+		blocked += len(r.Blocked)
+	}
+	return failed, blocked
+}
+
+// findStackResources はスタックに含まれるリソースを種類ごとに取得します
+// CloudFormationのリソースタイプを持たない種類は対象外です
+func findStackResources(ctx context.Context, clients ClientSet, types []ResourceType, stackName string) (map[string][]string, error) {
+	var cfnTypes []string
+	for _, t := range types {
+		if t.CfnType != "" {
+			cfnTypes = append(cfnTypes, t.CfnType)
 		}
+	}
 
-		fmt.Printf("\n【%s】\n", result.ResourceType)
+	physicalIds, err := cfn.GetCleanupResourcesFromStack(ctx, clients.CfnClient, stackName, cfnTypes)
+	if err != nil {
+		return nil, err
+	}
 
-		if len(result.Deleted) > 0 {
-			fmt.Printf("  ✅ 削除成功: %d件\n", len(result.Deleted))
-			for _, name := range result.Deleted {
-				fmt.Printf("     - %s\n", name)
+	found := make(map[string][]string)
+	for _, t := range types {
+		for _, id := range physicalIds[t.CfnType] {
+			name := id
+			if t.NameOf != nil {
+				name = t.NameOf(id)
 			}
+			found[t.Name] = append(found[t.Name], name)
+			common.Progressf(ctx, common.EventInfo, name, i18n.T("🔍 検出された%s: %s\n"), i18n.T(t.DisplayName), name)
 		}
+	}
+	return found, nil
+}
 
-		if len(result.Failed) > 0 {
-			fmt.Printf("  ❌ 削除失敗: %d件\n", len(result.Failed))
-			for _, name := range result.Failed {
-				fmt.Printf("     - %s\n", name)
-			}
+// CleanupResourcesAcross は複数アカウントで順にクリーンアップを実行します
+// いずれかのアカウントで失敗しても残りのアカウントの処理は継続し、最後に失敗したアカウントをまとめてエラーとして返します
+// 各アカウントの削除結果は、そのアカウントの処理が終わるごとに onResults に渡します（サマリーの表示等に使用）
+func CleanupResourcesAcross(ctx context.Context, targets []common.Target, newClients func(target common.Target) ClientSet, opts Options, onResults func([]common.CleanupResult)) error {
+	if err := ValidateAcrossOptions(opts); err != nil {
+		return err
+	}
+
+	var failed []string
+	for i, target := range targets {
+		if ctx.Err() != nil {
+			break
+		}
+		common.Infof(ctx, "\n===== [%d/%d] %s =====\n", i+1, len(targets), target)
+		targetOpts := opts
+		targetOpts.Tags = opts.Tags.ForTarget(target)
+		targetOpts.AccountName = func() string { return target.Account }
+		targetOpts.AccountId = target.Account
+		results, err := CleanupResources(guardrail.WithTarget(ctx, target), newClients(target), targetOpts)
+		if results != nil && onResults != nil {
+			onResults(results)
 		}
+		if err != nil {
+			common.Warnf(ctx, i18n.T("❌ %s のクリーンアップでエラー: %v\n"), target, err)
+			failed = append(failed, target.String())
+		}
+	}
 
-		totalDeleted += len(result.Deleted)
-		totalFailed += len(result.Failed)
+	if err := ctx.Err(); err != nil {
+		return fmt.Errorf(i18n.T("クリーンアップが中断されました: %w"), err)
 	}
+	if len(failed) > 0 {
+		return fmt.Errorf(i18n.T("%d個のアカウントでクリーンアップに失敗しました: %s"), len(failed), strings.Join(failed, ", "))
+	}
+	return nil
+}
 
-	fmt.Println()
-	fmt.Println("────────────────────────────────────────────────────────────")
-	fmt.Printf("合計: 削除成功 %d件 / 削除失敗 %d件\n", totalDeleted, totalFailed)
-	fmt.Println("════════════════════════════════════════════════════════════")
+// ValidateAcrossOptions は複数アカウントでのクリーンアップで指定できないオプションを検証します
+func ValidateAcrossOptions(opts Options) error {
+	if opts.Plan != nil {
+		return errors.New(i18n.T("複数アカウントでのクリーンアップではプランを作成できません"))
+	}
+	if opts.StackId != "" {
+		return errors.New(i18n.T("スタックIDはアカウント固有のため、複数アカウントでのクリーンアップでは指定できません"))
+	}
+	if opts.Run.HasTargets() {
+		return errors.New(i18n.T("複数アカウントでのクリーンアップは再開できません"))
+	}
+	if _, err := SelectTypes(opts.Types); err != nil {
+		return err
+	}
+	return nil
 }
 
-// validateCleanupOptions はクリーンアップオプションのバリデーションを行います
-func validateCleanupOptions(clients ClientSet) error {
-	if clients.S3Client == nil {
-		return fmt.Errorf("s3クライアントが指定されていません")
+// planCleanupResources は検出したリソースの削除アクションをプランに追加します
+// 対象にする種類がすべてプランの作成に対応していることは validateCleanupOptions で検証済みです
+func planCleanupResources(ctx context.Context, clients ClientSet, p *plan.Plan, types []ResourceType, found map[string][]string) error {
+	for _, t := range types {
+		names := found[t.Name]
+		if len(names) == 0 {
+			continue
+		}
+		actions, err := t.Plan(ctx, clients, names)
+		if err != nil {
+			return err
+		}
+		p.Add(actions...)
 	}
-	if clients.EcrClient == nil {
-		return fmt.Errorf("ecrクライアントが指定されていません")
+	return nil
+}
+
+// validateCleanupOptions は対象にするリソースの種類の処理に必要なクライアントが指定されているか検証します
+// プランを作成する場合は、対象にする種類がすべてプランの作成に対応しているかも検証します
+func validateCleanupOptions(clients ClientSet, types []ResourceType, opts Options) error {
+	if clients.CfnClient == nil && (opts.StackName != "" || opts.StackId != "") {
+		return errors.New(i18n.T("cloudFormationクライアントが指定されていません"))
 	}
-	if clients.CfnClient == nil {
-		return fmt.Errorf("cloudFormationクライアントが指定されていません")
+	var unplannable []string
+	for _, t := range types {
+		if !t.HasClient(clients) {
+			return fmt.Errorf(i18n.T("%sのクライアントが指定されていません"), i18n.T(t.DisplayName))
+		}
+		if t.Plan == nil {
+			unplannable = append(unplannable, t.Name)
+		}
 	}
-	if clients.LogsClient == nil {
-		return fmt.Errorf("cloudWatchLogsクライアントが指定されていません")
+	if opts.Plan != nil && len(unplannable) > 0 {
+		return fmt.Errorf(i18n.T("プランの作成に対応していない種類が対象に含まれています: %s（プランを作成できる種類: %s）"), strings.Join(unplannable, ", "), strings.Join(PlanTypeNames(), ", "))
 	}
 	return nil
 }
 
 // validateOptions はオプションの論理バリデーションを行います
 func validateOptions(opts Options) error {
+	if opts.Run.HasTargets() {
+		if opts.SearchString != "" || opts.StackName != "" || opts.StackId != "" || opts.Tags.Enabled() || opts.Age.Enabled() {
+			return errors.New(i18n.T("中断した実行を再開する場合は、検索キーワード・タグ条件・経過時間条件・スタックを指定できません（前回検出した対象を削除します）"))
+		}
+		if opts.Plan != nil {
+			return errors.New(i18n.T("中断した実行を再開する場合は、プランを作成できません"))
+		}
+		return nil
+	}
 	count := 0
 	if opts.SearchString != "" {
 		count++
@@ -181,11 +305,52 @@ func validateOptions(opts Options) error {
 	if opts.StackId != "" {
 		count++
 	}
-	if count == 0 {
-		return fmt.Errorf("検索キーワード、スタック名、またはスタックIDのいずれかを指定してください")
+	if count == 0 && !opts.Tags.Enabled() {
+		return errors.New(i18n.T("検索キーワード、タグ条件、スタック名、またはスタックIDのいずれかを指定してください"))
 	}
 	if count > 1 {
-		return fmt.Errorf("検索キーワード、スタック名、スタックIDは同時に指定できません。いずれか一つのみ指定してください")
+		return errors.New(i18n.T("検索キーワード、スタック名、スタックIDは同時に指定できません。いずれか一つのみ指定してください"))
+	}
+	if opts.Tags.Enabled() && (opts.StackName != "" || opts.StackId != "") {
+		return errors.New(i18n.T("タグ条件はスタック名・スタックIDと同時に指定できません"))
+	}
+	if opts.Age.Enabled() && (opts.StackName != "" || opts.StackId != "") {
+		return errors.New(i18n.T("経過時間条件はスタック名・スタックIDと同時に指定できません"))
 	}
 	return nil
 }
+
+// confirmCleanup は削除対象のリソースをまとめて表示し、削除してよいか確認する
+// 複数のサービスのリソースを一括で削除するため、アカウント名が分かる場合はその入力で確認する
+func confirmCleanup(opts Options, types []ResourceType, found map[string][]string) (bool, error) {
+	var targets []string
+	for _, t := range types {
+		for _, name := range found[t.Name] {
+			// 中断した実行を再開する場合、前回削除したリソースは確認の対象に含めない
+			if opts.Run.IsCompleted(t.Name, name) {
+				continue
+			}
+			targets = append(targets, fmt.Sprintf("%s: %s", i18n.T(t.DisplayName), name))
+		}
+	}
+	if len(targets) == 0 {
+		return true, nil
+	}
+
+	// アカウント名の取得にはAPI呼び出しが必要なため、確認する対象がある場合のみ取得する
+	var phrase string
+	if opts.AccountName != nil {
+		phrase = opts.AccountName()
+	}
+	ok, err := confirm.Ask(confirm.Request{
+		Message:     fmt.Sprintf(i18n.T("\n⚠️  以下の%d件のリソースを削除します:"), len(targets)),
+		Targets:     targets,
+		Prompt:      i18n.T("本当に削除しますか？"),
+		Phrase:      phrase,
+		PhraseLabel: i18n.T("アカウント"),
+	})
+	if err == nil && !ok {
+		logging.Infof("%s\n", i18n.T("削除をキャンセルしました"))
+	}
+	return ok, err
+}
@@ -0,0 +1,295 @@
+package cleanup
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/runstate"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// Reference はリソース間の依存関係（From のリソースを削除した後でなければ To のリソースを削除できない）
+// 例: ロードバランサー → ターゲットグループ、Lambda関数 → セキュリティグループ
+type Reference struct {
+	From   string // 参照元のリソース名（先に削除する）
+	ToType string // 参照先のリソースの種類の名前（ResourceType.Name）
+	To     string // 参照先のリソース名（後に削除する）
+}
+
+// referencesTo は From のリソースから同じ種類の複数のリソースへの参照を作成する
+func referencesTo(from, toType string, to []string) []Reference {
+	refs := make([]Reference, 0, len(to))
+	for _, name := range to {
+		refs = append(refs, Reference{From: from, ToType: toType, To: name})
+	}
+	return refs
+}
+
+// arnReferences は From のリソースから、ARNで指定されたリソースへの参照を作成する
+// クリーンアップの対象にできない種類のARNは無視する
+func arnReferences(from string, arns []string) []Reference {
+	var refs []Reference
+	for _, value := range arns {
+		parsed, err := arn.Parse(value)
+		if err != nil {
+			continue
+		}
+		switch parsed.Service {
+		case "sns":
+			refs = append(refs, Reference{From: from, ToType: "sns", To: parsed.Resource})
+		case "sqs":
+			refs = append(refs, Reference{From: from, ToType: "sqs", To: parsed.Resource})
+		case "lambda":
+			// function:名前[:エイリアスまたはバージョン]
+			parts := strings.Split(parsed.Resource, ":")
+			if len(parts) >= 2 && parts[0] == "function" {
+				refs = append(refs, Reference{From: from, ToType: "lambda", To: parts[1]})
+			}
+		}
+	}
+	return refs
+}
+
+// resourceNode は削除順序のグラフの頂点（削除対象の1つのリソース）
+type resourceNode struct {
+	Type string // リソースの種類の名前（ResourceType.Name）
+	Name string // リソース名
+}
+
+// deletionGraph は検出したリソースの削除順序を表す有向グラフ
+type deletionGraph struct {
+	nodes []resourceNode
+	// prerequisites は各リソースより先に削除する必要があるリソース（そのリソースを参照しているもの）
+	prerequisites map[resourceNode][]resourceNode
+}
+
+// buildDeletionGraph は検出したリソース間の参照を取得し、削除順序のグラフを作成する
+// 参照先が削除対象に含まれない参照は無視する
+// 参照の取得に失敗した種類は警告を表示し、その種類からの参照はないものとして扱う
+func buildDeletionGraph(ctx context.Context, clients ClientSet, types []ResourceType, found map[string][]string) *deletionGraph {
+	g := &deletionGraph{prerequisites: make(map[resourceNode][]resourceNode)}
+	displayNames := make(map[string]string, len(types))
+	exists := make(map[resourceNode]bool)
+	for _, t := range types {
+		displayNames[t.Name] = i18n.T(t.DisplayName)
+		for _, name := range found[t.Name] {
+			n := resourceNode{Type: t.Name, Name: name}
+			if !exists[n] {
+				exists[n] = true
+				g.nodes = append(g.nodes, n)
+			}
+		}
+	}
+
+	for _, t := range types {
+		if t.References == nil || len(found[t.Name]) == 0 {
+			continue
+		}
+		refs, err := t.References(ctx, clients, found[t.Name])
+		if err != nil {
+			common.Warnf(ctx, i18n.T("⚠️  %sの依存関係を取得できなかったため、依存関係を考慮せずに削除します: %v\n"), i18n.T(t.DisplayName), err)
+			continue
+		}
+		for _, ref := range refs {
+			from := resourceNode{Type: t.Name, Name: ref.From}
+			to := resourceNode{Type: ref.ToType, Name: ref.To}
+			if from == to || !exists[from] || !exists[to] || slices.Contains(g.prerequisites[to], from) {
+				continue
+			}
+			g.prerequisites[to] = append(g.prerequisites[to], from)
+			common.Debugf(ctx, i18n.T("🔗 %s %s を削除してから %s %s を削除します\n"), displayNames[from.Type], from.Name, displayNames[to.Type], to.Name)
+		}
+	}
+	return g
+}
+
+// levels は削除順序のレベルを返す（同じレベルのリソースは互いに依存しないため並列に削除できる）
+// 循環参照があり順序を決められないリソースは cyclic として返す
+func (g *deletionGraph) levels() (levels [][]resourceNode, cyclic []resourceNode) {
+	// 各リソースの先に削除する必要があるリソースの数と、各リソースの後に削除するリソースを数える
+	remaining := make(map[resourceNode]int, len(g.nodes))
+	dependents := make(map[resourceNode][]resourceNode)
+	for _, n := range g.nodes {
+		remaining[n] = len(g.prerequisites[n])
+		for _, prerequisite := range g.prerequisites[n] {
+			dependents[prerequisite] = append(dependents[prerequisite], n)
+		}
+	}
+
+	var current []resourceNode
+	for _, n := range g.nodes {
+		if remaining[n] == 0 {
+			current = append(current, n)
+		}
+	}
+
+	placed := 0
+	for len(current) > 0 {
+		levels = append(levels, current)
+		placed += len(current)
+		var next []resourceNode
+		for _, n := range current {
+			for _, dependent := range dependents[n] {
+				remaining[dependent]--
+				if remaining[dependent] == 0 {
+					next = append(next, dependent)
+				}
+			}
+		}
+		current = next
+	}
+
+	if placed < len(g.nodes) {
+		for _, n := range g.nodes {
+			if remaining[n] > 0 {
+				cyclic = append(cyclic, n)
+			}
+		}
+	}
+	return levels, cyclic
+}
+
+// blocker は n より先に削除する必要があるリソースのうち、削除できなかったものを返す
+func (g *deletionGraph) blocker(n resourceNode, notDeleted map[resourceNode]bool) (resourceNode, bool) {
+	for _, prerequisite := range g.prerequisites[n] {
+		if notDeleted[prerequisite] {
+			return prerequisite, true
+		}
+	}
+	return resourceNode{}, false
+}
+
+// deleteInDependencyOrder は検出したリソースを依存関係の順（参照しているリソースが先）に削除し、種類ごとの削除結果を返す
+// 同じレベルのリソースは種類ごとに並列に削除し、前のレベルの削除が終わってから次のレベルに進む
+// 先に削除する必要があるリソースを削除できなかった場合、そのリソースが参照しているリソースは削除せずに Blocked として返す
+// run が指定された場合は削除したリソースを記録し、前回までの実行で削除したリソースは削除済みとして扱う
+func deleteInDependencyOrder(ctx context.Context, clients ClientSet, types []ResourceType, found map[string][]string, run *runstate.Run) []common.CleanupResult {
+	// 前回までの実行で削除したリソースは、参照の取得・削除の対象から除く
+	previous := make(map[string][]string)
+	if run != nil {
+		remaining := make(map[string][]string, len(found))
+		for typeName, names := range found {
+			for _, name := range names {
+				if run.IsCompleted(typeName, name) {
+					previous[typeName] = append(previous[typeName], name)
+				} else {
+					remaining[typeName] = append(remaining[typeName], name)
+				}
+			}
+		}
+		found = remaining
+	}
+
+	graph := buildDeletionGraph(ctx, clients, types, found)
+	levels, cyclic := graph.levels()
+	if len(cyclic) > 0 {
+		common.Warnf(ctx, i18n.T("⚠️  循環参照があるため、%d件のリソースは依存関係を考慮せずに最後に削除します\n"), len(cyclic))
+		levels = append(levels, cyclic)
+	}
+
+	byType := make(map[string]*common.CleanupResult)
+	resultOf := func(t ResourceType) *common.CleanupResult {
+		if byType[t.Name] == nil {
+			byType[t.Name] = &common.CleanupResult{ResourceType: i18n.T(t.DisplayName), Type: t.Name, Deleted: []string{}, Failed: []string{}}
+		}
+		return byType[t.Name]
+	}
+
+	for _, t := range types {
+		for _, name := range previous[t.Name] {
+			common.Progressf(ctx, common.EventSuccess, name, i18n.T("✅ %s %s は前回の実行で削除済みです\n"), i18n.T(t.DisplayName), name)
+			result := resultOf(t)
+			result.Deleted = append(result.Deleted, name)
+			result.Results = append(result.Results, common.ProcessResult{Item: name, Success: true})
+		}
+		if len(found[t.Name]) == 0 && len(previous[t.Name]) == 0 {
+			common.Infof(ctx, i18n.T("%sの削除を開始...\n"), i18n.T(t.DisplayName))
+			common.Infof(ctx, i18n.T("  削除対象の%sはありません\n"), i18n.T(t.DisplayName))
+		}
+	}
+	displayNames := make(map[string]string, len(types))
+	for _, t := range types {
+		displayNames[t.Name] = i18n.T(t.DisplayName)
+	}
+
+	notDeleted := make(map[resourceNode]bool)
+	for i, level := range levels {
+		if len(levels) > 1 {
+			common.Infof(ctx, i18n.T("\n📶 削除順序 %d/%d（%d件）\n"), i+1, len(levels), len(level))
+		}
+		for _, t := range types {
+			var names []string
+			var blocked []common.ProcessResult
+			for _, n := range level {
+				if n.Type != t.Name {
+					continue
+				}
+				// 中断された場合は各種類の削除処理で未実行として扱う
+				if b, ok := graph.blocker(n, notDeleted); ok && ctx.Err() == nil {
+					notDeleted[n] = true
+					err := fmt.Errorf(i18n.T("先に削除する必要がある%s %s を削除できませんでした"), displayNames[b.Type], b.Name)
+					common.Progressf(ctx, common.EventWarning, n.Name, i18n.T("⛔ %s %s を削除しません: %v\n"), displayNames[n.Type], n.Name, err)
+					blocked = append(blocked, common.SkippedResult(n.Name, err))
+					continue
+				}
+				names = append(names, n.Name)
+			}
+			if len(blocked) > 0 {
+				common.RecordResults(i18n.T(t.DisplayName), common.ActionDelete, blocked)
+				result := resultOf(t)
+				for _, b := range blocked {
+					result.Blocked = append(result.Blocked, b.Item)
+				}
+				result.Results = append(result.Results, blocked...)
+			}
+			if len(names) == 0 {
+				continue
+			}
+
+			common.Infof(ctx, i18n.T("%sの削除を開始...\n"), i18n.T(t.DisplayName))
+			deleteCtx := ctx
+			if run != nil {
+				deleteCtx = common.WithCheckpoint(ctx, run.Checkpoint(t.Name))
+			}
+			deleteCtx, exclusions := guardrail.WithExclusions(deleteCtx)
+			r := t.Delete(deleteCtx, clients, names)
+			run.Complete(t.Name, r.Deleted...)
+			result := resultOf(t)
+			result.Deleted = append(result.Deleted, r.Deleted...)
+			result.Failed = append(result.Failed, r.Failed...)
+			result.Skipped = append(result.Skipped, r.Skipped...)
+			result.Blocked = append(result.Blocked, r.Blocked...)
+			result.Results = append(result.Results, r.Results...)
+
+			// ガードレールで除外したリソースは、削除しなかったリソースとして結果に含める
+			if protected := exclusions.Results(); len(protected) > 0 {
+				common.RecordResults(i18n.T(t.DisplayName), common.ActionDelete, protected)
+				for _, p := range protected {
+					result.Protected = append(result.Protected, p.Item)
+				}
+				result.Results = append(result.Results, protected...)
+			}
+
+			// ガードレールで除外された場合を含め、削除しなかったリソースが参照しているリソースは削除しない
+			for _, name := range names {
+				if !slices.Contains(r.Deleted, name) {
+					notDeleted[resourceNode{Type: t.Name, Name: name}] = true
+				}
+			}
+		}
+	}
+
+	results := []common.CleanupResult{}
+	for _, t := range types {
+		if r := byType[t.Name]; r != nil {
+			results = append(results, *r)
+		}
+	}
+	return results
+}
@@ -0,0 +1,133 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+
+	"github.com/ngsw877/awstk/internal/service/common"
+)
+
+// fakeType はテスト用のリソースの種類を作成する
+// refs は参照元の名前から参照先のリソースへの参照、fail は削除に失敗させるリソース名
+// 削除したリソースは deleted に削除した順に記録する
+func fakeType(name string, refs []Reference, fail []string, deleted *[]string, mu *sync.Mutex) ResourceType {
+	return ResourceType{
+		Name:        name,
+		DisplayName: name,
+		HasClient:   func(ClientSet) bool { return true },
+		References: func(ctx context.Context, _ ClientSet, names []string) ([]Reference, error) {
+			return refs, nil
+		},
+		Delete: func(ctx context.Context, _ ClientSet, names []string) common.CleanupResult {
+			result := common.CleanupResult{ResourceType: name, Type: name, Deleted: []string{}, Failed: []string{}}
+			mu.Lock()
+			defer mu.Unlock()
+			for _, n := range names {
+				if slices.Contains(fail, n) {
+					result.Failed = append(result.Failed, n)
+					result.Results = append(result.Results, common.ProcessResult{Item: n, Error: errors.New("failed")})
+					continue
+				}
+				*deleted = append(*deleted, name+"/"+n)
+				result.Deleted = append(result.Deleted, n)
+				result.Results = append(result.Results, common.ProcessResult{Item: n, Success: true})
+			}
+			return result
+		},
+	}
+}
+
+func TestDeletionGraphLevels(t *testing.T) {
+	var mu sync.Mutex
+	var deleted []string
+	types := []ResourceType{
+		fakeType("tg", nil, nil, &deleted, &mu),
+		fakeType("lb", []Reference{{From: "lb-1", ToType: "tg", To: "tg-1"}, {From: "lb-1", ToType: "sg", To: "sg-1"}}, nil, &deleted, &mu),
+		fakeType("sg", []Reference{{From: "sg-1", ToType: "sg", To: "sg-1"}}, nil, &deleted, &mu), // 自己参照は無視する
+		fakeType("x", []Reference{{From: "x-1", ToType: "y", To: "y-1"}, {From: "x-1", ToType: "tg", To: "missing"}}, nil, &deleted, &mu),
+		fakeType("y", []Reference{{From: "y-1", ToType: "x", To: "x-1"}}, nil, &deleted, &mu),
+	}
+	found := map[string][]string{
+		"tg": {"tg-1", "tg-2"},
+		"lb": {"lb-1"},
+		"sg": {"sg-1"},
+		"x":  {"x-1"},
+		"y":  {"y-1"},
+	}
+
+	graph := buildDeletionGraph(context.Background(), ClientSet{}, types, found)
+	levels, cyclic := graph.levels()
+
+	want := [][]resourceNode{
+		{{Type: "tg", Name: "tg-2"}, {Type: "lb", Name: "lb-1"}},
+		{{Type: "tg", Name: "tg-1"}, {Type: "sg", Name: "sg-1"}},
+	}
+	if len(levels) != len(want) {
+		t.Fatalf("levels = %v, want %v", levels, want)
+	}
+	for i := range want {
+		if !slices.Equal(levels[i], want[i]) {
+			t.Errorf("levels[%d] = %v, want %v", i, levels[i], want[i])
+		}
+	}
+	wantCyclic := []resourceNode{{Type: "x", Name: "x-1"}, {Type: "y", Name: "y-1"}}
+	if !slices.Equal(cyclic, wantCyclic) {
+		t.Errorf("cyclic = %v, want %v", cyclic, wantCyclic)
+	}
+}
+
+func TestDeleteInDependencyOrder(t *testing.T) {
+	tests := []struct {
+		name        string
+		fail        []string // lb の削除に失敗させるリソース
+		wantOrder   []string
+		wantBlocked map[string][]string
+	}{
+		{
+			name:      "参照しているリソースから順に削除",
+			wantOrder: []string{"lb/lb-1", "tg/tg-1", "sg/sg-1"},
+		},
+		{
+			name:      "削除に失敗したリソースが参照しているリソースは参照先まで削除しない",
+			fail:      []string{"lb-1"},
+			wantOrder: []string{},
+			wantBlocked: map[string][]string{
+				"tg": {"tg-1"},
+				"sg": {"sg-1"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mu sync.Mutex
+			deleted := []string{}
+			// lb-1 → tg-1 → sg-1 の順に削除する必要がある
+			types := []ResourceType{
+				fakeType("sg", nil, nil, &deleted, &mu),
+				fakeType("tg", []Reference{{From: "tg-1", ToType: "sg", To: "sg-1"}}, nil, &deleted, &mu),
+				fakeType("lb", []Reference{{From: "lb-1", ToType: "tg", To: "tg-1"}}, tt.fail, &deleted, &mu),
+			}
+			found := map[string][]string{"sg": {"sg-1"}, "tg": {"tg-1"}, "lb": {"lb-1"}}
+
+			results := deleteInDependencyOrder(context.Background(), ClientSet{}, types, found, nil)
+
+			if !slices.Equal(deleted, tt.wantOrder) {
+				t.Errorf("delete order = %v, want %v", deleted, tt.wantOrder)
+			}
+			for _, r := range results {
+				if want := tt.wantBlocked[r.Type]; !slices.Equal(r.Blocked, want) {
+					t.Errorf("%s Blocked = %v, want %v", r.Type, r.Blocked, want)
+				}
+				for _, item := range r.Blocked {
+					if !slices.ContainsFunc(r.Results, func(p common.ProcessResult) bool { return p.Item == item && p.Skipped }) {
+						t.Errorf("%s result of blocked %s is not recorded as skipped", r.Type, item)
+					}
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,177 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/plan"
+	"github.com/ngsw877/awstk/internal/service/report"
+)
+
+// OrphanOptions は削除済みスタックに残されたリソースのクリーンアップのパラメータを格納する構造体
+type OrphanOptions struct {
+	StackFilter string         // スタック名のフィルター（空の場合はすべての削除済みスタック）
+	Exact       bool           // 大文字小文字を区別してマッチ
+	Types       []string       // 対象にするリソースの種類（--types の指定値。空の場合は既定の種類）
+	Plan        *plan.Plan     // 指定された場合は削除せず、プランにアクションを追加する
+	AccountName func() string  // 削除前の確認で入力を求めるアカウント名を返す関数（削除できるリソースがある場合のみ呼び出す。nil または空文字の場合は y/N で確認）
+	NoConfirm   bool           // true の場合は削除前の確認を行わない
+	ListOnly    bool           // true の場合は一覧を表示するのみで削除しない
+	Report      *report.Report // 指定された場合は削除結果をレポートに追加する
+	AccountId   string         // レポートに記録するアカウントID（ARNの作成にも使用する）
+}
+
+// Orphan は削除済みスタックに残され、現在も存在するリソース
+type Orphan struct {
+	cfn.RetainedResource
+	Type string // リソースの種類の名前（ResourceType.Name。クリーンアップの対象にできない種類の場合は空）
+	Name string // 削除に使用する名前（クリーンアップの対象にできない種類の場合は物理ID）
+}
+
+// CleanupOrphans は削除済みスタックに残されたリソースのうち現在も存在するものを表示し、既存のクリーンアップと同じ手順で削除します
+// クリーンアップの対象にできない種類のリソースは、存在を確認できないため一覧に表示するのみです
+// 削除を実行しなかった場合（一覧の表示のみ・プランの作成時・確認でキャンセルされた場合）は nil を返します
+func CleanupOrphans(ctx context.Context, clients ClientSet, opts OrphanOptions) ([]common.CleanupResult, error) {
+	types, err := SelectTypes(opts.Types)
+	if err != nil {
+		return nil, err
+	}
+	if clients.CfnClient == nil {
+		return nil, errors.New(i18n.T("cloudFormationクライアントが指定されていません"))
+	}
+	if err := validateCleanupOptions(clients, types, Options{Plan: opts.Plan}); err != nil {
+		return nil, err
+	}
+
+	orphans, err := FindOrphans(ctx, clients, types, opts.StackFilter, opts.Exact)
+	if err != nil {
+		return nil, err
+	}
+	if err := displayOrphans(orphans); err != nil {
+		return nil, err
+	}
+	if opts.ListOnly {
+		return nil, nil
+	}
+
+	// 残されたリソースがある種類のみを、登録順に削除する
+	found := make(map[string][]string)
+	for _, o := range orphans {
+		if o.Type != "" && !slices.Contains(found[o.Type], o.Name) {
+			found[o.Type] = append(found[o.Type], o.Name)
+		}
+	}
+	var targetTypes []ResourceType
+	for _, t := range types {
+		if len(found[t.Name]) > 0 {
+			targetTypes = append(targetTypes, t)
+		}
+	}
+	if len(targetTypes) == 0 {
+		common.Infof(ctx, "%s\n", i18n.T("削除できる残されたリソースはありません"))
+		return nil, nil
+	}
+
+	return cleanupFound(ctx, clients, Options{
+		Types:       opts.Types,
+		Plan:        opts.Plan,
+		AccountName: opts.AccountName,
+		NoConfirm:   opts.NoConfirm,
+		Report:      opts.Report,
+		AccountId:   opts.AccountId,
+	}, targetTypes, found)
+}
+
+// FindOrphans は削除済みスタックに残されたリソースのうち、現在も存在するものを取得します
+// クリーンアップの対象にできる種類は、種類ごとの一覧に含まれるもの（既に削除されたもの・使用中のネットワークインターフェース等を除く）のみを返します
+// クリーンアップの対象にできない種類は、存在を確認せずに Type を空にして返します
+func FindOrphans(ctx context.Context, clients ClientSet, types []ResourceType, stackFilter string, exact bool) ([]Orphan, error) {
+	retained, err := cfn.FindRetainedResources(ctx, clients.CfnClient, stackFilter, exact)
+	if err != nil {
+		return nil, err
+	}
+
+	byCfnType := make(map[string]ResourceType)
+	for _, t := range types {
+		if t.CfnType != "" {
+			byCfnType[t.CfnType] = t
+		}
+	}
+
+	// 種類ごとに一度だけ一覧を取得し、現在も存在するかを確認する（一覧の取得中の検出メッセージは表示しない）
+	// 一覧の取得に失敗した種類は警告を表示し、クリーンアップの対象にできない種類と同様に扱う
+	existing := make(map[string]map[string]bool)
+	listFailed := make(map[string]bool)
+	listCtx := common.WithEventSink(ctx, func(common.Event) {})
+	orphans := []Orphan{}
+	for _, r := range retained {
+		t, ok := byCfnType[r.ResourceType]
+		if ok && !listFailed[t.Name] && existing[t.Name] == nil {
+			names, err := t.List(listCtx, clients, Options{})
+			if err != nil {
+				common.Progressf(ctx, common.EventWarning, "", i18n.T("❌ %s一覧取得中にエラーが発生しました: %v\n"), i18n.T(t.DisplayName), err)
+				listFailed[t.Name] = true
+			} else {
+				existing[t.Name] = make(map[string]bool, len(names))
+				for _, name := range names {
+					existing[t.Name][name] = true
+				}
+			}
+		}
+		if !ok || listFailed[t.Name] {
+			orphans = append(orphans, Orphan{RetainedResource: r, Name: r.PhysicalId})
+			continue
+		}
+
+		name := r.PhysicalId
+		if t.NameOf != nil {
+			name = t.NameOf(r.PhysicalId)
+		}
+		if !existing[t.Name][name] {
+			common.Debugf(ctx, i18n.T("スタック '%s' に残された%s %s は既に存在しません\n"), r.StackName, i18n.T(t.DisplayName), name)
+			continue
+		}
+		orphans = append(orphans, Orphan{RetainedResource: r, Type: t.Name, Name: name})
+		common.Progressf(ctx, common.EventInfo, name, i18n.T("🔍 検出された%s: %s\n"), i18n.T(t.DisplayName), name)
+	}
+	return orphans, nil
+}
+
+// displayOrphans は削除済みスタックに残されたリソースの一覧を表示します
+func displayOrphans(orphans []Orphan) error {
+	return common.DisplayList(
+		orphans,
+		i18n.T("削除済みスタックに残されたリソース"),
+		func(orphans []Orphan) ([]common.TableColumn, [][]string) {
+			columns := []common.TableColumn{
+				{Header: i18n.T("スタック名"), Key: "stack"},
+				{Header: i18n.T("削除日時"), Key: "deletedAt"},
+				{Header: i18n.T("論理ID"), Key: "logicalId"},
+				{Header: i18n.T("リソースタイプ"), Key: "resourceType"},
+				{Header: i18n.T("名前"), Key: "name"},
+				{Header: i18n.T("クリーンアップの種類"), Key: "type"},
+			}
+			data := make([][]string, len(orphans))
+			for i, o := range orphans {
+				deletedAt := "-"
+				if o.DeletedAt != nil {
+					deletedAt = o.DeletedAt.Local().Format("2006-01-02 15:04:05")
+				}
+				cleanupType := i18n.T("対象外（手動で確認してください）")
+				if o.Type != "" {
+					cleanupType = o.Type
+				}
+				data[i] = []string{o.StackName, deletedAt, o.LogicalId, o.ResourceType, o.Name, cleanupType}
+			}
+			return columns, data
+		},
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: i18n.T("削除済みスタックに残されたリソースは見つかりませんでした"),
+		},
+	)
+}
@@ -0,0 +1,432 @@
+package cleanup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	dynamodbsvc "github.com/ngsw877/awstk/internal/service/dynamodb"
+	ec2svc "github.com/ngsw877/awstk/internal/service/ec2"
+	ecrsvc "github.com/ngsw877/awstk/internal/service/ecr"
+	elbsvc "github.com/ngsw877/awstk/internal/service/elb"
+	kmssvc "github.com/ngsw877/awstk/internal/service/kms"
+	lambdasvc "github.com/ngsw877/awstk/internal/service/lambda"
+	logssvc "github.com/ngsw877/awstk/internal/service/logs"
+	"github.com/ngsw877/awstk/internal/service/plan"
+	s3svc "github.com/ngsw877/awstk/internal/service/s3"
+	secretssvc "github.com/ngsw877/awstk/internal/service/secretsmanager"
+	snssvc "github.com/ngsw877/awstk/internal/service/sns"
+	sqssvc "github.com/ngsw877/awstk/internal/service/sqs"
+	ssmsvc "github.com/ngsw877/awstk/internal/service/ssm"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+// ResourceType はクリーンアップの対象にできるリソースの種類
+// 種類を追加する場合は、ClientSet にクライアントを追加したうえで resourceTypes に定義を追加する
+type ResourceType struct {
+	Name        string // --types で指定する名前
+	DisplayName string // 表示名（翻訳前の文字列）
+	CfnType     string // CloudFormationのリソースタイプ（空の場合はスタック指定時の対象外）
+	Default     bool   // --types を指定しない場合の対象に含める（false の場合は --types で指定した場合のみ対象）
+
+	// HasClient はこの種類の処理に必要なクライアントが指定されているかを返す
+	HasClient func(clients ClientSet) bool
+	// List は検索文字列・タグ条件・経過時間条件に一致するリソースの名前を返す
+	List func(ctx context.Context, clients ClientSet, opts Options) ([]string, error)
+	// NameOf はスタックのリソースの物理IDを削除に使用する名前に変換する（nil の場合は物理IDをそのまま使用する）
+	NameOf func(physicalId string) string
+	// Delete は指定した名前のリソースを削除する
+	Delete func(ctx context.Context, clients ClientSet, names []string) common.CleanupResult
+	// Plan は指定した名前のリソースの削除アクションを作成する（nil の場合はプランの作成に対応しない）
+	Plan func(ctx context.Context, clients ClientSet, names []string) ([]plan.Action, error)
+	// References は指定した名前のリソースが参照している、それらの削除後でなければ削除できないリソースを返す（nil の場合は参照なし）
+	// 参照先が削除対象に含まれる場合のみ削除順序に反映される
+	References func(ctx context.Context, clients ClientSet, names []string) ([]Reference, error)
+	// Arn はリソース名からARNを作成する（レポートの出力に使用。nil の場合や作成できない場合は空）
+	// 削除処理でARNを取得した場合はそちらを優先する
+	Arn func(partition, region, account, name string) string
+}
+
+// resourceTypes はクリーンアップの対象にできるリソースの種類（この順に検索・削除する）
+var resourceTypes = []ResourceType{
+	{
+		Name:        "s3",
+		DisplayName: "S3バケット",
+		CfnType:     "AWS::S3::Bucket",
+		Default:     true,
+		HasClient:   func(c ClientSet) bool { return c.S3Client != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return s3svc.GetS3BucketsByFilter(ctx, c.S3Client, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		Arn: s3Arn,
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return s3svc.CleanupS3Buckets(ctx, c.S3Client, names)
+		},
+		Plan: func(ctx context.Context, c ClientSet, names []string) ([]plan.Action, error) {
+			return s3svc.PlanBucketDeletion(ctx, c.S3Client, names)
+		},
+		// イベント通知の送信先（SNSトピック・SQSキュー・Lambda関数）より先に削除する
+		References: func(ctx context.Context, c ClientSet, names []string) ([]Reference, error) {
+			destinations, err := s3svc.GetBucketNotificationDestinations(ctx, c.S3Client, names)
+			if err != nil {
+				return nil, err
+			}
+			var refs []Reference
+			for _, bucket := range names {
+				refs = append(refs, arnReferences(bucket, destinations[bucket])...)
+			}
+			return refs, nil
+		},
+	},
+	{
+		Name:        "ecr",
+		DisplayName: "ECRリポジトリ",
+		CfnType:     "AWS::ECR::Repository",
+		Default:     true,
+		HasClient:   func(c ClientSet) bool { return c.EcrClient != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return ecrsvc.GetEcrRepositoriesByFilter(ctx, c.EcrClient, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		Arn: regionalArn("ecr", "repository/"),
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return ecrsvc.CleanupEcrRepositories(ctx, c.EcrClient, names)
+		},
+		Plan: func(ctx context.Context, c ClientSet, names []string) ([]plan.Action, error) {
+			return ecrsvc.PlanRepositoryDeletion(ctx, c.EcrClient, names)
+		},
+	},
+	{
+		Name:        "logs",
+		DisplayName: "CloudWatch Logsグループ",
+		CfnType:     "AWS::Logs::LogGroup",
+		Default:     true,
+		HasClient:   func(c ClientSet) bool { return c.LogsClient != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return logssvc.GetLogGroupsByFilter(ctx, c.LogsClient, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		Arn: regionalArn("logs", "log-group:"),
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return logssvc.CleanupLogGroups(ctx, c.LogsClient, names)
+		},
+		// cleanup all と同様に、ロググループは削除保護を解除して削除するアクションになる
+		Plan: func(ctx context.Context, c ClientSet, names []string) ([]plan.Action, error) {
+			return logssvc.PlanLogGroupDeletion(ctx, c.LogsClient, names, true)
+		},
+		// サブスクリプションフィルターの送信先（Lambda関数）より先に削除する
+		References: func(ctx context.Context, c ClientSet, names []string) ([]Reference, error) {
+			destinations, err := logssvc.GetSubscriptionDestinations(ctx, c.LogsClient, names)
+			if err != nil {
+				return nil, err
+			}
+			var refs []Reference
+			for _, group := range names {
+				refs = append(refs, arnReferences(group, destinations[group])...)
+			}
+			return refs, nil
+		},
+	},
+	{
+		Name:        "dynamodb",
+		DisplayName: "DynamoDBテーブル",
+		CfnType:     "AWS::DynamoDB::Table",
+		HasClient:   func(c ClientSet) bool { return c.DynamoDbClient != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return dynamodbsvc.GetDynamoDbTablesByFilter(ctx, c.DynamoDbClient, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		Arn: regionalArn("dynamodb", "table/"),
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return dynamodbsvc.CleanupDynamoDbTables(ctx, c.DynamoDbClient, names)
+		},
+	},
+	{
+		Name:        "sqs",
+		DisplayName: "SQSキュー",
+		CfnType:     "AWS::SQS::Queue",
+		HasClient:   func(c ClientSet) bool { return c.SqsClient != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return sqssvc.GetSqsQueuesByFilter(ctx, c.SqsClient, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		NameOf: sqssvc.QueueNameFromUrl,
+		Arn:    regionalArn("sqs", ""),
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return sqssvc.CleanupSqsQueues(ctx, c.SqsClient, names)
+		},
+	},
+	{
+		Name:        "sns",
+		DisplayName: "SNSトピック",
+		CfnType:     "AWS::SNS::Topic",
+		HasClient:   func(c ClientSet) bool { return c.SnsClient != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return snssvc.GetSnsTopicsByFilter(ctx, c.SnsClient, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		NameOf: snssvc.TopicNameFromArn,
+		Arn:    regionalArn("sns", ""),
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return snssvc.CleanupSnsTopics(ctx, c.SnsClient, names)
+		},
+	},
+	{
+		Name:        "lambda",
+		DisplayName: "Lambda関数",
+		CfnType:     "AWS::Lambda::Function",
+		HasClient:   func(c ClientSet) bool { return c.LambdaClient != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return lambdasvc.GetLambdaFunctionsByFilter(ctx, c.LambdaClient, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		Arn: regionalArn("lambda", "function:"),
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return lambdasvc.CleanupLambdaFunctions(ctx, c.LambdaClient, names)
+		},
+		// VPC接続のセキュリティグループとログの出力先のロググループより先に削除する
+		References: func(ctx context.Context, c ClientSet, names []string) ([]Reference, error) {
+			dependencies, err := lambdasvc.GetFunctionDependencies(ctx, c.LambdaClient, names)
+			if err != nil {
+				return nil, err
+			}
+			var refs []Reference
+			for _, function := range names {
+				deps, ok := dependencies[function]
+				if !ok {
+					continue
+				}
+				refs = append(refs, referencesTo(function, "security-group", deps.SecurityGroups)...)
+				refs = append(refs, Reference{From: function, ToType: "logs", To: deps.LogGroup})
+			}
+			return refs, nil
+		},
+	},
+	{
+		Name:        "secrets",
+		DisplayName: "シークレット",
+		CfnType:     "AWS::SecretsManager::Secret",
+		HasClient:   func(c ClientSet) bool { return c.SecretsClient != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return secretssvc.GetSecretsByFilter(ctx, c.SecretsClient, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		NameOf: secretssvc.SecretNameFromArn,
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return secretssvc.CleanupSecrets(ctx, c.SecretsClient, names)
+		},
+	},
+	{
+		Name:        "ssm",
+		DisplayName: "SSMパラメータ",
+		CfnType:     "AWS::SSM::Parameter",
+		HasClient:   func(c ClientSet) bool { return c.SsmClient != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return ssmsvc.GetParametersByFilter(ctx, c.SsmClient, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		Arn: ssmParameterArn,
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return ssmsvc.CleanupParameters(ctx, c.SsmClient, names)
+		},
+	},
+	{
+		Name:        "kms",
+		DisplayName: "KMSキー",
+		CfnType:     "AWS::KMS::Key",
+		HasClient:   func(c ClientSet) bool { return c.KmsClient != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return kmssvc.GetKmsKeysByFilter(ctx, c.KmsClient, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		Arn: regionalArn("kms", "key/"),
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return kmssvc.CleanupKmsKeys(ctx, c.KmsClient, names)
+		},
+	},
+	{
+		// スナップショットはスタックのリソースとして作成されないため、スタック指定時は対象外
+		Name:        "ebs-snapshot",
+		DisplayName: "EBSスナップショット",
+		HasClient:   func(c ClientSet) bool { return c.Ec2Client != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return ec2svc.GetEbsSnapshotsByFilter(ctx, c.Ec2Client, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		Arn: ebsSnapshotArn,
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return ec2svc.CleanupEbsSnapshots(ctx, c.Ec2Client, names)
+		},
+	},
+	{
+		Name:        "elb",
+		DisplayName: "ロードバランサー",
+		CfnType:     "AWS::ElasticLoadBalancingV2::LoadBalancer",
+		HasClient:   func(c ClientSet) bool { return c.ElbClient != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return elbsvc.GetLoadBalancerNamesByFilter(ctx, c.ElbClient, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		NameOf: elbsvc.LoadBalancerNameFromArn,
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return elbsvc.CleanupLoadBalancers(ctx, c.ElbClient, names)
+		},
+		// cleanup all と同様に、削除保護の解除とターゲットグループの削除は行わないアクションになる
+		Plan: func(ctx context.Context, c ClientSet, names []string) ([]plan.Action, error) {
+			return elbsvc.PlanLoadBalancerDeletion(ctx, c.ElbClient, names, false, false)
+		},
+		// ターゲットグループとセキュリティグループより先に削除する
+		References: func(ctx context.Context, c ClientSet, names []string) ([]Reference, error) {
+			dependencies, err := elbsvc.GetLoadBalancerDependencies(ctx, c.ElbClient, names)
+			if err != nil {
+				return nil, err
+			}
+			var refs []Reference
+			for _, lb := range names {
+				refs = append(refs, referencesTo(lb, "target-group", dependencies[lb].TargetGroups)...)
+				refs = append(refs, referencesTo(lb, "security-group", dependencies[lb].SecurityGroups)...)
+			}
+			return refs, nil
+		},
+	},
+	{
+		Name:        "target-group",
+		DisplayName: "ターゲットグループ",
+		CfnType:     "AWS::ElasticLoadBalancingV2::TargetGroup",
+		HasClient:   func(c ClientSet) bool { return c.ElbClient != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return elbsvc.GetTargetGroupsByFilter(ctx, c.ElbClient, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		NameOf: elbsvc.TargetGroupNameFromArn,
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return elbsvc.CleanupTargetGroups(ctx, c.ElbClient, names)
+		},
+	},
+	{
+		Name:        "eni",
+		DisplayName: "ネットワークインターフェース",
+		CfnType:     "AWS::EC2::NetworkInterface",
+		HasClient:   func(c ClientSet) bool { return c.Ec2Client != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return ec2svc.GetNetworkInterfacesByFilter(ctx, c.Ec2Client, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		Arn: regionalArn("ec2", "network-interface/"),
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return ec2svc.CleanupNetworkInterfaces(ctx, c.Ec2Client, names)
+		},
+		// 関連付けられているセキュリティグループより先に削除する
+		References: func(ctx context.Context, c ClientSet, names []string) ([]Reference, error) {
+			groups, err := ec2svc.GetNetworkInterfaceSecurityGroups(ctx, c.Ec2Client, names)
+			if err != nil {
+				return nil, err
+			}
+			var refs []Reference
+			for _, eni := range names {
+				refs = append(refs, referencesTo(eni, "security-group", groups[eni])...)
+			}
+			return refs, nil
+		},
+	},
+	{
+		// 名前はVPC間で重複することがあるため、セキュリティグループIDで扱う
+		Name:        "security-group",
+		DisplayName: "セキュリティグループ",
+		CfnType:     "AWS::EC2::SecurityGroup",
+		HasClient:   func(c ClientSet) bool { return c.Ec2Client != nil },
+		List: func(ctx context.Context, c ClientSet, opts Options) ([]string, error) {
+			return ec2svc.GetSecurityGroupsByFilter(ctx, c.Ec2Client, opts.SearchString, opts.Exact, opts.Tags, opts.Age)
+		},
+		Arn: regionalArn("ec2", "security-group/"),
+		Delete: func(ctx context.Context, c ClientSet, names []string) common.CleanupResult {
+			return ec2svc.CleanupSecurityGroups(ctx, c.Ec2Client, names)
+		},
+	},
+}
+
+// TypeNames は --types で指定できるリソースの種類の名前を返す
+func TypeNames() []string {
+	names := make([]string, len(resourceTypes))
+	for i, t := range resourceTypes {
+		names[i] = t.Name
+	}
+	return names
+}
+
+// PlanTypeNames はプランの作成に対応しているリソースの種類の名前を返す
+func PlanTypeNames() []string {
+	var names []string
+	for _, t := range resourceTypes {
+		if t.Plan != nil {
+			names = append(names, t.Name)
+		}
+	}
+	return names
+}
+
+// SelectTypes は --types の指定値から対象にするリソースの種類を返す（未指定の場合は既定の種類: s3, ecr, logs）
+//   - name  : 指定した種類のみを対象にする（複数指定時はいずれか）
+//   - all   : すべての種類を対象にする
+//   - !name : 指定した種類を対象から除外する（除外のみの場合は残りの既定の種類が対象）
+func SelectTypes(spec []string) ([]ResourceType, error) {
+	known := make(map[string]bool, len(resourceTypes))
+	for _, t := range resourceTypes {
+		known[t.Name] = true
+	}
+
+	all := false
+	include := make(map[string]bool)
+	exclude := make(map[string]bool)
+	for _, value := range spec {
+		name := strings.TrimSpace(value)
+		if name == "all" {
+			all = true
+			continue
+		}
+		negate := strings.HasPrefix(name, "!")
+		name = strings.TrimPrefix(name, "!")
+		if !known[name] {
+			return nil, fmt.Errorf(i18n.T("不明なリソースの種類です: %s（指定できる値: %s）"), value, strings.Join(append(TypeNames(), "all"), ", "))
+		}
+		if negate {
+			exclude[name] = true
+		} else {
+			include[name] = true
+		}
+	}
+
+	var selected []ResourceType
+	for _, t := range resourceTypes {
+		if exclude[t.Name] {
+			continue
+		}
+		if all || include[t.Name] || (len(include) == 0 && t.Default) {
+			selected = append(selected, t)
+		}
+	}
+	if len(selected) == 0 {
+		return nil, errors.New(i18n.T("対象にするリソースの種類がありません"))
+	}
+	return selected, nil
+}
+
+// regionalArn はリソース部分が prefix に続けてリソース名となる、リージョンとアカウントIDを含むARNを作成する関数を返す
+// アカウントIDが不明な場合は空文字を返す
+func regionalArn(service, prefix string) func(partition, region, account, name string) string {
+	return func(partition, region, account, name string) string {
+		if account == "" || region == "" {
+			return ""
+		}
+		return arn.ARN{Partition: partition, Service: service, Region: region, AccountID: account, Resource: prefix + name}.String()
+	}
+}
+
+// s3Arn はS3バケットのARNを作成する（リージョンとアカウントIDを含まない）
+func s3Arn(partition, _, _, name string) string {
+	return arn.ARN{Partition: partition, Service: "s3", Resource: name}.String()
+}
+
+// ssmParameterArn はSSMパラメータのARNを作成する（階層のパラメータ名の先頭の / は含めない）
+func ssmParameterArn(partition, region, account, name string) string {
+	return regionalArn("ssm", "parameter/")(partition, region, account, strings.TrimPrefix(name, "/"))
+}
+
+// ebsSnapshotArn はEBSスナップショットのARNを作成する（アカウントIDを含まない）
+func ebsSnapshotArn(partition, region, _, name string) string {
+	if region == "" {
+		return ""
+	}
+	return arn.ARN{Partition: partition, Service: "ec2", Region: region, Resource: "snapshot/" + name}.String()
+}
@@ -0,0 +1,55 @@
+package cleanup
+
+import (
+	"slices"
+	"testing"
+)
+
+func TestSelectTypes(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    []string
+		want    []string
+		wantErr bool
+	}{
+		{name: "未指定の場合は既定の種類", spec: nil, want: []string{"s3", "ecr", "logs"}},
+		{name: "指定した種類のみ", spec: []string{"sqs", "lambda"}, want: []string{"sqs", "lambda"}},
+		{name: "除外のみの場合は残りの既定の種類", spec: []string{"!ecr"}, want: []string{"s3", "logs"}},
+		{name: "指定と除外の組み合わせ", spec: []string{"s3", "sns", "!s3"}, want: []string{"sns"}},
+		{name: "all から除外", spec: []string{"all", "!kms", "!secrets"}, want: withoutTypes("kms", "secrets")},
+		{name: "前後の空白は無視", spec: []string{" logs "}, want: []string{"logs"}},
+		{name: "不明な種類はエラー", spec: []string{"s3", "bogus"}, wantErr: true},
+		{name: "不明な種類の除外もエラー", spec: []string{"!bogus"}, wantErr: true},
+		{name: "すべて除外した場合はエラー", spec: []string{"!s3", "!ecr", "!logs"}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			types, err := SelectTypes(tt.spec)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			var got []string
+			for _, rt := range types {
+				got = append(got, rt.Name)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("SelectTypes(%q) = %v, want %v", tt.spec, got, tt.want)
+			}
+		})
+	}
+}
+
+// withoutTypes は登録順のすべての種類の名前から指定した名前を除いたものを返す
+func withoutTypes(excluded ...string) []string {
+	var names []string
+	for _, name := range TypeNames() {
+		if !slices.Contains(excluded, name) {
+			names = append(names, name)
+		}
+	}
+	return names
+}
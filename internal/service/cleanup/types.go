@@ -1,24 +1,58 @@
 package cleanup
 
 import (
-	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
-	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
-	"github.com/aws/aws-sdk-go-v2/service/ecr"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/ngsw877/awstk/internal/runstate"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	"github.com/ngsw877/awstk/internal/service/common"
+	dynamodbsvc "github.com/ngsw877/awstk/internal/service/dynamodb"
+	ec2svc "github.com/ngsw877/awstk/internal/service/ec2"
+	ecrsvc "github.com/ngsw877/awstk/internal/service/ecr"
+	elbsvc "github.com/ngsw877/awstk/internal/service/elb"
+	kmssvc "github.com/ngsw877/awstk/internal/service/kms"
+	lambdasvc "github.com/ngsw877/awstk/internal/service/lambda"
+	logssvc "github.com/ngsw877/awstk/internal/service/logs"
+	"github.com/ngsw877/awstk/internal/service/plan"
+	"github.com/ngsw877/awstk/internal/service/report"
+	s3svc "github.com/ngsw877/awstk/internal/service/s3"
+	secretssvc "github.com/ngsw877/awstk/internal/service/secretsmanager"
+	snssvc "github.com/ngsw877/awstk/internal/service/sns"
+	sqssvc "github.com/ngsw877/awstk/internal/service/sqs"
+	ssmsvc "github.com/ngsw877/awstk/internal/service/ssm"
+	"github.com/ngsw877/awstk/internal/service/tagging"
 )
 
 // ClientSet はクリーンアップ処理に必要なクライアントをまとめた構造体
+// 各フィールドにはSDKのクライアントまたは同じインターフェースを満たす実装（テスト用のフェイク等）を指定する
+// 対象にするリソースの種類のクライアントのみ必須（CfnClient はスタックを指定する場合のみ必須）
 type ClientSet struct {
-	S3Client   *s3.Client
-	EcrClient  *ecr.Client
-	CfnClient  *cloudformation.Client
-	LogsClient *cloudwatchlogs.Client
+	S3Client       s3svc.S3Api
+	EcrClient      ecrsvc.EcrApi
+	CfnClient      cfn.CfnApi
+	LogsClient     logssvc.LogsApi
+	DynamoDbClient dynamodbsvc.DynamoDbApi
+	SqsClient      sqssvc.SqsApi
+	SnsClient      snssvc.SnsApi
+	LambdaClient   lambdasvc.LambdaApi
+	SecretsClient  secretssvc.SecretsManagerApi
+	SsmClient      ssmsvc.SsmApi
+	KmsClient      kmssvc.KmsApi
+	Ec2Client      ec2svc.CleanupApi
+	ElbClient      elbsvc.ElbApi
 }
 
 // Options はクリーンアップ処理のパラメータを格納する構造体
 type Options struct {
-	SearchString string // 検索文字列
-	StackName    string // CloudFormationスタック名
-	StackId      string // CloudFormationスタックID (ARN可)
-	Exact        bool   // 大文字小文字を区別してマッチ
+	SearchString string            // 検索文字列
+	StackName    string            // CloudFormationスタック名
+	StackId      string            // CloudFormationスタックID (ARN可)
+	Exact        bool              // 大文字小文字を区別してマッチ
+	Types        []string          // 対象にするリソースの種類（--types の指定値。空の場合は既定の種類）
+	Tags         *tagging.Selector // 指定された場合は検索文字列とタグ条件の両方に一致するリソースを対象にする
+	Age          *common.AgeFilter // 指定された場合は作成日時（または最終アクティビティ日時）の経過時間にも一致するリソースを対象にする
+	Plan         *plan.Plan        // 指定された場合は削除せず、プランにアクションを追加する
+	AccountName  func() string     // 削除前の確認で入力を求めるアカウント名（エイリアスまたはID）を返す関数。確認が必要になった時点で呼び出す（nil または空文字の場合は y/N で確認）
+	NoConfirm    bool              // true の場合は削除前の確認を行わない（ライブラリからの呼び出しなど、呼び出し側で確認済みの場合）
+	Run          *runstate.Run     // 指定された場合は進捗を記録する（削除対象が保存済みの場合は検出を省略し、中断した実行の続きから削除する）
+	Report       *report.Report    // 指定された場合は削除結果をレポートに追加する
+	AccountId    string            // レポートに記録するアカウントID（ARNの作成にも使用する。空の場合はアカウントIDを含むARNを作成しない）
 }
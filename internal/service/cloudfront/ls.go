@@ -0,0 +1,35 @@
+package cloudfront
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudfront"
+)
+
+// ListDistributions はCloudFrontディストリビューションの一覧を返します
+func ListDistributions(ctx context.Context, client *cloudfront.Client) ([]DistributionInfo, error) {
+	var distributions []DistributionInfo
+	paginator := cloudfront.NewListDistributionsPaginator(client, &cloudfront.ListDistributionsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("ディストリビューション一覧の取得に失敗: %w"), err)
+		}
+		if page.DistributionList == nil {
+			continue
+		}
+		for _, item := range page.DistributionList.Items {
+			distributions = append(distributions, DistributionInfo{
+				Id:         aws.ToString(item.Id),
+				DomainName: aws.ToString(item.DomainName),
+				Comment:    aws.ToString(item.Comment),
+				Enabled:    aws.ToBool(item.Enabled),
+			})
+		}
+	}
+	return distributions, nil
+}
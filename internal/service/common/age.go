@@ -0,0 +1,170 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+)
+
+// NewAgeFilter は --older-than / --newer-than / --age-by の指定値から絞り込み条件を作成する
+// 期間がいずれも指定されていない場合は nil を返す
+func NewAgeFilter(olderThan, newerThan, basis string) (*AgeFilter, error) {
+	if olderThan == "" && newerThan == "" {
+		return nil, nil
+	}
+
+	f := &AgeFilter{Basis: AgeBasis(basis), Now: time.Now()}
+	switch f.Basis {
+	case "":
+		f.Basis = AgeByCreated
+	case AgeByCreated, AgeByLastActivity:
+	default:
+		return nil, fmt.Errorf(i18n.T("--age-by の指定が不正です: %s（created または last-activity を指定してください）"), basis)
+	}
+
+	var err error
+	if olderThan != "" {
+		if f.OlderThan, err = ParseAgeDuration(olderThan); err != nil {
+			return nil, err
+		}
+	}
+	if newerThan != "" {
+		if f.NewerThan, err = ParseAgeDuration(newerThan); err != nil {
+			return nil, err
+		}
+	}
+	if f.OlderThan > 0 && f.NewerThan > 0 && f.NewerThan <= f.OlderThan {
+		return nil, errors.New(i18n.T("--newer-than には --older-than より長い期間を指定してください"))
+	}
+	return f, nil
+}
+
+// ParseAgeDuration は期間の指定値を解析する
+// time.ParseDuration の形式（例: 36h, 90m）に加えて、日数（例: 14d）と週数（例: 2w）に対応する
+func ParseAgeDuration(s string) (time.Duration, error) {
+	value := strings.TrimSpace(s)
+	var d time.Duration
+	var err error
+	switch {
+	case strings.HasSuffix(value, "d"), strings.HasSuffix(value, "w"):
+		unit := 24 * time.Hour
+		if strings.HasSuffix(value, "w") {
+			unit = 7 * 24 * time.Hour
+		}
+		var n int
+		n, err = strconv.Atoi(value[:len(value)-1])
+		d = time.Duration(n) * unit
+	default:
+		d, err = time.ParseDuration(value)
+	}
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf(i18n.T("期間の指定が不正です: %s（例: 14d、2w、36h）"), s)
+	}
+	return d, nil
+}
+
+// Enabled は絞り込み条件が指定されているかを返す（nil の AgeFilter は条件なしとして扱う）
+func (f *AgeFilter) Enabled() bool {
+	return f != nil && (f.OlderThan > 0 || f.NewerThan > 0)
+}
+
+// UsesLastActivity は最終アクティビティ日時で判定するかを返す
+// 最終アクティビティ日時の取得に追加のAPI呼び出しが必要なリソースは、この場合のみ取得する
+func (f *AgeFilter) UsesLastActivity() bool {
+	return f.Enabled() && f.Basis == AgeByLastActivity
+}
+
+// Matches はリソースの日時が絞り込み条件を満たすか判定する
+// lastActivity が取得できない場合は作成日時で判定し、日時が不明なリソースは対象外とする
+func (f *AgeFilter) Matches(created, lastActivity *time.Time) bool {
+	if !f.Enabled() {
+		return true
+	}
+	t := f.timeOf(created, lastActivity)
+	if t == nil {
+		return false
+	}
+	age := f.Now.Sub(*t)
+	if f.OlderThan > 0 && age < f.OlderThan {
+		return false
+	}
+	if f.NewerThan > 0 && age > f.NewerThan {
+		return false
+	}
+	return true
+}
+
+// Annotate は確認用の一覧に表示するため、リソース名に判定に使用した日時と経過時間を付けて返す
+// 絞り込み条件が指定されていない場合はリソース名をそのまま返す
+func (f *AgeFilter) Annotate(name string, created, lastActivity *time.Time) string {
+	if !f.Enabled() {
+		return name
+	}
+	label := i18n.T("作成")
+	if f.Basis == AgeByLastActivity && lastActivity != nil {
+		label = i18n.T("最終アクティビティ")
+	}
+	t := f.timeOf(created, lastActivity)
+	if t == nil {
+		return fmt.Sprintf("%s (%s: -)", name, label)
+	}
+	return fmt.Sprintf(i18n.T("%s (%s: %s, %s前)"), name, label, t.Local().Format("2006-01-02 15:04"), formatAge(f.Now.Sub(*t)))
+}
+
+// String は絞り込み条件を表示用の文字列で返す（例: "作成日時が14日以上前"）
+func (f *AgeFilter) String() string {
+	if !f.Enabled() {
+		return ""
+	}
+	label := i18n.T("作成日時")
+	if f.Basis == AgeByLastActivity {
+		label = i18n.T("最終アクティビティ日時")
+	}
+	var parts []string
+	if f.OlderThan > 0 {
+		parts = append(parts, fmt.Sprintf(i18n.T("%s以上前"), formatAge(f.OlderThan)))
+	}
+	if f.NewerThan > 0 {
+		parts = append(parts, fmt.Sprintf(i18n.T("%s以内"), formatAge(f.NewerThan)))
+	}
+	return fmt.Sprintf(i18n.T("%sが%s"), label, strings.Join(parts, i18n.T("かつ")))
+}
+
+// timeOf は判定に使用する日時を返す
+func (f *AgeFilter) timeOf(created, lastActivity *time.Time) *time.Time {
+	if f.Basis == AgeByLastActivity && lastActivity != nil {
+		return lastActivity
+	}
+	return created
+}
+
+// SelectByAge は絞り込み条件に一致するリソースだけを返す（条件が指定されていない場合はそのまま返す）
+// timesOf は各リソースの作成日時と最終アクティビティ日時（不明な場合は nil）を返す
+func SelectByAge[T any](f *AgeFilter, items []T, timesOf func(T) (created, lastActivity *time.Time)) []T {
+	if !f.Enabled() {
+		return items
+	}
+	selected := make([]T, 0, len(items))
+	for _, item := range items {
+		if f.Matches(timesOf(item)) {
+			selected = append(selected, item)
+		}
+	}
+	return selected
+}
+
+// formatAge は経過時間を表示用の文字列で返す（1日以上は日数、それ未満は時間・分）
+func formatAge(d time.Duration) string {
+	switch {
+	case d >= 24*time.Hour:
+		return fmt.Sprintf(i18n.T("%d日"), int(d/(24*time.Hour)))
+	case d >= time.Hour:
+		return fmt.Sprintf(i18n.T("%d時間"), int(d/time.Hour))
+	default:
+		return fmt.Sprintf(i18n.T("%d分"), int(d/time.Minute))
+	}
+}
@@ -0,0 +1,105 @@
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseAgeDuration(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "14d", want: 14 * 24 * time.Hour},
+		{value: "2w", want: 14 * 24 * time.Hour},
+		{value: "36h", want: 36 * time.Hour},
+		{value: "90m", want: 90 * time.Minute},
+		{value: "1h30m", want: 90 * time.Minute},
+		{value: " 7d ", want: 7 * 24 * time.Hour},
+		{value: "0d", wantErr: true},
+		{value: "-1d", wantErr: true},
+		{value: "1.5d", wantErr: true},
+		{value: "d", wantErr: true},
+		{value: "14", wantErr: true},
+		{value: "", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseAgeDuration(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("ParseAgeDuration(%q) err = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("ParseAgeDuration(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestNewAgeFilter(t *testing.T) {
+	tests := []struct {
+		name                 string
+		olderThan, newerThan string
+		basis                string
+		wantNil, wantErr     bool
+		wantOlder, wantNewer time.Duration
+		wantBasis            AgeBasis
+	}{
+		{name: "期間の指定なし", basis: "created", wantNil: true},
+		{name: "--older-than のみ", olderThan: "14d", wantOlder: 14 * 24 * time.Hour, wantBasis: AgeByCreated},
+		{name: "最終アクティビティ日時で判定", newerThan: "1d", basis: "last-activity", wantNewer: 24 * time.Hour, wantBasis: AgeByLastActivity},
+		{name: "範囲指定", olderThan: "1d", newerThan: "2w", basis: "created", wantOlder: 24 * time.Hour, wantNewer: 14 * 24 * time.Hour, wantBasis: AgeByCreated},
+		{name: "--newer-than が --older-than 以下", olderThan: "2w", newerThan: "14d", wantErr: true},
+		{name: "不明な判定日時", olderThan: "1d", basis: "modified", wantErr: true},
+		{name: "不正な期間", olderThan: "soon", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewAgeFilter(tt.olderThan, tt.newerThan, tt.basis)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if (f == nil) != tt.wantNil {
+				t.Fatalf("filter = %+v, wantNil %v", f, tt.wantNil)
+			}
+			if f != nil && (f.OlderThan != tt.wantOlder || f.NewerThan != tt.wantNewer || f.Basis != tt.wantBasis) {
+				t.Errorf("filter = %+v, want older %v newer %v basis %s", f, tt.wantOlder, tt.wantNewer, tt.wantBasis)
+			}
+		})
+	}
+}
+
+func TestAgeFilterMatches(t *testing.T) {
+	now := time.Date(2026, 1, 31, 12, 0, 0, 0, time.UTC)
+	daysAgo := func(d int) *time.Time {
+		t := now.Add(-time.Duration(d) * 24 * time.Hour)
+		return &t
+	}
+
+	tests := []struct {
+		name                string
+		filter              *AgeFilter
+		created, lastActive *time.Time
+		want                bool
+	}{
+		{name: "条件なしは常に一致", filter: nil, want: true},
+		{name: "指定期間より前に作成", filter: &AgeFilter{OlderThan: 14 * 24 * time.Hour, Basis: AgeByCreated, Now: now}, created: daysAgo(20), want: true},
+		{name: "指定期間以内に作成", filter: &AgeFilter{OlderThan: 14 * 24 * time.Hour, Basis: AgeByCreated, Now: now}, created: daysAgo(3), want: false},
+		{name: "境界は一致", filter: &AgeFilter{OlderThan: 14 * 24 * time.Hour, Basis: AgeByCreated, Now: now}, created: daysAgo(14), want: true},
+		{name: "範囲内", filter: &AgeFilter{OlderThan: 24 * time.Hour, NewerThan: 7 * 24 * time.Hour, Basis: AgeByCreated, Now: now}, created: daysAgo(3), want: true},
+		{name: "範囲より古い", filter: &AgeFilter{OlderThan: 24 * time.Hour, NewerThan: 7 * 24 * time.Hour, Basis: AgeByCreated, Now: now}, created: daysAgo(10), want: false},
+		{name: "最終アクティビティ日時で判定", filter: &AgeFilter{OlderThan: 14 * 24 * time.Hour, Basis: AgeByLastActivity, Now: now}, created: daysAgo(90), lastActive: daysAgo(2), want: false},
+		{name: "最終アクティビティ日時が不明な場合は作成日時", filter: &AgeFilter{OlderThan: 14 * 24 * time.Hour, Basis: AgeByLastActivity, Now: now}, created: daysAgo(90), want: true},
+		{name: "日時が不明なリソースは対象外", filter: &AgeFilter{OlderThan: 14 * 24 * time.Hour, Basis: AgeByCreated, Now: now}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.filter.Matches(tt.created, tt.lastActive); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,43 @@
+package common
+
+import "context"
+
+// Checkpoint は中断した処理を再開するための進捗の記録先（実行状態ファイル等）
+// 1つのリソースの種類ごとに作成し、リソース名をキーに記録する
+type Checkpoint interface {
+	// Done はリソースの処理が完了したことを記録する
+	Done(item string)
+	// Marker はリソースの処理を途中から再開する位置を返す（記録がない場合は nil）
+	Marker(item string) map[string]string
+	// SetMarker はリソースの処理を途中から再開する位置を記録する（nil の場合は記録を消す）
+	SetMarker(item string, marker map[string]string)
+}
+
+type checkpointKey struct{}
+
+// WithCheckpoint は進捗の記録先を設定したコンテキストを返す
+func WithCheckpoint(ctx context.Context, cp Checkpoint) context.Context {
+	return context.WithValue(ctx, checkpointKey{}, cp)
+}
+
+// MarkDone はリソースの処理が完了したことを進捗の記録先に記録する（記録先が未設定の場合は何もしない）
+func MarkDone(ctx context.Context, item string) {
+	if cp, ok := ctx.Value(checkpointKey{}).(Checkpoint); ok {
+		cp.Done(item)
+	}
+}
+
+// ResumeMarker は前回の実行で記録したリソースの処理の再開位置を返す（記録がない場合は nil）
+func ResumeMarker(ctx context.Context, item string) map[string]string {
+	if cp, ok := ctx.Value(checkpointKey{}).(Checkpoint); ok {
+		return cp.Marker(item)
+	}
+	return nil
+}
+
+// SaveMarker はリソースの処理の再開位置を進捗の記録先に記録する（記録先が未設定の場合は何もしない）
+func SaveMarker(ctx context.Context, item string, marker map[string]string) {
+	if cp, ok := ctx.Value(checkpointKey{}).(Checkpoint); ok {
+		cp.SetMarker(item, marker)
+	}
+}
@@ -0,0 +1,34 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// RestoreTimeout は中断後に実行する復元・後始末処理の最大待ち時間
+const RestoreTimeout = 30 * time.Second
+
+// IsCanceled はエラーがCtrl-C等による中断を示すかを返す
+func IsCanceled(err error) bool {
+	return errors.Is(err, context.Canceled)
+}
+
+// Sleep は指定時間待機する（待機中に中断された場合は ctx.Err() を返す）
+// 待機ループでは time.Sleep の代わりにこれを使い、Ctrl-C で即座に抜けられるようにする
+func Sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// RestoreContext は中断後でも復元・後始末処理を実行できるコンテキストを返す
+// 親コンテキストのキャンセルは引き継がず、RestoreTimeout でタイムアウトする
+func RestoreContext(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(context.WithoutCancel(ctx), RestoreTimeout)
+}
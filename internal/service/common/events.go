@@ -0,0 +1,110 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/logging"
+)
+
+// EventKind は進捗イベントの種類
+type EventKind string
+
+const (
+	EventInfo    EventKind = "info"    // 対象の一覧や処理の開始などの情報
+	EventStart   EventKind = "start"   // リソースに対する操作の開始
+	EventSuccess EventKind = "success" // リソースに対する操作の成功
+	EventFailure EventKind = "failure" // リソースに対する操作の失敗
+	EventWarning EventKind = "warning" // 処理は継続する警告（ガードレールによる除外等）
+	EventDebug   EventKind = "debug"   // 詳細な情報（CLIでは -v 指定時のみ表示）
+)
+
+// Event はサービス層の処理の進捗を表すイベント
+type Event struct {
+	Kind     EventKind
+	Resource string    // 対象のリソース名（特定のリソースに関するイベントでない場合は空）
+	Message  string    // 翻訳済みのメッセージ（前後の空白・改行は除く）
+	Err      error     // 失敗の原因（EventFailure の場合）
+	Time     time.Time // イベントの発生時刻
+}
+
+// EventSink は進捗イベントの受け取り先
+type EventSink func(Event)
+
+type eventSinkKey struct{}
+
+// WithEventSink は進捗イベントの受け取り先を設定したコンテキストを返す
+// 並列処理のゴルーチンから送られたイベントも直列化して渡すため、sink 側で排他制御をする必要はない
+func WithEventSink(ctx context.Context, sink EventSink) context.Context {
+	var mu sync.Mutex
+	return context.WithValue(ctx, eventSinkKey{}, EventSink(func(e Event) {
+		mu.Lock()
+		defer mu.Unlock()
+		sink(e)
+	}))
+}
+
+// Infof はリソースに依存しない情報のイベントを送る
+func Infof(ctx context.Context, format string, args ...any) {
+	emit(ctx, EventInfo, "", nil, fmt.Sprintf(format, args...))
+}
+
+// Warnf はリソースに依存しない警告のイベントを送る
+func Warnf(ctx context.Context, format string, args ...any) {
+	emit(ctx, EventWarning, "", nil, fmt.Sprintf(format, args...))
+}
+
+// Debugf は詳細な情報のイベントを送る
+func Debugf(ctx context.Context, format string, args ...any) {
+	emit(ctx, EventDebug, "", nil, fmt.Sprintf(format, args...))
+}
+
+// Progressf はリソースに対する操作の進捗イベントを送る
+func Progressf(ctx context.Context, kind EventKind, resource, format string, args ...any) {
+	emit(ctx, kind, resource, nil, fmt.Sprintf(format, args...))
+}
+
+// Failuref はリソースに対する操作の失敗イベントを送る
+func Failuref(ctx context.Context, resource string, err error, format string, args ...any) {
+	emit(ctx, EventFailure, resource, err, fmt.Sprintf(format, args...))
+}
+
+// emit はコンテキストの受け取り先にイベントを渡す
+// 受け取り先が設定されていない場合は、CLIの診断メッセージとして種類に応じたレベルでログ（標準エラー出力）に書き出す
+func emit(ctx context.Context, kind EventKind, resource string, err error, text string) {
+	sink, _ := ctx.Value(eventSinkKey{}).(EventSink)
+	if sink == nil {
+		logging.Log(logging.Record{
+			Level:    logLevelOf(kind),
+			Kind:     string(kind),
+			Resource: resource,
+			Text:     text,
+			Err:      err,
+		})
+		return
+	}
+	sink(Event{
+		Kind:     kind,
+		Resource: resource,
+		Message:  strings.TrimSpace(text),
+		Err:      err,
+		Time:     time.Now(),
+	})
+}
+
+// logLevelOf はイベントの種類に対応するログのレベルを返す
+func logLevelOf(kind EventKind) logging.Level {
+	switch kind {
+	case EventFailure:
+		return logging.LevelError
+	case EventWarning:
+		return logging.LevelWarn
+	case EventDebug:
+		return logging.LevelDebug
+	default:
+		return logging.LevelInfo
+	}
+}
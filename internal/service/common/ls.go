@@ -2,9 +2,12 @@ package common
 
 import (
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+
 	"github.com/mattn/go-runewidth"
 )
 
@@ -27,7 +30,7 @@ func FormatBytes(bytes int64) string {
 // FormatTimestamp はUnixミリ秒のタイムスタンプをフォーマットする関数
 func FormatTimestamp(timestamp *int64) string {
 	if timestamp == nil {
-		return "不明"
+		return i18n.T("不明")
 	}
 	t := time.Unix(*timestamp/1000, (*timestamp%1000)*1000000)
 	return t.Format("2006-01-02 15:04:05")
@@ -36,7 +39,7 @@ func FormatTimestamp(timestamp *int64) string {
 // GenerateFilteredTitle はフィルタ条件に基づいてタイトルを生成
 func GenerateFilteredTitle(resourceType string, conditions ...string) string {
 	if len(conditions) == 0 {
-		return fmt.Sprintf("%s一覧", resourceType)
+		return fmt.Sprintf(i18n.T("%s一覧"), resourceType)
 	}
 
 	// 空文字列を除外
@@ -48,32 +51,37 @@ func GenerateFilteredTitle(resourceType string, conditions ...string) string {
 	}
 
 	if len(validConditions) == 0 {
-		return fmt.Sprintf("%s一覧", resourceType)
+		return fmt.Sprintf(i18n.T("%s一覧"), resourceType)
 	}
 
-	return fmt.Sprintf("%s%s一覧", strings.Join(validConditions, ""), resourceType)
+	return fmt.Sprintf(i18n.T("%s%s一覧"), strings.Join(validConditions, ""), resourceType)
 }
 
 // FormatListError はリスト取得エラーを統一フォーマットで返す
 func FormatListError(service string, err error) error {
-	return fmt.Errorf("❌ %s一覧取得でエラー: %w", service, err)
+	return fmt.Errorf(i18n.T("❌ %s一覧取得でエラー: %w"), service, err)
 }
 
 // FormatEmptyMessage は該当リソースがない場合のメッセージを返す
 func FormatEmptyMessage(resourceType string) string {
-	return fmt.Sprintf("%sが見つかりませんでした", resourceType)
+	return fmt.Sprintf(i18n.T("%sが見つかりませんでした"), resourceType)
 }
 
 // ===== 低レベル表示関数 =====
 
 // PrintSimpleList はシンプルな箇条書きリストを表示
 func PrintSimpleList(output ListOutput) {
+	if IsStructuredOutput() {
+		printStructured(os.Stdout, nameColumns(), itemsToRows(output.Items))
+		return
+	}
+
 	// タイトル表示
 	fmt.Printf("%s:\n", output.Title)
 
 	// アイテムがない場合
 	if len(output.Items) == 0 {
-		fmt.Printf("該当する%sはありませんでした\n", output.ResourceName)
+		fmt.Printf(i18n.T("該当する%sはありませんでした\n"), output.ResourceName)
 		return
 	}
 
@@ -84,18 +92,23 @@ func PrintSimpleList(output ListOutput) {
 
 	// 合計数表示
 	if output.ShowCount {
-		fmt.Printf("\n合計: %d個の%s\n", len(output.Items), output.ResourceName)
+		fmt.Printf(i18n.T("\n合計: %d個の%s\n"), len(output.Items), output.ResourceName)
 	}
 }
 
 // PrintNumberedList は番号付きリストを表示
 func PrintNumberedList(output ListOutput) {
+	if IsStructuredOutput() {
+		printStructured(os.Stdout, nameColumns(), itemsToRows(output.Items))
+		return
+	}
+
 	// タイトル表示（件数付き）
-	fmt.Printf("%s: (全%d件)\n", output.Title, len(output.Items))
+	fmt.Printf(i18n.T("%s: (全%d件)\n"), output.Title, len(output.Items))
 
 	// アイテムがない場合
 	if len(output.Items) == 0 {
-		fmt.Printf("%sが見つかりませんでした\n", output.ResourceName)
+		fmt.Printf(i18n.T("%sが見つかりませんでした\n"), output.ResourceName)
 		return
 	}
 
@@ -107,10 +120,20 @@ func PrintNumberedList(output ListOutput) {
 
 // PrintStatusList はステータス付きリストを表示
 func PrintStatusList(title string, items []ListItem, resourceName string) {
-	fmt.Printf("%s: (全%d件)\n", title, len(items))
+	if IsStructuredOutput() {
+		columns := []TableColumn{{Header: i18n.T("名前"), Key: "name"}, {Header: i18n.T("ステータス"), Key: "status"}}
+		data := make([][]string, len(items))
+		for i, item := range items {
+			data[i] = []string{item.Name, item.Status}
+		}
+		printStructured(os.Stdout, columns, data)
+		return
+	}
+
+	fmt.Printf(i18n.T("%s: (全%d件)\n"), title, len(items))
 
 	if len(items) == 0 {
-		fmt.Printf("%sが見つかりませんでした\n", resourceName)
+		fmt.Printf(i18n.T("%sが見つかりませんでした\n"), resourceName)
 		return
 	}
 
@@ -124,7 +147,13 @@ func PrintStatusList(title string, items []ListItem, resourceName string) {
 }
 
 // PrintTable はテーブル形式でデータを表示する
+// 構造化出力が選択されている場合はタイトルを省略し、列のKeyをフィールド名としてレコードを出力する
 func PrintTable(title string, columns []TableColumn, data [][]string) {
+	if IsStructuredOutput() {
+		printStructured(os.Stdout, columns, data)
+		return
+	}
+
 	if title != "" {
 		fmt.Printf("\n%s:\n", title)
 	}
@@ -199,7 +228,7 @@ func DisplayList[T any](
 		opts = &DisplayOptions{}
 	}
 	if opts.EmptyMessage == "" {
-		opts.EmptyMessage = "リソースが見つかりませんでした"
+		opts.EmptyMessage = i18n.T("リソースが見つかりませんでした")
 	}
 
 	// フィルタ条件がある場合はタイトルに追加
@@ -207,6 +236,13 @@ func DisplayList[T any](
 		title = GenerateFilteredTitle(title, opts.FilterMessages...)
 	}
 
+	// 構造化出力の場合は空でもレコード列として出力する
+	if IsStructuredOutput() {
+		columns, data := toTableData(items)
+		printStructured(os.Stdout, columns, data)
+		return nil
+	}
+
 	// 空の場合の処理
 	if len(items) == 0 {
 		fmt.Println(opts.EmptyMessage)
@@ -219,7 +255,7 @@ func DisplayList[T any](
 
 	// 件数表示
 	if opts.ShowCount {
-		fmt.Printf("\n合計: %d件\n", len(items))
+		fmt.Printf(i18n.T("\n合計: %d件\n"), len(items))
 	}
 
 	return nil
@@ -233,7 +269,7 @@ func DisplaySimpleList[T any](
 	opts *DisplayOptions,
 ) error {
 	toTableData := func(items []T) ([]TableColumn, [][]string) {
-		columns := []TableColumn{{Header: "名前"}}
+		columns := nameColumns()
 		data := make([][]string, len(items))
 		for i, item := range items {
 			data[i] = []string{getName(item)}
@@ -243,3 +279,17 @@ func DisplaySimpleList[T any](
 
 	return DisplayList(items, title, toTableData, opts)
 }
+
+// nameColumns は名前のみの1列の列定義を返す
+func nameColumns() []TableColumn {
+	return []TableColumn{{Header: i18n.T("名前"), Key: "name"}}
+}
+
+// itemsToRows は文字列のリストを1列のテーブルデータに変換する
+func itemsToRows(items []string) [][]string {
+	data := make([][]string, len(items))
+	for i, item := range items {
+		data[i] = []string{item}
+	}
+	return data
+}
@@ -0,0 +1,196 @@
+package common
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+
+	"gopkg.in/yaml.v3"
+)
+
+// OutputFormat は一覧表示の出力形式
+type OutputFormat string
+
+const (
+	OutputTable OutputFormat = "table"
+	OutputJson  OutputFormat = "json"
+	OutputYaml  OutputFormat = "yaml"
+	OutputCsv   OutputFormat = "csv"
+	OutputTsv   OutputFormat = "tsv"
+)
+
+// SupportedOutputFormats は指定可能な出力形式の一覧
+var SupportedOutputFormats = []OutputFormat{OutputTable, OutputJson, OutputYaml, OutputCsv, OutputTsv}
+
+// currentOutputFormat は現在の出力形式（--output フラグで設定される）
+var currentOutputFormat = OutputTable
+
+// SetOutputFormat は出力形式を設定する
+func SetOutputFormat(format string) error {
+	normalized := OutputFormat(strings.ToLower(strings.TrimSpace(format)))
+	if normalized == "" {
+		normalized = OutputTable
+	}
+	for _, f := range SupportedOutputFormats {
+		if f == normalized {
+			currentOutputFormat = normalized
+			return nil
+		}
+	}
+	names := make([]string, len(SupportedOutputFormats))
+	for i, f := range SupportedOutputFormats {
+		names[i] = string(f)
+	}
+	return fmt.Errorf(i18n.T("%s 未対応の出力形式です: %s（指定可能: %s）"), ErrorIcon, format, strings.Join(names, ", "))
+}
+
+// GetOutputFormat は現在の出力形式を返す
+func GetOutputFormat() OutputFormat {
+	return currentOutputFormat
+}
+
+// IsStructuredOutput はテーブル以外の機械可読な出力形式が選択されているかを返す
+func IsStructuredOutput() bool {
+	return currentOutputFormat != OutputTable
+}
+
+// PrintEmptyResult は該当リソースがない場合の表示を行う
+// 構造化出力の場合はメッセージの代わりに空のレコード列を出力する
+func PrintEmptyResult(message string) {
+	if IsStructuredOutput() {
+		printStructured(os.Stdout, nil, nil)
+		return
+	}
+	fmt.Println(message)
+}
+
+// columnKey は構造化出力で使用するフィールド名を返す
+func columnKey(col TableColumn) string {
+	if col.Key != "" {
+		return col.Key
+	}
+	return col.Header
+}
+
+// printStructured はテーブルデータを現在の出力形式で書き出す
+func printStructured(w io.Writer, columns []TableColumn, data [][]string) {
+	keys := make([]string, len(columns))
+	for i, col := range columns {
+		keys[i] = columnKey(col)
+	}
+
+	var err error
+	switch currentOutputFormat {
+	case OutputJson:
+		err = writeJson(w, keys, data)
+	case OutputYaml:
+		err = writeYaml(w, keys, data)
+	case OutputCsv:
+		err = writeDelimited(w, ',', keys, data)
+	case OutputTsv:
+		err = writeDelimited(w, '\t', keys, data)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, i18n.T("%s 出力の書き込みに失敗: %v\n"), ErrorIcon, err)
+	}
+}
+
+// orderedRecord は列順を保ったままJSONに変換するためのレコード
+type orderedRecord struct {
+	keys   []string
+	values []string
+}
+
+// MarshalJSON は列定義の順序でJSONオブジェクトを生成する
+func (r orderedRecord) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for i, key := range r.keys {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		value := ""
+		if i < len(r.values) {
+			value = r.values[i]
+		}
+		v, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
+// writeJson はレコードをJSON配列として書き出す
+func writeJson(w io.Writer, keys []string, data [][]string) error {
+	records := make([]orderedRecord, len(data))
+	for i, row := range data {
+		records[i] = orderedRecord{keys: keys, values: row}
+	}
+	out, err := json.MarshalIndent(records, "", "  ")
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintln(w, string(out))
+	return err
+}
+
+// writeYaml はレコードをYAMLのシーケンスとして書き出す
+func writeYaml(w io.Writer, keys []string, data [][]string) error {
+	root := &yaml.Node{Kind: yaml.SequenceNode}
+	for _, row := range data {
+		record := &yaml.Node{Kind: yaml.MappingNode}
+		for i, key := range keys {
+			value := ""
+			if i < len(row) {
+				value = row[i]
+			}
+			record.Content = append(record.Content,
+				&yaml.Node{Kind: yaml.ScalarNode, Value: key},
+				&yaml.Node{Kind: yaml.ScalarNode, Value: value, Tag: "!!str"},
+			)
+		}
+		root.Content = append(root.Content, record)
+	}
+
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(root); err != nil {
+		return err
+	}
+	return encoder.Close()
+}
+
+// writeDelimited はレコードをヘッダー付きの区切り文字形式で書き出す
+func writeDelimited(w io.Writer, delimiter rune, keys []string, data [][]string) error {
+	writer := csv.NewWriter(w)
+	writer.Comma = delimiter
+	if len(keys) > 0 {
+		if err := writer.Write(keys); err != nil {
+			return err
+		}
+	}
+	for _, row := range data {
+		record := make([]string, len(keys))
+		copy(record, row)
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
@@ -0,0 +1,100 @@
+package common
+
+import (
+	"bytes"
+	"testing"
+)
+
+// useOutputFormat は出力形式を設定する（テスト終了時に元に戻す）
+func useOutputFormat(t *testing.T, format string) {
+	t.Helper()
+	previous := currentOutputFormat
+	t.Cleanup(func() { currentOutputFormat = previous })
+	if err := SetOutputFormat(format); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestSetOutputFormat(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    OutputFormat
+		wantErr bool
+	}{
+		{value: "json", want: OutputJson},
+		{value: " YAML ", want: OutputYaml},
+		{value: "", want: OutputTable},
+		{value: "xml", wantErr: true},
+	}
+	for _, tt := range tests {
+		previous := currentOutputFormat
+		err := SetOutputFormat(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("SetOutputFormat(%q) err = %v, wantErr %v", tt.value, err, tt.wantErr)
+		}
+		if !tt.wantErr && GetOutputFormat() != tt.want {
+			t.Errorf("SetOutputFormat(%q) = %s, want %s", tt.value, GetOutputFormat(), tt.want)
+		}
+		if tt.wantErr && GetOutputFormat() != previous {
+			t.Errorf("SetOutputFormat(%q) changed the format to %s", tt.value, GetOutputFormat())
+		}
+		currentOutputFormat = previous
+	}
+}
+
+func TestColumnKey(t *testing.T) {
+	if got := columnKey(TableColumn{Header: "名前", Key: "name"}); got != "name" {
+		t.Errorf("columnKey with Key = %q, want name", got)
+	}
+	// Key が未指定の列はヘッダーをそのまま使用する
+	if got := columnKey(TableColumn{Header: "Status"}); got != "Status" {
+		t.Errorf("columnKey without Key = %q, want Status", got)
+	}
+}
+
+func TestPrintStructured(t *testing.T) {
+	columns := []TableColumn{{Header: "名前", Key: "name"}, {Header: "State"}}
+	data := [][]string{{"app-1", "running"}, {"app-2"}} // 列が足りない行は空文字で補う
+
+	tests := []struct {
+		format string
+		want   string
+	}{
+		{format: "json", want: `[
+  {
+    "name": "app-1",
+    "State": "running"
+  },
+  {
+    "name": "app-2",
+    "State": ""
+  }
+]
+`},
+		{format: "yaml", want: `- name: app-1
+  State: running
+- name: app-2
+  State: ""
+`},
+		{format: "csv", want: "name,State\napp-1,running\napp-2,\n"},
+		{format: "tsv", want: "name\tState\napp-1\trunning\napp-2\t\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.format, func(t *testing.T) {
+			useOutputFormat(t, tt.format)
+			var buf bytes.Buffer
+			printStructured(&buf, columns, data)
+			if got := buf.String(); got != tt.want {
+				t.Errorf("output =\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+
+	// 該当リソースがない場合も空のレコード列として出力する
+	useOutputFormat(t, "json")
+	var buf bytes.Buffer
+	printStructured(&buf, nil, nil)
+	if got := buf.String(); got != "[]\n" {
+		t.Errorf("empty json output = %q, want []", got)
+	}
+}
@@ -1,7 +1,11 @@
 package common
 
 import (
+	"context"
 	"sync"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
 )
 
 // ParallelExecutor は並列処理を管理する構造体
@@ -11,6 +15,27 @@ type ParallelExecutor struct {
 	semaphore  chan struct{}
 }
 
+// concurrency は設定ファイル等で指定された最大同時実行数（0の場合は各処理の既定値を使用）
+var concurrency int
+
+// SetConcurrency は並列処理の最大同時実行数を設定する（0で各処理の既定値に戻す）
+func SetConcurrency(n int) {
+	concurrency = n
+}
+
+// WorkerCount は並列処理のワーカー数を返す
+// 同時実行数が設定されていればそれを、なければ defaultWorkers を使い、対象件数を上限とする
+func WorkerCount(defaultWorkers, itemCount int) int {
+	workers := defaultWorkers
+	if concurrency > 0 {
+		workers = concurrency
+	}
+	if itemCount < workers {
+		workers = itemCount
+	}
+	return workers
+}
+
 // NewParallelExecutor は新しいParallelExecutorを作成
 func NewParallelExecutor(maxWorkers int) *ParallelExecutor {
 	return &ParallelExecutor{
@@ -37,14 +62,27 @@ func (p *ParallelExecutor) Wait() {
 
 // ProcessResult は処理結果を保持する構造体
 type ProcessResult struct {
-	Item    string
-	Success bool
-	Error   error
+	Item     string
+	Success  bool
+	Skipped  bool // 中断により実行されなかった場合 true
+	Error    error
+	Arn      string        // リソースのARN（処理中に取得できた場合のみ）
+	Duration time.Duration // 処理にかかった時間（再試行を含む）
+	Objects  int64         // 削除したオブジェクト数（S3バケット等、中身を削除した場合のみ）
+	Bytes    int64         // 削除したオブジェクトの合計サイズ（バイト）
+}
+
+// SkippedResult は中断により実行されなかったアイテムの処理結果を返す
+func SkippedResult(item string, err error) ProcessResult {
+	return ProcessResult{Item: item, Skipped: true, Error: err}
 }
 
-// CollectResults は並列処理の結果を収集するヘルパー関数
+// CollectResults は並列処理の結果を収集するヘルパー関数（中断により未実行のものは含まない）
 func CollectResults(results []ProcessResult) (successCount, failCount int) {
 	for _, result := range results {
+		if result.Skipped {
+			continue
+		}
 		if result.Success {
 			successCount++
 		} else {
@@ -54,27 +92,102 @@ func CollectResults(results []ProcessResult) (successCount, failCount int) {
 	return
 }
 
+// CountSkipped は中断により実行されなかったアイテム数を返す
+func CountSkipped(results []ProcessResult) int {
+	count := 0
+	for _, result := range results {
+		if result.Skipped {
+			count++
+		}
+	}
+	return count
+}
+
+// PrintProcessSummary は並列処理の結果件数を表示する（中断された場合は未実行件数も表示）
+func PrintProcessSummary(ctx context.Context, label string, results []ProcessResult) {
+	successCount, failCount := CollectResults(results)
+	if skipped := CountSkipped(results); skipped > 0 {
+		Infof(ctx, i18n.T("\n%s %s中断: 成功 %d個, 失敗 %d個, 未実行 %d個\n"), WarningIcon, label, successCount, failCount, skipped)
+		return
+	}
+	Infof(ctx, i18n.T("\n%s %s完了: 成功 %d個, 失敗 %d個\n"), SuccessIcon, label, successCount, failCount)
+}
+
+// 処理結果の記録で使用するアクション名
+const (
+	ActionDelete = "delete"
+	ActionPut    = "put"
+)
+
+// ResultRecorder は処理結果の記録先（監査ジャーナル等）
+type ResultRecorder func(resourceType, action string, results []ProcessResult)
+
+var (
+	resultRecorder   ResultRecorder
+	resultRecorderMu sync.Mutex
+)
+
+// SetResultRecorder は処理結果の記録先を設定する（nilで解除）
+func SetResultRecorder(recorder ResultRecorder) {
+	resultRecorderMu.Lock()
+	defer resultRecorderMu.Unlock()
+	resultRecorder = recorder
+}
+
+// RecordResults は処理結果を記録先に渡す（記録先が未設定の場合は何もしない）
+func RecordResults(resourceType, action string, results []ProcessResult) {
+	if len(results) == 0 {
+		return
+	}
+	resultRecorderMu.Lock()
+	defer resultRecorderMu.Unlock()
+	if resultRecorder != nil {
+		resultRecorder(resourceType, action, results)
+	}
+}
+
 // CleanupResult はクリーンアップ処理の結果を保持する構造体
 type CleanupResult struct {
-	ResourceType string   // リソースタイプ（例: "S3バケット", "ECRリポジトリ"）
-	Deleted      []string // 削除成功したリソース名
-	Failed       []string // 削除失敗したリソース名
+	ResourceType string          // リソースタイプ（例: "S3バケット", "ECRリポジトリ"）
+	Deleted      []string        // 削除成功したリソース名
+	Failed       []string        // 削除失敗したリソース名
+	Skipped      []string        // 中断により削除しなかったリソース名
+	Blocked      []string        // 先に削除する必要があるリソースを削除できなかったため、削除しなかったリソース名
+	Protected    []string        // ガードレールにより削除しなかったリソース名
+	Type         string          // リソースの種類の名前（cleanup all の --types で指定する名前。例: "s3"。種類の一覧を経由しない場合は空）
+	Results      []ProcessResult // リソースごとの処理結果（エラー・所要時間等。レポートの出力に使用）
 }
 
 // TotalCount は対象リソースの総数を返します
 func (r CleanupResult) TotalCount() int {
-	return len(r.Deleted) + len(r.Failed)
+	return len(r.Deleted) + len(r.Failed) + len(r.Skipped) + len(r.Blocked) + len(r.Protected)
+}
+
+// SetArns はリソースごとの処理結果にARNを設定します（arnOf が空文字を返すリソースは設定しません）
+func (r *CleanupResult) SetArns(arnOf func(name string) string) {
+	for i := range r.Results {
+		if r.Results[i].Arn == "" {
+			r.Results[i].Arn = arnOf(r.Results[i].Item)
+		}
+	}
 }
 
 // CollectCleanupResult はProcessResultからCleanupResultを生成します
+// 削除結果として記録先（監査ジャーナル等）にも渡します
 func CollectCleanupResult(resourceType string, results []ProcessResult) CleanupResult {
+	RecordResults(resourceType, ActionDelete, results)
+
 	result := CleanupResult{
 		ResourceType: resourceType,
 		Deleted:      []string{},
 		Failed:       []string{},
+		Skipped:      []string{},
+		Results:      results,
 	}
 	for _, r := range results {
-		if r.Success {
+		if r.Skipped {
+			result.Skipped = append(result.Skipped, r.Item)
+		} else if r.Success {
 			result.Deleted = append(result.Deleted, r.Item)
 		} else {
 			result.Failed = append(result.Failed, r.Item)
@@ -82,3 +195,49 @@ func CollectCleanupResult(resourceType string, results []ProcessResult) CleanupR
 	}
 	return result
 }
+
+// DeleteInParallel は指定したリソースを並列で削除し、結果を集計して返します
+// resourceType は表示と結果の記録に使用するリソースの種類（例: "DynamoDBテーブル"）
+// deleteOne は1件のリソースを削除する関数で、スロットリング等の一時的なエラーの場合は再試行します
+func DeleteInParallel(ctx context.Context, resourceType string, names []string, defaultWorkers int, deleteOne func(name string) error) CleanupResult {
+	if len(names) == 0 {
+		return CleanupResult{ResourceType: resourceType, Deleted: []string{}, Failed: []string{}}
+	}
+
+	maxWorkers := WorkerCount(defaultWorkers, len(names))
+	executor := NewParallelExecutor(maxWorkers)
+	results := make([]ProcessResult, len(names))
+
+	Infof(ctx, i18n.T("🚀 %d個の%sを最大%d並列で削除します...\n\n"), len(names), resourceType, maxWorkers)
+
+	for i, name := range names {
+		executor.Execute(func() {
+			// 中断された場合は未実行として記録
+			if ctx.Err() != nil {
+				results[i] = SkippedResult(name, ctx.Err())
+				return
+			}
+
+			Progressf(ctx, EventStart, name, i18n.T("%s %s を削除中...\n"), resourceType, name)
+			start := time.Now()
+			err := RetryDelete(ctx, name, func() error {
+				return deleteOne(name)
+			})
+			if err != nil {
+				Failuref(ctx, name, err, i18n.T("❌ %s %s の削除に失敗しました: %v\n"), resourceType, name, err)
+				results[i] = ProcessResult{Item: name, Success: false, Error: err, Duration: time.Since(start)}
+				return
+			}
+			Progressf(ctx, EventSuccess, name, i18n.T("✅ %s %s を削除しました\n"), resourceType, name)
+			MarkDone(ctx, name)
+			results[i] = ProcessResult{Item: name, Success: true, Duration: time.Since(start)}
+		})
+	}
+
+	executor.Wait()
+
+	// 結果の集計
+	PrintProcessSummary(ctx, i18n.T("削除"), results)
+
+	return CollectCleanupResult(resourceType, results)
+}
@@ -0,0 +1,253 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/aws/retry"
+	"github.com/aws/smithy-go"
+)
+
+// RetryPolicy は並列処理の各タスクを再試行する際の設定
+// SDK自体の再試行（既定3回）を使い切ってもスロットリング等で失敗した場合に、タスク単位で再試行する
+type RetryPolicy struct {
+	MaxAttempts int           // 最大試行回数（1の場合は再試行しない）
+	BaseDelay   time.Duration // 待機時間の基準値（試行ごとに2倍）
+	MaxDelay    time.Duration // 待機時間の上限
+}
+
+// DefaultRetryPolicy は既定の再試行設定
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts: 5,
+	BaseDelay:   500 * time.Millisecond,
+	MaxDelay:    20 * time.Second,
+}
+
+var retryPolicy = DefaultRetryPolicy
+
+// SetMaxAttempts はタスクの最大試行回数を設定する（0で既定値に戻す）
+func SetMaxAttempts(n int) {
+	if n <= 0 {
+		retryPolicy.MaxAttempts = DefaultRetryPolicy.MaxAttempts
+		return
+	}
+	retryPolicy.MaxAttempts = n
+}
+
+// 共有レートリミッターの送信レート（1秒あたりのリクエスト数）
+const (
+	throttledInitialRate = 10.0 // レート無制限の状態で初めてスロットリングされた場合のレート
+	minRate              = 0.5  // スロットリングが続いた場合の下限
+	maxAdaptiveRate      = 50.0 // 成功が続いてこのレートに達したら無制限に戻す
+	rateIncrease         = 0.5  // 成功1回あたりのレートの増加量
+)
+
+// adaptiveLimiter は全ゴルーチンで共有するレートリミッター
+// スロットリングを検出すると送信レートを半減し、成功が続くと徐々に戻す（AIMD）
+type adaptiveLimiter struct {
+	mu    sync.Mutex
+	rate  float64   // 1秒あたりの最大リクエスト数（0は無制限）
+	limit float64   // --rate-limit で指定された上限（0は指定なし）
+	next  time.Time // 次にリクエストを送信できる時刻
+}
+
+var sharedLimiter = &adaptiveLimiter{}
+
+// SetRateLimit は全ての並列処理で共有する1秒あたりの最大リクエスト数を設定する（0で無制限に戻す）
+// 設定した場合もスロットリングを検出すると一時的にレートを下げる
+func SetRateLimit(rps float64) {
+	sharedLimiter.mu.Lock()
+	defer sharedLimiter.mu.Unlock()
+	sharedLimiter.limit = rps
+	sharedLimiter.rate = rps
+}
+
+// wait は送信レートに従って次のリクエストを送信できるまで待機する
+func (l *adaptiveLimiter) wait(ctx context.Context) error {
+	l.mu.Lock()
+	if l.rate == 0 {
+		l.mu.Unlock()
+		return nil
+	}
+	now := time.Now()
+	start := l.next
+	if start.Before(now) {
+		start = now
+	}
+	l.next = start.Add(time.Duration(float64(time.Second) / l.rate))
+	l.mu.Unlock()
+
+	delay := time.Until(start)
+	if delay <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// onThrottle はスロットリングを検出したときに送信レートを半減する
+func (l *adaptiveLimiter) onThrottle() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.rate == 0 {
+		l.rate = throttledInitialRate
+		return
+	}
+	l.rate = max(l.rate/2, minRate)
+}
+
+// onSuccess は成功したときに送信レートを少しずつ戻す
+func (l *adaptiveLimiter) onSuccess() {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.rate == 0 {
+		return
+	}
+	l.rate += rateIncrease
+	switch {
+	case l.limit > 0 && l.rate >= l.limit:
+		l.rate = l.limit
+	case l.limit == 0 && l.rate >= maxAdaptiveRate:
+		l.rate = 0
+	}
+}
+
+// IsThrottlingError はAWSのスロットリングエラー（ThrottlingException, TooManyRequestsException 等）かを判定する
+func IsThrottlingError(err error) bool {
+	return retry.IsErrorThrottles(retry.DefaultThrottles).IsErrorThrottle(err) == aws.TrueTernary
+}
+
+// IsRetryableError は再試行で成功する可能性のあるエラー（スロットリング、一時的なサーバーエラー、通信エラー等）かを判定する
+func IsRetryableError(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return false
+	}
+	return IsThrottlingError(err) ||
+		retry.IsErrorRetryables(retry.DefaultRetryables).IsErrorRetryable(err) == aws.TrueTernary
+}
+
+// Retry は op を実行し、再試行可能なエラーの場合はジッター付きの指数バックオフで再試行する
+// 全ての並列処理で共有するレートリミッターに従って実行し、スロットリングを検出するとレートを下げる
+// item は再試行時のメッセージに表示するリソース名
+func Retry(ctx context.Context, item string, op func() error) error {
+	policy := retryPolicy
+	for attempt := 1; ; attempt++ {
+		if err := sharedLimiter.wait(ctx); err != nil {
+			return err
+		}
+
+		err := op()
+		if err == nil {
+			sharedLimiter.onSuccess()
+			return nil
+		}
+
+		throttled := IsThrottlingError(err)
+		if throttled {
+			sharedLimiter.onThrottle()
+		}
+		if attempt >= policy.MaxAttempts || !IsRetryableError(err) || ctx.Err() != nil {
+			return err
+		}
+
+		reason := i18n.T("一時的なエラー")
+		if throttled {
+			reason = i18n.T("スロットリング")
+		}
+		delay := backoffDelay(policy, attempt)
+		Progressf(ctx, EventWarning, item, i18n.T("⏳ %s: %sのため %.1f秒後に再試行します (%d/%d)\n"), item, reason, delay.Seconds(), attempt+1, policy.MaxAttempts)
+
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return err
+		case <-timer.C:
+		}
+	}
+}
+
+// RetryDelete は削除の op を Retry と同様に再試行する
+// 再試行した時点でリソースが存在しない場合は、前回の試行がサーバー側では成功していたものとして成功扱いにする
+func RetryDelete(ctx context.Context, item string, op func() error) error {
+	attempted := false
+	return Retry(ctx, item, func() error {
+		retried := attempted
+		attempted = true
+		err := op()
+		if retried && IsNotFoundError(err) {
+			Progressf(ctx, EventDebug, item, i18n.T("%s: 前回の削除が完了していたため成功として扱います\n"), item)
+			return nil
+		}
+		return err
+	})
+}
+
+// backoffDelay は試行回数に応じた待機時間を返す
+// 指数バックオフの半分を固定、残り半分をランダムにすることで、並列タスクの再試行が同時に集中しないようにする
+func backoffDelay(policy RetryPolicy, attempt int) time.Duration {
+	delay := policy.BaseDelay << (attempt - 1)
+	if delay <= 0 || delay > policy.MaxDelay {
+		delay = policy.MaxDelay
+	}
+	half := delay / 2
+	return half + rand.N(half+1)
+}
+
+// RetryWhileInUse は op を実行し、依存するリソースがまだ解放されていないエラーの場合は timeout まで interval ごとに再試行する
+// 削除したリソースが参照（ENI等）を解放するまでに時間がかかる場合の待機に使用する
+// codes は再試行するAPIエラーのコード（例: DependencyViolation, ResourceInUse）
+func RetryWhileInUse(ctx context.Context, item string, timeout, interval time.Duration, codes []string, op func() error) error {
+	deadline := time.Now().Add(timeout)
+	waiting := false
+	for {
+		err := Retry(ctx, item, op)
+		if err == nil || !isErrorCode(err, codes) || time.Now().Add(interval).After(deadline) {
+			return err
+		}
+		if !waiting {
+			Progressf(ctx, EventInfo, item, i18n.T("⏳ %s: 依存するリソースが解放されるまで待機しています（最大%s）...\n"), item, timeout)
+			waiting = true
+		}
+		if err := Sleep(ctx, interval); err != nil {
+			return err
+		}
+	}
+}
+
+// isErrorCode はAPIエラーのコードが codes のいずれかに一致するかを判定する
+func isErrorCode(err error, codes []string) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	return slices.Contains(codes, apiErr.ErrorCode())
+}
+
+// IsNotFoundError はAPIエラーが対象リソースの不存在（NoSuchBucket, ResourceNotFoundException, InvalidXxx.NotFound 等）を示すかを判定する
+func IsNotFoundError(err error) bool {
+	var apiErr smithy.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	code := apiErr.ErrorCode()
+	return strings.HasPrefix(code, "NoSuch") ||
+		strings.HasSuffix(code, "NotFound") ||
+		strings.HasSuffix(code, "NotFoundException") ||
+		strings.HasSuffix(code, "NotFoundFault") ||
+		code == "AWS.SimpleQueueService.NonExistentQueue"
+}
@@ -0,0 +1,202 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/smithy-go"
+)
+
+// useTestRetry は再試行の待機時間を短くし、共有レートリミッターを初期状態にする（テスト終了時に元に戻す）
+func useTestRetry(t *testing.T, maxAttempts int) {
+	t.Helper()
+	policy, limiter := retryPolicy, sharedLimiter
+	retryPolicy = RetryPolicy{MaxAttempts: maxAttempts, BaseDelay: time.Millisecond, MaxDelay: 4 * time.Millisecond}
+	sharedLimiter = &adaptiveLimiter{}
+	t.Cleanup(func() {
+		retryPolicy, sharedLimiter = policy, limiter
+	})
+}
+
+func TestBackoffDelay(t *testing.T) {
+	policy := RetryPolicy{MaxAttempts: 10, BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	tests := []struct {
+		attempt int
+		want    time.Duration // ジッターを加える前の待機時間（この値の半分から全体の範囲になる）
+	}{
+		{attempt: 1, want: 100 * time.Millisecond},
+		{attempt: 2, want: 200 * time.Millisecond},
+		{attempt: 4, want: 800 * time.Millisecond},
+		{attempt: 5, want: time.Second},  // 上限
+		{attempt: 70, want: time.Second}, // シフトで桁あふれした場合も上限
+	}
+	for _, tt := range tests {
+		for range 100 {
+			got := backoffDelay(policy, tt.attempt)
+			if got < tt.want/2 || got > tt.want {
+				t.Fatalf("backoffDelay(attempt %d) = %v, want between %v and %v", tt.attempt, got, tt.want/2, tt.want)
+			}
+		}
+	}
+}
+
+func TestAdaptiveLimiter(t *testing.T) {
+	t.Run("無制限の場合は初回のスロットリングで既定のレートにし、以降は半減する", func(t *testing.T) {
+		l := &adaptiveLimiter{}
+		for _, want := range []float64{throttledInitialRate, 5, 2.5, 1.25, 0.625, minRate, minRate} {
+			l.onThrottle()
+			if l.rate != want {
+				t.Fatalf("rate = %v, want %v", l.rate, want)
+			}
+		}
+	})
+
+	t.Run("成功が続くと徐々に戻し、上限に達したら無制限に戻す", func(t *testing.T) {
+		l := &adaptiveLimiter{rate: maxAdaptiveRate - 1}
+		l.onSuccess()
+		if l.rate != maxAdaptiveRate-1+rateIncrease {
+			t.Fatalf("rate = %v, want %v", l.rate, maxAdaptiveRate-1+rateIncrease)
+		}
+		l.onSuccess()
+		if l.rate != 0 {
+			t.Fatalf("rate = %v, want 0 (unlimited)", l.rate)
+		}
+	})
+
+	t.Run("レート制限の指定がある場合は指定値を超えない", func(t *testing.T) {
+		l := &adaptiveLimiter{rate: 4, limit: 4}
+		l.onThrottle()
+		if l.rate != 2 {
+			t.Fatalf("rate = %v, want 2", l.rate)
+		}
+		for range 10 {
+			l.onSuccess()
+		}
+		if l.rate != 4 {
+			t.Fatalf("rate = %v, want 4", l.rate)
+		}
+	})
+}
+
+func TestRetry(t *testing.T) {
+	throttled := &smithy.GenericAPIError{Code: "ThrottlingException", Message: "Rate exceeded"}
+	accessDenied := &smithy.GenericAPIError{Code: "AccessDenied", Message: "access denied"}
+
+	tests := []struct {
+		name         string
+		maxAttempts  int
+		errs         []error // 各試行で返すエラー（試行回数がこれを超えた場合は成功）
+		wantErr      error
+		wantAttempts int
+		wantRate     float64
+	}{
+		{name: "成功した場合は再試行しない", maxAttempts: 5, wantAttempts: 1},
+		{name: "スロットリングは成功するまで再試行し、レートを下げる", maxAttempts: 5, errs: []error{throttled, throttled}, wantAttempts: 3, wantRate: throttledInitialRate/2 + rateIncrease},
+		{name: "最大試行回数に達したら最後のエラーを返す", maxAttempts: 2, errs: []error{throttled, throttled, throttled}, wantErr: throttled, wantAttempts: 2, wantRate: throttledInitialRate / 2},
+		{name: "再試行できないエラーはすぐに返す", maxAttempts: 5, errs: []error{accessDenied}, wantErr: accessDenied, wantAttempts: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTestRetry(t, tt.maxAttempts)
+			attempts := 0
+			err := Retry(context.Background(), "item", func() error {
+				attempts++
+				// スロットリング後のレートでの待機でテストが遅くならないよう、次の送信時刻を戻す
+				sharedLimiter.mu.Lock()
+				sharedLimiter.next = time.Time{}
+				sharedLimiter.mu.Unlock()
+				if attempts <= len(tt.errs) {
+					return tt.errs[attempts-1]
+				}
+				return nil
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+			if sharedLimiter.rate != tt.wantRate {
+				t.Errorf("rate = %v, want %v", sharedLimiter.rate, tt.wantRate)
+			}
+		})
+	}
+}
+
+func TestRetryCanceled(t *testing.T) {
+	useTestRetry(t, 5)
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	err := Retry(ctx, "item", func() error {
+		attempts++
+		cancel()
+		return &smithy.GenericAPIError{Code: "ThrottlingException"}
+	})
+	if err == nil || attempts != 1 {
+		t.Errorf("err = %v, attempts = %d, want the error after 1 attempt", err, attempts)
+	}
+}
+
+func TestRetryDelete(t *testing.T) {
+	timeout := &smithy.GenericAPIError{Code: "RequestTimeout", Message: "request timeout"}
+	notFound := &smithy.GenericAPIError{Code: "NoSuchBucket", Message: "bucket does not exist"}
+
+	tests := []struct {
+		name         string
+		errs         []error // 各試行で返すエラー（試行回数がこれを超えた場合は成功）
+		wantErr      error
+		wantAttempts int
+	}{
+		{name: "初回の不存在エラーはそのまま返す", errs: []error{notFound}, wantErr: notFound, wantAttempts: 1},
+		{name: "再試行時の不存在エラーは前回の削除が完了していたものとして成功扱いにする", errs: []error{timeout, notFound}, wantAttempts: 2},
+		{name: "再試行時も不存在以外のエラーは再試行を続ける", errs: []error{timeout, timeout}, wantAttempts: 3},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			useTestRetry(t, 5)
+			attempts := 0
+			err := RetryDelete(context.Background(), "item", func() error {
+				attempts++
+				if attempts <= len(tt.errs) {
+					return tt.errs[attempts-1]
+				}
+				return nil
+			})
+
+			if !errors.Is(err, tt.wantErr) {
+				t.Errorf("err = %v, want %v", err, tt.wantErr)
+			}
+			if attempts != tt.wantAttempts {
+				t.Errorf("attempts = %d, want %d", attempts, tt.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestIsNotFoundError(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{code: "NoSuchBucket", want: true},
+		{code: "ResourceNotFoundException", want: true},
+		{code: "InvalidGroup.NotFound", want: true},
+		{code: "DBInstanceNotFoundFault", want: true},
+		{code: "AWS.SimpleQueueService.NonExistentQueue", want: true},
+		{code: "AccessDenied", want: false},
+		{code: "ThrottlingException", want: false},
+	}
+	for _, tt := range tests {
+		if got := IsNotFoundError(&smithy.GenericAPIError{Code: tt.code}); got != tt.want {
+			t.Errorf("IsNotFoundError(%s) = %v, want %v", tt.code, got, tt.want)
+		}
+	}
+	if IsNotFoundError(errors.New("not found")) {
+		t.Error("IsNotFoundError(non-API error) = true, want false")
+	}
+}
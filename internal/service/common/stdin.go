@@ -0,0 +1,10 @@
+package common
+
+import (
+	"bufio"
+	"os"
+)
+
+// Stdin は対話的な入力に使用する標準入力のリーダー
+// 確認プロンプト・一覧からの選択・MFAトークンの入力で先読みした入力を失わないよう、すべての読み取りで共有する
+var Stdin = bufio.NewReader(os.Stdin)
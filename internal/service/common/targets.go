@@ -0,0 +1,124 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+)
+
+// Target は複数アカウント・複数リージョンで処理する際の実行対象
+// 複数アカウントで実行しない場合は Account、複数リージョンで実行しない場合は Region が空になる
+type Target struct {
+	Account string
+	Region  string
+}
+
+// String は実行対象を表示用の文字列で返す（例: "111111111111/us-east-1"）
+func (t Target) String() string {
+	parts := make([]string, 0, 2)
+	if t.Account != "" {
+		parts = append(parts, t.Account)
+	}
+	if t.Region != "" {
+		parts = append(parts, t.Region)
+	}
+	if len(parts) == 0 {
+		return "-"
+	}
+	return strings.Join(parts, "/")
+}
+
+// TargetResult は実行対象ごとの一覧取得結果
+type TargetResult[T any] struct {
+	Target Target
+	Items  []T
+	Err    error
+}
+
+// FetchAcrossTargets は指定した実行対象で並列に一覧を取得する
+// 結果は指定した実行対象の順に並び、取得に失敗した対象は Err に格納する
+func FetchAcrossTargets[T any](ctx context.Context, targets []Target, fetch func(ctx context.Context, target Target) ([]T, error)) []TargetResult[T] {
+	results := make([]TargetResult[T], len(targets))
+	executor := NewParallelExecutor(WorkerCount(10, len(targets)))
+
+	for i, target := range targets {
+		idx := i
+		t := target
+		executor.Execute(func() {
+			if ctx.Err() != nil {
+				results[idx] = TargetResult[T]{Target: t, Err: ctx.Err()}
+				return
+			}
+			items, err := fetch(ctx, t)
+			results[idx] = TargetResult[T]{Target: t, Items: items, Err: err}
+		})
+	}
+
+	executor.Wait()
+	return results
+}
+
+// DisplayTargetList は実行対象ごとの取得結果をアカウント列・リージョン列付きの1つのテーブルにまとめて表示する
+// 取得に失敗した対象は警告を標準エラー出力に表示し、他の対象の結果は表示したうえでエラーを返す
+func DisplayTargetList[T any](
+	results []TargetResult[T],
+	title string,
+	toTableData func([]T) ([]TableColumn, [][]string),
+	opts *DisplayOptions,
+) error {
+	hasAccount, hasRegion := false, false
+	for _, r := range results {
+		hasAccount = hasAccount || r.Target.Account != ""
+		hasRegion = hasRegion || r.Target.Region != ""
+	}
+
+	var prefix []TableColumn
+	if hasAccount {
+		prefix = append(prefix, TableColumn{Header: i18n.T("アカウント"), Key: "account"})
+	}
+	if hasRegion {
+		prefix = append(prefix, TableColumn{Header: i18n.T("リージョン"), Key: "region"})
+	}
+	columns, _ := toTableData(nil)
+	columns = append(prefix, columns...)
+
+	rows := [][]string{}
+	var failed []string
+	for _, r := range results {
+		if r.Err != nil {
+			fmt.Fprintf(os.Stderr, i18n.T("%s %s の一覧取得でエラー: %v\n"), WarningIcon, r.Target, r.Err)
+			failed = append(failed, r.Target.String())
+			continue
+		}
+		var cells []string
+		if hasAccount {
+			cells = append(cells, r.Target.Account)
+		}
+		if hasRegion {
+			cells = append(cells, r.Target.Region)
+		}
+		_, data := toTableData(r.Items)
+		for _, row := range data {
+			rows = append(rows, append(append([]string{}, cells...), row...))
+		}
+	}
+
+	err := DisplayList(rows, title, func(rows [][]string) ([]TableColumn, [][]string) {
+		return columns, rows
+	}, opts)
+	if err != nil {
+		return err
+	}
+	if len(failed) > 0 {
+		return fmt.Errorf(i18n.T("❌ %d個の対象で一覧取得に失敗しました: %s"), len(failed), strings.Join(failed, ", "))
+	}
+	return nil
+}
+
+// NamesToTableData は名前のリストを1列のテーブルデータに変換する
+func NamesToTableData(names []string) ([]TableColumn, [][]string) {
+	return nameColumns(), itemsToRows(names)
+}
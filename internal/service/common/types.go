@@ -1,5 +1,7 @@
 package common
 
+import "time"
+
 // ListOutput はリスト表示の共通構造体
 type ListOutput struct {
 	Title        string   // 例: "S3バケット一覧"
@@ -18,6 +20,7 @@ type ListItem struct {
 type TableColumn struct {
 	Header string
 	Width  int
+	Key    string // 構造化出力（json/yaml/csv/tsv）で使用する英語のフィールド名
 }
 
 // DisplayOptions はリスト表示のオプション
@@ -26,3 +29,19 @@ type DisplayOptions struct {
 	EmptyMessage   string   // 空の場合のメッセージ（デフォルト: "リソースが見つかりませんでした"）
 	FilterMessages []string // フィルタ条件のメッセージ
 }
+
+// AgeBasis は経過時間の判定に使用する日時の種類
+type AgeBasis string
+
+const (
+	AgeByCreated      AgeBasis = "created"       // 作成日時
+	AgeByLastActivity AgeBasis = "last-activity" // 最終アクティビティ日時（取得できないリソースは作成日時）
+)
+
+// AgeFilter は作成日時・最終アクティビティ日時の経過時間による絞り込み条件
+type AgeFilter struct {
+	OlderThan time.Duration // 指定した期間より前の日時のリソースのみを対象にする（0の場合は条件なし）
+	NewerThan time.Duration // 指定した期間以内の日時のリソースのみを対象にする（0の場合は条件なし）
+	Basis     AgeBasis      // 判定に使用する日時
+	Now       time.Time     // 経過時間の基準時刻
+}
@@ -0,0 +1,110 @@
+package confirm
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+
+	"golang.org/x/term"
+)
+
+// assumeYes は --yes が指定されたか
+var assumeYes bool
+
+// SetAssumeYes は --yes の指定を設定する
+func SetAssumeYes(enabled bool) {
+	assumeYes = enabled
+}
+
+// AssumeYes は --yes が指定されたかを返す
+func AssumeYes() bool {
+	return assumeYes
+}
+
+// Ask は対象の一覧を表示し、実行してよいかを確認する
+// --yes が指定されている場合は確認せずに true を返す
+// 標準入力が端末でない場合は入力を待たずにエラーを返す（パイプ等からの入力で意図せず実行されないようにする）
+func Ask(req Request) (bool, error) {
+	printTargets(req)
+	if assumeYes {
+		logging.Infof("%s\n", i18n.T("ℹ️  --yes が指定されたため確認を省略します"))
+		return true, nil
+	}
+	if !isTerminal() {
+		return false, errors.New(i18n.T("標準入力が端末ではないため確認できません（確認せずに実行するには --yes を指定してください）"))
+	}
+	return prompt(req), nil
+}
+
+// AskAlways は --yes の指定に関わらず確認する
+// ガードレールの上書きなど、必ず利用者の入力を求める操作に使用する
+func AskAlways(req Request) (bool, error) {
+	printTargets(req)
+	if !isTerminal() {
+		return false, errors.New(i18n.T("標準入力が端末ではないため確認できません"))
+	}
+	return prompt(req), nil
+}
+
+// AskDeletion は検出時に一覧を表示済みの削除対象を、削除してよいか y/N で確認する
+// キャンセルされた場合はその旨を表示する
+func AskDeletion(resourceName string, count int) (bool, error) {
+	ok, err := Ask(Request{
+		Message: fmt.Sprintf(i18n.T("\n⚠️  上記の%d件の%sを削除します"), count, resourceName),
+		Prompt:  i18n.T("本当に削除しますか？"),
+	})
+	if err == nil && !ok {
+		logging.Infof("%s\n", i18n.T("削除をキャンセルしました"))
+	}
+	return ok, err
+}
+
+// printTargets は確認のメッセージと対象の一覧を表示する
+// メッセージは警告として -q でも表示し、対象の一覧は -q の場合は表示しない
+func printTargets(req Request) {
+	if req.Message != "" {
+		logging.Warnf("%s\n", req.Message)
+	}
+	for _, target := range req.Targets {
+		logging.Infof("  - %s\n", target)
+	}
+}
+
+// prompt は利用者の入力で確認する
+// Phrase が指定されている場合はその文字列の入力、それ以外は y/N で確認する
+func prompt(req Request) bool {
+	if req.Phrase != "" {
+		fmt.Fprintf(os.Stderr, i18n.T("続行するには%s %s を入力してください: "), req.PhraseLabel, req.Phrase)
+		input, err := common.Stdin.ReadString('\n')
+		if err != nil && input == "" {
+			return false
+		}
+		if strings.TrimSpace(input) != req.Phrase {
+			logging.Warnf("%s\n", i18n.T("入力が一致しません"))
+			return false
+		}
+		return true
+	}
+
+	question := req.Prompt
+	if question == "" {
+		question = i18n.T("本当に実行しますか？")
+	}
+	fmt.Fprintf(os.Stderr, "%s [y/N]: ", question)
+	input, err := common.Stdin.ReadString('\n')
+	if err != nil && input == "" {
+		return false
+	}
+	input = strings.ToLower(strings.TrimSpace(input))
+	return input == "y" || input == "yes"
+}
+
+// isTerminal は標準入力が端末かどうかを返す（/dev/null 等のキャラクタデバイスは端末として扱わない）
+func isTerminal() bool {
+	return term.IsTerminal(int(os.Stdin.Fd()))
+}
@@ -0,0 +1,10 @@
+package confirm
+
+// Request は実行前の確認の内容
+type Request struct {
+	Message     string   // 対象の一覧の前に表示するメッセージ（例: "以下のS3バケットを削除します:"）
+	Targets     []string // 対象の一覧（表示されたものだけが実行されることを確認できるよう、省略せずに表示する）
+	Prompt      string   // y/N で確認する場合の質問（省略時は "本当に実行しますか？"）
+	Phrase      string   // 高リスクの操作で入力を求める文字列（アカウントエイリアス・スタック名等。空の場合は y/N で確認する）
+	PhraseLabel string   // Phrase の説明（例: "アカウント"、"スタック名"）
+}
@@ -0,0 +1,78 @@
+package dynamodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+)
+
+// GetDynamoDbTablesByFilter はフィルターに一致するDynamoDBテーブル名の一覧を取得します
+// exact が true の場合、大文字小文字を区別します
+// tags が指定された場合は、タグ条件にも一致するテーブルのみを返します
+// age が指定された場合は、作成日時の経過時間にも一致するテーブルのみを返します（作成日時の取得のため、テーブルごとにDescribeTableを呼び出します）
+func GetDynamoDbTablesByFilter(ctx context.Context, client DynamoDbApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
+	var matched []string
+	paginator := dynamodb.NewListTablesPaginator(client, &dynamodb.ListTablesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("dynamoDBテーブル一覧取得エラー: %w"), err)
+		}
+		for _, name := range output.TableNames {
+			if common.MatchesFilter(name, searchString, exact) {
+				matched = append(matched, name)
+			}
+		}
+	}
+
+	matched, err := tagging.Select(ctx, tags, common.Target{}, tagging.TypeDynamoDbTable, matched, tableKey)
+	if err != nil {
+		return nil, err
+	}
+
+	// 経過時間で絞り込み（作成日時は一覧に含まれないため、条件が指定された場合のみ取得する）
+	created := make(map[string]*time.Time)
+	if age.Enabled() {
+		for _, name := range matched {
+			output, err := client.DescribeTable(ctx, &dynamodb.DescribeTableInput{TableName: aws.String(name)})
+			if err != nil {
+				return nil, fmt.Errorf(i18n.T("dynamoDBテーブルの情報取得エラー (%s): %w"), name, err)
+			}
+			created[name] = output.Table.CreationDateTime
+		}
+	}
+	matched = common.SelectByAge(age, matched, func(name string) (*time.Time, *time.Time) {
+		return created[name], nil
+	})
+
+	foundTables := []string{}
+	for _, name := range matched {
+		foundTables = append(foundTables, name)
+		common.Progressf(ctx, common.EventInfo, name, i18n.T("🔍 検出されたDynamoDBテーブル: %s\n"), age.Annotate(name, created[name], nil))
+	}
+	return foundTables, nil
+}
+
+// CleanupDynamoDbTables は指定したDynamoDBテーブル一覧を削除します
+// 削除保護が有効なテーブルは解除せず、削除の失敗として扱います
+func CleanupDynamoDbTables(ctx context.Context, client DynamoDbApi, tableNames []string) common.CleanupResult {
+	tableNames = guardrail.ExcludeNames(ctx, i18n.T("DynamoDBテーブル"), tagging.TypeDynamoDbTable, tableNames, tableKey)
+
+	return common.DeleteInParallel(ctx, i18n.T("DynamoDBテーブル"), tableNames, 10, func(name string) error {
+		_, err := client.DeleteTable(ctx, &dynamodb.DeleteTableInput{TableName: aws.String(name)})
+		return err
+	})
+}
+
+// tableKey はタグ・ガードレールの確認に使用するARNのリソース部分を返す
+func tableKey(name string) string {
+	return "table/" + name
+}
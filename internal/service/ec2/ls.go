@@ -1,57 +1,98 @@
 package ec2
 
 import (
-	"bufio"
 	"context"
+	"errors"
 	"fmt"
-	"os"
-	"strconv"
-	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/selector"
+	"github.com/ngsw877/awstk/internal/service/tagging"
 
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
-
-	"awstk/internal/service/cfn"
-	"awstk/internal/service/common"
 )
 
 // ListEc2Instances cmdから呼ばれるメイン関数（Get + Display）
-func ListEc2Instances(ec2Client *ec2.Client, cfnClient *cloudformation.Client, stackName string) error {
+// tags が指定された場合はタグ条件に一致するインスタンスのみを表示する
+func ListEc2Instances(ctx context.Context, ec2Client *ec2.Client, cfnClient *cloudformation.Client, stackName string, tags *tagging.Selector) error {
 	// Get: データ取得
-	instances, err := getEc2Instances(ec2Client, cfnClient, stackName)
+	instances, err := getEc2Instances(ctx, ec2Client, cfnClient, stackName)
 	if err != nil {
 		if stackName != "" {
-			return fmt.Errorf("❌ CloudFormationスタックからインスタンス名の取得に失敗: %w", err)
+			return fmt.Errorf(i18n.T("❌ CloudFormationスタックからインスタンス名の取得に失敗: %w"), err)
 		}
-		return common.FormatListError("EC2インスタンス", err)
+		return common.FormatListError(i18n.T("EC2インスタンス"), err)
+	}
+	instances, err = selectInstancesByTags(ctx, tags, common.Target{}, instances)
+	if err != nil {
+		return common.FormatListError(i18n.T("EC2インスタンス"), err)
 	}
 
 	// Display: 共通表示処理
 	return common.DisplayList(
 		instances,
-		"EC2インスタンス一覧",
+		i18n.T("EC2インスタンス一覧"),
 		ec2InstancesToTableData,
 		&common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: "EC2インスタンスが見つかりませんでした",
+			EmptyMessage: i18n.T("EC2インスタンスが見つかりませんでした"),
 		},
 	)
 }
 
+// ListEc2InstancesAcross は複数のアカウント・リージョンのEC2インスタンスを並列に取得し、アカウント列・リージョン列付きで表示する
+func ListEc2InstancesAcross(ctx context.Context, targets []common.Target, newClient func(target common.Target) *ec2.Client, tags *tagging.Selector) error {
+	results := common.FetchAcrossTargets(ctx, targets, func(ctx context.Context, target common.Target) ([]Instance, error) {
+		instances, err := getAllEc2Instances(ctx, newClient(target))
+		if err != nil {
+			return nil, err
+		}
+		return selectInstancesByTags(ctx, tags, target, instances)
+	})
+	return common.DisplayTargetList(
+		results,
+		i18n.T("EC2インスタンス一覧"),
+		ec2InstancesToTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: i18n.T("EC2インスタンスが見つかりませんでした"),
+		},
+	)
+}
+
+// FindEc2InstancesByTags はタグ条件に一致するEC2インスタンスを取得する（終了済みのインスタンスは含まない）
+func FindEc2InstancesByTags(ctx context.Context, ec2Client *ec2.Client, tags *tagging.Selector) ([]Instance, error) {
+	instances, err := getAllEc2Instances(ctx, ec2Client)
+	if err != nil {
+		return nil, err
+	}
+	return selectInstancesByTags(ctx, tags, common.Target{}, instances)
+}
+
+// selectInstancesByTags はタグ条件に一致するインスタンスに絞り込む
+func selectInstancesByTags(ctx context.Context, tags *tagging.Selector, target common.Target, instances []Instance) ([]Instance, error) {
+	return tagging.Select(ctx, tags, target, tagging.TypeEc2Instance, instances, func(ins Instance) string {
+		return "instance/" + ins.InstanceId
+	})
+}
+
 // getEc2Instances データ取得内部関数
-func getEc2Instances(ec2Client *ec2.Client, cfnClient *cloudformation.Client, stackName string) ([]Instance, error) {
+func getEc2Instances(ctx context.Context, ec2Client *ec2.Client, cfnClient *cloudformation.Client, stackName string) ([]Instance, error) {
 	if stackName != "" {
-		return getEc2InstancesByStackName(ec2Client, cfnClient, stackName)
+		return getEc2InstancesByStackName(ctx, ec2Client, cfnClient, stackName)
 	}
-	return getAllEc2Instances(ec2Client)
+	return getAllEc2Instances(ctx, ec2Client)
 }
 
 // getAllEc2Instances 現在のリージョンの全EC2インスタンスを取得
-func getAllEc2Instances(ec2Client *ec2.Client) ([]Instance, error) {
-	result, err := ec2Client.DescribeInstances(context.Background(), &ec2.DescribeInstancesInput{})
+func getAllEc2Instances(ctx context.Context, ec2Client *ec2.Client) ([]Instance, error) {
+	result, err := ec2Client.DescribeInstances(ctx, &ec2.DescribeInstancesInput{})
 	if err != nil {
-		return nil, fmt.Errorf("EC2インスタンス一覧の取得に失敗: %w", err)
+		return nil, fmt.Errorf(i18n.T("EC2インスタンス一覧の取得に失敗: %w"), err)
 	}
 
 	var instances []Instance
@@ -63,7 +104,7 @@ func getAllEc2Instances(ec2Client *ec2.Client) ([]Instance, error) {
 			}
 
 			// インスタンス名を取得（Nameタグから）
-			instanceName := "（名前なし）"
+			instanceName := i18n.T("（名前なし）")
 			for _, tag := range instance.Tags {
 				if *tag.Key == "Name" && tag.Value != nil {
 					instanceName = *tag.Value
@@ -83,8 +124,8 @@ func getAllEc2Instances(ec2Client *ec2.Client) ([]Instance, error) {
 }
 
 // getEc2InstancesByStackName 指定されたCloudFormationスタック名でフィルタリングしたEC2インスタンス一覧を取得
-func getEc2InstancesByStackName(ec2Client *ec2.Client, cfnClient *cloudformation.Client, stackName string) ([]Instance, error) {
-	ids, err := cfn.GetAllEc2FromStack(cfnClient, stackName)
+func getEc2InstancesByStackName(ctx context.Context, ec2Client *ec2.Client, cfnClient *cloudformation.Client, stackName string) ([]Instance, error) {
+	ids, err := cfn.GetAllEc2FromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
@@ -93,7 +134,7 @@ func getEc2InstancesByStackName(ec2Client *ec2.Client, cfnClient *cloudformation
 		return []Instance{}, nil
 	}
 
-	all, err := getAllEc2Instances(ec2Client)
+	all, err := getAllEc2Instances(ctx, ec2Client)
 	if err != nil {
 		return nil, err
 	}
@@ -116,9 +157,9 @@ func getEc2InstancesByStackName(ec2Client *ec2.Client, cfnClient *cloudformation
 // ec2InstancesToTableData EC2インスタンス情報をテーブルデータに変換
 func ec2InstancesToTableData(instances []Instance) ([]common.TableColumn, [][]string) {
 	columns := []common.TableColumn{
-		{Header: "インスタンスID"},
-		{Header: "インスタンス名"},
-		{Header: "状態"},
+		{Header: i18n.T("インスタンスID"), Key: "instanceId"},
+		{Header: i18n.T("インスタンス名"), Key: "instanceName"},
+		{Header: i18n.T("状態"), Key: "state"},
 	}
 
 	data := make([][]string, len(instances))
@@ -132,64 +173,30 @@ func ec2InstancesToTableData(instances []Instance) ([]common.TableColumn, [][]st
 	return columns, data
 }
 
-// SelectInstanceInteractively EC2インスタンス一覧を表示してユーザーに選択させる
-func SelectInstanceInteractively(ec2Client *ec2.Client) (string, error) {
-	fmt.Println("EC2インスタンス一覧を取得中...")
+// SelectInstanceInteractively EC2インスタンス一覧からユーザーに選択させる
+func SelectInstanceInteractively(ctx context.Context, ec2Client *ec2.Client) (string, error) {
+	common.Infof(ctx, "%s\n", i18n.T("EC2インスタンス一覧を取得中..."))
 
-	instances, err := getAllEc2Instances(ec2Client)
+	instances, err := getAllEc2Instances(ctx, ec2Client)
 	if err != nil {
-		return "", fmt.Errorf("❌ EC2インスタンス一覧の取得に失敗: %w", err)
+		return "", fmt.Errorf(i18n.T("❌ EC2インスタンス一覧の取得に失敗: %w"), err)
 	}
 
 	if len(instances) == 0 {
-		return "", fmt.Errorf("❌ 利用可能なEC2インスタンスが見つかりません")
-	}
-
-	// インスタンス一覧を表示
-	fmt.Println("\n📋 利用可能なEC2インスタンス:")
-
-	columns := []common.TableColumn{
-		{Header: "番号"},
-		{Header: "インスタンスID"},
-		{Header: "インスタンス名"},
-		{Header: "状態"},
+		return "", errors.New(i18n.T("❌ 利用可能なEC2インスタンスが見つかりません"))
 	}
 
-	data := make([][]string, len(instances))
+	items := make([]selector.Item, len(instances))
 	for i, instance := range instances {
-		data[i] = []string{
-			fmt.Sprintf("%d", i+1),
-			instance.InstanceId,
-			instance.InstanceName,
-			instance.State,
+		items[i] = selector.Item{
+			Value: instance.InstanceId,
+			Label: fmt.Sprintf("%s  %s  (%s)", instance.InstanceId, instance.InstanceName, instance.State),
 		}
 	}
 
-	common.PrintTable("EC2インスタンス一覧", columns, data)
-
-	// ユーザーに選択させる
-	fmt.Print("\n操作するインスタンスの番号を入力してください: ")
-	reader := bufio.NewReader(os.Stdin)
-	input, err := reader.ReadString('\n')
-	if err != nil {
-		return "", fmt.Errorf("❌ 入力の読み取りに失敗: %w", err)
-	}
-
-	// 入力を数値に変換
-	input = strings.TrimSpace(input)
-	selectedNum, err := strconv.Atoi(input)
+	selected, err := selector.Select(i18n.T("操作するインスタンスを選択してください"), items)
 	if err != nil {
-		return "", fmt.Errorf("❌ 無効な番号です: %s", input)
+		return "", fmt.Errorf(i18n.T("❌ エラー: %w"), err)
 	}
-
-	// 範囲チェック
-	if selectedNum < 1 || selectedNum > len(instances) {
-		return "", fmt.Errorf("❌ 番号は1から%dの間で入力してください", len(instances))
-	}
-
-	selectedInstance := instances[selectedNum-1]
-	fmt.Printf("✅ 選択されたインスタンス: %s (%s)\n",
-		selectedInstance.InstanceName, selectedInstance.InstanceId)
-
-	return selectedInstance.InstanceId, nil
+	return selected.Value, nil
 }
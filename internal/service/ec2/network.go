@@ -0,0 +1,220 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// securityGroupReleaseTimeout は削除したロードバランサー等のネットワークインターフェースが
+// セキュリティグループを解放するまで待機する最大時間
+const securityGroupReleaseTimeout = 10 * time.Minute
+
+// GetSecurityGroupsByFilter はフィルターに一致するセキュリティグループIDの一覧を取得します
+// グループID・グループ名・Nameタグのいずれかが検索文字列に一致するものが対象です（削除できない default グループは除きます）
+// exact が true の場合、大文字小文字を区別します
+// tags が指定された場合は、タグ条件にも一致するセキュリティグループのみを返します
+// セキュリティグループは作成日時を取得できないため、age が指定された場合は対象外になります
+func GetSecurityGroupsByFilter(ctx context.Context, client CleanupApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
+	var matched []types.SecurityGroup
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(client, &ec2.DescribeSecurityGroupsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("セキュリティグループ一覧取得エラー: %w"), err)
+		}
+		for _, sg := range output.SecurityGroups {
+			if aws.ToString(sg.GroupName) == "default" {
+				continue
+			}
+			for _, candidate := range []string{aws.ToString(sg.GroupId), aws.ToString(sg.GroupName), nameTagOf(sg.Tags)} {
+				if candidate != "" && common.MatchesFilter(candidate, searchString, exact) {
+					matched = append(matched, sg)
+					break
+				}
+			}
+		}
+	}
+
+	matched = common.SelectByAge(age, matched, func(types.SecurityGroup) (*time.Time, *time.Time) {
+		return nil, nil
+	})
+	matched, err := tagging.Select(ctx, tags, common.Target{}, tagging.TypeSecurityGroup, matched, func(sg types.SecurityGroup) string {
+		return securityGroupKey(aws.ToString(sg.GroupId))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	foundGroups := []string{}
+	for _, sg := range matched {
+		groupId := aws.ToString(sg.GroupId)
+		foundGroups = append(foundGroups, groupId)
+		common.Progressf(ctx, common.EventInfo, groupId, i18n.T("🔍 検出されたセキュリティグループ: %s\n"), fmt.Sprintf("%s (%s)", groupId, aws.ToString(sg.GroupName)))
+	}
+	return foundGroups, nil
+}
+
+// CleanupSecurityGroups は指定したセキュリティグループ一覧を削除します
+// 削除したロードバランサー等のネットワークインターフェースが残っている間は使用中のエラーになるため、一定時間は再試行します
+func CleanupSecurityGroups(ctx context.Context, client CleanupApi, groupIds []string) common.CleanupResult {
+	groupIds = guardrail.ExcludeIds(ctx, i18n.T("セキュリティグループ"), tagging.TypeSecurityGroup, groupIds, func() (map[string]guardrail.Aliases, error) {
+		return securityGroupNames(ctx, client, groupIds)
+	}, securityGroupKey)
+
+	return common.DeleteInParallel(ctx, i18n.T("セキュリティグループ"), groupIds, 10, func(groupId string) error {
+		return common.RetryWhileInUse(ctx, groupId, securityGroupReleaseTimeout, 15*time.Second, []string{"DependencyViolation"}, func() error {
+			_, err := client.DeleteSecurityGroup(ctx, &ec2.DeleteSecurityGroupInput{GroupId: aws.String(groupId)})
+			return err
+		})
+	})
+}
+
+// securityGroupNames は指定したセキュリティグループごとに、ガードレールの照合に使用するグループ名とNameタグを取得します
+func securityGroupNames(ctx context.Context, client CleanupApi, groupIds []string) (map[string]guardrail.Aliases, error) {
+	names := make(map[string]guardrail.Aliases, len(groupIds))
+	paginator := ec2.NewDescribeSecurityGroupsPaginator(client, &ec2.DescribeSecurityGroupsInput{
+		Filters: []types.Filter{{Name: aws.String("group-id"), Values: groupIds}},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("セキュリティグループ一覧取得エラー: %w"), err)
+		}
+		for _, sg := range output.SecurityGroups {
+			names[aws.ToString(sg.GroupId)] = guardrail.Aliases{Names: []string{aws.ToString(sg.GroupName), nameTagOf(sg.Tags)}}
+		}
+	}
+	return names, nil
+}
+
+// securityGroupKey はタグ・ガードレールの確認に使用するARNのリソース部分を返す
+func securityGroupKey(groupId string) string {
+	return "security-group/" + groupId
+}
+
+// GetNetworkInterfacesByFilter はフィルターに一致する未使用（available）のネットワークインターフェースIDの一覧を取得します
+// 使用中のネットワークインターフェースはアタッチ先のリソースと一緒に削除されるため対象外です
+// インターフェースID・説明・Nameタグのいずれかが検索文字列に一致するものが対象です
+// exact が true の場合、大文字小文字を区別します
+// tags が指定された場合は、タグ条件にも一致するネットワークインターフェースのみを返します
+// ネットワークインターフェースは作成日時を取得できないため、age が指定された場合は対象外になります
+func GetNetworkInterfacesByFilter(ctx context.Context, client CleanupApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
+	interfaces, err := describeNetworkInterfaces(ctx, client, []types.Filter{
+		{Name: aws.String("status"), Values: []string{string(types.NetworkInterfaceStatusAvailable)}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []types.NetworkInterface
+	for _, eni := range interfaces {
+		for _, candidate := range []string{aws.ToString(eni.NetworkInterfaceId), aws.ToString(eni.Description), nameTagOf(eni.TagSet)} {
+			if candidate != "" && common.MatchesFilter(candidate, searchString, exact) {
+				matched = append(matched, eni)
+				break
+			}
+		}
+	}
+
+	matched = common.SelectByAge(age, matched, func(types.NetworkInterface) (*time.Time, *time.Time) {
+		return nil, nil
+	})
+	matched, err = tagging.Select(ctx, tags, common.Target{}, tagging.TypeNetworkInterface, matched, func(eni types.NetworkInterface) string {
+		return networkInterfaceKey(aws.ToString(eni.NetworkInterfaceId))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	foundInterfaces := []string{}
+	for _, eni := range matched {
+		eniId := aws.ToString(eni.NetworkInterfaceId)
+		foundInterfaces = append(foundInterfaces, eniId)
+		label := eniId
+		if description := aws.ToString(eni.Description); description != "" {
+			label = fmt.Sprintf("%s (%s)", eniId, description)
+		}
+		common.Progressf(ctx, common.EventInfo, eniId, i18n.T("🔍 検出されたネットワークインターフェース: %s\n"), label)
+	}
+	return foundInterfaces, nil
+}
+
+// GetNetworkInterfaceSecurityGroups は指定したネットワークインターフェースごとに、関連付けられているセキュリティグループIDを取得します
+// 既に削除されたネットワークインターフェースは結果に含まれません
+func GetNetworkInterfaceSecurityGroups(ctx context.Context, client CleanupApi, eniIds []string) (map[string][]string, error) {
+	interfaces, err := describeNetworkInterfaces(ctx, client, []types.Filter{
+		{Name: aws.String("network-interface-id"), Values: eniIds},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	groups := make(map[string][]string, len(interfaces))
+	for _, eni := range interfaces {
+		eniId := aws.ToString(eni.NetworkInterfaceId)
+		for _, group := range eni.Groups {
+			groups[eniId] = append(groups[eniId], aws.ToString(group.GroupId))
+		}
+	}
+	return groups, nil
+}
+
+// describeNetworkInterfaces はフィルターに一致するネットワークインターフェースの一覧を取得します
+func describeNetworkInterfaces(ctx context.Context, client CleanupApi, filters []types.Filter) ([]types.NetworkInterface, error) {
+	var interfaces []types.NetworkInterface
+	paginator := ec2.NewDescribeNetworkInterfacesPaginator(client, &ec2.DescribeNetworkInterfacesInput{Filters: filters})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("ネットワークインターフェース一覧取得エラー: %w"), err)
+		}
+		interfaces = append(interfaces, output.NetworkInterfaces...)
+	}
+	return interfaces, nil
+}
+
+// CleanupNetworkInterfaces は指定したネットワークインターフェース一覧を削除します
+func CleanupNetworkInterfaces(ctx context.Context, client CleanupApi, eniIds []string) common.CleanupResult {
+	eniIds = guardrail.ExcludeIds(ctx, i18n.T("ネットワークインターフェース"), tagging.TypeNetworkInterface, eniIds, func() (map[string]guardrail.Aliases, error) {
+		return networkInterfaceNames(ctx, client, eniIds)
+	}, networkInterfaceKey)
+
+	return common.DeleteInParallel(ctx, i18n.T("ネットワークインターフェース"), eniIds, 10, func(eniId string) error {
+		_, err := client.DeleteNetworkInterface(ctx, &ec2.DeleteNetworkInterfaceInput{NetworkInterfaceId: aws.String(eniId)})
+		return err
+	})
+}
+
+// networkInterfaceNames は指定したネットワークインターフェースごとに、ガードレールの照合に使用するNameタグと説明を取得します
+func networkInterfaceNames(ctx context.Context, client CleanupApi, eniIds []string) (map[string]guardrail.Aliases, error) {
+	interfaces, err := describeNetworkInterfaces(ctx, client, []types.Filter{
+		{Name: aws.String("network-interface-id"), Values: eniIds},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]guardrail.Aliases, len(interfaces))
+	for _, eni := range interfaces {
+		names[aws.ToString(eni.NetworkInterfaceId)] = guardrail.Aliases{
+			Names:        []string{nameTagOf(eni.TagSet)},
+			Descriptions: []string{aws.ToString(eni.Description)},
+		}
+	}
+	return names, nil
+}
+
+// networkInterfaceKey はタグ・ガードレールの確認に使用するARNのリソース部分を返す
+func networkInterfaceKey(eniId string) string {
+	return "network-interface/" + eniId
+}
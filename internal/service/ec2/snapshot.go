@@ -0,0 +1,114 @@
+package ec2
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ec2/types"
+)
+
+// GetEbsSnapshotsByFilter はフィルターに一致する自アカウント所有のEBSスナップショットIDの一覧を取得します
+// スナップショットID・Nameタグ・説明のいずれかが検索文字列に一致するものが対象です
+// exact が true の場合、大文字小文字を区別します
+// tags が指定された場合は、タグ条件にも一致するスナップショットのみを返します
+// age が指定された場合は、作成日時の経過時間にも一致するスナップショットのみを返します
+func GetEbsSnapshotsByFilter(ctx context.Context, client CleanupApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
+	var matched []types.Snapshot
+	paginator := ec2.NewDescribeSnapshotsPaginator(client, &ec2.DescribeSnapshotsInput{
+		OwnerIds: []string{"self"},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("ebsスナップショット一覧取得エラー: %w"), err)
+		}
+		for _, snapshot := range output.Snapshots {
+			for _, candidate := range []string{aws.ToString(snapshot.SnapshotId), nameTagOf(snapshot.Tags), aws.ToString(snapshot.Description)} {
+				if candidate != "" && common.MatchesFilter(candidate, searchString, exact) {
+					matched = append(matched, snapshot)
+					break
+				}
+			}
+		}
+	}
+
+	matched = common.SelectByAge(age, matched, func(s types.Snapshot) (*time.Time, *time.Time) {
+		return s.StartTime, nil
+	})
+	matched, err := tagging.Select(ctx, tags, common.Target{}, tagging.TypeEbsSnapshot, matched, func(s types.Snapshot) string {
+		return snapshotKey(aws.ToString(s.SnapshotId))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	foundSnapshots := []string{}
+	for _, snapshot := range matched {
+		snapshotId := aws.ToString(snapshot.SnapshotId)
+		foundSnapshots = append(foundSnapshots, snapshotId)
+		label := snapshotId
+		if name := nameTagOf(snapshot.Tags); name != "" {
+			label = fmt.Sprintf("%s (%s)", snapshotId, name)
+		}
+		common.Progressf(ctx, common.EventInfo, snapshotId, i18n.T("🔍 検出されたEBSスナップショット: %s\n"), age.Annotate(label, snapshot.StartTime, nil))
+	}
+	return foundSnapshots, nil
+}
+
+// nameTagOf はタグの一覧からNameタグの値を返します（ない場合は空文字）
+func nameTagOf(tags []types.Tag) string {
+	for _, tag := range tags {
+		if aws.ToString(tag.Key) == "Name" {
+			return aws.ToString(tag.Value)
+		}
+	}
+	return ""
+}
+
+// CleanupEbsSnapshots は指定したEBSスナップショット一覧を削除します
+// AMIが使用しているスナップショットは削除できないため、削除の失敗として扱います
+func CleanupEbsSnapshots(ctx context.Context, client CleanupApi, snapshotIds []string) common.CleanupResult {
+	snapshotIds = guardrail.ExcludeIds(ctx, i18n.T("EBSスナップショット"), tagging.TypeEbsSnapshot, snapshotIds, func() (map[string]guardrail.Aliases, error) {
+		return snapshotNames(ctx, client, snapshotIds)
+	}, snapshotKey)
+
+	return common.DeleteInParallel(ctx, i18n.T("EBSスナップショット"), snapshotIds, 10, func(snapshotId string) error {
+		_, err := client.DeleteSnapshot(ctx, &ec2.DeleteSnapshotInput{SnapshotId: aws.String(snapshotId)})
+		return err
+	})
+}
+
+// snapshotNames は指定したスナップショットごとに、ガードレールの照合に使用するNameタグと説明を取得します
+func snapshotNames(ctx context.Context, client CleanupApi, snapshotIds []string) (map[string]guardrail.Aliases, error) {
+	names := make(map[string]guardrail.Aliases, len(snapshotIds))
+	paginator := ec2.NewDescribeSnapshotsPaginator(client, &ec2.DescribeSnapshotsInput{
+		OwnerIds: []string{"self"},
+		Filters:  []types.Filter{{Name: aws.String("snapshot-id"), Values: snapshotIds}},
+	})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("ebsスナップショット一覧取得エラー: %w"), err)
+		}
+		for _, snapshot := range output.Snapshots {
+			names[aws.ToString(snapshot.SnapshotId)] = guardrail.Aliases{
+				Names:        []string{nameTagOf(snapshot.Tags)},
+				Descriptions: []string{aws.ToString(snapshot.Description)},
+			}
+		}
+	}
+	return names, nil
+}
+
+// snapshotKey はタグ・ガードレールの確認に使用するARNのリソース部分を返す
+func snapshotKey(snapshotId string) string {
+	return "snapshot/" + snapshotId
+}
@@ -4,18 +4,20 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 )
 
 // StopEc2Instance はEC2インスタンスを停止します
-func StopEc2Instance(ec2Client *ec2.Client, instanceId string) error {
+func StopEc2Instance(ctx context.Context, ec2Client *ec2.Client, instanceId string) error {
 	input := &ec2.StopInstancesInput{
 		InstanceIds: []string{instanceId},
 	}
 
-	_, err := ec2Client.StopInstances(context.Background(), input)
+	_, err := ec2Client.StopInstances(ctx, input)
 	if err != nil {
-		return fmt.Errorf("EC2インスタンス停止エラー: %w", err)
+		return fmt.Errorf(i18n.T("EC2インスタンス停止エラー: %w"), err)
 	}
 
 	return nil
@@ -1,33 +1,42 @@
 package ecr
 
 import (
-	"awstk/internal/service/common"
 	"context"
 	"fmt"
 	"sync"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/confirm"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/plan"
+	"github.com/ngsw877/awstk/internal/service/tagging"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/ecr/types"
 )
 
 // GetEcrRepositoriesByFilter はフィルターに一致するECRリポジトリ名の一覧を取得します
 // exact が true の場合、大文字小文字を区別します
-func GetEcrRepositoriesByFilter(ecrClient *ecr.Client, searchString string, exact bool) ([]string, error) {
+// tags が指定された場合は、タグ条件にも一致するリポジトリのみを返します
+// age が指定された場合は、作成日時（last-activity の場合は最後にイメージをプッシュ・プルした日時）の経過時間にも一致するリポジトリのみを返します
+func GetEcrRepositoriesByFilter(ctx context.Context, ecrClient EcrApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
 	// リポジトリ一覧を取得
 	listReposInput := &ecr.DescribeRepositoriesInput{}
-	foundRepos := []string{}
+	var matched []types.Repository
 
 	// ページネーション対応
 	for {
-		listReposOutput, err := ecrClient.DescribeRepositories(context.Background(), listReposInput)
+		listReposOutput, err := ecrClient.DescribeRepositories(ctx, listReposInput)
 		if err != nil {
-			return nil, fmt.Errorf("ecrリポジトリ一覧取得エラー: %w", err)
+			return nil, fmt.Errorf(i18n.T("ecrリポジトリ一覧取得エラー: %w"), err)
 		}
 
 		for _, repo := range listReposOutput.Repositories {
 			if common.MatchesFilter(*repo.RepositoryName, searchString, exact) {
-				foundRepos = append(foundRepos, *repo.RepositoryName)
-				fmt.Printf("🔍 検出されたECRリポジトリ: %s\n", *repo.RepositoryName)
+				matched = append(matched, repo)
 			}
 		}
 
@@ -37,52 +46,122 @@ func GetEcrRepositoriesByFilter(ecrClient *ecr.Client, searchString string, exac
 		listReposInput.NextToken = listReposOutput.NextToken
 	}
 
+	// 経過時間で絞り込み
+	lastActivity := make(map[string]*time.Time)
+	if age.UsesLastActivity() {
+		for _, repo := range matched {
+			t, err := getLastImageActivity(ctx, ecrClient, *repo.RepositoryName)
+			if err != nil {
+				return nil, fmt.Errorf(i18n.T("イメージ一覧取得エラー (%s): %w"), *repo.RepositoryName, err)
+			}
+			lastActivity[*repo.RepositoryName] = t
+		}
+	}
+	matched = common.SelectByAge(age, matched, func(r types.Repository) (*time.Time, *time.Time) {
+		return r.CreatedAt, lastActivity[*r.RepositoryName]
+	})
+
+	names := make([]string, len(matched))
+	for i, repo := range matched {
+		names[i] = *repo.RepositoryName
+	}
+	names, err := selectRepositoryNamesByTags(ctx, tags, common.Target{}, names)
+	if err != nil {
+		return nil, err
+	}
+
+	reposByName := make(map[string]types.Repository, len(matched))
+	for _, repo := range matched {
+		reposByName[*repo.RepositoryName] = repo
+	}
+
+	foundRepos := []string{}
+	for _, name := range names {
+		foundRepos = append(foundRepos, name)
+		common.Progressf(ctx, common.EventInfo, name, i18n.T("🔍 検出されたECRリポジトリ: %s\n"), age.Annotate(name, reposByName[name].CreatedAt, lastActivity[name]))
+	}
+
 	return foundRepos, nil
 }
 
+// getLastImageActivity はリポジトリ内のイメージが最後にプッシュ・プルされた日時を返します（イメージがない場合は nil）
+func getLastImageActivity(ctx context.Context, ecrClient EcrApi, repoName string) (*time.Time, error) {
+	imageDetails, err := getRepositoryImageDetails(ctx, ecrClient, repoName)
+	if err != nil {
+		return nil, err
+	}
+
+	var latest *time.Time
+	for _, image := range imageDetails {
+		for _, t := range []*time.Time{image.ImagePushedAt, image.LastRecordedPullTime} {
+			if t != nil && (latest == nil || t.After(*latest)) {
+				latest = t
+			}
+		}
+	}
+	return latest, nil
+}
+
+// selectRepositoryNamesByTags はタグ条件に一致するリポジトリ名に絞り込む
+func selectRepositoryNamesByTags(ctx context.Context, tags *tagging.Selector, target common.Target, names []string) ([]string, error) {
+	return tagging.Select(ctx, tags, target, tagging.TypeEcrRepository, names, func(name string) string {
+		return "repository/" + name
+	})
+}
+
 // CleanupEcrRepositories は指定したECRリポジトリ一覧を削除します
-func CleanupEcrRepositories(ecrClient *ecr.Client, repoNames []string) common.CleanupResult {
+func CleanupEcrRepositories(ctx context.Context, ecrClient EcrApi, repoNames []string) common.CleanupResult {
 	result := common.CleanupResult{
-		ResourceType: "ECRリポジトリ",
+		ResourceType: i18n.T("ECRリポジトリ"),
 		Deleted:      []string{},
 		Failed:       []string{},
 	}
 
+	repoNames = guardrail.ExcludeNames(ctx, i18n.T("ECRリポジトリ"), tagging.TypeEcrRepository, repoNames, func(name string) string { return "repository/" + name })
 	if len(repoNames) == 0 {
 		return result
 	}
 
-	// 並列実行数を設定（最大10並列）
-	maxWorkers := 10
-	if len(repoNames) < maxWorkers {
-		maxWorkers = len(repoNames)
-	}
+	// 並列実行数を設定（既定は最大10並列、--concurrency または設定ファイルの concurrency で変更可）
+	maxWorkers := common.WorkerCount(10, len(repoNames))
 
 	executor := common.NewParallelExecutor(maxWorkers)
 	results := make([]common.ProcessResult, len(repoNames))
 	resultsMutex := &sync.Mutex{}
 
-	fmt.Printf("🚀 %d個のリポジトリを最大%d並列で削除します...\n\n", len(repoNames), maxWorkers)
+	common.Infof(ctx, i18n.T("🚀 %d個のリポジトリを最大%d並列で削除します...\n\n"), len(repoNames), maxWorkers)
 
 	for i, repoName := range repoNames {
 		idx := i
 		repo := repoName
 		executor.Execute(func() {
-			fmt.Printf("リポジトリ %s を削除中...\n", repo)
+			// 中断された場合は未実行として記録
+			if ctx.Err() != nil {
+				resultsMutex.Lock()
+				results[idx] = common.SkippedResult(repo, ctx.Err())
+				resultsMutex.Unlock()
+				return
+			}
 
+			common.Progressf(ctx, common.EventStart, repo, i18n.T("リポジトリ %s を削除中...\n"), repo)
+
+			start := time.Now()
 			// リポジトリの削除（強制削除フラグで内部のイメージも含めて削除）
-			_, err := ecrClient.DeleteRepository(context.Background(), &ecr.DeleteRepositoryInput{
-				RepositoryName: aws.String(repo),
-				Force:          true, // 強制削除（イメージが残っていても削除）
+			err := common.Retry(ctx, repo, func() error {
+				_, err := ecrClient.DeleteRepository(ctx, &ecr.DeleteRepositoryInput{
+					RepositoryName: aws.String(repo),
+					Force:          true, // 強制削除（イメージが残っていても削除）
+				})
+				return err
 			})
 
 			resultsMutex.Lock()
 			if err != nil {
-				fmt.Printf("❌ リポジトリ %s の削除に失敗しました: %v\n", repo, err)
-				results[idx] = common.ProcessResult{Item: repo, Success: false, Error: err}
+				common.Failuref(ctx, repo, err, i18n.T("❌ リポジトリ %s の削除に失敗しました: %v\n"), repo, err)
+				results[idx] = common.ProcessResult{Item: repo, Success: false, Error: err, Duration: time.Since(start)}
 			} else {
-				fmt.Printf("✅ リポジトリ %s を削除しました\n", repo)
-				results[idx] = common.ProcessResult{Item: repo, Success: true}
+				common.Progressf(ctx, common.EventSuccess, repo, i18n.T("✅ リポジトリ %s を削除しました\n"), repo)
+				results[idx] = common.ProcessResult{Item: repo, Success: true, Duration: time.Since(start)}
 			}
 			resultsMutex.Unlock()
 		})
@@ -91,32 +170,51 @@ func CleanupEcrRepositories(ecrClient *ecr.Client, repoNames []string) common.Cl
 	executor.Wait()
 
 	// 結果の集計
-	successCount, failCount := common.CollectResults(results)
-	fmt.Printf("\n✅ 削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
+	common.PrintProcessSummary(ctx, i18n.T("削除"), results)
 
-	return common.CollectCleanupResult("ECRリポジトリ", results)
+	return common.CollectCleanupResult(i18n.T("ECRリポジトリ"), results)
 }
 
 // CleanupRepositoriesByFilter はフィルターに基づいてリポジトリを削除する
 // exact が true の場合、大文字小文字を区別します
-func CleanupRepositoriesByFilter(ecrClient *ecr.Client, filter string, exact bool) error {
+// p が指定された場合は削除せず、プランにアクションを追加します
+func CleanupRepositoriesByFilter(ctx context.Context, ecrClient EcrApi, filter string, exact bool, tags *tagging.Selector, age *common.AgeFilter, p *plan.Plan) error {
 	// フィルターに一致するリポジトリを取得
-	repositories, err := GetEcrRepositoriesByFilter(ecrClient, filter, exact)
+	repositories, err := GetEcrRepositoriesByFilter(ctx, ecrClient, filter, exact, tags, age)
 	if err != nil {
-		return fmt.Errorf("❌ ECRリポジトリ一覧取得エラー: %w", err)
+		return fmt.Errorf(i18n.T("❌ ECRリポジトリ一覧取得エラー: %w"), err)
 	}
 
 	if len(repositories) == 0 {
-		fmt.Printf("フィルター '%s' に一致するECRリポジトリが見つかりませんでした\n", filter)
+		common.Infof(ctx, i18n.T("フィルター '%s' に一致するECRリポジトリが見つかりませんでした\n"), filter)
+		return nil
+	}
+
+	// プラン作成モードの場合は削除せずにプランへ追加
+	if p != nil {
+		actions, err := PlanRepositoryDeletion(ctx, ecrClient, repositories)
+		if err != nil {
+			return fmt.Errorf("❌ %w", err)
+		}
+		p.Add(actions...)
+		return nil
+	}
+
+	// 削除前の確認
+	ok, err := confirm.AskDeletion(i18n.T("ECRリポジトリ"), len(repositories))
+	if err != nil {
+		return fmt.Errorf("❌ %w", err)
+	}
+	if !ok {
 		return nil
 	}
 
 	// リポジトリを削除
-	result := CleanupEcrRepositories(ecrClient, repositories)
+	result := CleanupEcrRepositories(ctx, ecrClient, repositories)
 	if len(result.Failed) > 0 {
-		return fmt.Errorf("❌ %d個のECRリポジトリの削除に失敗しました", len(result.Failed))
+		return fmt.Errorf(i18n.T("❌ %d個のECRリポジトリの削除に失敗しました"), len(result.Failed))
 	}
 
-	fmt.Println("✅ ECRリポジトリの削除が完了しました")
+	common.Infof(ctx, "%s\n", i18n.T("✅ ECRリポジトリの削除が完了しました"))
 	return nil
 }
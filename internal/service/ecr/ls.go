@@ -1,10 +1,15 @@
 package ecr
 
 import (
-	"awstk/internal/service/common"
 	"context"
 	"errors"
 	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecr"
@@ -12,7 +17,7 @@ import (
 )
 
 // ListEcrRepositories はECRリポジトリの一覧を取得する関数
-func ListEcrRepositories(client *ecr.Client) ([]RepositoryInfo, error) {
+func ListEcrRepositories(ctx context.Context, client EcrApi) ([]RepositoryInfo, error) {
 	var repositories []RepositoryInfo
 	var nextToken *string
 
@@ -21,9 +26,9 @@ func ListEcrRepositories(client *ecr.Client) ([]RepositoryInfo, error) {
 			NextToken: nextToken,
 		}
 
-		result, err := client.DescribeRepositories(context.Background(), input)
+		result, err := client.DescribeRepositories(ctx, input)
 		if err != nil {
-			return nil, fmt.Errorf("リポジトリ一覧取得エラー: %w", err)
+			return nil, fmt.Errorf(i18n.T("リポジトリ一覧取得エラー: %w"), err)
 		}
 
 		// 各リポジトリの基本情報を取得（追加APIリクエストなし）
@@ -47,20 +52,20 @@ func ListEcrRepositories(client *ecr.Client) ([]RepositoryInfo, error) {
 }
 
 // GetRepositoryImageCount はリポジトリ内のイメージ数を取得する関数
-func GetRepositoryImageCount(client *ecr.Client, repoName string) (int, error) {
+func GetRepositoryImageCount(ctx context.Context, client EcrApi, repoName string) (int, error) {
 	input := &ecr.DescribeImagesInput{
 		RepositoryName: aws.String(repoName),
 		MaxResults:     aws.Int32(1), // カウントだけ必要なので最小限に
 	}
 
-	result, err := client.DescribeImages(context.Background(), input)
+	result, err := client.DescribeImages(ctx, input)
 	if err != nil {
 		return 0, err
 	}
 
 	// NextTokenがある場合は、全件取得してカウント
 	if result.NextToken != nil {
-		imageDetails, err := getRepositoryImageDetails(client, repoName)
+		imageDetails, err := getRepositoryImageDetails(ctx, client, repoName)
 		if err != nil {
 			return 0, err
 		}
@@ -71,7 +76,7 @@ func GetRepositoryImageCount(client *ecr.Client, repoName string) (int, error) {
 }
 
 // getRepositoryImageDetails はリポジトリ内のイメージ詳細を取得する関数
-func getRepositoryImageDetails(client *ecr.Client, repoName string) ([]types.ImageDetail, error) {
+func getRepositoryImageDetails(ctx context.Context, client EcrApi, repoName string) ([]types.ImageDetail, error) {
 	var imageDetails []types.ImageDetail
 	var nextToken *string
 
@@ -81,7 +86,7 @@ func getRepositoryImageDetails(client *ecr.Client, repoName string) ([]types.Ima
 			NextToken:      nextToken,
 		}
 
-		result, err := client.DescribeImages(context.Background(), input)
+		result, err := client.DescribeImages(ctx, input)
 		if err != nil {
 			return nil, err
 		}
@@ -98,13 +103,13 @@ func getRepositoryImageDetails(client *ecr.Client, repoName string) ([]types.Ima
 }
 
 // FilterEmptyRepositories は空のリポジトリのみを返す関数
-func FilterEmptyRepositories(client *ecr.Client, repositories []RepositoryInfo) ([]RepositoryInfo, error) {
+func FilterEmptyRepositories(ctx context.Context, client EcrApi, repositories []RepositoryInfo) ([]RepositoryInfo, error) {
 	var emptyRepos []RepositoryInfo
 
 	for _, repo := range repositories {
-		imageCount, err := GetRepositoryImageCount(client, repo.RepositoryName)
+		imageCount, err := GetRepositoryImageCount(ctx, client, repo.RepositoryName)
 		if err != nil {
-			return nil, fmt.Errorf("イメージ数取得エラー (%s): %w", repo.RepositoryName, err)
+			return nil, fmt.Errorf(i18n.T("イメージ数取得エラー (%s): %w"), repo.RepositoryName, err)
 		}
 
 		repo.ImageCount = imageCount
@@ -117,13 +122,13 @@ func FilterEmptyRepositories(client *ecr.Client, repositories []RepositoryInfo)
 }
 
 // FilterNoLifecycleRepositories はライフサイクルポリシーが未設定のリポジトリのみを返す関数
-func FilterNoLifecycleRepositories(client *ecr.Client, repositories []RepositoryInfo) ([]RepositoryInfo, error) {
+func FilterNoLifecycleRepositories(ctx context.Context, client EcrApi, repositories []RepositoryInfo) ([]RepositoryInfo, error) {
 	var noLifecycleRepos []RepositoryInfo
 
 	for _, repo := range repositories {
-		hasLifecycle, err := CheckLifecyclePolicy(client, repo.RepositoryName)
+		hasLifecycle, err := CheckLifecyclePolicy(ctx, client, repo.RepositoryName)
 		if err != nil {
-			return nil, fmt.Errorf("ライフサイクルポリシー確認エラー (%s): %w", repo.RepositoryName, err)
+			return nil, fmt.Errorf(i18n.T("ライフサイクルポリシー確認エラー (%s): %w"), repo.RepositoryName, err)
 		}
 
 		repo.HasLifecycle = hasLifecycle
@@ -136,8 +141,8 @@ func FilterNoLifecycleRepositories(client *ecr.Client, repositories []Repository
 }
 
 // CheckLifecyclePolicy はリポジトリにライフサイクルポリシーが設定されているか確認する関数
-func CheckLifecyclePolicy(client *ecr.Client, repoName string) (bool, error) {
-	_, err := client.GetLifecyclePolicy(context.Background(), &ecr.GetLifecyclePolicyInput{
+func CheckLifecyclePolicy(ctx context.Context, client EcrApi, repoName string) (bool, error) {
+	_, err := client.GetLifecyclePolicy(ctx, &ecr.GetLifecyclePolicyInput{
 		RepositoryName: aws.String(repoName),
 	})
 
@@ -153,11 +158,11 @@ func CheckLifecyclePolicy(client *ecr.Client, repoName string) (bool, error) {
 }
 
 // EnrichRepositoryDetails はリポジトリの詳細情報を取得して追加する関数
-func EnrichRepositoryDetails(client *ecr.Client, repo *RepositoryInfo) error {
+func EnrichRepositoryDetails(ctx context.Context, client EcrApi, repo *RepositoryInfo) error {
 	// イメージ詳細を取得
-	imageDetails, err := getRepositoryImageDetails(client, repo.RepositoryName)
+	imageDetails, err := getRepositoryImageDetails(ctx, client, repo.RepositoryName)
 	if err != nil {
-		return fmt.Errorf("イメージ詳細取得エラー: %w", err)
+		return fmt.Errorf(i18n.T("イメージ詳細取得エラー: %w"), err)
 	}
 
 	repo.ImageCount = len(imageDetails)
@@ -169,7 +174,7 @@ func EnrichRepositoryDetails(client *ecr.Client, repo *RepositoryInfo) error {
 	}
 
 	// ライフサイクルポリシーの有無を確認
-	repo.HasLifecycle, _ = CheckLifecyclePolicy(client, repo.RepositoryName)
+	repo.HasLifecycle, _ = CheckLifecyclePolicy(ctx, client, repo.RepositoryName)
 
 	return nil
 }
@@ -178,54 +183,40 @@ func EnrichRepositoryDetails(client *ecr.Client, repo *RepositoryInfo) error {
 func DisplayRepositoryDetails(repo RepositoryInfo) {
 	fmt.Printf("  - %s\n", repo.RepositoryName)
 	fmt.Printf("    URI: %s\n", repo.RepositoryUri)
-	fmt.Printf("    イメージ数: %d個\n", repo.ImageCount)
-	fmt.Printf("    サイズ: %s\n", common.FormatBytes(repo.SizeInBytes))
+	fmt.Printf(i18n.T("    イメージ数: %d個\n"), repo.ImageCount)
+	fmt.Printf(i18n.T("    サイズ: %s\n"), common.FormatBytes(repo.SizeInBytes))
 
 	if repo.CreatedAt != nil {
-		fmt.Printf("    作成日: %s\n", repo.CreatedAt.Format("2006-01-02 15:04:05"))
+		fmt.Printf(i18n.T("    作成日: %s\n"), repo.CreatedAt.Format("2006-01-02 15:04:05"))
 	}
 
-	lifecycleStatus := "設定済み"
+	lifecycleStatus := i18n.T("設定済み")
 	if !repo.HasLifecycle {
-		lifecycleStatus = "未設定"
+		lifecycleStatus = i18n.T("未設定")
 	}
-	fmt.Printf("    ライフサイクルポリシー: %s\n", lifecycleStatus)
+	fmt.Printf(i18n.T("    ライフサイクルポリシー: %s\n"), lifecycleStatus)
 }
 
 // ListRepositories はオプションに基づいてリポジトリ一覧を取得・表示する
-func ListRepositories(ecrClient *ecr.Client, opts ListOptions) error {
+func ListRepositories(ctx context.Context, ecrClient EcrApi, opts ListOptions) error {
 	// リポジトリ一覧を取得
-	repositories, err := ListEcrRepositories(ecrClient)
+	repositories, err := ListEcrRepositories(ctx, ecrClient)
 	if err != nil {
-		return common.FormatListError("ECRリポジトリ", err)
+		return common.FormatListError(i18n.T("ECRリポジトリ"), err)
 	}
 
 	if len(repositories) == 0 {
-		fmt.Println(common.FormatEmptyMessage("ECRリポジトリ"))
+		common.PrintEmptyResult(common.FormatEmptyMessage(i18n.T("ECRリポジトリ")))
 		return nil
 	}
 
 	// フィルタリング処理
-	filteredRepos := repositories
-	var conditions []string
-
-	if opts.EmptyOnly {
-		conditions = append(conditions, "空の")
-		filteredRepos, err = FilterEmptyRepositories(ecrClient, filteredRepos)
-		if err != nil {
-			return fmt.Errorf("❌ 空リポジトリチェックでエラー: %w", err)
-		}
-	}
-
-	if opts.NoLifecycle {
-		conditions = append(conditions, "ライフサイクルポリシー未設定の")
-		filteredRepos, err = FilterNoLifecycleRepositories(ecrClient, filteredRepos)
-		if err != nil {
-			return fmt.Errorf("❌ ライフサイクルポリシーチェックでエラー: %w", err)
-		}
+	filteredRepos, err := filterRepositories(ctx, ecrClient, common.Target{}, repositories, opts)
+	if err != nil {
+		return err
 	}
 
-	title := common.GenerateFilteredTitle("ECRリポジトリ", conditions...)
+	title := common.GenerateFilteredTitle(i18n.T("ECRリポジトリ"), listConditions(opts)...)
 
 	// 結果表示
 	if !opts.ShowDetails {
@@ -233,12 +224,91 @@ func ListRepositories(ecrClient *ecr.Client, opts ListOptions) error {
 		displaySimpleList(filteredRepos, title)
 	} else {
 		// 詳細表示
-		displayDetailedList(ecrClient, filteredRepos, title)
+		displayDetailedList(ctx, ecrClient, filteredRepos, title)
 	}
 
 	return nil
 }
 
+// ListRepositoriesAcross は複数のアカウント・リージョンのリポジトリを並列に取得し、アカウント列・リージョン列付きで表示する
+// 詳細表示の場合、詳細取得に失敗したリポジトリは警告を標準エラー出力に表示してスキップする
+func ListRepositoriesAcross(ctx context.Context, targets []common.Target, newClient func(target common.Target) EcrApi, opts ListOptions) error {
+	results := common.FetchAcrossTargets(ctx, targets, func(ctx context.Context, target common.Target) ([]RepositoryInfo, error) {
+		client := newClient(target)
+		repositories, err := ListEcrRepositories(ctx, client)
+		if err != nil {
+			return nil, err
+		}
+		repositories, err = filterRepositories(ctx, client, target, repositories, opts)
+		if err != nil {
+			return nil, err
+		}
+		if !opts.ShowDetails {
+			return repositories, nil
+		}
+		return enrichRepositories(ctx, client, repositories), nil
+	})
+
+	toTableData := repositoryNamesToTableData
+	if opts.ShowDetails {
+		toTableData = repositoriesToRecordData
+	}
+
+	return common.DisplayTargetList(
+		results,
+		common.GenerateFilteredTitle(i18n.T("ECRリポジトリ"), listConditions(opts)...),
+		toTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("ECRリポジトリ")),
+		},
+	)
+}
+
+// filterRepositories はオプションに基づいてリポジトリを絞り込む
+func filterRepositories(ctx context.Context, ecrClient EcrApi, target common.Target, repos []RepositoryInfo, opts ListOptions) ([]RepositoryInfo, error) {
+	repos, err := tagging.Select(ctx, opts.Tags, target, tagging.TypeEcrRepository, repos, func(r RepositoryInfo) string {
+		return "repository/" + r.RepositoryName
+	})
+	if err != nil {
+		return nil, fmt.Errorf("❌ %w", err)
+	}
+	if opts.EmptyOnly {
+		repos, err = FilterEmptyRepositories(ctx, ecrClient, repos)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("❌ 空リポジトリチェックでエラー: %w"), err)
+		}
+	}
+	if opts.NoLifecycle {
+		repos, err = FilterNoLifecycleRepositories(ctx, ecrClient, repos)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("❌ ライフサイクルポリシーチェックでエラー: %w"), err)
+		}
+	}
+	return repos, nil
+}
+
+// listConditions はタイトルに表示する絞り込み条件を返す
+func listConditions(opts ListOptions) []string {
+	var conditions []string
+	if opts.EmptyOnly {
+		conditions = append(conditions, i18n.T("空の"))
+	}
+	if opts.NoLifecycle {
+		conditions = append(conditions, i18n.T("ライフサイクルポリシー未設定の"))
+	}
+	return conditions
+}
+
+// repositoryNamesToTableData はリポジトリ名のみのテーブルデータに変換
+func repositoryNamesToTableData(repos []RepositoryInfo) ([]common.TableColumn, [][]string) {
+	names := make([]string, len(repos))
+	for i, repo := range repos {
+		names[i] = repo.RepositoryName
+	}
+	return common.NamesToTableData(names)
+}
+
 // displaySimpleList はリポジトリ一覧をシンプル形式で表示
 func displaySimpleList(repos []RepositoryInfo, title string) {
 	names := make([]string, len(repos))
@@ -248,25 +318,81 @@ func displaySimpleList(repos []RepositoryInfo, title string) {
 	common.PrintSimpleList(common.ListOutput{
 		Title:        title,
 		Items:        names,
-		ResourceName: "リポジトリ",
+		ResourceName: i18n.T("リポジトリ"),
 		ShowCount:    true,
 	})
 }
 
 // displayDetailedList はリポジトリ一覧を詳細形式で表示
-func displayDetailedList(ecrClient *ecr.Client, repos []RepositoryInfo, title string) {
+func displayDetailedList(ctx context.Context, ecrClient EcrApi, repos []RepositoryInfo, title string) {
+	if common.IsStructuredOutput() {
+		displayDetailedRecords(ctx, ecrClient, repos)
+		return
+	}
+
 	fmt.Printf("%s:\n", title)
 	if len(repos) == 0 {
-		fmt.Println("該当するリポジトリはありませんでした")
+		fmt.Println(i18n.T("該当するリポジトリはありませんでした"))
 		return
 	}
 
 	for i := range repos {
-		if err := EnrichRepositoryDetails(ecrClient, &repos[i]); err != nil {
-			fmt.Printf("  - %s (詳細取得エラー: %v)\n", repos[i].RepositoryName, err)
+		if err := EnrichRepositoryDetails(ctx, ecrClient, &repos[i]); err != nil {
+			fmt.Printf(i18n.T("  - %s (詳細取得エラー: %v)\n"), repos[i].RepositoryName, err)
 			continue
 		}
 		DisplayRepositoryDetails(repos[i])
 	}
-	fmt.Printf("\n合計: %d個のリポジトリ\n", len(repos))
+	fmt.Printf(i18n.T("\n合計: %d個のリポジトリ\n"), len(repos))
+}
+
+// displayDetailedRecords はリポジトリの詳細情報を構造化出力用のレコードとして出力
+// 詳細取得に失敗したリポジトリは警告を標準エラー出力に表示してスキップする
+func displayDetailedRecords(ctx context.Context, ecrClient EcrApi, repos []RepositoryInfo) {
+	columns, data := repositoriesToRecordData(enrichRepositories(ctx, ecrClient, repos))
+	common.PrintTable("", columns, data)
+}
+
+// enrichRepositories はリポジトリの詳細情報を取得する
+// 詳細取得に失敗したリポジトリは警告を標準エラー出力に表示して結果から除外する
+func enrichRepositories(ctx context.Context, ecrClient EcrApi, repos []RepositoryInfo) []RepositoryInfo {
+	enriched := make([]RepositoryInfo, 0, len(repos))
+	for i := range repos {
+		if err := EnrichRepositoryDetails(ctx, ecrClient, &repos[i]); err != nil {
+			fmt.Fprintf(os.Stderr, i18n.T("%s %s の詳細取得エラー: %v\n"), common.WarningIcon, repos[i].RepositoryName, err)
+			continue
+		}
+		enriched = append(enriched, repos[i])
+	}
+	return enriched
+}
+
+// repositoriesToRecordData はリポジトリの詳細情報をテーブルデータに変換
+func repositoriesToRecordData(repos []RepositoryInfo) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("リポジトリ名"), Key: "name"},
+		{Header: "URI", Key: "uri"},
+		{Header: i18n.T("イメージ数"), Key: "imageCount"},
+		{Header: i18n.T("サイズ(バイト)"), Key: "sizeBytes"},
+		{Header: i18n.T("作成日"), Key: "createdAt"},
+		{Header: i18n.T("ライフサイクルポリシー"), Key: "hasLifecyclePolicy"},
+	}
+
+	data := make([][]string, 0, len(repos))
+	for i := range repos {
+		createdAt := ""
+		if repos[i].CreatedAt != nil {
+			createdAt = repos[i].CreatedAt.Format("2006-01-02 15:04:05")
+		}
+		data = append(data, []string{
+			repos[i].RepositoryName,
+			repos[i].RepositoryUri,
+			strconv.Itoa(repos[i].ImageCount),
+			strconv.FormatInt(repos[i].SizeInBytes, 10),
+			createdAt,
+			strconv.FormatBool(repos[i].HasLifecycle),
+		})
+	}
+
+	return columns, data
 }
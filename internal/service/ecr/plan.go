@@ -0,0 +1,58 @@
+package ecr
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/plan"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+)
+
+// PlanRepositoryDeletion は指定したECRリポジトリの削除アクションを作成します
+// 存在しないリポジトリは結果に含まれません（apply時の再検証でも使用）
+func PlanRepositoryDeletion(ctx context.Context, ecrClient EcrApi, repoNames []string) ([]plan.Action, error) {
+	targets := make(map[string]bool, len(repoNames))
+	for _, name := range repoNames {
+		targets[name] = true
+	}
+
+	actions := []plan.Action{}
+	input := &ecr.DescribeRepositoriesInput{}
+	for {
+		output, err := ecrClient.DescribeRepositories(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("ecrリポジトリ一覧取得エラー: %w"), err)
+		}
+
+		for _, repo := range output.Repositories {
+			name := aws.ToString(repo.RepositoryName)
+			if !targets[name] {
+				continue
+			}
+			actions = append(actions, plan.Action{
+				Type:   plan.ResourceEcrRepository,
+				Action: plan.ActionDelete,
+				Name:   name,
+				Id:     aws.ToString(repo.RepositoryArn),
+				Fingerprint: map[string]string{
+					"createdAt": plan.FormatTime(repo.CreatedAt),
+				},
+			})
+		}
+
+		if output.NextToken == nil {
+			break
+		}
+		input.NextToken = output.NextToken
+	}
+	return actions, nil
+}
+
+// ApplyRepositoryDeletion はプランのアクションに従ってECRリポジトリを削除します
+func ApplyRepositoryDeletion(ctx context.Context, ecrClient EcrApi, actions []plan.Action) common.CleanupResult {
+	return CleanupEcrRepositories(ctx, ecrClient, plan.Names(actions))
+}
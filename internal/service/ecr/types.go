@@ -1,6 +1,10 @@
 package ecr
 
-import "time"
+import (
+	"time"
+
+	"github.com/ngsw877/awstk/internal/service/tagging"
+)
 
 // RepositoryInfo はリポジトリの詳細情報を保持する構造体
 type RepositoryInfo struct {
@@ -14,7 +18,8 @@ type RepositoryInfo struct {
 
 // ListOptions はリポジトリ一覧表示のオプション
 type ListOptions struct {
-	EmptyOnly   bool // 空のリポジトリのみを表示
-	NoLifecycle bool // ライフサイクルポリシー未設定のリポジトリのみを表示
-	ShowDetails bool // 詳細情報を表示
+	EmptyOnly   bool              // 空のリポジトリのみを表示
+	NoLifecycle bool              // ライフサイクルポリシー未設定のリポジトリのみを表示
+	ShowDetails bool              // 詳細情報を表示
+	Tags        *tagging.Selector // 指定された場合はタグ条件に一致するリポジトリのみを表示
 }
@@ -1,35 +1,38 @@
 package ecs
 
 import (
-	awsCtx "awstk/internal/aws"
-	"awstk/internal/cli"
 	"context"
 	"fmt"
 
+	awsCtx "github.com/ngsw877/awstk/internal/aws"
+	"github.com/ngsw877/awstk/internal/cli"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 )
 
 // GetRunningTask 実行中のタスクを取得する
-func GetRunningTask(ecsClient *ecs.Client, clusterName, serviceName string) (string, error) {
-	fmt.Println("🔍 実行中のタスクを検索中...")
+func GetRunningTask(ctx context.Context, ecsClient *ecs.Client, clusterName, serviceName string) (string, error) {
+	common.Infof(ctx, "%s\n", i18n.T("🔍 実行中のタスクを検索中..."))
 
 	// タスク一覧を取得
-	taskList, err := ecsClient.ListTasks(context.Background(), &ecs.ListTasksInput{
+	taskList, err := ecsClient.ListTasks(ctx, &ecs.ListTasksInput{
 		Cluster:     aws.String(clusterName),
 		ServiceName: aws.String(serviceName),
 	})
 	if err != nil {
-		return "", fmt.Errorf("タスク一覧取得エラー: %w", err)
+		return "", fmt.Errorf(i18n.T("タスク一覧取得エラー: %w"), err)
 	}
 
 	if len(taskList.TaskArns) == 0 {
-		return "", fmt.Errorf("クラスター '%s' のサービス '%s' で実行中のタスクが見つかりませんでした", clusterName, serviceName)
+		return "", fmt.Errorf(i18n.T("クラスター '%s' のサービス '%s' で実行中のタスクが見つかりませんでした"), clusterName, serviceName)
 	}
 
 	// 最初のタスクを使用
 	taskId := taskList.TaskArns[0]
-	fmt.Println("✅ 実行中のタスク '" + taskId + "' を検出しました")
+	common.Infof(ctx, i18n.T("✅ 実行中のタスク '%s' を検出しました\n"), taskId)
 
 	return taskId, nil
 }
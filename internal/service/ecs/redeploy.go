@@ -2,17 +2,21 @@ package ecs
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 )
 
 // ForceRedeployService はECSサービスを強制再デプロイします
-func ForceRedeployService(ecsClient *ecs.Client, clusterName, serviceName string) error {
-	fmt.Printf("🚀 ECSサービス '%s' を強制再デプロイします...\n", serviceName)
+func ForceRedeployService(ctx context.Context, ecsClient *ecs.Client, clusterName, serviceName string) error {
+	common.Infof(ctx, i18n.T("🚀 ECSサービス '%s' を強制再デプロイします...\n"), serviceName)
 
 	updateInput := &ecs.UpdateServiceInput{
 		Cluster:            aws.String(clusterName),
@@ -20,19 +24,19 @@ func ForceRedeployService(ecsClient *ecs.Client, clusterName, serviceName string
 		ForceNewDeployment: true,
 	}
 
-	_, err := ecsClient.UpdateService(context.Background(), updateInput)
+	_, err := ecsClient.UpdateService(ctx, updateInput)
 
 	if err != nil {
-		return fmt.Errorf("サービスの強制再デプロイに失敗しました: %w", err)
+		return fmt.Errorf(i18n.T("サービスの強制再デプロイに失敗しました: %w"), err)
 	}
 
-	fmt.Println("✅ 強制再デプロイを開始しました")
+	common.Infof(ctx, "%s\n", i18n.T("✅ 強制再デプロイを開始しました"))
 	return nil
 }
 
 // WaitForDeploymentComplete はECSサービスのデプロイが完了するまで待機します
-func WaitForDeploymentComplete(ecsClient *ecs.Client, opts WaitDeploymentOptions) error {
-	fmt.Println("⏳ デプロイ完了を待機しています...")
+func WaitForDeploymentComplete(ctx context.Context, ecsClient *ecs.Client, opts WaitDeploymentOptions) error {
+	common.Infof(ctx, "%s\n", i18n.T("⏳ デプロイ完了を待機しています..."))
 
 	start := time.Now()
 	timeout := time.Duration(opts.TimeoutSeconds) * time.Second
@@ -40,19 +44,25 @@ func WaitForDeploymentComplete(ecsClient *ecs.Client, opts WaitDeploymentOptions
 	defer ticker.Stop()
 
 	for {
-		<-ticker.C
+		select {
+		case <-ctx.Done():
+			elapsed := time.Since(start).Round(time.Second)
+			common.Warnf(ctx, i18n.T("⚠️  デプロイ完了の待機を中断しました（経過時間: %s）。デプロイ自体はAWS側で継続されます\n"), elapsed)
+			return fmt.Errorf(i18n.T("デプロイ完了の待機が中断されました: %w"), ctx.Err())
+		case <-ticker.C:
+		}
 
 		// サービスの詳細を取得
-		resp, err := ecsClient.DescribeServices(context.Background(), &ecs.DescribeServicesInput{
+		resp, err := ecsClient.DescribeServices(ctx, &ecs.DescribeServicesInput{
 			Cluster:  aws.String(opts.ClusterName),
 			Services: []string{opts.ServiceName},
 		})
 		if err != nil {
-			return fmt.Errorf("サービス情報の取得に失敗しました: %w", err)
+			return fmt.Errorf(i18n.T("サービス情報の取得に失敗しました: %w"), err)
 		}
 
 		if len(resp.Services) == 0 {
-			return fmt.Errorf("サービス '%s' が見つかりません", opts.ServiceName)
+			return fmt.Errorf(i18n.T("サービス '%s' が見つかりません"), opts.ServiceName)
 		}
 
 		service := resp.Services[0]
@@ -67,7 +77,7 @@ func WaitForDeploymentComplete(ecsClient *ecs.Client, opts WaitDeploymentOptions
 		}
 
 		if primaryDeployment == nil {
-			return fmt.Errorf("プライマリデプロイメントが見つかりません")
+			return errors.New(i18n.T("プライマリデプロイメントが見つかりません"))
 		}
 
 		runningCount := int(primaryDeployment.RunningCount)
@@ -76,18 +86,18 @@ func WaitForDeploymentComplete(ecsClient *ecs.Client, opts WaitDeploymentOptions
 
 		// 経過時間と進捗状況を表示
 		elapsed := time.Since(start).Round(time.Second)
-		fmt.Printf("⏱️ 経過時間: %s - デプロイ状況: %s - 実行中タスク: %d / 希望タスク数: %d\n",
+		common.Infof(ctx, i18n.T("⏱️ 経過時間: %s - デプロイ状況: %s - 実行中タスク: %d / 希望タスク数: %d\n"),
 			elapsed, deploymentStatus, runningCount, desiredCount)
 
 		// デプロイ完了の確認
 		if deploymentStatus == "PRIMARY" && runningCount == desiredCount && desiredCount > 0 {
-			fmt.Println("✅ デプロイが完了しました")
+			common.Infof(ctx, "%s\n", i18n.T("✅ デプロイが完了しました"))
 			return nil
 		}
 
 		// タイムアウトのチェック
 		if time.Since(start) > timeout {
-			return fmt.Errorf("タイムアウト: %d秒経過しましたがデプロイは完了していません", opts.TimeoutSeconds)
+			return fmt.Errorf(i18n.T("タイムアウト: %d秒経過しましたがデプロイは完了していません"), opts.TimeoutSeconds)
 		}
 	}
 }
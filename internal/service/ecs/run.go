@@ -7,42 +7,52 @@ import (
 	"strings"
 	"time"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 	"github.com/aws/aws-sdk-go-v2/service/ecs/types"
 )
 
 // waitForTaskStopped はタスクが停止するまで待機し、コンテナの終了コードを返します
-func waitForTaskStopped(ecsClient *ecs.Client, opts waitTaskOptions) (int, error) {
-	fmt.Println("⏳ タスクの完了を待機中...")
+func waitForTaskStopped(ctx context.Context, ecsClient *ecs.Client, opts waitTaskOptions) (int, error) {
+	common.Infof(ctx, "%s\n", i18n.T("⏳ タスクの完了を待機中..."))
 
-	timeout := time.Duration(opts.TimeoutSeconds) * time.Second
+	timeout := time.NewTimer(time.Duration(opts.TimeoutSeconds) * time.Second)
+	defer timeout.Stop()
 	ticker := time.NewTicker(5 * time.Second)
 	defer ticker.Stop()
 	startTime := time.Now()
+	lastStatus := i18n.T("不明")
 
 	for {
 		select {
+		case <-ctx.Done():
+			elapsed := time.Since(startTime).Round(time.Second)
+			common.Warnf(ctx, i18n.T("⚠️  待機を中断しました（経過時間: %s - 最終確認時のタスク状態: %s）\n"), elapsed, lastStatus)
+			common.Warnf(ctx, i18n.T("   タスク '%s' は停止されていないため、必要に応じて手動で停止してください\n"), opts.TaskArn)
+			return -1, fmt.Errorf(i18n.T("タスクの完了待機が中断されました: %w"), ctx.Err())
 		case <-ticker.C:
 			// タスクの状態を確認
-			resp, err := ecsClient.DescribeTasks(context.Background(), &ecs.DescribeTasksInput{
+			resp, err := ecsClient.DescribeTasks(ctx, &ecs.DescribeTasksInput{
 				Cluster: aws.String(opts.ClusterName),
 				Tasks:   []string{opts.TaskArn},
 			})
 			if err != nil {
-				return -1, fmt.Errorf("タスク情報の取得に失敗しました: %w", err)
+				return -1, fmt.Errorf(i18n.T("タスク情報の取得に失敗しました: %w"), err)
 			}
 
 			if len(resp.Tasks) == 0 {
-				return -1, fmt.Errorf("タスク '%s' が見つかりません", opts.TaskArn)
+				return -1, fmt.Errorf(i18n.T("タスク '%s' が見つかりません"), opts.TaskArn)
 			}
 
 			task := resp.Tasks[0]
-			lastStatus := *task.LastStatus
+			lastStatus = *task.LastStatus
 
 			// 経過時間と状態を表示
 			elapsed := time.Since(startTime).Round(time.Second)
-			fmt.Printf("⏱️ 経過時間: %s - タスク状態: %s\n", elapsed, lastStatus)
+			common.Infof(ctx, i18n.T("⏱️ 経過時間: %s - タスク状態: %s\n"), elapsed, lastStatus)
 
 			// タスクが停止した場合
 			if lastStatus == "STOPPED" {
@@ -50,7 +60,7 @@ func waitForTaskStopped(ecsClient *ecs.Client, opts waitTaskOptions) (int, error
 				for _, container := range task.Containers {
 					if *container.Name == opts.ContainerName {
 						if container.ExitCode == nil {
-							return -1, fmt.Errorf("コンテナ '%s' の終了コードが取得できませんでした", opts.ContainerName)
+							return -1, fmt.Errorf(i18n.T("コンテナ '%s' の終了コードが取得できませんでした"), opts.ContainerName)
 						}
 						exitCode := int(*container.ExitCode)
 						return exitCode, nil
@@ -62,17 +72,17 @@ func waitForTaskStopped(ecsClient *ecs.Client, opts waitTaskOptions) (int, error
 				for _, container := range task.Containers {
 					containerNames = append(containerNames, *container.Name)
 				}
-				return -1, fmt.Errorf("コンテナ '%s' がタスク内に見つかりません。利用可能なコンテナ: %s",
+				return -1, fmt.Errorf(i18n.T("コンテナ '%s' がタスク内に見つかりません。利用可能なコンテナ: %s"),
 					opts.ContainerName, strings.Join(containerNames, ", "))
 			}
-		case <-time.After(timeout):
-			return -1, fmt.Errorf("タイムアウト: %d秒経過しましたがタスクは停止していません", opts.TimeoutSeconds)
+		case <-timeout.C:
+			return -1, fmt.Errorf(i18n.T("タイムアウト: %d秒経過しましたがタスクは停止していません"), opts.TimeoutSeconds)
 		}
 	}
 }
 
 // RunAndWaitForTask はECSタスクを実行し、完了するまで待機します
-func RunAndWaitForTask(ecsClient *ecs.Client, opts RunAndWaitForTaskOptions) (int, error) {
+func RunAndWaitForTask(ctx context.Context, ecsClient *ecs.Client, opts RunAndWaitForTaskOptions) (int, error) {
 	// タスク定義とネットワーク設定を決定
 	var taskDefArn string
 	var networkConfig *types.NetworkConfiguration
@@ -80,18 +90,18 @@ func RunAndWaitForTask(ecsClient *ecs.Client, opts RunAndWaitForTaskOptions) (in
 	if opts.TaskDefinition != "" {
 		// タスク定義が直接指定された場合はそれを使用
 		taskDefArn = opts.TaskDefinition
-		fmt.Println("🔍 指定されたタスク定義を使用します: " + taskDefArn)
+		common.Infof(ctx, "%s\n", i18n.T("🔍 指定されたタスク定義を使用します: ")+taskDefArn)
 	} else {
 		// サービスからタスク定義を取得
-		fmt.Println("🔍 サービスの情報を取得中...")
-		service, err := describeService(ecsClient, opts.ClusterName, opts.ServiceName)
+		common.Infof(ctx, "%s\n", i18n.T("🔍 サービスの情報を取得中..."))
+		service, err := describeService(ctx, ecsClient, opts.ClusterName, opts.ServiceName)
 		if err != nil {
 			return -1, err
 		}
 
 		taskDefArn = *service.TaskDefinition
 		networkConfig = service.NetworkConfiguration
-		fmt.Println("🔍 サービスのタスク定義を使用します: " + taskDefArn)
+		common.Infof(ctx, "%s\n", i18n.T("🔍 サービスのタスク定義を使用します: ")+taskDefArn)
 	}
 
 	// コマンドをオーバーライド
@@ -111,7 +121,7 @@ func RunAndWaitForTask(ecsClient *ecs.Client, opts RunAndWaitForTaskOptions) (in
 			ContainerOverrides: containerOverrides,
 		}
 
-		fmt.Printf("🔍 コンテナ '%s' で実行するコマンド: %s\n", opts.ContainerName, opts.Command)
+		common.Infof(ctx, i18n.T("🔍 コンテナ '%s' で実行するコマンド: %s\n"), opts.ContainerName, opts.Command)
 	}
 
 	// タスク実行パラメータを設定
@@ -132,18 +142,18 @@ func RunAndWaitForTask(ecsClient *ecs.Client, opts RunAndWaitForTaskOptions) (in
 	}
 
 	// タスクを実行
-	fmt.Println("🚀 タスクを実行中...")
-	runResult, err := ecsClient.RunTask(context.Background(), runTaskInput)
+	common.Infof(ctx, "%s\n", i18n.T("🚀 タスクを実行中..."))
+	runResult, err := ecsClient.RunTask(ctx, runTaskInput)
 	if err != nil {
-		return -1, fmt.Errorf("タスクの実行に失敗しました: %w", err)
+		return -1, fmt.Errorf(i18n.T("タスクの実行に失敗しました: %w"), err)
 	}
 
 	if len(runResult.Tasks) == 0 {
-		return -1, errors.New("タスクの実行に失敗しました: タスクが作成されませんでした")
+		return -1, errors.New(i18n.T("タスクの実行に失敗しました: タスクが作成されませんでした"))
 	}
 
 	taskArn := *runResult.Tasks[0].TaskArn
-	fmt.Println("✅ タスクが開始されました: " + taskArn)
+	common.Infof(ctx, "%s\n", i18n.T("✅ タスクが開始されました: ")+taskArn)
 
 	// タスクが停止するまで待機
 	waitTaskOpts := waitTaskOptions{
@@ -152,7 +162,7 @@ func RunAndWaitForTask(ecsClient *ecs.Client, opts RunAndWaitForTaskOptions) (in
 		ContainerName:  opts.ContainerName,
 		TimeoutSeconds: opts.TimeoutSeconds,
 	}
-	exitCode, err := waitForTaskStopped(ecsClient, waitTaskOpts)
+	exitCode, err := waitForTaskStopped(ctx, ecsClient, waitTaskOpts)
 	if err != nil {
 		return -1, err
 	}
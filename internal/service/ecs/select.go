@@ -0,0 +1,155 @@
+package ecs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/selector"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+)
+
+// SelectClusterAndService はECSクラスターとサービスを一覧から対話的に選択させます
+// clusterName が指定されている場合はクラスターの選択を省略し、そのクラスターのサービスから選択させます
+func SelectClusterAndService(ctx context.Context, ecsClient *ecs.Client, clusterName string) (string, string, error) {
+	if clusterName == "" {
+		clusters, err := ListClusterNames(ctx, ecsClient)
+		if err != nil {
+			return "", "", fmt.Errorf(i18n.T("❌ ECSクラスター一覧の取得に失敗: %w"), err)
+		}
+		if len(clusters) == 0 {
+			return "", "", errors.New(i18n.T("❌ ECSクラスターが見つかりません"))
+		}
+
+		selected, err := selector.Select(i18n.T("ECSクラスターを選択してください"), namesToItems(clusters))
+		if err != nil {
+			return "", "", err
+		}
+		clusterName = selected.Value
+	}
+
+	services, err := ListServiceNames(ctx, ecsClient, clusterName)
+	if err != nil {
+		return "", "", fmt.Errorf(i18n.T("❌ ECSサービス一覧の取得に失敗: %w"), err)
+	}
+	if len(services) == 0 {
+		return "", "", fmt.Errorf(i18n.T("❌ クラスター '%s' にECSサービスが見つかりません"), clusterName)
+	}
+
+	selected, err := selector.Select(i18n.T("ECSサービスを選択してください"), namesToItems(services))
+	if err != nil {
+		return "", "", err
+	}
+	return clusterName, selected.Value, nil
+}
+
+// ListClusterNames はECSクラスター名の一覧を返します
+func ListClusterNames(ctx context.Context, ecsClient *ecs.Client) ([]string, error) {
+	return listNames(func(nextToken *string) ([]string, *string, error) {
+		resp, err := ecsClient.ListClusters(ctx, &ecs.ListClustersInput{NextToken: nextToken})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.ClusterArns, resp.NextToken, nil
+	})
+}
+
+// ListServiceNames は指定したクラスターのECSサービス名の一覧を返します
+func ListServiceNames(ctx context.Context, ecsClient *ecs.Client, clusterName string) ([]string, error) {
+	return listNames(func(nextToken *string) ([]string, *string, error) {
+		resp, err := ecsClient.ListServices(ctx, &ecs.ListServicesInput{Cluster: aws.String(clusterName), NextToken: nextToken})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.ServiceArns, resp.NextToken, nil
+	})
+}
+
+// FindServicesByTags はすべてのクラスターからタグ条件に一致するECSサービスを返します
+func FindServicesByTags(ctx context.Context, ecsClient *ecs.Client, tags *tagging.Selector) ([]Service, error) {
+	clusterArns, err := listArns(func(nextToken *string) ([]string, *string, error) {
+		resp, err := ecsClient.ListClusters(ctx, &ecs.ListClustersInput{NextToken: nextToken})
+		if err != nil {
+			return nil, nil, err
+		}
+		return resp.ClusterArns, resp.NextToken, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("❌ ECSクラスター一覧の取得に失敗: %w"), err)
+	}
+
+	var services []Service
+	serviceArns := make(map[Service]string)
+	for _, clusterArn := range clusterArns {
+		clusterName := resourceName(clusterArn)
+		arns, err := listArns(func(nextToken *string) ([]string, *string, error) {
+			resp, err := ecsClient.ListServices(ctx, &ecs.ListServicesInput{Cluster: aws.String(clusterArn), NextToken: nextToken})
+			if err != nil {
+				return nil, nil, err
+			}
+			return resp.ServiceArns, resp.NextToken, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("❌ ECSサービス一覧の取得に失敗: %w"), err)
+		}
+		for _, arn := range arns {
+			svc := Service{ClusterName: clusterName, ServiceName: resourceName(arn)}
+			services = append(services, svc)
+			serviceArns[svc] = arn
+		}
+	}
+
+	return tagging.Select(ctx, tags, common.Target{}, tagging.TypeEcsService, services, func(svc Service) string {
+		return serviceArns[svc]
+	})
+}
+
+// listNames はページングされた一覧APIからARNをすべて取得し、リソース名（ARNの末尾）の一覧を返します
+func listNames(list func(nextToken *string) ([]string, *string, error)) ([]string, error) {
+	arns, err := listArns(list)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(arns))
+	for i, arn := range arns {
+		names[i] = resourceName(arn)
+	}
+	return names, nil
+}
+
+// listArns はページングされた一覧APIからARNをすべて取得します
+func listArns(list func(nextToken *string) ([]string, *string, error)) ([]string, error) {
+	var arns []string
+	var nextToken *string
+	for {
+		page, next, err := list(nextToken)
+		if err != nil {
+			return nil, err
+		}
+		arns = append(arns, page...)
+		if next == nil {
+			return arns, nil
+		}
+		nextToken = next
+	}
+}
+
+// resourceName はARNの末尾のリソース名を返します
+func resourceName(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+// namesToItems はリソース名の一覧を選択肢に変換します
+func namesToItems(names []string) []selector.Item {
+	items := make([]selector.Item, len(names))
+	for i, name := range names {
+		items[i] = selector.Item{Value: name}
+	}
+	return items
+}
@@ -1,14 +1,18 @@
 package ecs
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 )
 
 // StartEcsService はECSサービスを起動します
-func StartEcsService(ecsClient *ecs.Client, aasClient *applicationautoscaling.Client, opts StartServiceOptions) error {
+func StartEcsService(ctx context.Context, ecsClient *ecs.Client, aasClient *applicationautoscaling.Client, opts StartServiceOptions) error {
 	capacityOpts := ServiceCapacityOptions{
 		ClusterName: opts.ClusterName,
 		ServiceName: opts.ServiceName,
@@ -16,10 +20,10 @@ func StartEcsService(ecsClient *ecs.Client, aasClient *applicationautoscaling.Cl
 		MaxCapacity: opts.MaxCapacity,
 	}
 
-	fmt.Println("🚀 サービスの起動を開始します...")
-	err := SetEcsServiceCapacity(aasClient, capacityOpts)
+	common.Infof(ctx, "%s\n", i18n.T("🚀 サービスの起動を開始します..."))
+	err := SetEcsServiceCapacity(ctx, aasClient, capacityOpts)
 	if err != nil {
-		return fmt.Errorf("❌ エラー: %w", err)
+		return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
 	}
 
 	waitOpts := waitOptions{
@@ -28,9 +32,9 @@ func StartEcsService(ecsClient *ecs.Client, aasClient *applicationautoscaling.Cl
 		TargetRunningCount: opts.MinCapacity,
 		TimeoutSeconds:     opts.TimeoutSeconds,
 	}
-	err = waitForServiceStatus(ecsClient, waitOpts)
+	err = waitForServiceStatus(ctx, ecsClient, waitOpts)
 	if err != nil {
-		return fmt.Errorf("❌ サービス起動監視エラー: %w", err)
+		return fmt.Errorf(i18n.T("❌ サービス起動監視エラー: %w"), err)
 	}
 
 	return nil
@@ -5,15 +5,18 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
 	autoscalingtypes "github.com/aws/aws-sdk-go-v2/service/applicationautoscaling/types"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 )
 
 // GetServiceStatus はECSサービスの状態を取得する
-func GetServiceStatus(ecsClient *ecs.Client, aasClient *applicationautoscaling.Client, opts StatusOptions) (*serviceStatus, error) {
+func GetServiceStatus(ctx context.Context, ecsClient *ecs.Client, aasClient *applicationautoscaling.Client, opts StatusOptions) (*serviceStatus, error) {
 	// サービス情報を取得
-	serviceResp, err := ecsClient.DescribeServices(context.Background(), &ecs.DescribeServicesInput{
+	serviceResp, err := ecsClient.DescribeServices(ctx, &ecs.DescribeServicesInput{
 		Cluster:  &opts.ClusterName,
 		Services: []string{opts.ServiceName},
 	})
@@ -47,17 +50,17 @@ func GetServiceStatus(ecsClient *ecs.Client, aasClient *applicationautoscaling.C
 	}
 
 	// タスク詳細を取得
-	tasks, err := getTaskDetails(ecsClient, opts.ClusterName, opts.ServiceName)
+	tasks, err := getTaskDetails(ctx, ecsClient, opts.ClusterName, opts.ServiceName)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get task details: %w", err)
 	}
 	status.Tasks = tasks
 
 	// Auto Scaling設定を取得
-	autoScaling, err := getAutoScalingInfo(aasClient, opts.ClusterName, opts.ServiceName)
+	autoScaling, err := getAutoScalingInfo(ctx, aasClient, opts.ClusterName, opts.ServiceName)
 	if err != nil {
 		// Auto Scalingが設定されていない場合はエラーではない
-		fmt.Printf("ℹ️  Auto Scaling情報の取得に失敗しました（設定されていない可能性があります）: %v\n", err)
+		common.Debugf(ctx, i18n.T("ℹ️  Auto Scaling情報の取得に失敗しました（設定されていない可能性があります）: %v\n"), err)
 	} else {
 		status.AutoScaling = autoScaling
 	}
@@ -66,9 +69,9 @@ func GetServiceStatus(ecsClient *ecs.Client, aasClient *applicationautoscaling.C
 }
 
 // getTaskDetails はサービスに関連するタスクの詳細を取得する
-func getTaskDetails(ecsClient *ecs.Client, clusterName, serviceName string) ([]taskInfo, error) {
+func getTaskDetails(ctx context.Context, ecsClient *ecs.Client, clusterName, serviceName string) ([]taskInfo, error) {
 	// サービスのタスクARNを取得
-	tasksResp, err := ecsClient.ListTasks(context.Background(), &ecs.ListTasksInput{
+	tasksResp, err := ecsClient.ListTasks(ctx, &ecs.ListTasksInput{
 		Cluster:     &clusterName,
 		ServiceName: &serviceName,
 	})
@@ -81,7 +84,7 @@ func getTaskDetails(ecsClient *ecs.Client, clusterName, serviceName string) ([]t
 	}
 
 	// タスクの詳細情報を取得
-	taskDetailsResp, err := ecsClient.DescribeTasks(context.Background(), &ecs.DescribeTasksInput{
+	taskDetailsResp, err := ecsClient.DescribeTasks(ctx, &ecs.DescribeTasksInput{
 		Cluster: &clusterName,
 		Tasks:   tasksResp.TaskArns,
 	})
@@ -119,11 +122,11 @@ func getTaskDetails(ecsClient *ecs.Client, clusterName, serviceName string) ([]t
 }
 
 // getAutoScalingInfo はAuto Scalingの設定情報を取得する
-func getAutoScalingInfo(autoScalingClient *applicationautoscaling.Client, clusterName, serviceName string) (*autoScalingInfo, error) {
+func getAutoScalingInfo(ctx context.Context, autoScalingClient *applicationautoscaling.Client, clusterName, serviceName string) (*autoScalingInfo, error) {
 	resourceId := fmt.Sprintf("service/%s/%s", clusterName, serviceName)
 
 	// Scalable Targetsを取得
-	targetsResp, err := autoScalingClient.DescribeScalableTargets(context.Background(), &applicationautoscaling.DescribeScalableTargetsInput{
+	targetsResp, err := autoScalingClient.DescribeScalableTargets(ctx, &applicationautoscaling.DescribeScalableTargetsInput{
 		ServiceNamespace: autoscalingtypes.ServiceNamespaceEcs,
 		ResourceIds:      []string{resourceId},
 	})
@@ -165,34 +168,34 @@ func extractTaskId(taskArn string) string {
 
 // ShowServiceStatus はECSサービスの状態を表示する
 func ShowServiceStatus(status *serviceStatus) {
-	fmt.Printf("🔍 ECSサービス状態: %s/%s\n\n", status.ClusterName, status.ServiceName)
+	fmt.Printf(i18n.T("🔍 ECSサービス状態: %s/%s\n\n"), status.ClusterName, status.ServiceName)
 
 	// サービス基本情報
-	fmt.Printf("📊 サービス情報:\n")
-	fmt.Printf("  状態:           %s\n", status.Status)
-	fmt.Printf("  タスク定義:      %s\n", status.TaskDefinition)
-	fmt.Printf("  期待数:         %d\n", status.DesiredCount)
-	fmt.Printf("  実行中:         %d\n", status.RunningCount)
-	fmt.Printf("  起動中:         %d\n", status.PendingCount)
+	fmt.Print(i18n.T("📊 サービス情報:\n"))
+	fmt.Printf(i18n.T("  状態:           %s\n"), status.Status)
+	fmt.Printf(i18n.T("  タスク定義:      %s\n"), status.TaskDefinition)
+	fmt.Printf(i18n.T("  期待数:         %d\n"), status.DesiredCount)
+	fmt.Printf(i18n.T("  実行中:         %d\n"), status.RunningCount)
+	fmt.Printf(i18n.T("  起動中:         %d\n"), status.PendingCount)
 
 	// Auto Scaling情報
 	if status.AutoScaling != nil {
-		fmt.Printf("\n⚖️  Auto Scaling設定:\n")
-		fmt.Printf("  最小キャパシティ: %d\n", status.AutoScaling.MinCapacity)
-		fmt.Printf("  最大キャパシティ: %d\n", status.AutoScaling.MaxCapacity)
+		fmt.Print(i18n.T("\n⚖️  Auto Scaling設定:\n"))
+		fmt.Printf(i18n.T("  最小キャパシティ: %d\n"), status.AutoScaling.MinCapacity)
+		fmt.Printf(i18n.T("  最大キャパシティ: %d\n"), status.AutoScaling.MaxCapacity)
 	}
 
 	// タスク詳細
-	fmt.Printf("\n📋 タスク詳細:\n")
+	fmt.Print(i18n.T("\n📋 タスク詳細:\n"))
 	if len(status.Tasks) == 0 {
-		fmt.Println("  実行中のタスクはありません")
+		fmt.Println(i18n.T("  実行中のタスクはありません"))
 	} else {
 		for i, task := range status.Tasks {
-			fmt.Printf("  %d. タスクID: %s\n", i+1, task.TaskId)
-			fmt.Printf("     状態:     %s\n", task.Status)
-			fmt.Printf("     ヘルス:   %s\n", task.HealthStatus)
+			fmt.Printf(i18n.T("  %d. タスクID: %s\n"), i+1, task.TaskId)
+			fmt.Printf(i18n.T("     状態:     %s\n"), task.Status)
+			fmt.Printf(i18n.T("     ヘルス:   %s\n"), task.HealthStatus)
 			if task.CreatedAt != "" {
-				fmt.Printf("     作成日時: %s\n", task.CreatedAt)
+				fmt.Printf(i18n.T("     作成日時: %s\n"), task.CreatedAt)
 			}
 			fmt.Println()
 		}
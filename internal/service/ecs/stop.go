@@ -1,14 +1,18 @@
 package ecs
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
 	"github.com/aws/aws-sdk-go-v2/service/ecs"
 )
 
 // StopEcsService はECSサービスを停止します
-func StopEcsService(ecsClient *ecs.Client, aasClient *applicationautoscaling.Client, opts StopServiceOptions) error {
+func StopEcsService(ctx context.Context, ecsClient *ecs.Client, aasClient *applicationautoscaling.Client, opts StopServiceOptions) error {
 	// キャパシティ設定オプションを作成（停止のため0に設定）
 	capacityOpts := ServiceCapacityOptions{
 		ClusterName: opts.ClusterName,
@@ -18,10 +22,10 @@ func StopEcsService(ecsClient *ecs.Client, aasClient *applicationautoscaling.Cli
 	}
 
 	// キャパシティを設定
-	fmt.Println("🛑 サービスの停止を開始します...")
-	err := SetEcsServiceCapacity(aasClient, capacityOpts)
+	common.Infof(ctx, "%s\n", i18n.T("🛑 サービスの停止を開始します..."))
+	err := SetEcsServiceCapacity(ctx, aasClient, capacityOpts)
 	if err != nil {
-		return fmt.Errorf("❌ エラー: %w", err)
+		return fmt.Errorf(i18n.T("❌ エラー: %w"), err)
 	}
 
 	// 停止完了を必ず待機
@@ -31,9 +35,9 @@ func StopEcsService(ecsClient *ecs.Client, aasClient *applicationautoscaling.Cli
 		TargetRunningCount: 0,
 		TimeoutSeconds:     opts.TimeoutSeconds,
 	}
-	err = waitForServiceStatus(ecsClient, waitOpts)
+	err = waitForServiceStatus(ctx, ecsClient, waitOpts)
 	if err != nil {
-		return fmt.Errorf("❌ サービス停止監視エラー: %w", err)
+		return fmt.Errorf(i18n.T("❌ サービス停止監視エラー: %w"), err)
 	}
 
 	return nil
@@ -1,5 +1,11 @@
 package ecs
 
+// Service はクラスター名とサービス名の組でECSサービスを表す構造体
+type Service struct {
+	ClusterName string
+	ServiceName string
+}
+
 // ServiceCapacityOptions はECSサービスのキャパシティ設定用パラメータを格納する構造体
 type ServiceCapacityOptions struct {
 	ClusterName string
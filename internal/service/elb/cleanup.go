@@ -0,0 +1,222 @@
+package elb
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// targetGroupReleaseTimeout はロードバランサーの削除後、ターゲットグループがリスナーから解放されるまで待機する最大時間
+const targetGroupReleaseTimeout = 2 * time.Minute
+
+// GetLoadBalancerNamesByFilter はフィルターに一致するロードバランサー名の一覧を取得します
+// exact が true の場合、大文字小文字を区別します
+// tags が指定された場合は、タグ条件にも一致するロードバランサーのみを返します
+// age が指定された場合は、作成日時の経過時間にも一致するロードバランサーのみを返します
+func GetLoadBalancerNamesByFilter(ctx context.Context, client ElbApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
+	lbs, err := GetLoadBalancersByFilter(ctx, client, searchString, "", exact, tags)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ロードバランサー一覧取得エラー: %w"), err)
+	}
+	lbs = common.SelectByAge(age, lbs, func(lb types.LoadBalancer) (*time.Time, *time.Time) {
+		return lb.CreatedTime, nil
+	})
+
+	foundLBs := []string{}
+	for _, lb := range lbs {
+		name := aws.ToString(lb.LoadBalancerName)
+		foundLBs = append(foundLBs, name)
+		common.Progressf(ctx, common.EventInfo, name, i18n.T("🔍 検出されたロードバランサー: %s\n"), age.Annotate(name, lb.CreatedTime, nil))
+	}
+	return foundLBs, nil
+}
+
+// CleanupLoadBalancers は指定したロードバランサー一覧を並列に削除します
+// 削除保護が有効なロードバランサーは解除せず、削除の失敗として扱います
+// ターゲットグループは削除しません（クリーンアップの対象にする場合は別途指定します）
+func CleanupLoadBalancers(ctx context.Context, client ElbApi, names []string) common.CleanupResult {
+	if len(names) == 0 {
+		return common.CleanupResult{ResourceType: i18n.T("ロードバランサー"), Deleted: []string{}, Failed: []string{}}
+	}
+
+	// 削除・ガードレールの確認にはARNが必要なため、名前から引けるようにする（取得に失敗した場合は各ロードバランサーの削除の失敗として扱う）
+	byName := make(map[string]types.LoadBalancer)
+	allLBs, listErr := describeLoadBalancers(ctx, client, "")
+	for _, lb := range allLBs {
+		byName[aws.ToString(lb.LoadBalancerName)] = lb
+	}
+
+	arnOf := func(name string) string {
+		return aws.ToString(byName[name].LoadBalancerArn)
+	}
+	names = guardrail.ExcludeNames(ctx, i18n.T("ロードバランサー"), tagging.TypeLoadBalancer, names, arnOf)
+
+	result := common.DeleteInParallel(ctx, i18n.T("ロードバランサー"), names, 5, func(name string) error {
+		if listErr != nil {
+			return listErr
+		}
+		lb, ok := byName[name]
+		if !ok {
+			return fmt.Errorf(i18n.T("ロードバランサー %s が見つかりません"), name)
+		}
+		return deleteLoadBalancer(ctx, client, lb, false, false)
+	})
+	result.SetArns(arnOf)
+	return result
+}
+
+// LoadBalancerDependencies はロードバランサーが参照しているリソース（ロードバランサーの削除後でなければ削除できないもの）
+type LoadBalancerDependencies struct {
+	TargetGroups   []string // ターゲットグループ名
+	SecurityGroups []string // セキュリティグループID
+}
+
+// GetLoadBalancerDependencies は指定したロードバランサーごとに、参照しているターゲットグループとセキュリティグループを取得します
+func GetLoadBalancerDependencies(ctx context.Context, client ElbApi, names []string) (map[string]LoadBalancerDependencies, error) {
+	allLBs, err := describeLoadBalancers(ctx, client, "")
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ロードバランサー一覧取得エラー: %w"), err)
+	}
+	targets := make(map[string]bool, len(names))
+	for _, name := range names {
+		targets[name] = true
+	}
+
+	dependencies := make(map[string]LoadBalancerDependencies)
+	for _, lb := range allLBs {
+		name := aws.ToString(lb.LoadBalancerName)
+		if !targets[name] {
+			continue
+		}
+		deps := LoadBalancerDependencies{SecurityGroups: lb.SecurityGroups}
+		paginator := elasticloadbalancingv2.NewDescribeTargetGroupsPaginator(client, &elasticloadbalancingv2.DescribeTargetGroupsInput{
+			LoadBalancerArn: lb.LoadBalancerArn,
+		})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf(i18n.T("ターゲットグループ一覧取得エラー: %w"), err)
+			}
+			for _, tg := range output.TargetGroups {
+				deps.TargetGroups = append(deps.TargetGroups, aws.ToString(tg.TargetGroupName))
+			}
+		}
+		dependencies[name] = deps
+	}
+	return dependencies, nil
+}
+
+// LoadBalancerNameFromArn はロードバランサーのARNからロードバランサー名を取り出します（CloudFormationの物理IDはロードバランサーのARN）
+// 例: arn:aws:elasticloadbalancing:ap-northeast-1:123456789012:loadbalancer/app/my-lb/50dc6c495c0c9188 → my-lb
+func LoadBalancerNameFromArn(lbArn string) string {
+	parts := strings.Split(lbArn, "/")
+	if len(parts) < 3 {
+		return lbArn
+	}
+	return parts[len(parts)-2]
+}
+
+// GetTargetGroupsByFilter はフィルターに一致するターゲットグループ名の一覧を取得します
+// exact が true の場合、大文字小文字を区別します
+// tags が指定された場合は、タグ条件にも一致するターゲットグループのみを返します
+// ターゲットグループは作成日時を取得できないため、age が指定された場合は対象外になります
+func GetTargetGroupsByFilter(ctx context.Context, client ElbApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
+	allTGs, err := describeTargetGroups(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []types.TargetGroup
+	for _, tg := range allTGs {
+		if common.MatchesFilter(aws.ToString(tg.TargetGroupName), searchString, exact) {
+			matched = append(matched, tg)
+		}
+	}
+	matched = common.SelectByAge(age, matched, func(types.TargetGroup) (*time.Time, *time.Time) {
+		return nil, nil
+	})
+	matched, err = tagging.Select(ctx, tags, common.Target{}, tagging.TypeTargetGroup, matched, func(tg types.TargetGroup) string {
+		return aws.ToString(tg.TargetGroupArn)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	foundTGs := []string{}
+	for _, tg := range matched {
+		name := aws.ToString(tg.TargetGroupName)
+		foundTGs = append(foundTGs, name)
+		common.Progressf(ctx, common.EventInfo, name, i18n.T("🔍 検出されたターゲットグループ: %s\n"), name)
+	}
+	return foundTGs, nil
+}
+
+// describeTargetGroups はターゲットグループ一覧を取得します
+func describeTargetGroups(ctx context.Context, client ElbApi) ([]types.TargetGroup, error) {
+	var allTGs []types.TargetGroup
+	paginator := elasticloadbalancingv2.NewDescribeTargetGroupsPaginator(client, &elasticloadbalancingv2.DescribeTargetGroupsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("ターゲットグループ一覧取得エラー: %w"), err)
+		}
+		allTGs = append(allTGs, output.TargetGroups...)
+	}
+	return allTGs, nil
+}
+
+// CleanupTargetGroups は指定したターゲットグループ一覧を削除します
+// 削除したロードバランサーのリスナーから解放されるまで使用中のエラーになるため、一定時間は再試行します
+func CleanupTargetGroups(ctx context.Context, client ElbApi, names []string) common.CleanupResult {
+	if len(names) == 0 {
+		return common.CleanupResult{ResourceType: i18n.T("ターゲットグループ"), Deleted: []string{}, Failed: []string{}}
+	}
+
+	// 削除・ガードレールの確認にはARNが必要なため、名前から引けるようにする（取得に失敗した場合は各ターゲットグループの削除の失敗として扱う）
+	tgArns := make(map[string]string)
+	allTGs, listErr := describeTargetGroups(ctx, client)
+	for _, tg := range allTGs {
+		tgArns[aws.ToString(tg.TargetGroupName)] = aws.ToString(tg.TargetGroupArn)
+	}
+
+	arnOf := func(name string) string {
+		return tgArns[name]
+	}
+	names = guardrail.ExcludeNames(ctx, i18n.T("ターゲットグループ"), tagging.TypeTargetGroup, names, arnOf)
+
+	result := common.DeleteInParallel(ctx, i18n.T("ターゲットグループ"), names, 10, func(name string) error {
+		if listErr != nil {
+			return listErr
+		}
+		arn, ok := tgArns[name]
+		if !ok {
+			return fmt.Errorf(i18n.T("ターゲットグループ %s が見つかりません"), name)
+		}
+		return common.RetryWhileInUse(ctx, name, targetGroupReleaseTimeout, 10*time.Second, []string{"ResourceInUse"}, func() error {
+			_, err := client.DeleteTargetGroup(ctx, &elasticloadbalancingv2.DeleteTargetGroupInput{TargetGroupArn: aws.String(arn)})
+			return err
+		})
+	})
+	result.SetArns(arnOf)
+	return result
+}
+
+// TargetGroupNameFromArn はターゲットグループのARNからターゲットグループ名を取り出します（CloudFormationの物理IDはターゲットグループのARN）
+// 例: arn:aws:elasticloadbalancing:ap-northeast-1:123456789012:targetgroup/my-tg/73e2d6bc24d8a067 → my-tg
+func TargetGroupNameFromArn(tgArn string) string {
+	parts := strings.Split(tgArn, "/")
+	if len(parts) < 3 {
+		return tgArn
+	}
+	return parts[len(parts)-2]
+}
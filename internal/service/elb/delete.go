@@ -1,123 +1,167 @@
 package elb
 
 import (
-	"bufio"
 	"context"
 	"fmt"
-	"os"
 	"strings"
 	"time"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/confirm"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/plan"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 )
 
 // DeleteLoadBalancersByFilter はフィルターに一致するロードバランサーを削除する
 // force=true の場合、削除保護が有効なロードバランサーも保護を解除して削除する
-func DeleteLoadBalancersByFilter(client *elasticloadbalancingv2.Client, filter string, withTargetGroups bool, lbType string, exact bool, force bool) error {
-	// フィルターに一致するロードバランサーを取得
-	lbs, err := GetLoadBalancersByFilter(client, filter, lbType, exact)
+// p が指定された場合は削除せず、プランにアクションを追加する
+func DeleteLoadBalancersByFilter(ctx context.Context, client *elasticloadbalancingv2.Client, filter string, withTargetGroups bool, lbType string, exact bool, force bool, tags *tagging.Selector, p *plan.Plan) error {
+	// フィルター（とタグ条件）に一致するロードバランサーを取得
+	lbs, err := GetLoadBalancersByFilter(ctx, client, filter, lbType, exact, tags)
 	if err != nil {
-		return fmt.Errorf("ロードバランサー一覧取得エラー: %w", err)
+		return fmt.Errorf(i18n.T("ロードバランサー一覧取得エラー: %w"), err)
 	}
 
 	if len(lbs) == 0 {
-		typeMsg := "ロードバランサー"
+		typeMsg := i18n.T("ロードバランサー")
 		if lbType != "" {
-			typeMsg = fmt.Sprintf("%sタイプのロードバランサー", strings.ToUpper(lbType))
+			typeMsg = fmt.Sprintf(i18n.T("%sタイプのロードバランサー"), strings.ToUpper(lbType))
 		}
-		fmt.Printf("フィルター '%s' に一致する%sが見つかりませんでした\n", filter, typeMsg)
+		common.Infof(ctx, i18n.T("フィルター '%s' に一致する%sが見つかりませんでした\n"), filter, typeMsg)
 		return nil
 	}
 
 	// 削除対象のロードバランサーと削除保護状態を表示
-	fmt.Printf("\n🎯 削除対象のロードバランサー（%d件）:\n", len(lbs))
-	fmt.Println(strings.Repeat("-", 70))
+	common.Infof(ctx, i18n.T("\n🎯 削除対象のロードバランサー（%d件）:\n"), len(lbs))
+	common.Infof(ctx, "%s\n", strings.Repeat("-", 70))
 
 	protectedCount := 0
 	var protectedNames []string
 	for i, lb := range lbs {
-		protected, err := IsDeletionProtected(client, *lb.LoadBalancerArn)
+		protected, err := IsDeletionProtected(ctx, client, *lb.LoadBalancerArn)
 		if err != nil {
-			return fmt.Errorf("削除保護状態の確認エラー: %w", err)
+			return fmt.Errorf(i18n.T("削除保護状態の確認エラー: %w"), err)
 		}
 
-		protectionStatus := "無効"
+		protectionStatus := i18n.T("無効")
 		if protected {
-			protectionStatus = "🔒有効"
+			protectionStatus = i18n.T("🔒有効")
 			protectedCount++
 			protectedNames = append(protectedNames, *lb.LoadBalancerName)
 		}
 
 		lbTypeStr := getLBTypeDisplay(lb.Type)
-		fmt.Printf("%d. %s [%s] (削除保護: %s)\n", i+1, *lb.LoadBalancerName, lbTypeStr, protectionStatus)
+		common.Infof(ctx, i18n.T("%d. %s [%s] (削除保護: %s)\n"), i+1, *lb.LoadBalancerName, lbTypeStr, protectionStatus)
 	}
 
 	// 削除保護が有効なロードバランサーがある場合
 	if protectedCount > 0 {
 		if !force {
-			fmt.Printf("\n⚠️  %d件のロードバランサーで削除保護が有効です。\n", protectedCount)
-			fmt.Println("削除保護を解除して削除するには --force オプションを指定してください")
-			return fmt.Errorf("削除保護が有効なロードバランサーがあります: %v", protectedNames)
+			common.Warnf(ctx, i18n.T("\n⚠️  %d件のロードバランサーで削除保護が有効です。\n"), protectedCount)
+			common.Warnf(ctx, "%s\n", i18n.T("削除保護を解除して削除するには --force オプションを指定してください"))
+			return fmt.Errorf(i18n.T("削除保護が有効なロードバランサーがあります: %v"), protectedNames)
 		}
-		fmt.Printf("\n⚠️  %d件のロードバランサーで削除保護が有効です。--force により削除前に解除されます。\n", protectedCount)
+		common.Warnf(ctx, i18n.T("\n⚠️  %d件のロードバランサーで削除保護が有効です。--force により削除前に解除されます。\n"), protectedCount)
 	}
 
 	// ターゲットグループも削除する場合の確認
 	if withTargetGroups {
-		fmt.Println("\n📌 関連するターゲットグループも削除されます")
+		common.Infof(ctx, "%s\n", i18n.T("\n📌 関連するターゲットグループも削除されます"))
 	}
 
-	// 確認プロンプト
-	fmt.Printf("\n本当に削除しますか？ (yes/no): ")
-	reader := bufio.NewReader(os.Stdin)
-	answer, _ := reader.ReadString('\n')
-	answer = strings.TrimSpace(strings.ToLower(answer))
+	// プラン作成モードの場合は確認・削除せずにプランへ追加
+	if p != nil {
+		names := make([]string, len(lbs))
+		for i, lb := range lbs {
+			names[i] = *lb.LoadBalancerName
+		}
+		actions, err := PlanLoadBalancerDeletion(ctx, client, names, withTargetGroups, force)
+		if err != nil {
+			return err
+		}
+		p.Add(actions...)
+		return nil
+	}
 
-	if answer != "yes" && answer != "y" {
-		fmt.Println("削除をキャンセルしました")
+	// 確認プロンプト
+	ok, err := confirm.Ask(confirm.Request{Prompt: i18n.T("\n本当に削除しますか？")})
+	if err != nil {
+		return err
+	}
+	if !ok {
+		common.Infof(ctx, "%s\n", i18n.T("削除をキャンセルしました"))
 		return nil
 	}
 
-	// 削除実行
-	fmt.Println("\n削除を開始します...")
+	common.Infof(ctx, "%s\n", i18n.T("\n削除を開始します..."))
+	DeleteLoadBalancers(ctx, client, lbs, withTargetGroups, force)
+
+	common.Infof(ctx, "%s\n", i18n.T("\n✨ ロードバランサーの削除が完了しました"))
+	return nil
+}
+
+// DeleteLoadBalancers は指定したロードバランサーを順番に削除する
+func DeleteLoadBalancers(ctx context.Context, client ElbApi, lbs []types.LoadBalancer, withTargetGroups bool, force bool) common.CleanupResult {
+	var results []common.ProcessResult
+
+	lbs = guardrail.Exclude(ctx, i18n.T("ロードバランサー"), tagging.TypeLoadBalancer, lbs, func(lb types.LoadBalancer) string { return *lb.LoadBalancerName }, func(lb types.LoadBalancer) string { return *lb.LoadBalancerArn })
 	for _, lb := range lbs {
+		name := *lb.LoadBalancerName
+		if ctx.Err() != nil {
+			results = append(results, common.SkippedResult(name, ctx.Err()))
+			continue
+		}
+
 		lbTypeStr := getLBTypeDisplay(lb.Type)
-		fmt.Printf("  %s [%s] を処理中...\n", *lb.LoadBalancerName, lbTypeStr)
+		common.Infof(ctx, i18n.T("  %s [%s] を処理中...\n"), name, lbTypeStr)
 
-		if err := deleteLoadBalancer(client, lb, withTargetGroups, force); err != nil {
-			fmt.Printf("❌ %s の削除に失敗: %v\n", *lb.LoadBalancerName, err)
+		if err := deleteLoadBalancer(ctx, client, lb, withTargetGroups, force); err != nil {
+			common.Warnf(ctx, i18n.T("❌ %s の削除に失敗: %v\n"), name, err)
+			results = append(results, common.ProcessResult{Item: name, Success: false, Error: err})
 			continue
 		}
-		fmt.Printf("✅ %s を削除しました\n", *lb.LoadBalancerName)
+		common.Infof(ctx, i18n.T("✅ %s を削除しました\n"), name)
+		results = append(results, common.ProcessResult{Item: name, Success: true})
 	}
 
-	fmt.Println("\n✨ ロードバランサーの削除が完了しました")
-	return nil
+	return common.CollectCleanupResult(i18n.T("ロードバランサー"), results)
 }
 
 // deleteLoadBalancer は単一のロードバランサーを削除する
 // force=true の場合、削除保護が有効でも解除して削除する
-func deleteLoadBalancer(client *elasticloadbalancingv2.Client, lb types.LoadBalancer, withTargetGroups bool, force bool) error {
+func deleteLoadBalancer(ctx context.Context, client ElbApi, lb types.LoadBalancer, withTargetGroups bool, force bool) (err error) {
 	// 削除保護の確認と解除
-	protected, err := IsDeletionProtected(client, *lb.LoadBalancerArn)
+	protected, err := IsDeletionProtected(ctx, client, *lb.LoadBalancerArn)
 	if err != nil {
-		return fmt.Errorf("削除保護状態の確認エラー: %w", err)
+		return fmt.Errorf(i18n.T("削除保護状態の確認エラー: %w"), err)
 	}
 
 	if protected && force {
-		fmt.Printf("    🔓 削除保護を解除中...\n")
-		if err := disableDeletionProtection(client, *lb.LoadBalancerArn); err != nil {
-			return fmt.Errorf("削除保護の解除エラー: %w", err)
+		common.Infof(ctx, "%s", i18n.T("    🔓 削除保護を解除中...\n"))
+		if err := disableDeletionProtection(ctx, client, *lb.LoadBalancerArn); err != nil {
+			return fmt.Errorf(i18n.T("削除保護の解除エラー: %w"), err)
 		}
+		// 削除前に中断された場合は削除保護を元に戻す
+		defer func() {
+			if err != nil && ctx.Err() != nil {
+				restoreDeletionProtection(ctx, client, *lb.LoadBalancerArn)
+			}
+		}()
 		// 削除保護解除が反映されるまで少し待つ
-		time.Sleep(2 * time.Second)
+		if err := common.Sleep(ctx, 2*time.Second); err != nil {
+			return err
+		}
 	}
 
 	// ターゲットグループを先に削除（指定された場合）
 	if withTargetGroups {
-		if err := deleteRelatedTargetGroups(client, *lb.LoadBalancerArn); err != nil {
-			fmt.Printf("    ⚠️  ターゲットグループ削除エラー: %v\n", err)
+		if err := deleteRelatedTargetGroups(ctx, client, *lb.LoadBalancerArn); err != nil {
+			common.Warnf(ctx, i18n.T("    ⚠️  ターゲットグループ削除エラー: %v\n"), err)
 			// ターゲットグループの削除に失敗してもロードバランサー削除は続行
 		}
 	}
@@ -127,7 +171,7 @@ func deleteLoadBalancer(client *elasticloadbalancingv2.Client, lb types.LoadBala
 		LoadBalancerArn: lb.LoadBalancerArn,
 	}
 
-	_, err = client.DeleteLoadBalancer(context.Background(), deleteInput)
+	_, err = client.DeleteLoadBalancer(ctx, deleteInput)
 	if err != nil {
 		return err
 	}
@@ -136,7 +180,7 @@ func deleteLoadBalancer(client *elasticloadbalancingv2.Client, lb types.LoadBala
 }
 
 // disableDeletionProtection は削除保護を無効化する
-func disableDeletionProtection(client *elasticloadbalancingv2.Client, arn string) error {
+func disableDeletionProtection(ctx context.Context, client ElbApi, arn string) error {
 	input := &elasticloadbalancingv2.ModifyLoadBalancerAttributesInput{
 		LoadBalancerArn: &arn,
 		Attributes: []types.LoadBalancerAttribute{
@@ -147,18 +191,39 @@ func disableDeletionProtection(client *elasticloadbalancingv2.Client, arn string
 		},
 	}
 
-	_, err := client.ModifyLoadBalancerAttributes(context.Background(), input)
+	_, err := client.ModifyLoadBalancerAttributes(ctx, input)
 	return err
 }
 
+// restoreDeletionProtection は中断により削除しなかったロードバランサーの削除保護を元に戻す
+func restoreDeletionProtection(ctx context.Context, client ElbApi, arn string) {
+	restoreCtx, cancel := common.RestoreContext(ctx)
+	defer cancel()
+
+	_, err := client.ModifyLoadBalancerAttributes(restoreCtx, &elasticloadbalancingv2.ModifyLoadBalancerAttributesInput{
+		LoadBalancerArn: &arn,
+		Attributes: []types.LoadBalancerAttribute{
+			{
+				Key:   strPtr("deletion_protection.enabled"),
+				Value: strPtr("true"),
+			},
+		},
+	})
+	if err != nil {
+		common.Warnf(ctx, i18n.T("    ⚠️  削除保護の復元に失敗しました: %v\n"), err)
+		return
+	}
+	common.Infof(ctx, "%s", i18n.T("    🔒 削除保護を元に戻しました\n"))
+}
+
 // deleteRelatedTargetGroups は関連するターゲットグループを削除する
-func deleteRelatedTargetGroups(client *elasticloadbalancingv2.Client, lbArn string) error {
+func deleteRelatedTargetGroups(ctx context.Context, client ElbApi, lbArn string) error {
 	// ロードバランサーに関連するターゲットグループを取得
 	tgInput := &elasticloadbalancingv2.DescribeTargetGroupsInput{
 		LoadBalancerArn: &lbArn,
 	}
 
-	tgResp, err := client.DescribeTargetGroups(context.Background(), tgInput)
+	tgResp, err := client.DescribeTargetGroups(ctx, tgInput)
 	if err != nil {
 		return err
 	}
@@ -167,7 +232,7 @@ func deleteRelatedTargetGroups(client *elasticloadbalancingv2.Client, lbArn stri
 		return nil
 	}
 
-	fmt.Printf("    🗑️  関連するターゲットグループ（%d件）を削除中...\n", len(tgResp.TargetGroups))
+	common.Infof(ctx, i18n.T("    🗑️  関連するターゲットグループ（%d件）を削除中...\n"), len(tgResp.TargetGroups))
 
 	// 各ターゲットグループを削除
 	for _, tg := range tgResp.TargetGroups {
@@ -175,12 +240,12 @@ func deleteRelatedTargetGroups(client *elasticloadbalancingv2.Client, lbArn stri
 			TargetGroupArn: tg.TargetGroupArn,
 		}
 
-		_, err := client.DeleteTargetGroup(context.Background(), deleteInput)
+		_, err := client.DeleteTargetGroup(ctx, deleteInput)
 		if err != nil {
 			// エラーが発生してもログ出力して続行
-			fmt.Printf("      ⚠️  %s の削除に失敗: %v\n", *tg.TargetGroupName, err)
+			common.Warnf(ctx, i18n.T("      ⚠️  %s の削除に失敗: %v\n"), *tg.TargetGroupName, err)
 		} else {
-			fmt.Printf("      ✓ %s を削除しました\n", *tg.TargetGroupName)
+			common.Infof(ctx, i18n.T("      ✓ %s を削除しました\n"), *tg.TargetGroupName)
 		}
 	}
 
@@ -1,56 +1,85 @@
 package elb
 
 import (
-	"awstk/internal/service/common"
 	"context"
 	"fmt"
 	"strconv"
 	"strings"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
 	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
 )
 
 // ListLoadBalancers はロードバランサー一覧を表示する
-func ListLoadBalancers(client *elasticloadbalancingv2.Client, opts ListOptions) error {
-	// ロードバランサー一覧を取得
-	lbs, err := describeLoadBalancers(client, opts.LoadBalancerType)
+func ListLoadBalancers(ctx context.Context, client *elasticloadbalancingv2.Client, opts ListOptions) error {
+	// ロードバランサー一覧を削除保護情報付きで取得
+	lbInfos, err := getLoadBalancerInfos(ctx, client, common.Target{}, opts)
 	if err != nil {
-		return fmt.Errorf("ロードバランサー一覧取得エラー: %w", err)
+		return err
 	}
 
-	if len(lbs) == 0 {
-		typeMsg := "ロードバランサー"
+	if len(lbInfos) == 0 {
+		typeMsg := i18n.T("ロードバランサー")
 		if opts.LoadBalancerType != "" {
-			typeMsg = fmt.Sprintf("%sタイプのロードバランサー", strings.ToUpper(opts.LoadBalancerType))
+			typeMsg = fmt.Sprintf(i18n.T("%sタイプのロードバランサー"), strings.ToUpper(opts.LoadBalancerType))
 		}
-		fmt.Printf("%sが見つかりませんでした\n", typeMsg)
+		common.PrintEmptyResult(fmt.Sprintf(i18n.T("%sが見つかりませんでした"), typeMsg))
 		return nil
 	}
 
-	// 削除保護情報を取得
+	// 表示
+	displayLoadBalancers(lbInfos, opts.ShowDetails)
+	return nil
+}
+
+// ListLoadBalancersAcross は複数のアカウント・リージョンのロードバランサーを並列に取得し、アカウント列・リージョン列付きで表示する
+func ListLoadBalancersAcross(ctx context.Context, targets []common.Target, newClient func(target common.Target) *elasticloadbalancingv2.Client, opts ListOptions) error {
+	results := common.FetchAcrossTargets(ctx, targets, func(ctx context.Context, target common.Target) ([]LoadBalancerInfo, error) {
+		return getLoadBalancerInfos(ctx, newClient(target), target, opts)
+	})
+	return common.DisplayTargetList(
+		results,
+		i18n.T("ロードバランサー一覧"),
+		loadBalancersToRecordData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: i18n.T("ロードバランサーが見つかりませんでした"),
+		},
+	)
+}
+
+// getLoadBalancerInfos はロードバランサー一覧を削除保護等の情報付きで取得し、オプションで絞り込む
+func getLoadBalancerInfos(ctx context.Context, client *elasticloadbalancingv2.Client, target common.Target, opts ListOptions) ([]LoadBalancerInfo, error) {
+	lbs, err := describeLoadBalancers(ctx, client, opts.LoadBalancerType)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ロードバランサー一覧取得エラー: %w"), err)
+	}
+	lbs, err = selectLoadBalancersByTags(ctx, opts.Tags, target, lbs)
+	if err != nil {
+		return nil, err
+	}
+
 	lbInfos := []LoadBalancerInfo{}
 	for _, lb := range lbs {
-		info, err := getLoadBalancerInfo(client, lb)
+		info, err := getLoadBalancerInfo(ctx, client, lb)
 		if err != nil {
-			return fmt.Errorf("ロードバランサー情報取得エラー: %w", err)
+			return nil, fmt.Errorf(i18n.T("ロードバランサー情報取得エラー: %w"), err)
 		}
-
-		// フィルタリング
 		if opts.ProtectedOnly && !info.DeletionProtection {
 			continue
 		}
-
 		lbInfos = append(lbInfos, info)
 	}
-
-	// 表示
-	displayLoadBalancers(lbInfos, opts.ShowDetails)
-	return nil
+	return lbInfos, nil
 }
 
 // describeLoadBalancers はロードバランサー一覧を取得する
-func describeLoadBalancers(client *elasticloadbalancingv2.Client, lbTypeFilter string) ([]types.LoadBalancer, error) {
+func describeLoadBalancers(ctx context.Context, client ElbApi, lbTypeFilter string) ([]types.LoadBalancer, error) {
 	var allLBs []types.LoadBalancer
 	var nextMarker *string
 
@@ -59,7 +88,7 @@ func describeLoadBalancers(client *elasticloadbalancingv2.Client, lbTypeFilter s
 			Marker: nextMarker,
 		}
 
-		resp, err := client.DescribeLoadBalancers(context.Background(), input)
+		resp, err := client.DescribeLoadBalancers(ctx, input)
 		if err != nil {
 			return nil, err
 		}
@@ -101,7 +130,7 @@ func shouldIncludeLoadBalancer(lb types.LoadBalancer, typeFilter string) bool {
 }
 
 // getLoadBalancerInfo はロードバランサーの詳細情報を取得する
-func getLoadBalancerInfo(client *elasticloadbalancingv2.Client, lb types.LoadBalancer) (LoadBalancerInfo, error) {
+func getLoadBalancerInfo(ctx context.Context, client *elasticloadbalancingv2.Client, lb types.LoadBalancer) (LoadBalancerInfo, error) {
 	info := LoadBalancerInfo{
 		Name:    *lb.LoadBalancerName,
 		ARN:     *lb.LoadBalancerArn,
@@ -132,9 +161,9 @@ func getLoadBalancerInfo(client *elasticloadbalancingv2.Client, lb types.LoadBal
 	attrInput := &elasticloadbalancingv2.DescribeLoadBalancerAttributesInput{
 		LoadBalancerArn: lb.LoadBalancerArn,
 	}
-	attrResp, err := client.DescribeLoadBalancerAttributes(context.Background(), attrInput)
+	attrResp, err := client.DescribeLoadBalancerAttributes(ctx, attrInput)
 	if err != nil {
-		return info, fmt.Errorf("属性取得エラー: %w", err)
+		return info, fmt.Errorf(i18n.T("属性取得エラー: %w"), err)
 	}
 
 	for _, attr := range attrResp.Attributes {
@@ -147,7 +176,7 @@ func getLoadBalancerInfo(client *elasticloadbalancingv2.Client, lb types.LoadBal
 	listenersInput := &elasticloadbalancingv2.DescribeListenersInput{
 		LoadBalancerArn: lb.LoadBalancerArn,
 	}
-	listenersResp, err := client.DescribeListeners(context.Background(), listenersInput)
+	listenersResp, err := client.DescribeListeners(ctx, listenersInput)
 	if err == nil {
 		info.ListenerCount = len(listenersResp.Listeners)
 	}
@@ -156,7 +185,7 @@ func getLoadBalancerInfo(client *elasticloadbalancingv2.Client, lb types.LoadBal
 	tgInput := &elasticloadbalancingv2.DescribeTargetGroupsInput{
 		LoadBalancerArn: lb.LoadBalancerArn,
 	}
-	tgResp, err := client.DescribeTargetGroups(context.Background(), tgInput)
+	tgResp, err := client.DescribeTargetGroups(ctx, tgInput)
 	if err == nil {
 		info.TargetGroupCount = len(tgResp.TargetGroups)
 	}
@@ -180,33 +209,40 @@ func getLBTypeDisplay(lbType types.LoadBalancerTypeEnum) string {
 
 // displayLoadBalancers はロードバランサー一覧を表示する
 func displayLoadBalancers(lbs []LoadBalancerInfo, showDetails bool) {
-	fmt.Printf("\n🔍 ロードバランサー一覧（%d件）\n", len(lbs))
+	// 構造化出力の場合は詳細表示の有無にかかわらず全項目を出力
+	if common.IsStructuredOutput() {
+		columns, data := loadBalancersToRecordData(lbs)
+		common.PrintTable("", columns, data)
+		return
+	}
+
+	fmt.Printf(i18n.T("\n🔍 ロードバランサー一覧（%d件）\n"), len(lbs))
 	fmt.Println(strings.Repeat("=", 80))
 
 	if showDetails {
 		// 詳細表示
 		for i, lb := range lbs {
 			fmt.Printf("\n[%d] %s (%s)\n", i+1, lb.Name, lb.Type)
-			fmt.Printf("    状態: %s\n", lb.State)
-			fmt.Printf("    スキーマ: %s\n", lb.Scheme)
-			fmt.Printf("    DNS名: %s\n", lb.DNSName)
-			fmt.Printf("    削除保護: %s\n", formatBool(lb.DeletionProtection))
-			fmt.Printf("    リスナー数: %d\n", lb.ListenerCount)
-			fmt.Printf("    ターゲットグループ数: %d\n", lb.TargetGroupCount)
+			fmt.Printf(i18n.T("    状態: %s\n"), lb.State)
+			fmt.Printf(i18n.T("    スキーマ: %s\n"), lb.Scheme)
+			fmt.Printf(i18n.T("    DNS名: %s\n"), lb.DNSName)
+			fmt.Printf(i18n.T("    削除保護: %s\n"), formatBool(lb.DeletionProtection))
+			fmt.Printf(i18n.T("    リスナー数: %d\n"), lb.ListenerCount)
+			fmt.Printf(i18n.T("    ターゲットグループ数: %d\n"), lb.TargetGroupCount)
 			fmt.Printf("    VPC ID: %s\n", lb.VPCId)
 			fmt.Printf("    AZ: %s\n", strings.Join(lb.AvailabilityZones, ", "))
-			fmt.Printf("    作成日時: %s\n", lb.CreatedTime)
+			fmt.Printf(i18n.T("    作成日時: %s\n"), lb.CreatedTime)
 		}
 	} else {
 		// 簡易表示（テーブル形式）
 		fmt.Printf("%-35s %-5s %-10s %-8s %-10s %-5s %-5s\n",
-			"名前", "タイプ", "状態", "スキーマ", "削除保護", "TG数", "ﾘｽﾅｰ")
+			i18n.T("名前"), i18n.T("タイプ"), i18n.T("状態"), i18n.T("スキーマ"), i18n.T("削除保護"), i18n.T("TG数"), i18n.T("ﾘｽﾅｰ"))
 		fmt.Println(strings.Repeat("-", 80))
 
 		for _, lb := range lbs {
-			protection := "無効"
+			protection := i18n.T("無効")
 			if lb.DeletionProtection {
-				protection = "🔒有効"
+				protection = i18n.T("🔒有効")
 			}
 			fmt.Printf("%-35s %-5s %-10s %-8s %-10s %-5d %-5d\n",
 				truncate(lb.Name, 35),
@@ -222,12 +258,49 @@ func displayLoadBalancers(lbs []LoadBalancerInfo, showDetails bool) {
 	fmt.Println()
 }
 
+// loadBalancersToRecordData はロードバランサー一覧を構造化出力用のテーブルデータに変換
+func loadBalancersToRecordData(lbs []LoadBalancerInfo) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("名前"), Key: "name"},
+		{Header: i18n.T("タイプ"), Key: "type"},
+		{Header: i18n.T("状態"), Key: "state"},
+		{Header: i18n.T("スキーマ"), Key: "scheme"},
+		{Header: i18n.T("削除保護"), Key: "deletionProtection"},
+		{Header: i18n.T("TG数"), Key: "targetGroupCount"},
+		{Header: i18n.T("リスナー数"), Key: "listenerCount"},
+		{Header: i18n.T("DNS名"), Key: "dnsName"},
+		{Header: "VPC ID", Key: "vpcId"},
+		{Header: "AZ", Key: "availabilityZones"},
+		{Header: i18n.T("作成日時"), Key: "createdTime"},
+		{Header: "ARN", Key: "arn"},
+	}
+
+	data := make([][]string, len(lbs))
+	for i, lb := range lbs {
+		data[i] = []string{
+			lb.Name,
+			lb.Type,
+			lb.State,
+			lb.Scheme,
+			strconv.FormatBool(lb.DeletionProtection),
+			strconv.Itoa(lb.TargetGroupCount),
+			strconv.Itoa(lb.ListenerCount),
+			lb.DNSName,
+			lb.VPCId,
+			strings.Join(lb.AvailabilityZones, ","),
+			lb.CreatedTime,
+			lb.ARN,
+		}
+	}
+	return columns, data
+}
+
 // formatBool はブール値を日本語で表示する
 func formatBool(b bool) string {
 	if b {
-		return "有効"
+		return i18n.T("有効")
 	}
-	return "無効"
+	return i18n.T("無効")
 }
 
 // truncate は文字列を指定長で切り詰める
@@ -239,8 +312,9 @@ func truncate(s string, maxLen int) string {
 }
 
 // GetLoadBalancersByFilter はフィルターに一致するロードバランサーを取得する
-func GetLoadBalancersByFilter(client *elasticloadbalancingv2.Client, filter string, lbType string, exact bool) ([]types.LoadBalancer, error) {
-	allLBs, err := describeLoadBalancers(client, lbType)
+// tags が指定された場合は、タグ条件にも一致するロードバランサーのみを返す
+func GetLoadBalancersByFilter(ctx context.Context, client ElbApi, filter string, lbType string, exact bool, tags *tagging.Selector) ([]types.LoadBalancer, error) {
+	allLBs, err := describeLoadBalancers(ctx, client, lbType)
 	if err != nil {
 		return nil, err
 	}
@@ -252,16 +326,23 @@ func GetLoadBalancersByFilter(client *elasticloadbalancingv2.Client, filter stri
 		}
 	}
 
-	return filtered, nil
+	return selectLoadBalancersByTags(ctx, tags, common.Target{}, filtered)
+}
+
+// selectLoadBalancersByTags はタグ条件に一致するロードバランサーに絞り込む
+func selectLoadBalancersByTags(ctx context.Context, tags *tagging.Selector, target common.Target, lbs []types.LoadBalancer) ([]types.LoadBalancer, error) {
+	return tagging.Select(ctx, tags, target, tagging.TypeLoadBalancer, lbs, func(lb types.LoadBalancer) string {
+		return aws.ToString(lb.LoadBalancerArn)
+	})
 }
 
 // IsDeletionProtected は削除保護が有効かチェックする
-func IsDeletionProtected(client *elasticloadbalancingv2.Client, arn string) (bool, error) {
+func IsDeletionProtected(ctx context.Context, client ElbApi, arn string) (bool, error) {
 	input := &elasticloadbalancingv2.DescribeLoadBalancerAttributesInput{
 		LoadBalancerArn: &arn,
 	}
 
-	resp, err := client.DescribeLoadBalancerAttributes(context.Background(), input)
+	resp, err := client.DescribeLoadBalancerAttributes(ctx, input)
 	if err != nil {
 		return false, err
 	}
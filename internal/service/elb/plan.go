@@ -0,0 +1,80 @@
+package elb
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/plan"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2/types"
+)
+
+// PlanLoadBalancerDeletion は指定したロードバランサーの削除アクションを作成する
+// 存在しないロードバランサーは結果に含まれない（apply時の再検証でも使用）
+func PlanLoadBalancerDeletion(ctx context.Context, client ElbApi, names []string, withTargetGroups bool, force bool) ([]plan.Action, error) {
+	allLBs, err := describeLoadBalancers(ctx, client, "")
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make(map[string]bool, len(names))
+	for _, name := range names {
+		targets[name] = true
+	}
+
+	actions := []plan.Action{}
+	for _, lb := range allLBs {
+		name := aws.ToString(lb.LoadBalancerName)
+		if !targets[name] {
+			continue
+		}
+		actions = append(actions, plan.Action{
+			Type:   plan.ResourceLoadBalancer,
+			Action: plan.ActionDelete,
+			Name:   name,
+			Id:     aws.ToString(lb.LoadBalancerArn),
+			Fingerprint: map[string]string{
+				"createdTime": plan.FormatTime(lb.CreatedTime),
+			},
+			Options: map[string]string{
+				plan.OptionWithTargetGroups: strconv.FormatBool(withTargetGroups),
+				plan.OptionForce:            strconv.FormatBool(force),
+			},
+		})
+	}
+	return actions, nil
+}
+
+// ApplyLoadBalancerDeletion はプランのアクションに従ってロードバランサーを削除する
+func ApplyLoadBalancerDeletion(ctx context.Context, client ElbApi, actions []plan.Action) common.CleanupResult {
+	result := common.CollectCleanupResult(i18n.T("ロードバランサー"), nil)
+
+	allLBs, err := describeLoadBalancers(ctx, client, "")
+	if err != nil {
+		result.Failed = append(result.Failed, plan.Names(actions)...)
+		return result
+	}
+	byArn := make(map[string]types.LoadBalancer, len(allLBs))
+	for _, lb := range allLBs {
+		byArn[aws.ToString(lb.LoadBalancerArn)] = lb
+	}
+
+	for _, a := range actions {
+		lb, ok := byArn[a.Id]
+		if !ok {
+			result.Failed = append(result.Failed, a.Name)
+			continue
+		}
+		withTargetGroups, _ := strconv.ParseBool(a.Options[plan.OptionWithTargetGroups])
+		force, _ := strconv.ParseBool(a.Options[plan.OptionForce])
+
+		r := DeleteLoadBalancers(ctx, client, []types.LoadBalancer{lb}, withTargetGroups, force)
+		result.Deleted = append(result.Deleted, r.Deleted...)
+		result.Failed = append(result.Failed, r.Failed...)
+		result.Skipped = append(result.Skipped, r.Skipped...)
+	}
+	return result
+}
@@ -1,10 +1,13 @@
 package elb
 
+import "github.com/ngsw877/awstk/internal/service/tagging"
+
 // ListOptions はロードバランサー一覧表示時のオプション
 type ListOptions struct {
-	ProtectedOnly    bool   // 削除保護が有効なもののみ表示
-	ShowDetails      bool   // 詳細情報を表示
-	LoadBalancerType string // ロードバランサータイプ (alb, nlb, gwlb, 空文字で全て)
+	ProtectedOnly    bool              // 削除保護が有効なもののみ表示
+	ShowDetails      bool              // 詳細情報を表示
+	LoadBalancerType string            // ロードバランサータイプ (alb, nlb, gwlb, 空文字で全て)
+	Tags             *tagging.Selector // 指定された場合はタグ条件に一致するもののみ表示
 }
 
 // LoadBalancerInfo はロードバランサーの情報を保持する構造体
@@ -1,11 +1,15 @@
 package env
 
-import "fmt"
+import (
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+)
 
 // ValidateVariable は変数名が有効かチェック
 func ValidateVariable(variable string) error {
 	if _, ok := SupportedVariables[variable]; !ok {
-		return fmt.Errorf("❌ エラー: '%s' はサポートされていない変数です。stack または profile を指定してください", variable)
+		return fmt.Errorf(i18n.T("❌ エラー: '%s' はサポートされていない変数です。stack または profile を指定してください"), variable)
 	}
 	return nil
 }
@@ -3,11 +3,15 @@ package env
 import (
 	"fmt"
 	"os"
+
+	"github.com/ngsw877/awstk/internal/config"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
 )
 
 // ShowAllVariables はすべてのサポートされている環境変数を表示
 func ShowAllVariables() {
-	fmt.Println("📋 AWS関連の環境変数の状態:")
+	fmt.Println(i18n.T("📋 AWS関連の環境変数の状態:"))
 	fmt.Println()
 
 	// 順序を固定するため、明示的に順番を定義
@@ -18,9 +22,73 @@ func ShowAllVariables() {
 		value := os.Getenv(v.Name)
 
 		if value != "" {
-			fmt.Printf("  %s (%s): %s\n", v.Description, v.Name, value)
+			fmt.Printf("  %s (%s): %s\n", i18n.T(v.Description), v.Name, value)
 		} else {
-			fmt.Printf("  %s (%s): 未設定\n", v.Description, v.Name)
+			fmt.Printf(i18n.T("  %s (%s): 未設定\n"), i18n.T(v.Description), v.Name)
+		}
+	}
+}
+
+// resolvedItem は有効な設定の1項目
+type resolvedItem struct {
+	Key   string
+	Label string
+	Value config.Value
+}
+
+// ShowResolvedConfig はフラグ・環境変数・設定ファイルから解決した有効な設定と、その取得元を表示
+func ShowResolvedConfig(resolved *config.Resolved) error {
+	items := []resolvedItem{
+		{Key: "env", Label: i18n.T("環境"), Value: resolved.Env},
+		{Key: "profile", Label: i18n.T("プロファイル"), Value: resolved.Profile},
+		{Key: "region", Label: i18n.T("リージョン"), Value: resolved.Region},
+		{Key: "stackName", Label: i18n.T("スタック名"), Value: resolved.StackName},
+		{Key: "search", Label: i18n.T("検索パターンの既定値"), Value: resolved.Search},
+		{Key: "exact", Label: i18n.T("大文字小文字の区別"), Value: resolved.Exact},
+		{Key: "concurrency", Label: i18n.T("最大同時実行数"), Value: resolved.Concurrency},
+		{Key: "protected", Label: i18n.T("保護対象パターン"), Value: resolved.Protected},
+		{Key: "guardrail.deny", Label: i18n.T("ガードレールの拒否パターン"), Value: resolved.GuardrailDeny},
+		{Key: "guardrail.requiredTags", Label: i18n.T("ガードレールの必須タグ"), Value: resolved.GuardrailRequiredTags},
+		{Key: "mcp.allowTools", Label: i18n.T("MCPで公開する変更系ツール"), Value: resolved.McpAllowTools},
+		{Key: "accounts", Label: i18n.T("対象アカウント"), Value: resolved.Accounts},
+		{Key: "roleName", Label: i18n.T("引き受けるロール名"), Value: resolved.RoleName},
+		{Key: "externalId", Label: i18n.T("外部ID"), Value: resolved.ExternalId},
+		{Key: "mfaSerial", Label: i18n.T("MFAデバイス"), Value: resolved.MfaSerial},
+	}
+
+	if !common.IsStructuredOutput() {
+		fmt.Println(i18n.T("📋 読み込んだ設定ファイル:"))
+		if len(resolved.Files) == 0 {
+			fmt.Printf(i18n.T("  なし（%s またはユーザー設定ディレクトリの awstk/%s を作成してください）\n"), config.FileName, config.UserFileName)
+		}
+		for _, f := range resolved.Files {
+			fmt.Printf("  %s\n", f)
+		}
+		fmt.Println()
+	}
+
+	return common.DisplayList(items, i18n.T("有効な設定"), resolvedItemsToTableData, nil)
+}
+
+// resolvedItemsToTableData は有効な設定をテーブルデータに変換
+func resolvedItemsToTableData(items []resolvedItem) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("項目"), Key: "key"},
+		{Header: i18n.T("値"), Key: "value"},
+		{Header: i18n.T("取得元"), Key: "source"},
+	}
+
+	data := make([][]string, len(items))
+	for i, item := range items {
+		if common.IsStructuredOutput() {
+			data[i] = []string{item.Key, item.Value.Value, item.Value.Source}
+			continue
+		}
+		value, source := item.Value.Value, item.Value.Source
+		if !item.Value.IsSet() {
+			value, source = i18n.T("未設定"), "-"
 		}
+		data[i] = []string{item.Label, value, source}
 	}
+	return columns, data
 }
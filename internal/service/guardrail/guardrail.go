@@ -0,0 +1,313 @@
+package guardrail
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/confirm"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/gobwas/glob"
+)
+
+var (
+	// policy は適用中のポリシー（SetPolicy で設定する）
+	policy = NewPolicy(nil, nil, true)
+	// override は --override-guardrail が指定されたか
+	override bool
+	// newTagClient は必須タグの確認に使用するクライアントを作成する
+	newTagClient func(target common.Target) tagging.TaggingApi
+)
+
+// targetKey は実行対象をコンテキストに格納するためのキー
+type targetKey struct{}
+
+// exclusionsKey は除外したリソースの記録先をコンテキストに格納するためのキー
+type exclusionsKey struct{}
+
+// policyKey は呼び出し単位で適用するポリシーをコンテキストに格納するためのキー
+type policyKey struct{}
+
+// scopedPolicy は WithPolicy で設定したポリシーとタグ取得用のクライアントの作成方法
+type scopedPolicy struct {
+	policy       Policy
+	newTagClient func(target common.Target) tagging.TaggingApi
+}
+
+// Exclusions はガードレールにより削除対象から除外したリソースの記録先
+type Exclusions struct {
+	mu      sync.Mutex
+	results []common.ProcessResult
+}
+
+// NewPolicy は設定ファイルの拒否パターン・必須タグからポリシーを作成する
+// useDefaults が true の場合は組み込みの拒否パターンも適用する
+func NewPolicy(deny []string, requiredTags map[string]string, useDefaults bool) Policy {
+	p := Policy{Deny: common.RemoveDuplicates(deny), RequiredTags: requiredTags}
+	if useDefaults {
+		p.Defaults = DefaultDenyPatterns
+	}
+	return p
+}
+
+// SetPolicy は削除前に適用するポリシーを設定する
+func SetPolicy(p Policy) {
+	policy = p
+}
+
+// SetOverride は --override-guardrail の指定を設定する
+// 上書きする場合も、対象のリソースごとにリソース名の入力を求める
+func SetOverride(enabled bool) {
+	override = enabled
+}
+
+// SetTagClient は必須タグの確認に使用するクライアントの作成方法を設定する
+func SetTagClient(fn func(target common.Target) tagging.TaggingApi) {
+	newTagClient = fn
+}
+
+// WithPolicy は SetPolicy・SetTagClient で設定したものの代わりに、指定したポリシーを適用するコンテキストを返す
+// ライブラリから呼び出し単位でポリシーを指定する場合に使用する
+func WithPolicy(ctx context.Context, p Policy, newClient func(target common.Target) tagging.TaggingApi) context.Context {
+	return context.WithValue(ctx, policyKey{}, scopedPolicy{policy: p, newTagClient: newClient})
+}
+
+// policyOf はコンテキストで適用するポリシーとタグ取得用のクライアントの作成方法を返す
+func policyOf(ctx context.Context) (Policy, func(target common.Target) tagging.TaggingApi) {
+	if s, ok := ctx.Value(policyKey{}).(scopedPolicy); ok {
+		return s.policy, s.newTagClient
+	}
+	return policy, newTagClient
+}
+
+// WithTarget は実行対象のアカウント・リージョンをコンテキストに設定する
+// 複数アカウントで順に削除する場合に、必須タグを各アカウントで確認するために使用する
+func WithTarget(ctx context.Context, target common.Target) context.Context {
+	return context.WithValue(ctx, targetKey{}, target)
+}
+
+// WithExclusions は除外したリソースの記録先を設定したコンテキストを返す
+// 除外したリソースを削除結果に含める場合（cleanup all のサマリー・レポート等）に使用する
+func WithExclusions(ctx context.Context) (context.Context, *Exclusions) {
+	e := &Exclusions{}
+	return context.WithValue(ctx, exclusionsKey{}, e), e
+}
+
+// Results は除外したリソースの処理結果を返す（未実行として扱い、Error に除外した理由を設定する）
+func (e *Exclusions) Results() []common.ProcessResult {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return slices.Clone(e.results)
+}
+
+// recordExclusion は除外したリソースをコンテキストの記録先に記録する（記録先が未設定の場合は何もしない）
+func recordExclusion(ctx context.Context, name, reason string) {
+	e, ok := ctx.Value(exclusionsKey{}).(*Exclusions)
+	if !ok {
+		return
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.results = append(e.results, common.SkippedResult(name, fmt.Errorf(i18n.T("ガードレールにより削除しません（%s）"), reason)))
+}
+
+// Exclude はガードレールに違反するリソースを削除対象から除外し、除外したものを表示する
+// --override-guardrail が指定されている場合は、リソース名を入力したものだけを削除対象に残す
+// keyOf は必須タグの確認に使用するARN、またはARNのリソース部分（例: repository/my-repo）を返す
+// WithExclusions で記録先を設定した場合は、除外したリソースを記録する
+func Exclude[T any](ctx context.Context, resourceLabel, resourceType string, items []T, nameOf, keyOf func(T) string) []T {
+	return exclude(ctx, resourceLabel, resourceType, items, nameOf, nil, keyOf)
+}
+
+// ExcludeNames はガードレールに違反するリソース名を削除対象から除外する
+func ExcludeNames(ctx context.Context, resourceLabel, resourceType string, names []string, keyOf func(string) string) []string {
+	return Exclude(ctx, resourceLabel, resourceType, names, func(name string) string { return name }, keyOf)
+}
+
+// ExcludeIds はIDで指定したリソースのうち、ガードレールに違反するものを削除対象から除外する
+// 拒否パターンはIDに加えて、lookupNames が返す名前（Nameタグ・エイリアス等）と説明にも照合する（説明は設定ファイルの拒否パターンのみ）
+// lookupNames は拒否パターンがある場合のみ呼び出し、取得に失敗した場合は警告を表示してIDだけで照合する
+func ExcludeIds(ctx context.Context, resourceLabel, resourceType string, ids []string, lookupNames func() (map[string]Aliases, error), keyOf func(string) string) []string {
+	if p, _ := policyOf(ctx); len(ids) == 0 || (len(p.Deny) == 0 && len(p.Defaults) == 0) {
+		return ExcludeNames(ctx, resourceLabel, resourceType, ids, keyOf)
+	}
+	names, err := lookupNames()
+	if err != nil {
+		common.Warnf(ctx, i18n.T("%s  %sの名前を取得できないため、ガードレールはIDのみで確認します: %v\n"), common.WarningIcon, resourceLabel, err)
+	}
+	return exclude(ctx, resourceLabel, resourceType, ids, func(id string) string { return id }, func(id string) Aliases { return names[id] }, keyOf)
+}
+
+// exclude はガードレールに違反するリソースを削除対象から除外する
+// aliasesOf が指定された場合は、nameOf の名前に加えてその別名・説明にも拒否パターンを照合する
+func exclude[T any](ctx context.Context, resourceLabel, resourceType string, items []T, nameOf func(T) string, aliasesOf func(T) Aliases, keyOf func(T) string) []T {
+	p, _ := policyOf(ctx)
+	if len(items) == 0 || (len(p.Deny) == 0 && len(p.Defaults) == 0 && len(p.RequiredTags) == 0) {
+		return items
+	}
+
+	tagsByKey, tagErr := fetchRequiredTags(ctx, resourceType)
+
+	allowed := make([]T, 0, len(items))
+	blocked := 0
+	for _, item := range items {
+		name := nameOf(item)
+		names := []string{name}
+		var descriptions []string
+		if aliasesOf != nil {
+			aliases := aliasesOf(item)
+			names = append(names, aliases.Names...)
+			descriptions = aliases.Descriptions
+		}
+		reason := violation(p, names, descriptions, resourceType, keyOf(item), tagsByKey, tagErr)
+		if reason == "" {
+			allowed = append(allowed, item)
+			continue
+		}
+		if override && confirmOverride(resourceLabel, name, reason) {
+			allowed = append(allowed, item)
+			continue
+		}
+		common.Progressf(ctx, common.EventWarning, name, i18n.T("🛡️  %s %s はガードレールにより削除しません（%s）\n"), resourceLabel, name, reason)
+		recordExclusion(ctx, name, reason)
+		blocked++
+	}
+	if blocked > 0 && !override {
+		common.Infof(ctx, "%s\n", i18n.T("ℹ️  ガードレールを上書きして削除するには --override-guardrail を指定してください（リソースごとに名前の入力が必要です）"))
+	}
+	return allowed
+}
+
+// Check は単一のリソースがガードレールに違反していないか確認する
+// 違反している場合はエラーを返す（--override-guardrail でリソース名を入力した場合を除く）
+func Check(ctx context.Context, resourceLabel, resourceType, name, key string) error {
+	if len(Exclude(ctx, resourceLabel, resourceType, []string{name}, func(n string) string { return n }, func(string) string { return key })) == 0 {
+		return fmt.Errorf(i18n.T("%s %s はガードレールにより削除できません"), resourceLabel, name)
+	}
+	return nil
+}
+
+// violation はリソースがガードレールに違反する理由を返す（違反しない場合は空文字）
+// 組み込みの拒否パターンは names のいずれかの全体に、設定ファイルの拒否パターンは names・descriptions のいずれかに一致した場合に違反とする
+func violation(p Policy, names, descriptions []string, resourceType, key string, tagsByKey map[string]map[string]string, tagErr error) string {
+	if reason := denyReason(p.Defaults, names, matchesDefault); reason != "" {
+		return reason
+	}
+	if reason := denyReason(p.Deny, append(slices.Clone(names), descriptions...), matchesDeny); reason != "" {
+		return reason
+	}
+	if len(p.RequiredTags) == 0 {
+		return ""
+	}
+	if tagErr != nil {
+		return fmt.Sprintf(i18n.T("必須タグを確認できません: %v"), tagErr)
+	}
+
+	tags := tagsByKey[normalizeKey(resourceType, tagging.ResourceKey(key))]
+	var missing []string
+	for key, value := range p.RequiredTags {
+		if actual, ok := tags[key]; !ok || (value != "" && actual != value) {
+			missing = append(missing, formatTag(key, value))
+		}
+	}
+	if len(missing) == 0 {
+		return ""
+	}
+	sort.Strings(missing)
+	return fmt.Sprintf(i18n.T("必須タグ %s がありません"), strings.Join(missing, ", "))
+}
+
+// denyReason は texts のいずれかが拒否パターンに一致する場合に、その理由を返す（一致しない場合は空文字）
+// texts の先頭はリソース名として扱い、それ以外に一致した場合は一致した文字列も理由に含める
+func denyReason(patterns, texts []string, match func(text, pattern string) bool) string {
+	for _, pattern := range patterns {
+		for i, text := range texts {
+			if text == "" || !match(text, pattern) {
+				continue
+			}
+			if i > 0 {
+				return fmt.Sprintf(i18n.T("%s が拒否パターン %s に一致"), text, pattern)
+			}
+			return fmt.Sprintf(i18n.T("拒否パターン %s に一致"), pattern)
+		}
+	}
+	return ""
+}
+
+// matchesDeny は設定ファイルの拒否パターンに一致するか判定する（--search と同じく部分一致またはワイルドカード）
+func matchesDeny(text, pattern string) bool {
+	return common.MatchesFilter(text, pattern, false)
+}
+
+// matchesDefault は組み込みの拒否パターンが名前全体に一致するか判定する（大文字小文字を区別しない）
+func matchesDefault(text, pattern string) bool {
+	return glob.MustCompile(strings.ToLower(pattern)).Match(strings.ToLower(text))
+}
+
+// fetchRequiredTags は必須タグの確認のため、リソースタイプのリソースのタグを取得する
+func fetchRequiredTags(ctx context.Context, resourceType string) (map[string]map[string]string, error) {
+	p, newTagClient := policyOf(ctx)
+	if len(p.RequiredTags) == 0 {
+		return nil, nil
+	}
+	if newTagClient == nil {
+		return nil, errors.New(i18n.T("タグ取得用のクライアントが設定されていません"))
+	}
+
+	target, _ := ctx.Value(targetKey{}).(common.Target)
+	if strings.HasPrefix(resourceType, "iam:") || strings.HasPrefix(resourceType, "route53:") {
+		target.Region = tagging.GlobalRegion
+	}
+	tagsByKey, err := tagging.FetchTags(ctx, newTagClient(target), nil, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	normalized := make(map[string]map[string]string, len(tagsByKey))
+	for key, tags := range tagsByKey {
+		normalized[normalizeKey(resourceType, key)] = tags
+	}
+	return normalized, nil
+}
+
+// normalizeKey はリソースの比較に使用するキーを返す
+// IAMのARNにはパス（例: role/service-role/my-role）が含まれるため、種別と名前だけで比較する
+func normalizeKey(resourceType, key string) string {
+	if !strings.HasPrefix(resourceType, "iam:") {
+		return key
+	}
+	kind, rest, ok := strings.Cut(key, "/")
+	if !ok {
+		return key
+	}
+	return kind + "/" + rest[strings.LastIndex(rest, "/")+1:]
+}
+
+// confirmOverride はガードレールを上書きして削除するか、リソース名の入力で確認する（--yes の指定に関わらず確認する）
+func confirmOverride(resourceLabel, name, reason string) bool {
+	ok, err := confirm.AskAlways(confirm.Request{
+		Message:     fmt.Sprintf(i18n.T("⚠️  %s %s はガードレールの対象です（%s）"), resourceLabel, name, reason),
+		Phrase:      name,
+		PhraseLabel: resourceLabel,
+	})
+	if err != nil {
+		logging.Warnf("   %v\n", err)
+	}
+	return ok
+}
+
+// formatTag はタグを表示用の文字列で返す
+func formatTag(key, value string) string {
+	if value == "" {
+		return key
+	}
+	return key + "=" + value
+}
@@ -0,0 +1,301 @@
+package guardrail
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+)
+
+// usePolicy はテスト中に適用するポリシーとタグ取得用のクライアントを設定し、終了時に元に戻す
+func usePolicy(t *testing.T, p Policy, client tagging.TaggingApi) {
+	t.Helper()
+	prevPolicy, prevClient, prevOverride := policy, newTagClient, override
+	t.Cleanup(func() {
+		policy, newTagClient, override = prevPolicy, prevClient, prevOverride
+	})
+	policy, override = p, false
+	newTagClient = nil
+	if client != nil {
+		newTagClient = func(common.Target) tagging.TaggingApi { return client }
+	}
+}
+
+// fakeTagging はARNごとのタグを返す TaggingApi（err を設定した場合はエラーを返す）
+type fakeTagging struct {
+	tags   map[string]map[string]string
+	err    error
+	inputs []*resourcegroupstaggingapi.GetResourcesInput
+}
+
+func (f *fakeTagging) GetResources(ctx context.Context, params *resourcegroupstaggingapi.GetResourcesInput, optFns ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	f.inputs = append(f.inputs, params)
+	if f.err != nil {
+		return nil, f.err
+	}
+	output := &resourcegroupstaggingapi.GetResourcesOutput{}
+	for arn, tags := range f.tags {
+		m := types.ResourceTagMapping{ResourceARN: aws.String(arn)}
+		for k, v := range tags {
+			m.Tags = append(m.Tags, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		output.ResourceTagMappingList = append(output.ResourceTagMappingList, m)
+	}
+	return output, nil
+}
+
+func TestDefaultDenyPatterns(t *testing.T) {
+	tests := []struct {
+		name    string
+		names   []string
+		desc    []string
+		blocked bool
+	}{
+		{name: "prod のみの名前", names: []string{"prod"}, blocked: true},
+		{name: "末尾が -prod", names: []string{"my-app-prod"}, blocked: true},
+		{name: "先頭が prod-", names: []string{"prod-db"}, blocked: true},
+		{name: "途中の _prod_", names: []string{"app_prod_logs"}, blocked: true},
+		{name: ".production.", names: []string{"my.production.bucket"}, blocked: true},
+		{name: "大文字小文字を区別しない", names: []string{"MyApp-Prod"}, blocked: true},
+		{name: "CDKのブートストラップ", names: []string{"cdk-hnb659fds-assets-123456789012-ap-northeast-1"}, blocked: true},
+		{name: "CDKToolkit", names: []string{"CDKToolkit"}, blocked: true},
+		{name: "Control Tower", names: []string{"aws-controltower-CloudTrailRole"}, blocked: true},
+		{name: "prod で始まる単語は対象外", names: []string{"product-images-dev"}},
+		{name: "prod を含む単語は対象外", names: []string{"reproduction-test"}},
+		{name: "区切られていない prod は対象外", names: []string{"myappprod"}},
+		{name: "別名が一致", names: []string{"key-1", "alias/app-prod"}, blocked: true},
+		{name: "説明には照合しない", names: []string{"snap-1"}, desc: []string{"backup of prod-db"}},
+	}
+
+	p := NewPolicy(nil, nil, true)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason := violation(p, tt.names, tt.desc, tagging.TypeS3Bucket, tt.names[0], nil, nil)
+			if (reason != "") != tt.blocked {
+				t.Errorf("violation(%q, %q) = %q, blocked want %v", tt.names, tt.desc, reason, tt.blocked)
+			}
+		})
+	}
+}
+
+func TestViolation(t *testing.T) {
+	tests := []struct {
+		name       string
+		policy     Policy
+		names      []string
+		desc       []string
+		resource   string
+		key        string
+		tags       map[string]map[string]string
+		tagErr     error
+		wantReason string // 空の場合は違反しない
+	}{
+		{
+			name:       "設定ファイルの拒否パターンは部分一致",
+			policy:     NewPolicy([]string{"shared"}, nil, false),
+			names:      []string{"team-shared-bucket"},
+			wantReason: "拒否パターン shared に一致",
+		},
+		{
+			name:       "設定ファイルの拒否パターンは説明にも照合",
+			policy:     NewPolicy([]string{"*keep*"}, nil, false),
+			names:      []string{"snap-1"},
+			desc:       []string{"please keep"},
+			wantReason: "please keep が拒否パターン *keep* に一致",
+		},
+		{
+			name:   "組み込みの拒否パターンを使用しない",
+			policy: NewPolicy(nil, nil, false),
+			names:  []string{"my-app-prod"},
+		},
+		{
+			name:     "必須タグが付いている",
+			policy:   NewPolicy(nil, map[string]string{"env": "dev", "owner": ""}, false),
+			names:    []string{"my-repo"},
+			resource: tagging.TypeEcrRepository,
+			key:      "repository/my-repo",
+			tags:     map[string]map[string]string{"repository/my-repo": {"env": "dev", "owner": "me"}},
+		},
+		{
+			name:       "必須タグの値が異なる",
+			policy:     NewPolicy(nil, map[string]string{"env": "dev", "owner": ""}, false),
+			names:      []string{"my-repo"},
+			resource:   tagging.TypeEcrRepository,
+			key:        "arn:aws:ecr:ap-northeast-1:123456789012:repository/my-repo",
+			tags:       map[string]map[string]string{"repository/my-repo": {"env": "stg"}},
+			wantReason: "必須タグ env=dev, owner がありません",
+		},
+		{
+			name:       "タグが付いていない",
+			policy:     NewPolicy(nil, map[string]string{"env": ""}, false),
+			names:      []string{"my-repo"},
+			resource:   tagging.TypeEcrRepository,
+			key:        "repository/my-repo",
+			wantReason: "必須タグ env がありません",
+		},
+		{
+			name:       "タグを取得できない場合は削除しない",
+			policy:     NewPolicy(nil, map[string]string{"env": ""}, false),
+			names:      []string{"my-repo"},
+			resource:   tagging.TypeEcrRepository,
+			key:        "repository/my-repo",
+			tagErr:     errors.New("AccessDenied"),
+			wantReason: "必須タグを確認できません: AccessDenied",
+		},
+		{
+			name:     "IAMはパスを除いて照合",
+			policy:   NewPolicy(nil, map[string]string{"env": ""}, false),
+			names:    []string{"my-role"},
+			resource: tagging.TypeIamRole,
+			key:      "role/service-role/my-role",
+			tags:     map[string]map[string]string{"role/my-role": {"env": "dev"}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason := violation(tt.policy, tt.names, tt.desc, tt.resource, tt.key, tt.tags, tt.tagErr)
+			if reason != tt.wantReason {
+				t.Errorf("violation() = %q, want %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestNormalizeKey(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		key          string
+		want         string
+	}{
+		{tagging.TypeIamRole, "role/service-role/my-role", "role/my-role"},
+		{tagging.TypeIamRole, "role/my-role", "role/my-role"},
+		{tagging.TypeIamPolicy, "policy/team/a/my-policy", "policy/my-policy"},
+		{tagging.TypeIamRole, "my-role", "my-role"},
+		{tagging.TypeSsmParameter, "parameter/app/db/password", "parameter/app/db/password"},
+	}
+
+	for _, tt := range tests {
+		if got := normalizeKey(tt.resourceType, tt.key); got != tt.want {
+			t.Errorf("normalizeKey(%s, %s) = %s, want %s", tt.resourceType, tt.key, got, tt.want)
+		}
+	}
+}
+
+func TestFetchRequiredTags(t *testing.T) {
+	t.Run("パスを除いたキーで返す", func(t *testing.T) {
+		fake := &fakeTagging{tags: map[string]map[string]string{
+			"arn:aws:iam::123456789012:role/service-role/my-role": {"env": "dev"},
+		}}
+		usePolicy(t, NewPolicy(nil, map[string]string{"env": ""}, false), fake)
+
+		tags, err := fetchRequiredTags(context.Background(), tagging.TypeIamRole)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if tags["role/my-role"]["env"] != "dev" {
+			t.Errorf("tags = %v, want role/my-role with env=dev", tags)
+		}
+		if len(fake.inputs) != 1 || !slices.Equal(fake.inputs[0].ResourceTypeFilters, []string{tagging.TypeIamRole}) {
+			t.Errorf("GetResources inputs = %+v", fake.inputs)
+		}
+	})
+
+	t.Run("必須タグがない場合は取得しない", func(t *testing.T) {
+		fake := &fakeTagging{}
+		usePolicy(t, NewPolicy([]string{"shared"}, nil, false), fake)
+
+		if tags, err := fetchRequiredTags(context.Background(), tagging.TypeS3Bucket); tags != nil || err != nil {
+			t.Errorf("fetchRequiredTags() = %v, %v, want nil, nil", tags, err)
+		}
+		if len(fake.inputs) != 0 {
+			t.Errorf("GetResources called %d times, want 0", len(fake.inputs))
+		}
+	})
+
+	t.Run("クライアントが未設定の場合はエラー", func(t *testing.T) {
+		usePolicy(t, NewPolicy(nil, map[string]string{"env": ""}, false), nil)
+
+		if _, err := fetchRequiredTags(context.Background(), tagging.TypeS3Bucket); err == nil {
+			t.Error("err = nil, want error")
+		}
+	})
+}
+
+func TestExclude(t *testing.T) {
+	t.Run("タグを取得できない場合はすべて除外する", func(t *testing.T) {
+		usePolicy(t, NewPolicy(nil, map[string]string{"env": "dev"}, false), &fakeTagging{err: errors.New("AccessDenied")})
+
+		got := ExcludeNames(context.Background(), "S3バケット", tagging.TypeS3Bucket, []string{"a", "b"}, func(n string) string { return n })
+		if len(got) != 0 {
+			t.Errorf("ExcludeNames() = %v, want none", got)
+		}
+	})
+
+	t.Run("IDで指定したリソースは名前・別名にも照合する", func(t *testing.T) {
+		usePolicy(t, NewPolicy([]string{"*keep*"}, nil, true), nil)
+		aliases := map[string]Aliases{
+			"key-1": {Names: []string{"alias/app-prod"}},
+			"key-2": {Names: []string{"alias/app-dev"}, Descriptions: []string{"prod-db の暗号化キー"}},
+			"key-3": {Names: []string{"alias/tmp"}, Descriptions: []string{"keep this key"}},
+		}
+
+		got := ExcludeIds(context.Background(), "KMSキー", tagging.TypeKmsKey, []string{"key-1", "key-2", "key-3", "key-4"},
+			func() (map[string]Aliases, error) { return aliases, nil },
+			func(id string) string { return "key/" + id })
+		if want := []string{"key-2", "key-4"}; !slices.Equal(got, want) {
+			t.Errorf("ExcludeIds() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("名前を取得できない場合はIDのみで照合する", func(t *testing.T) {
+		usePolicy(t, NewPolicy(nil, nil, true), nil)
+
+		got := ExcludeIds(context.Background(), "KMSキー", tagging.TypeKmsKey, []string{"key-1"},
+			func() (map[string]Aliases, error) { return nil, errors.New("AccessDenied") },
+			func(id string) string { return "key/" + id })
+		if want := []string{"key-1"}; !slices.Equal(got, want) {
+			t.Errorf("ExcludeIds() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("コンテキストで指定したポリシーを優先する", func(t *testing.T) {
+		usePolicy(t, NewPolicy(nil, map[string]string{"env": "dev"}, true), nil)
+		ctx := WithPolicy(context.Background(), NewPolicy([]string{"*-shared"}, nil, false), nil)
+
+		got := ExcludeNames(ctx, "S3バケット", tagging.TypeS3Bucket, []string{"app-prod", "team-shared"}, func(n string) string { return n })
+		if want := []string{"app-prod"}; !slices.Equal(got, want) {
+			t.Errorf("ExcludeNames() = %v, want %v", got, want)
+		}
+	})
+
+	t.Run("除外したリソースを記録する", func(t *testing.T) {
+		usePolicy(t, NewPolicy([]string{"*-shared"}, nil, true), nil)
+		ctx, exclusions := WithExclusions(context.Background())
+
+		got := ExcludeNames(ctx, "S3バケット", tagging.TypeS3Bucket, []string{"app-dev", "app-prod", "team-shared"}, func(n string) string { return n })
+		if want := []string{"app-dev"}; !slices.Equal(got, want) {
+			t.Errorf("ExcludeNames() = %v, want %v", got, want)
+		}
+
+		results := exclusions.Results()
+		var items []string
+		for _, r := range results {
+			items = append(items, r.Item)
+			if !r.Skipped || r.Error == nil || !strings.Contains(r.Error.Error(), "拒否パターン") {
+				t.Errorf("result = %+v, want skipped with the reason", r)
+			}
+		}
+		if want := []string{"app-prod", "team-shared"}; !slices.Equal(items, want) {
+			t.Errorf("recorded = %v, want %v", items, want)
+		}
+	})
+}
@@ -0,0 +1,29 @@
+package guardrail
+
+// DefaultDenyPatterns は組み込みの拒否パターン
+// 本番環境らしい名前のリソースと、CDK・Control Tower が管理する基盤リソースを誤って削除しないようにする
+// 名前全体に照合するため、prod・production は区切り文字（- _ .）で区切られた部分に一致する場合のみ拒否する（product-images 等は対象外）
+var DefaultDenyPatterns = []string{
+	"{prod,production}",
+	"{prod,production}[-_.]*",
+	"*[-_.]{prod,production}",
+	"*[-_.]{prod,production}[-_.]*",
+	"cdk-hnb659fds-*",
+	"CDKToolkit",
+	"aws-controltower-*",
+	"StackSet-AWSControlTower*",
+}
+
+// Policy は削除前に適用するガードレールのポリシー
+// いずれかの拒否パターンに一致するリソース、または必須タグが付いていないリソースは削除しない
+type Policy struct {
+	Deny         []string          // 削除を拒否するリソース名のパターン（部分一致またはワイルドカード、大文字小文字を区別しない）
+	Defaults     []string          // 組み込みの拒否パターン（名前全体に照合し、説明には照合しない）
+	RequiredTags map[string]string // 削除に必要なタグ（値が空の場合はキーが付いていればよい）
+}
+
+// Aliases はIDで指定したリソースの、拒否パターンの照合に使用するID以外の文字列
+type Aliases struct {
+	Names        []string // Nameタグ・エイリアス等の名前（すべての拒否パターンに照合する）
+	Descriptions []string // 説明等の自由記述（設定ファイルの拒否パターンのみに照合する）
+}
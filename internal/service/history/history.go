@@ -0,0 +1,192 @@
+package history
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/journal"
+	"github.com/ngsw877/awstk/internal/service/common"
+)
+
+// ShowHistory はジャーナルから条件に一致する実行履歴を新しい順に表示する
+func ShowHistory(opts Options) error {
+	path, err := journal.Path()
+	if err != nil {
+		return fmt.Errorf("❌ %w", err)
+	}
+	if path == "" {
+		return fmt.Errorf(i18n.T("❌ ジャーナルは無効化されています（環境変数 %s=off）"), journal.PathEnv)
+	}
+
+	entries, err := journal.ReadAll(path)
+	if err != nil {
+		return fmt.Errorf("❌ %w", err)
+	}
+	entries = filterEntries(entries, opts, time.Now())
+
+	displayOpts := &common.DisplayOptions{
+		ShowCount:    true,
+		EmptyMessage: i18n.T("実行履歴が見つかりませんでした"),
+	}
+	if opts.Details {
+		return common.DisplayList(entries, i18n.T("実行履歴（リソース別）"), resultsToTableData, displayOpts)
+	}
+	return common.DisplayList(entries, i18n.T("実行履歴"), entriesToTableData, displayOpts)
+}
+
+// ParseSince は期間指定（例: 30m, 12h, 7d）を解析する
+// time.ParseDuration の単位に加えて日数（d）を受け付ける
+func ParseSince(value string) (time.Duration, error) {
+	if value == "" {
+		return 0, nil
+	}
+	if days, ok := strings.CutSuffix(value, "d"); ok {
+		n, err := strconv.Atoi(days)
+		if err != nil || n < 0 {
+			return 0, fmt.Errorf(i18n.T("❌ 期間の指定が不正です: %s（例: 30m, 12h, 7d）"), value)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil || d < 0 {
+		return 0, fmt.Errorf(i18n.T("❌ 期間の指定が不正です: %s（例: 30m, 12h, 7d）"), value)
+	}
+	return d, nil
+}
+
+// filterEntries は条件に一致するエントリを新しい順に最大 Limit 件返す
+func filterEntries(entries []journal.Entry, opts Options, now time.Time) []journal.Entry {
+	var cutoff time.Time
+	if opts.Since > 0 {
+		cutoff = now.Add(-opts.Since)
+	}
+
+	filtered := []journal.Entry{}
+	for i := len(entries) - 1; i >= 0; i-- {
+		e := entries[i]
+		if !cutoff.IsZero() && e.Timestamp.Before(cutoff) {
+			continue
+		}
+		if opts.FailedOnly && !hasFailure(e) {
+			continue
+		}
+		if opts.Search != "" && !matchesSearch(e, opts.Search) {
+			continue
+		}
+		filtered = append(filtered, e)
+		if opts.Limit > 0 && len(filtered) >= opts.Limit {
+			break
+		}
+	}
+	return filtered
+}
+
+// hasFailure はエントリがエラー終了・中断、または失敗したリソースを含むかを判定する
+func hasFailure(e journal.Entry) bool {
+	if e.Status != journal.StatusSuccess {
+		return true
+	}
+	_, failed, skipped := e.Counts()
+	return failed > 0 || skipped > 0
+}
+
+// matchesSearch はコマンドラインまたはリソース名に検索文字列が含まれるかを判定する（大文字小文字を区別しない）
+func matchesSearch(e journal.Entry, search string) bool {
+	search = strings.ToLower(search)
+	if strings.Contains(strings.ToLower(e.Command), search) {
+		return true
+	}
+	for _, r := range e.Results {
+		if strings.Contains(strings.ToLower(r.Resource), search) {
+			return true
+		}
+	}
+	return false
+}
+
+// formatEntryTime は表示用にエントリの時刻をローカル時刻で整形する
+func formatEntryTime(e journal.Entry) string {
+	return e.Timestamp.Local().Format("2006-01-02 15:04:05")
+}
+
+// formatStatus は実行結果のステータスを表示用に変換する
+func formatStatus(status string) string {
+	switch status {
+	case journal.StatusSuccess:
+		return i18n.T("成功")
+	case journal.StatusCanceled:
+		return i18n.T("中断")
+	case journal.StatusError:
+		return i18n.T("エラー")
+	default:
+		return status
+	}
+}
+
+// entriesToTableData は実行履歴を1実行1行のテーブルデータに変換
+func entriesToTableData(entries []journal.Entry) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("日時"), Key: "timestamp"},
+		{Header: i18n.T("プロファイル"), Key: "profile"},
+		{Header: i18n.T("リージョン"), Key: "region"},
+		{Header: i18n.T("結果"), Key: "status"},
+		{Header: i18n.T("成功"), Key: "succeeded"},
+		{Header: i18n.T("失敗"), Key: "failed"},
+		{Header: i18n.T("未実行"), Key: "skipped"},
+		{Header: i18n.T("所要時間"), Key: "duration"},
+		{Header: i18n.T("コマンド"), Key: "command"},
+	}
+
+	data := make([][]string, len(entries))
+	for i, e := range entries {
+		success, failed, skipped := e.Counts()
+		data[i] = []string{
+			formatEntryTime(e),
+			e.Profile,
+			e.Region,
+			formatStatus(e.Status),
+			strconv.Itoa(success),
+			strconv.Itoa(failed),
+			strconv.Itoa(skipped),
+			(time.Duration(e.DurationMs) * time.Millisecond).Round(time.Second).String(),
+			e.Command,
+		}
+	}
+	return columns, data
+}
+
+// resultsToTableData は実行履歴をリソースごとの処理結果1件1行のテーブルデータに変換
+// リソースの処理結果がない実行（start/stop等）はコマンドの結果を1行で表示する
+func resultsToTableData(entries []journal.Entry) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("日時"), Key: "timestamp"},
+		{Header: i18n.T("コマンド"), Key: "command"},
+		{Header: i18n.T("リソース種別"), Key: "resourceType"},
+		{Header: i18n.T("リソース"), Key: "resource"},
+		{Header: i18n.T("操作"), Key: "action"},
+		{Header: i18n.T("結果"), Key: "result"},
+		{Header: i18n.T("エラー"), Key: "error"},
+	}
+
+	data := [][]string{}
+	for _, e := range entries {
+		if len(e.Results) == 0 {
+			data = append(data, []string{formatEntryTime(e), e.Command, "", "", "", formatStatus(e.Status), e.Error})
+			continue
+		}
+		for _, r := range e.Results {
+			result := i18n.T("成功")
+			switch {
+			case r.Skipped:
+				result = i18n.T("未実行")
+			case !r.Success:
+				result = i18n.T("失敗")
+			}
+			data = append(data, []string{formatEntryTime(e), e.Command, r.ResourceType, r.Resource, r.Action, result, r.Error})
+		}
+	}
+	return columns, data
+}
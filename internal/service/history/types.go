@@ -0,0 +1,12 @@
+package history
+
+import "time"
+
+// Options は実行履歴の検索条件を格納する構造体
+type Options struct {
+	Search     string        // コマンドラインまたはリソース名に含まれる文字列
+	Since      time.Duration // 指定期間内の実行のみ表示（0の場合は無制限）
+	Limit      int           // 表示する最大件数（0以下の場合は無制限）
+	FailedOnly bool          // 失敗・中断を含む実行のみ表示
+	Details    bool          // リソースごとの処理結果を表示
+}
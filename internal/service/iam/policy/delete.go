@@ -1,62 +1,99 @@
 package policy
 
 import (
-	"awstk/internal/service/common"
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	sdkiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/iam/types"
 )
 
 // DeletePolicies はフィルター条件に一致するIAMポリシーを削除します
-func DeletePolicies(client *sdkiam.Client, opts DeleteOptions) error {
+func DeletePolicies(ctx context.Context, client *sdkiam.Client, opts DeleteOptions) error {
 	if client == nil {
 		return fmt.Errorf("iam client is nil")
 	}
-	if opts.Filter == "" {
-		return fmt.Errorf("フィルターパターンは必須です")
+	if opts.Filter == "" && !opts.Tags.Enabled() {
+		return errors.New(i18n.T("フィルターパターンまたはタグ条件は必須です"))
 	}
 
 	// 削除対象のポリシーを取得
-	policies, err := getPoliciesForDeletion(client, opts)
+	policies, err := getPoliciesForDeletion(ctx, client, opts)
 	if err != nil {
 		return err
 	}
 
 	if len(policies) == 0 {
-		fmt.Printf("フィルター '%s' に一致するIAMポリシーが見つかりませんでした\n", opts.Filter)
+		common.Infof(ctx, i18n.T("フィルター '%s' に一致するIAMポリシーが見つかりませんでした\n"), opts.Filter)
 		return nil
 	}
 
-	// 並列実行数を設定（最大8並列）
-	maxWorkers := 8
-	if len(policies) < maxWorkers {
-		maxWorkers = len(policies)
+	// プラン作成モードの場合は削除せずにプランへ追加
+	if opts.Plan != nil {
+		actions, err := PlanPolicyDeletion(ctx, client, policies)
+		if err != nil {
+			return err
+		}
+		opts.Plan.Add(actions...)
+		return nil
+	}
+
+	DeletePolicyItems(ctx, client, policies)
+
+	if ctx.Err() != nil {
+		return fmt.Errorf(i18n.T("ポリシーの削除 が中断されました: %w"), ctx.Err())
 	}
+	return nil
+}
+
+// DeletePolicyItems は指定したIAMポリシー一覧を並列で削除します
+func DeletePolicyItems(ctx context.Context, client *sdkiam.Client, policies []PolicyItem) common.CleanupResult {
+	policies = guardrail.Exclude(ctx, i18n.T("IAMポリシー"), tagging.TypeIamPolicy, policies, func(p PolicyItem) string { return p.Name }, func(p PolicyItem) string { return p.Arn })
+	if len(policies) == 0 {
+		return common.CollectCleanupResult(i18n.T("IAMポリシー"), nil)
+	}
+
+	// 並列実行数を設定（既定は最大8並列、--concurrency または設定ファイルの concurrency で変更可）
+	maxWorkers := common.WorkerCount(8, len(policies))
 
 	executor := common.NewParallelExecutor(maxWorkers)
 	results := make([]common.ProcessResult, len(policies))
 	resultsMutex := &sync.Mutex{}
 
-	fmt.Printf("🚀 %d個のポリシーを最大%d並列で削除します...\n\n", len(policies), maxWorkers)
+	common.Infof(ctx, i18n.T("🚀 %d個のポリシーを最大%d並列で削除します...\n\n"), len(policies), maxWorkers)
 
 	for i, policy := range policies {
 		idx := i
 		p := policy
 		executor.Execute(func() {
-			fmt.Printf("ポリシー %s を削除中...\n", p.Name)
+			// 中断された場合は未実行として記録
+			if ctx.Err() != nil {
+				resultsMutex.Lock()
+				results[idx] = common.SkippedResult(p.Name, ctx.Err())
+				resultsMutex.Unlock()
+				return
+			}
+
+			common.Infof(ctx, i18n.T("ポリシー %s を削除中...\n"), p.Name)
 
-			err := deletePolicy(client, p.Arn)
+			err := common.Retry(ctx, p.Name, func() error {
+				return deletePolicy(ctx, client, p.Arn)
+			})
 
 			resultsMutex.Lock()
 			if err != nil {
-				fmt.Printf("❌ ポリシー %s の削除に失敗しました: %v\n", p.Name, err)
+				common.Warnf(ctx, i18n.T("❌ ポリシー %s の削除に失敗しました: %v\n"), p.Name, err)
 				results[idx] = common.ProcessResult{Item: p.Name, Success: false, Error: err}
 			} else {
-				fmt.Printf("✅ ポリシー %s を削除しました\n", p.Name)
+				common.Infof(ctx, i18n.T("✅ ポリシー %s を削除しました\n"), p.Name)
 				results[idx] = common.ProcessResult{Item: p.Name, Success: true}
 			}
 			resultsMutex.Unlock()
@@ -66,14 +103,13 @@ func DeletePolicies(client *sdkiam.Client, opts DeleteOptions) error {
 	executor.Wait()
 
 	// 結果の集計
-	successCount, failCount := common.CollectResults(results)
-	fmt.Printf("\n✅ 削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
+	common.PrintProcessSummary(ctx, i18n.T("削除"), results)
 
-	return nil
+	return common.CollectCleanupResult(i18n.T("IAMポリシー"), results)
 }
 
 // getPoliciesForDeletion は削除対象のポリシー一覧を取得します
-func getPoliciesForDeletion(client *sdkiam.Client, opts DeleteOptions) ([]PolicyItem, error) {
+func getPoliciesForDeletion(ctx context.Context, client *sdkiam.Client, opts DeleteOptions) ([]PolicyItem, error) {
 	paginator := sdkiam.NewListPoliciesPaginator(client, &sdkiam.ListPoliciesInput{
 		Scope: types.PolicyScopeTypeLocal, // カスタマー管理ポリシーのみ
 	})
@@ -82,9 +118,9 @@ func getPoliciesForDeletion(client *sdkiam.Client, opts DeleteOptions) ([]Policy
 	var policies []PolicyItem
 
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, common.FormatListError("IAMポリシー", err)
+			return nil, common.FormatListError(i18n.T("IAMポリシー"), err)
 		}
 
 		for _, policy := range page.Policies {
@@ -115,21 +151,27 @@ func getPoliciesForDeletion(client *sdkiam.Client, opts DeleteOptions) ([]Policy
 				Arn:             aws.ToString(policy.Arn),
 				AttachmentCount: attachmentCount,
 			})
-
-			if attachmentCount == 0 {
-				fmt.Printf("🔍 検出されたIAMポリシー: %s (未アタッチ)\n", name)
-			} else {
-				fmt.Printf("🔍 検出されたIAMポリシー: %s (アタッチ数: %d)\n", name, attachmentCount)
-			}
 		}
 	}
 
+	// タグ条件チェック
+	policies, err := selectPoliciesByTags(ctx, opts.Tags, policies, func(p PolicyItem) string { return p.Arn })
+	if err != nil {
+		return nil, err
+	}
+
+	for _, p := range policies {
+		if p.AttachmentCount == 0 {
+			common.Infof(ctx, i18n.T("🔍 検出されたIAMポリシー: %s (未アタッチ)\n"), p.Name)
+		} else {
+			common.Infof(ctx, i18n.T("🔍 検出されたIAMポリシー: %s (アタッチ数: %d)\n"), p.Name, p.AttachmentCount)
+		}
+	}
 	return policies, nil
 }
 
 // deletePolicy は単一のIAMポリシーを削除します（前処理含む）
-func deletePolicy(client *sdkiam.Client, policyArn string) error {
-	ctx := context.Background()
+func deletePolicy(ctx context.Context, client *sdkiam.Client, policyArn string) error {
 
 	// 1. ポリシーがアタッチされているエンティティからデタッチ
 	entitiesOutput, err := client.ListEntitiesForPolicy(ctx, &sdkiam.ListEntitiesForPolicyInput{
@@ -143,7 +185,7 @@ func deletePolicy(client *sdkiam.Client, policyArn string) error {
 				PolicyArn: aws.String(policyArn),
 			})
 			if err != nil {
-				return fmt.Errorf("ユーザーからのポリシーデタッチエラー: %w", err)
+				return fmt.Errorf(i18n.T("ユーザーからのポリシーデタッチエラー: %w"), err)
 			}
 		}
 
@@ -154,7 +196,7 @@ func deletePolicy(client *sdkiam.Client, policyArn string) error {
 				PolicyArn: aws.String(policyArn),
 			})
 			if err != nil {
-				return fmt.Errorf("グループからのポリシーデタッチエラー: %w", err)
+				return fmt.Errorf(i18n.T("グループからのポリシーデタッチエラー: %w"), err)
 			}
 		}
 
@@ -165,7 +207,7 @@ func deletePolicy(client *sdkiam.Client, policyArn string) error {
 				PolicyArn: aws.String(policyArn),
 			})
 			if err != nil {
-				return fmt.Errorf("ロールからのポリシーデタッチエラー: %w", err)
+				return fmt.Errorf(i18n.T("ロールからのポリシーデタッチエラー: %w"), err)
 			}
 		}
 	}
@@ -182,7 +224,7 @@ func deletePolicy(client *sdkiam.Client, policyArn string) error {
 					VersionId: version.VersionId,
 				})
 				if err != nil {
-					return fmt.Errorf("ポリシーバージョン削除エラー: %w", err)
+					return fmt.Errorf(i18n.T("ポリシーバージョン削除エラー: %w"), err)
 				}
 			}
 		}
@@ -193,7 +235,7 @@ func deletePolicy(client *sdkiam.Client, policyArn string) error {
 		PolicyArn: aws.String(policyArn),
 	})
 	if err != nil {
-		return fmt.Errorf("ポリシー削除エラー: %w", err)
+		return fmt.Errorf(i18n.T("ポリシー削除エラー: %w"), err)
 	}
 
 	return nil
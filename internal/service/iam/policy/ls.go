@@ -1,52 +1,55 @@
 package policy
 
 import (
-	"awstk/internal/service/common"
 	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	sdkiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/iam/types"
 )
 
 // ListIamPolicies cmdから呼ばれるメイン関数（Get + Display）
-func ListIamPolicies(client *sdkiam.Client, opts ListOptions) error {
+func ListIamPolicies(ctx context.Context, client *sdkiam.Client, opts ListOptions) error {
 	if client == nil {
 		return fmt.Errorf("iam client is nil")
 	}
 
 	// Get: データ取得
 	if opts.UnattachedOnly {
-		items, err := getUnusedIamPolicies(client, opts)
+		items, err := getUnusedIamPolicies(ctx, client, opts)
 		if err != nil {
 			return err
 		}
 		// Display: 共通表示処理
-		return common.DisplayList(items, "未使用のカスタマー管理ポリシー", unusedIamPoliciesToTableData, &common.DisplayOptions{
+		return common.DisplayList(items, i18n.T("未使用のカスタマー管理ポリシー"), unusedIamPoliciesToTableData, &common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: common.FormatEmptyMessage("未使用のカスタマー管理ポリシー"),
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("未使用のカスタマー管理ポリシー")),
 		})
 	}
 
-	items, err := getAllIamPolicies(client, opts)
+	items, err := getAllIamPolicies(ctx, client, opts)
 	if err != nil {
 		return err
 	}
 	// Display: 共通表示処理
-	return common.DisplayList(items, "カスタマー管理ポリシー一覧", iamPoliciesToTableData, &common.DisplayOptions{ShowCount: true})
+	return common.DisplayList(items, i18n.T("カスタマー管理ポリシー一覧"), iamPoliciesToTableData, &common.DisplayOptions{ShowCount: true})
 }
 
 // getAllIamPolicies カスタマー管理ポリシー一覧を取得
-func getAllIamPolicies(client *sdkiam.Client, opts ListOptions) ([]PolicyItem, error) {
+func getAllIamPolicies(ctx context.Context, client *sdkiam.Client, opts ListOptions) ([]PolicyItem, error) {
 	paginator := sdkiam.NewListPoliciesPaginator(client, &sdkiam.ListPoliciesInput{Scope: types.PolicyScopeTypeLocal})
 	filters := common.RemoveDuplicates(opts.Exclude)
 
 	var policies []PolicyItem
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, common.FormatListError("カスタマー管理ポリシー", err)
+			return nil, common.FormatListError(i18n.T("カスタマー管理ポリシー"), err)
 		}
 		for _, policy := range page.Policies {
 			name := aws.ToString(policy.PolicyName)
@@ -64,19 +67,19 @@ func getAllIamPolicies(client *sdkiam.Client, opts ListOptions) ([]PolicyItem, e
 			})
 		}
 	}
-	return policies, nil
+	return selectPoliciesByTags(ctx, opts.Tags, policies, func(p PolicyItem) string { return p.Arn })
 }
 
 // getUnusedIamPolicies 未アタッチのカスタマー管理ポリシー一覧を取得
-func getUnusedIamPolicies(client *sdkiam.Client, opts ListOptions) ([]UnusedPolicy, error) {
+func getUnusedIamPolicies(ctx context.Context, client *sdkiam.Client, opts ListOptions) ([]UnusedPolicy, error) {
 	paginator := sdkiam.NewListPoliciesPaginator(client, &sdkiam.ListPoliciesInput{Scope: types.PolicyScopeTypeLocal})
 	filters := common.RemoveDuplicates(opts.Exclude)
 
 	var unusedPolicies []UnusedPolicy
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, common.FormatListError("カスタマー管理ポリシー", err)
+			return nil, common.FormatListError(i18n.T("カスタマー管理ポリシー"), err)
 		}
 		for _, policy := range page.Policies {
 			name := aws.ToString(policy.PolicyName)
@@ -84,11 +87,16 @@ func getUnusedIamPolicies(client *sdkiam.Client, opts ListOptions) ([]UnusedPoli
 				continue
 			}
 			if policy.AttachmentCount != nil && *policy.AttachmentCount == 0 {
-				unusedPolicies = append(unusedPolicies, UnusedPolicy{Name: name, Arn: aws.ToString(policy.Arn), Note: "未アタッチ"})
+				unusedPolicies = append(unusedPolicies, UnusedPolicy{Name: name, Arn: aws.ToString(policy.Arn), Note: i18n.T("未アタッチ")})
 			}
 		}
 	}
-	return unusedPolicies, nil
+	return selectPoliciesByTags(ctx, opts.Tags, unusedPolicies, func(p UnusedPolicy) string { return p.Arn })
+}
+
+// selectPoliciesByTags タグ条件に一致するポリシーに絞り込む（IAMはグローバルサービスのため GlobalRegion でタグを取得する）
+func selectPoliciesByTags[T any](ctx context.Context, tags *tagging.Selector, policies []T, arnOf func(T) string) ([]T, error) {
+	return tagging.Select(ctx, tags, common.Target{Region: tagging.GlobalRegion}, tagging.TypeIamPolicy, policies, arnOf)
 }
 
 // matchesAnyFilter 除外パターンに一致するか判定
@@ -109,7 +117,7 @@ func matchesAnyFilter(name string, filters []string) bool {
 
 // unusedIamPoliciesToTableData 未使用カスタマー管理ポリシー情報をテーブルデータに変換
 func unusedIamPoliciesToTableData(items []UnusedPolicy) ([]common.TableColumn, [][]string) {
-	columns := []common.TableColumn{{Header: "ポリシー名"}, {Header: "理由"}}
+	columns := []common.TableColumn{{Header: i18n.T("ポリシー名"), Key: "policyName"}, {Header: i18n.T("理由"), Key: "reason"}}
 	rows := make([][]string, len(items))
 	for index, policy := range items {
 		rows[index] = []string{policy.Name, policy.Note}
@@ -119,7 +127,7 @@ func unusedIamPoliciesToTableData(items []UnusedPolicy) ([]common.TableColumn, [
 
 // iamPoliciesToTableData カスタマー管理ポリシー情報をテーブルデータに変換
 func iamPoliciesToTableData(items []PolicyItem) ([]common.TableColumn, [][]string) {
-	columns := []common.TableColumn{{Header: "ポリシー名"}, {Header: "attachments"}}
+	columns := []common.TableColumn{{Header: i18n.T("ポリシー名"), Key: "policyName"}, {Header: "attachments", Key: "attachments"}}
 	rows := make([][]string, len(items))
 	for index, policy := range items {
 		rows[index] = []string{policy.Name, fmt.Sprintf("%d", policy.AttachmentCount)}
@@ -0,0 +1,56 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/plan"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// PlanPolicyDeletion は指定したIAMポリシーの削除アクションを作成します
+// 存在しないポリシーは結果に含まれません（apply時の再検証でも使用）
+func PlanPolicyDeletion(ctx context.Context, client *sdkiam.Client, policies []PolicyItem) ([]plan.Action, error) {
+	actions := []plan.Action{}
+	for _, p := range policies {
+		output, err := client.GetPolicy(ctx, &sdkiam.GetPolicyInput{PolicyArn: aws.String(p.Arn)})
+		if err != nil {
+			var notFound *types.NoSuchEntityException
+			if errors.As(err, &notFound) {
+				continue
+			}
+			return nil, fmt.Errorf(i18n.T(common.GetErrorFormat), common.ErrorIcon, i18n.T("IAMポリシー ")+p.Name, err)
+		}
+		actions = append(actions, plan.Action{
+			Type:   plan.ResourceIamPolicy,
+			Action: plan.ActionDelete,
+			Name:   aws.ToString(output.Policy.PolicyName),
+			Id:     aws.ToString(output.Policy.Arn),
+			Fingerprint: map[string]string{
+				"policyId":         aws.ToString(output.Policy.PolicyId),
+				"defaultVersionId": aws.ToString(output.Policy.DefaultVersionId),
+			},
+		})
+	}
+	return actions, nil
+}
+
+// PolicyItemsFromActions はプランのアクションから削除対象のポリシー一覧を復元します
+func PolicyItemsFromActions(actions []plan.Action) []PolicyItem {
+	policies := make([]PolicyItem, len(actions))
+	for i, a := range actions {
+		policies[i] = PolicyItem{Name: a.Name, Arn: a.Id}
+	}
+	return policies
+}
+
+// ApplyPolicyDeletion はプランのアクションに従ってIAMポリシーを削除します
+func ApplyPolicyDeletion(ctx context.Context, client *sdkiam.Client, actions []plan.Action) common.CleanupResult {
+	return DeletePolicyItems(ctx, client, PolicyItemsFromActions(actions))
+}
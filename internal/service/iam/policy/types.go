@@ -1,9 +1,15 @@
 package policy
 
+import (
+	"github.com/ngsw877/awstk/internal/service/plan"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+)
+
 // ListOptions IamPolicyListOptions IAMポリシー一覧取得時のオプション
 type ListOptions struct {
 	UnattachedOnly bool
 	Exclude        []string
+	Tags           *tagging.Selector // タグ条件（指定時は一致するポリシーのみ）
 }
 
 // PolicyItem IamPolicy IAMポリシー一覧表示用の情報
@@ -22,8 +28,10 @@ type UnusedPolicy struct {
 
 // DeleteOptions IAMポリシー削除時のオプション
 type DeleteOptions struct {
-	Filter         string   // 必須: 削除対象のフィルターパターン
-	UnattachedOnly bool     // 未アタッチのみ対象
-	Exclude        []string // 除外パターン
-	Exact          bool     // 大文字小文字を区別してマッチ
+	Filter         string            // 削除対象のフィルターパターン（Tags を指定しない場合は必須）
+	Tags           *tagging.Selector // タグ条件（指定時は一致するポリシーのみ）
+	UnattachedOnly bool              // 未アタッチのみ対象
+	Exclude        []string          // 除外パターン
+	Exact          bool              // 大文字小文字を区別してマッチ
+	Plan           *plan.Plan        // 指定された場合は削除せず、プランにアクションを追加する
 }
@@ -1,62 +1,100 @@
 package role
 
 import (
-	"awstk/internal/service/common"
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	sdkiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
 )
 
 // DeleteRoles はフィルター条件に一致するIAMロールを削除します
-func DeleteRoles(client *sdkiam.Client, opts DeleteOptions) error {
+func DeleteRoles(ctx context.Context, client *sdkiam.Client, opts DeleteOptions) error {
 	if client == nil {
 		return fmt.Errorf("iam client is nil")
 	}
-	if opts.Filter == "" {
-		return fmt.Errorf("フィルターパターンは必須です")
+	if opts.Filter == "" && !opts.Tags.Enabled() {
+		return errors.New(i18n.T("フィルターパターンまたはタグ条件は必須です"))
 	}
 
 	// 削除対象のロールを取得
-	roleNames, err := getRolesForDeletion(client, opts)
+	roleNames, err := getRolesForDeletion(ctx, client, opts)
 	if err != nil {
 		return err
 	}
 
 	if len(roleNames) == 0 {
-		fmt.Printf("フィルター '%s' に一致するIAMロールが見つかりませんでした\n", opts.Filter)
+		common.Infof(ctx, i18n.T("フィルター '%s' に一致するIAMロールが見つかりませんでした\n"), opts.Filter)
+		return nil
+	}
+
+	// プラン作成モードの場合は削除せずにプランへ追加
+	if opts.Plan != nil {
+		actions, err := PlanRoleDeletion(ctx, client, roleNames)
+		if err != nil {
+			return err
+		}
+		opts.Plan.Add(actions...)
 		return nil
 	}
 
-	// 並列実行数を設定（最大8並列）
-	maxWorkers := 8
-	if len(roleNames) < maxWorkers {
-		maxWorkers = len(roleNames)
+	DeleteRolesByName(ctx, client, roleNames)
+
+	if ctx.Err() != nil {
+		return fmt.Errorf(i18n.T("ロールの削除 が中断されました: %w"), ctx.Err())
+	}
+	return nil
+}
+
+// DeleteRolesByName は指定したIAMロール一覧を並列で削除します
+func DeleteRolesByName(ctx context.Context, client *sdkiam.Client, roleNames []string) common.CleanupResult {
+	roleNames = guardrail.ExcludeNames(ctx, i18n.T("IAMロール"), tagging.TypeIamRole, roleNames, func(name string) string { return "role/" + name })
+	if len(roleNames) == 0 {
+		return common.CollectCleanupResult(i18n.T("IAMロール"), nil)
 	}
 
+	// 並列実行数を設定（既定は最大8並列、--concurrency または設定ファイルの concurrency で変更可）
+	maxWorkers := common.WorkerCount(8, len(roleNames))
+
 	executor := common.NewParallelExecutor(maxWorkers)
 	results := make([]common.ProcessResult, len(roleNames))
 	resultsMutex := &sync.Mutex{}
 
-	fmt.Printf("🚀 %d個のロールを最大%d並列で削除します...\n\n", len(roleNames), maxWorkers)
+	common.Infof(ctx, i18n.T("🚀 %d個のロールを最大%d並列で削除します...\n\n"), len(roleNames), maxWorkers)
 
 	for i, roleName := range roleNames {
 		idx := i
 		name := roleName
 		executor.Execute(func() {
-			fmt.Printf("ロール %s を削除中...\n", name)
+			// 中断された場合は未実行として記録
+			if ctx.Err() != nil {
+				resultsMutex.Lock()
+				results[idx] = common.SkippedResult(name, ctx.Err())
+				resultsMutex.Unlock()
+				return
+			}
+
+			common.Infof(ctx, i18n.T("ロール %s を削除中...\n"), name)
 
-			err := deleteRole(client, name)
+			err := common.Retry(ctx, name, func() error {
+				return deleteRole(ctx, client, name)
+			})
 
 			resultsMutex.Lock()
 			if err != nil {
-				fmt.Printf("❌ ロール %s の削除に失敗しました: %v\n", name, err)
+				common.Warnf(ctx, i18n.T("❌ ロール %s の削除に失敗しました: %v\n"), name, err)
 				results[idx] = common.ProcessResult{Item: name, Success: false, Error: err}
 			} else {
-				fmt.Printf("✅ ロール %s を削除しました\n", name)
+				common.Infof(ctx, i18n.T("✅ ロール %s を削除しました\n"), name)
 				results[idx] = common.ProcessResult{Item: name, Success: true}
 			}
 			resultsMutex.Unlock()
@@ -66,26 +104,25 @@ func DeleteRoles(client *sdkiam.Client, opts DeleteOptions) error {
 	executor.Wait()
 
 	// 結果の集計
-	successCount, failCount := common.CollectResults(results)
-	fmt.Printf("\n✅ 削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
+	common.PrintProcessSummary(ctx, i18n.T("削除"), results)
 
-	return nil
+	return common.CollectCleanupResult(i18n.T("IAMロール"), results)
 }
 
 // getRolesForDeletion は削除対象のロール名一覧を取得します
-func getRolesForDeletion(client *sdkiam.Client, opts DeleteOptions) ([]string, error) {
+func getRolesForDeletion(ctx context.Context, client *sdkiam.Client, opts DeleteOptions) ([]string, error) {
 	paginator := sdkiam.NewListRolesPaginator(client, &sdkiam.ListRolesInput{})
 
 	excludes := common.RemoveDuplicates(opts.Exclude)
 	cutoff := time.Now().AddDate(0, 0, -opts.UnusedDays)
 
-	var candidateRoles []string
+	var candidates []types.Role
 
 	// 全ロールを取得してフィルタリング
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, common.FormatListError("IAMロール", err)
+			return nil, common.FormatListError(i18n.T("IAMロール"), err)
 		}
 
 		for _, role := range page.Roles {
@@ -106,27 +143,37 @@ func getRolesForDeletion(client *sdkiam.Client, opts DeleteOptions) ([]string, e
 				continue
 			}
 
-			candidateRoles = append(candidateRoles, name)
+			candidates = append(candidates, role)
 		}
 	}
 
+	// タグ条件チェック
+	candidates, err := selectRolesByTags(ctx, opts.Tags, candidates)
+	if err != nil {
+		return nil, err
+	}
+	candidateRoles := make([]string, len(candidates))
+	for i, role := range candidates {
+		candidateRoles[i] = aws.ToString(role.RoleName)
+	}
+
 	// UnusedDaysフィルターが無効（0）の場合はそのまま返す
 	if opts.UnusedDays == 0 {
 		for _, name := range candidateRoles {
-			fmt.Printf("🔍 検出されたIAMロール: %s\n", name)
+			common.Infof(ctx, i18n.T("🔍 検出されたIAMロール: %s\n"), name)
 		}
 		return candidateRoles, nil
 	}
 
 	// UnusedDaysフィルターが有効な場合は最終使用日時でフィルタリング
-	exec := common.NewParallelExecutor(8)
+	exec := common.NewParallelExecutor(common.WorkerCount(8, len(candidateRoles)))
 	var mu sync.Mutex
 	var filteredRoles []string
 
 	for _, roleName := range candidateRoles {
 		roleNameCopy := roleName
 		exec.Execute(func() {
-			outRole, err := client.GetRole(context.Background(), &sdkiam.GetRoleInput{
+			outRole, err := client.GetRole(ctx, &sdkiam.GetRoleInput{
 				RoleName: aws.String(roleNameCopy),
 			})
 			if err != nil {
@@ -144,7 +191,7 @@ func getRolesForDeletion(client *sdkiam.Client, opts DeleteOptions) ([]string, e
 				if lastUsed == nil {
 					mu.Lock()
 					filteredRoles = append(filteredRoles, roleNameCopy)
-					fmt.Printf("🔍 検出されたIAMロール: %s (未使用)\n", roleNameCopy)
+					common.Infof(ctx, i18n.T("🔍 検出されたIAMロール: %s (未使用)\n"), roleNameCopy)
 					mu.Unlock()
 				}
 				return
@@ -155,9 +202,9 @@ func getRolesForDeletion(client *sdkiam.Client, opts DeleteOptions) ([]string, e
 				mu.Lock()
 				filteredRoles = append(filteredRoles, roleNameCopy)
 				if lastUsed == nil {
-					fmt.Printf("🔍 検出されたIAMロール: %s (未使用)\n", roleNameCopy)
+					common.Infof(ctx, i18n.T("🔍 検出されたIAMロール: %s (未使用)\n"), roleNameCopy)
 				} else {
-					fmt.Printf("🔍 検出されたIAMロール: %s (最終使用: %s)\n", roleNameCopy, lastUsed.Format("2006-01-02"))
+					common.Infof(ctx, i18n.T("🔍 検出されたIAMロール: %s (最終使用: %s)\n"), roleNameCopy, lastUsed.Format("2006-01-02"))
 				}
 				mu.Unlock()
 			}
@@ -169,8 +216,7 @@ func getRolesForDeletion(client *sdkiam.Client, opts DeleteOptions) ([]string, e
 }
 
 // deleteRole は単一のIAMロールを削除します（前処理含む）
-func deleteRole(client *sdkiam.Client, roleName string) error {
-	ctx := context.Background()
+func deleteRole(ctx context.Context, client *sdkiam.Client, roleName string) error {
 
 	// 1. インスタンスプロファイルからロールを削除
 	profilesOutput, err := client.ListInstanceProfilesForRole(ctx, &sdkiam.ListInstanceProfilesForRoleInput{
@@ -183,7 +229,7 @@ func deleteRole(client *sdkiam.Client, roleName string) error {
 				RoleName:            aws.String(roleName),
 			})
 			if err != nil {
-				return fmt.Errorf("インスタンスプロファイルからのロール削除エラー: %w", err)
+				return fmt.Errorf(i18n.T("インスタンスプロファイルからのロール削除エラー: %w"), err)
 			}
 		}
 	}
@@ -199,7 +245,7 @@ func deleteRole(client *sdkiam.Client, roleName string) error {
 				PolicyArn: policy.PolicyArn,
 			})
 			if err != nil {
-				return fmt.Errorf("ポリシーのデタッチエラー: %w", err)
+				return fmt.Errorf(i18n.T("ポリシーのデタッチエラー: %w"), err)
 			}
 		}
 	}
@@ -215,7 +261,7 @@ func deleteRole(client *sdkiam.Client, roleName string) error {
 				PolicyName: aws.String(policyName),
 			})
 			if err != nil {
-				return fmt.Errorf("インラインポリシーの削除エラー: %w", err)
+				return fmt.Errorf(i18n.T("インラインポリシーの削除エラー: %w"), err)
 			}
 		}
 	}
@@ -225,7 +271,7 @@ func deleteRole(client *sdkiam.Client, roleName string) error {
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
-		return fmt.Errorf("ロール削除エラー: %w", err)
+		return fmt.Errorf(i18n.T("ロール削除エラー: %w"), err)
 	}
 
 	return nil
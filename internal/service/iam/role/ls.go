@@ -1,20 +1,23 @@
 package role
 
 import (
-	"awstk/internal/service/common"
 	"context"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	sdkiam "github.com/aws/aws-sdk-go-v2/service/iam"
 	"github.com/aws/aws-sdk-go-v2/service/iam/types"
 )
 
 // ListIamRoles cmdから呼ばれるメイン関数（Get + Display）
-func ListIamRoles(client *sdkiam.Client, opts ListOptions) error {
+func ListIamRoles(ctx context.Context, client *sdkiam.Client, opts ListOptions) error {
 	if client == nil {
 		return fmt.Errorf("iam client is nil")
 	}
@@ -22,48 +25,52 @@ func ListIamRoles(client *sdkiam.Client, opts ListOptions) error {
 	// Get: データ取得
 	// -1: 引数なし（never used のみ）  /  >0: 指定日数以上未使用
 	if opts.UnusedDays == -1 {
-		items, err := getNeverUsedIamRoles(client, opts)
+		items, err := getNeverUsedIamRoles(ctx, client, opts)
 		if err != nil {
 			return err
 		}
 		// Display: 共通表示処理
-		return common.DisplayList(items, "未使用のIAMロール", unusedIamRolesToTableData, &common.DisplayOptions{
+		return common.DisplayList(items, i18n.T("未使用のIAMロール"), unusedIamRolesToTableData, &common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: common.FormatEmptyMessage("未使用のIAMロール"),
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("未使用のIAMロール")),
 		})
 	}
 
 	if opts.UnusedDays > 0 {
-		items, err := getUnusedIamRoles(client, opts)
+		items, err := getUnusedIamRoles(ctx, client, opts)
 		if err != nil {
 			return err
 		}
 		// Display: 共通表示処理
-		return common.DisplayList(items, "未使用のIAMロール", unusedIamRolesToTableData, &common.DisplayOptions{
+		return common.DisplayList(items, i18n.T("未使用のIAMロール"), unusedIamRolesToTableData, &common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: common.FormatEmptyMessage("未使用のIAMロール"),
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("未使用のIAMロール")),
 		})
 	}
 
-	items, err := getAllIamRoles(client, opts)
+	items, err := getAllIamRoles(ctx, client, opts)
 	if err != nil {
 		return err
 	}
 	// Display: 共通表示処理
-	return common.DisplayList(items, "IAMロール一覧", iamRolesToTableData, &common.DisplayOptions{ShowCount: true})
+	return common.DisplayList(items, i18n.T("IAMロール一覧"), iamRolesToTableData, &common.DisplayOptions{ShowCount: true})
 }
 
 // getAllIamRoles IAMロール一覧を取得
-func getAllIamRoles(client *sdkiam.Client, opts ListOptions) ([]RoleItem, error) {
+func getAllIamRoles(ctx context.Context, client *sdkiam.Client, opts ListOptions) ([]RoleItem, error) {
 	paginator := sdkiam.NewListRolesPaginator(client, &sdkiam.ListRolesInput{})
 	var roles []types.Role
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, common.FormatListError("IAMロール", err)
+			return nil, common.FormatListError(i18n.T("IAMロール"), err)
 		}
 		roles = append(roles, page.Roles...)
 	}
+	roles, err := selectRolesByTags(ctx, opts.Tags, roles)
+	if err != nil {
+		return nil, err
+	}
 
 	roleItems := make([]RoleItem, 0, len(roles))
 	excludes := common.RemoveDuplicates(opts.Exclude)
@@ -88,12 +95,12 @@ func getAllIamRoles(client *sdkiam.Client, opts ListOptions) ([]RoleItem, error)
 	}
 
 	// 最終使用日時を並列取得
-	exec := common.NewParallelExecutor(8)
+	exec := common.NewParallelExecutor(common.WorkerCount(8, len(roleItems)))
 	var mu sync.Mutex
 	for index := range roleItems {
 		itemIndex := index
 		exec.Execute(func() {
-			outRole, err := client.GetRole(context.Background(), &sdkiam.GetRoleInput{RoleName: aws.String(roleItems[itemIndex].Name)})
+			outRole, err := client.GetRole(ctx, &sdkiam.GetRoleInput{RoleName: aws.String(roleItems[itemIndex].Name)})
 			if err != nil {
 				return
 			}
@@ -112,16 +119,20 @@ func getAllIamRoles(client *sdkiam.Client, opts ListOptions) ([]RoleItem, error)
 }
 
 // getUnusedIamRoles 指定日数以上未使用のIAMロールを取得
-func getUnusedIamRoles(client *sdkiam.Client, opts ListOptions) ([]UnusedRole, error) {
+func getUnusedIamRoles(ctx context.Context, client *sdkiam.Client, opts ListOptions) ([]UnusedRole, error) {
 	paginator := sdkiam.NewListRolesPaginator(client, &sdkiam.ListRolesInput{})
 	var roles []types.Role
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, common.FormatListError("IAMロール", err)
+			return nil, common.FormatListError(i18n.T("IAMロール"), err)
 		}
 		roles = append(roles, page.Roles...)
 	}
+	roles, err := selectRolesByTags(ctx, opts.Tags, roles)
+	if err != nil {
+		return nil, err
+	}
 
 	excludes := common.RemoveDuplicates(opts.Exclude)
 	cutoff := time.Now().AddDate(0, 0, -opts.UnusedDays)
@@ -142,13 +153,13 @@ func getUnusedIamRoles(client *sdkiam.Client, opts ListOptions) ([]UnusedRole, e
 		roleNames = append(roleNames, name)
 	}
 
-	exec := common.NewParallelExecutor(8)
+	exec := common.NewParallelExecutor(common.WorkerCount(8, len(roleNames)))
 	var mu sync.Mutex
 	var unusedRoles []UnusedRole
 	for _, roleName := range roleNames {
 		roleNameCopy := roleName
 		exec.Execute(func() {
-			outRole, err := client.GetRole(context.Background(), &sdkiam.GetRoleInput{RoleName: aws.String(roleNameCopy)})
+			outRole, err := client.GetRole(ctx, &sdkiam.GetRoleInput{RoleName: aws.String(roleNameCopy)})
 			if err != nil {
 				return
 			}
@@ -168,6 +179,13 @@ func getUnusedIamRoles(client *sdkiam.Client, opts ListOptions) ([]UnusedRole, e
 	return unusedRoles, nil
 }
 
+// selectRolesByTags タグ条件に一致するロールに絞り込む（IAMはグローバルサービスのため GlobalRegion でタグを取得する）
+func selectRolesByTags(ctx context.Context, tags *tagging.Selector, roles []types.Role) ([]types.Role, error) {
+	return tagging.Select(ctx, tags, common.Target{Region: tagging.GlobalRegion}, tagging.TypeIamRole, roles, func(role types.Role) string {
+		return aws.ToString(role.Arn)
+	})
+}
+
 // isServiceLinkedRole サービスリンクロールか判定
 func isServiceLinkedRole(role types.Role) bool {
 	return aws.ToString(role.Path) == "/aws-service-role/"
@@ -202,7 +220,7 @@ func formatIamRoleLastUsedLocal(lastUsedTime *time.Time) string {
 
 // iamRolesToTableData IAMロール情報をテーブルデータに変換
 func iamRolesToTableData(items []RoleItem) ([]common.TableColumn, [][]string) {
-	cols := []common.TableColumn{{Header: "ロール名"}, {Header: "最終使用"}, {Header: "備考"}}
+	cols := []common.TableColumn{{Header: i18n.T("ロール名"), Key: "roleName"}, {Header: i18n.T("最終使用"), Key: "lastUsed"}, {Header: i18n.T("備考"), Key: "note"}}
 	rows := make([][]string, len(items))
 	for index, roleItem := range items {
 		note := ""
@@ -216,7 +234,7 @@ func iamRolesToTableData(items []RoleItem) ([]common.TableColumn, [][]string) {
 
 // unusedIamRolesToTableData 未使用IAMロール情報をテーブルデータに変換
 func unusedIamRolesToTableData(items []UnusedRole) ([]common.TableColumn, [][]string) {
-	cols := []common.TableColumn{{Header: "ロール名"}, {Header: "最終使用"}}
+	cols := []common.TableColumn{{Header: i18n.T("ロール名"), Key: "roleName"}, {Header: i18n.T("最終使用"), Key: "lastUsed"}}
 	rows := make([][]string, len(items))
 	for index, unusedRole := range items {
 		rows[index] = []string{unusedRole.Name, formatIamRoleLastUsedLocal(unusedRole.LastUsed)}
@@ -225,16 +243,20 @@ func unusedIamRolesToTableData(items []UnusedRole) ([]common.TableColumn, [][]st
 }
 
 // getNeverUsedIamRoles 一度も使用されていないIAMロールを取得
-func getNeverUsedIamRoles(client *sdkiam.Client, opts ListOptions) ([]UnusedRole, error) {
+func getNeverUsedIamRoles(ctx context.Context, client *sdkiam.Client, opts ListOptions) ([]UnusedRole, error) {
 	paginator := sdkiam.NewListRolesPaginator(client, &sdkiam.ListRolesInput{})
 	var roles []types.Role
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, common.FormatListError("IAMロール", err)
+			return nil, common.FormatListError(i18n.T("IAMロール"), err)
 		}
 		roles = append(roles, page.Roles...)
 	}
+	roles, err := selectRolesByTags(ctx, opts.Tags, roles)
+	if err != nil {
+		return nil, err
+	}
 
 	excludes := common.RemoveDuplicates(opts.Exclude)
 	roleNames := make([]string, 0, len(roles))
@@ -253,13 +275,13 @@ func getNeverUsedIamRoles(client *sdkiam.Client, opts ListOptions) ([]UnusedRole
 		roleNames = append(roleNames, name)
 	}
 
-	exec := common.NewParallelExecutor(8)
+	exec := common.NewParallelExecutor(common.WorkerCount(8, len(roleNames)))
 	var mu sync.Mutex
 	var unusedRoles []UnusedRole
 	for _, roleName := range roleNames {
 		roleNameCopy := roleName
 		exec.Execute(func() {
-			outRole, err := client.GetRole(context.Background(), &sdkiam.GetRoleInput{RoleName: aws.String(roleNameCopy)})
+			outRole, err := client.GetRole(ctx, &sdkiam.GetRoleInput{RoleName: aws.String(roleNameCopy)})
 			if err != nil {
 				return
 			}
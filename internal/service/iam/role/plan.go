@@ -0,0 +1,46 @@
+package role
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/plan"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	sdkiam "github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/aws/aws-sdk-go-v2/service/iam/types"
+)
+
+// PlanRoleDeletion は指定したIAMロールの削除アクションを作成します
+// 存在しないロールは結果に含まれません（apply時の再検証でも使用）
+func PlanRoleDeletion(ctx context.Context, client *sdkiam.Client, roleNames []string) ([]plan.Action, error) {
+	actions := []plan.Action{}
+	for _, name := range roleNames {
+		output, err := client.GetRole(ctx, &sdkiam.GetRoleInput{RoleName: aws.String(name)})
+		if err != nil {
+			var notFound *types.NoSuchEntityException
+			if errors.As(err, &notFound) {
+				continue
+			}
+			return nil, fmt.Errorf(i18n.T(common.GetErrorFormat), common.ErrorIcon, i18n.T("IAMロール ")+name, err)
+		}
+		actions = append(actions, plan.Action{
+			Type:   plan.ResourceIamRole,
+			Action: plan.ActionDelete,
+			Name:   name,
+			Id:     aws.ToString(output.Role.RoleId),
+			Fingerprint: map[string]string{
+				"createDate": plan.FormatTime(output.Role.CreateDate),
+			},
+		})
+	}
+	return actions, nil
+}
+
+// ApplyRoleDeletion はプランのアクションに従ってIAMロールを削除します
+func ApplyRoleDeletion(ctx context.Context, client *sdkiam.Client, actions []plan.Action) common.CleanupResult {
+	return DeleteRolesByName(ctx, client, plan.Names(actions))
+}
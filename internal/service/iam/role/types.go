@@ -1,11 +1,17 @@
 package role
 
-import "time"
+import (
+	"time"
+
+	"github.com/ngsw877/awstk/internal/service/plan"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+)
 
 // ListOptions IamRoleListOptions IAMロール一覧取得時のオプション
 type ListOptions struct {
 	UnusedDays int
 	Exclude    []string
+	Tags       *tagging.Selector // タグ条件（指定時は一致するロールのみ）
 }
 
 // RoleItem IamRole IAMロール一覧表示用の情報
@@ -25,8 +31,10 @@ type UnusedRole struct {
 
 // DeleteOptions IAMロール削除時のオプション
 type DeleteOptions struct {
-	Filter     string   // 必須: 削除対象のフィルターパターン
-	UnusedDays int      // 0=無効、-1=never used、>0=指定日数以上未使用
-	Exclude    []string // 除外パターン
-	Exact      bool     // 大文字小文字を区別してマッチ
+	Filter     string            // 削除対象のフィルターパターン（Tags を指定しない場合は必須）
+	Tags       *tagging.Selector // タグ条件（指定時は一致するロールのみ）
+	UnusedDays int               // 0=無効、-1=never used、>0=指定日数以上未使用
+	Exclude    []string          // 除外パターン
+	Exact      bool              // 大文字小文字を区別してマッチ
+	Plan       *plan.Plan        // 指定された場合は削除せず、プランにアクションを追加する
 }
@@ -0,0 +1,151 @@
+package kms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/kms/types"
+)
+
+// PendingWindowInDays はKMSキーの削除を予約する待機期間（日数。KMSで指定できる最短の期間）
+const PendingWindowInDays = 7
+
+// GetKmsKeysByFilter はフィルターに一致するKMSキーのキーIDの一覧を取得します
+// キーID・エイリアス・説明のいずれかが検索文字列に一致するカスタマー管理キーが対象で、AWS管理キーと削除予約済みのキーは含みません
+// exact が true の場合、大文字小文字を区別します
+// tags が指定された場合は、タグ条件にも一致するキーのみを返します
+// age が指定された場合は、作成日時の経過時間にも一致するキーのみを返します
+func GetKmsKeysByFilter(ctx context.Context, client KmsApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
+	aliases, err := listAliasesByKeyId(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var keyIds []string
+	paginator := kms.NewListKeysPaginator(client, &kms.ListKeysInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("kmsキー一覧取得エラー: %w"), err)
+		}
+		for _, key := range output.Keys {
+			keyIds = append(keyIds, aws.ToString(key.KeyId))
+		}
+	}
+
+	// キーの種類・状態・説明は一覧に含まれないため、キーごとに取得する
+	var matched []types.KeyMetadata
+	for _, keyId := range keyIds {
+		output, err := client.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: aws.String(keyId)})
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("kmsキーの情報取得エラー (%s): %w"), keyId, err)
+		}
+		key := *output.KeyMetadata
+		if key.KeyManager != types.KeyManagerTypeCustomer || key.KeyState == types.KeyStatePendingDeletion {
+			continue
+		}
+		candidates := append([]string{keyId, aws.ToString(key.Description)}, aliases[keyId]...)
+		for _, candidate := range candidates {
+			if candidate != "" && common.MatchesFilter(candidate, searchString, exact) {
+				matched = append(matched, key)
+				break
+			}
+		}
+	}
+
+	matched = common.SelectByAge(age, matched, func(k types.KeyMetadata) (*time.Time, *time.Time) {
+		return k.CreationDate, nil
+	})
+	matched, err = tagging.Select(ctx, tags, common.Target{}, tagging.TypeKmsKey, matched, func(k types.KeyMetadata) string {
+		return keyKey(aws.ToString(k.KeyId))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	foundKeys := []string{}
+	for _, key := range matched {
+		keyId := aws.ToString(key.KeyId)
+		foundKeys = append(foundKeys, keyId)
+		label := keyId
+		if len(aliases[keyId]) > 0 {
+			label = fmt.Sprintf("%s (%s)", keyId, strings.Join(aliases[keyId], ", "))
+		}
+		common.Progressf(ctx, common.EventInfo, keyId, i18n.T("🔍 検出されたKMSキー: %s\n"), age.Annotate(label, key.CreationDate, nil))
+	}
+	return foundKeys, nil
+}
+
+// keyNames は指定したキーごとに、ガードレールの照合に使用するエイリアスと説明を取得します
+func keyNames(ctx context.Context, client KmsApi, keyIds []string) (map[string]guardrail.Aliases, error) {
+	aliases, err := listAliasesByKeyId(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	names := make(map[string]guardrail.Aliases, len(keyIds))
+	for _, keyId := range keyIds {
+		output, err := client.DescribeKey(ctx, &kms.DescribeKeyInput{KeyId: aws.String(keyId)})
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("kmsキーの情報取得エラー (%s): %w"), keyId, err)
+		}
+		names[keyId] = guardrail.Aliases{
+			Names:        aliases[keyId],
+			Descriptions: []string{aws.ToString(output.KeyMetadata.Description)},
+		}
+	}
+	return names, nil
+}
+
+// listAliasesByKeyId はキーIDごとのエイリアス名の一覧を取得します
+func listAliasesByKeyId(ctx context.Context, client KmsApi) (map[string][]string, error) {
+	aliases := make(map[string][]string)
+	paginator := kms.NewListAliasesPaginator(client, &kms.ListAliasesInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("kmsエイリアス一覧取得エラー: %w"), err)
+		}
+		for _, alias := range output.Aliases {
+			if alias.TargetKeyId == nil {
+				continue
+			}
+			keyId := aws.ToString(alias.TargetKeyId)
+			aliases[keyId] = append(aliases[keyId], aws.ToString(alias.AliasName))
+		}
+	}
+	return aliases, nil
+}
+
+// CleanupKmsKeys は指定したKMSキー一覧の削除を予約します
+// KMSキーは即時に削除できないため、PendingWindowInDays 日後に削除されます（それまでは kms cancel-key-deletion で取り消せます）
+func CleanupKmsKeys(ctx context.Context, client KmsApi, keyIds []string) common.CleanupResult {
+	keyIds = guardrail.ExcludeIds(ctx, i18n.T("KMSキー"), tagging.TypeKmsKey, keyIds, func() (map[string]guardrail.Aliases, error) {
+		return keyNames(ctx, client, keyIds)
+	}, keyKey)
+	if len(keyIds) > 0 {
+		common.Infof(ctx, i18n.T("ℹ️ KMSキーは即時に削除できないため、%d日後の削除を予約します\n"), PendingWindowInDays)
+	}
+
+	return common.DeleteInParallel(ctx, i18n.T("KMSキー"), keyIds, 10, func(keyId string) error {
+		_, err := client.ScheduleKeyDeletion(ctx, &kms.ScheduleKeyDeletionInput{
+			KeyId:               aws.String(keyId),
+			PendingWindowInDays: aws.Int32(PendingWindowInDays),
+		})
+		return err
+	})
+}
+
+// keyKey はタグ・ガードレールの確認に使用するARNのリソース部分を返す
+func keyKey(keyId string) string {
+	return "key/" + keyId
+}
@@ -0,0 +1,120 @@
+package lambda
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/lambda/types"
+)
+
+// lastModifiedLayout はLambda関数の最終更新日時の形式（例: 2024-01-02T03:04:05.678+0000）
+const lastModifiedLayout = "2006-01-02T15:04:05.000-0700"
+
+// GetLambdaFunctionsByFilter はフィルターに一致するLambda関数名の一覧を取得します
+// exact が true の場合、大文字小文字を区別します
+// tags が指定された場合は、タグ条件にも一致する関数のみを返します
+// age が指定された場合は、最終更新日時の経過時間にも一致する関数のみを返します（Lambda関数は作成日時を取得できないため、常に最終更新日時で判定します）
+func GetLambdaFunctionsByFilter(ctx context.Context, client LambdaApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
+	var matched []types.FunctionConfiguration
+	paginator := lambda.NewListFunctionsPaginator(client, &lambda.ListFunctionsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("lambda関数一覧取得エラー: %w"), err)
+		}
+		for _, function := range output.Functions {
+			if common.MatchesFilter(aws.ToString(function.FunctionName), searchString, exact) {
+				matched = append(matched, function)
+			}
+		}
+	}
+
+	matched = common.SelectByAge(age, matched, func(f types.FunctionConfiguration) (*time.Time, *time.Time) {
+		return lastModifiedOf(f), nil
+	})
+	matched, err := tagging.Select(ctx, tags, common.Target{}, tagging.TypeLambda, matched, func(f types.FunctionConfiguration) string {
+		return functionKey(aws.ToString(f.FunctionName))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	foundFunctions := []string{}
+	for _, function := range matched {
+		name := aws.ToString(function.FunctionName)
+		foundFunctions = append(foundFunctions, name)
+		common.Progressf(ctx, common.EventInfo, name, i18n.T("🔍 検出されたLambda関数: %s\n"), age.Annotate(name, lastModifiedOf(function), nil))
+	}
+	return foundFunctions, nil
+}
+
+// lastModifiedOf はLambda関数の最終更新日時を返します（形式が不正な場合は nil）
+func lastModifiedOf(f types.FunctionConfiguration) *time.Time {
+	t, err := time.Parse(lastModifiedLayout, aws.ToString(f.LastModified))
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+// CleanupLambdaFunctions は指定したLambda関数一覧を削除します（すべてのバージョンとエイリアスも削除されます）
+func CleanupLambdaFunctions(ctx context.Context, client LambdaApi, functionNames []string) common.CleanupResult {
+	functionNames = guardrail.ExcludeNames(ctx, i18n.T("Lambda関数"), tagging.TypeLambda, functionNames, functionKey)
+
+	return common.DeleteInParallel(ctx, i18n.T("Lambda関数"), functionNames, 10, func(name string) error {
+		_, err := client.DeleteFunction(ctx, &lambda.DeleteFunctionInput{FunctionName: aws.String(name)})
+		return err
+	})
+}
+
+// FunctionDependencies はLambda関数が参照しているリソース（関数の削除後でなければ削除できないもの）
+type FunctionDependencies struct {
+	SecurityGroups []string // VPC接続で使用するセキュリティグループID
+	LogGroup       string   // ログの出力先のロググループ名
+}
+
+// GetFunctionDependencies は指定したLambda関数ごとに、参照しているセキュリティグループとロググループを取得します
+// 関数を先に削除しないと、VPC接続のネットワークインターフェースがセキュリティグループを使用し続け、実行中の関数がロググループを作り直すことがあります
+func GetFunctionDependencies(ctx context.Context, client LambdaApi, functionNames []string) (map[string]FunctionDependencies, error) {
+	targets := make(map[string]bool, len(functionNames))
+	for _, name := range functionNames {
+		targets[name] = true
+	}
+
+	dependencies := make(map[string]FunctionDependencies)
+	paginator := lambda.NewListFunctionsPaginator(client, &lambda.ListFunctionsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("lambda関数一覧取得エラー: %w"), err)
+		}
+		for _, function := range output.Functions {
+			name := aws.ToString(function.FunctionName)
+			if !targets[name] {
+				continue
+			}
+			deps := FunctionDependencies{LogGroup: "/aws/lambda/" + name}
+			if function.LoggingConfig != nil && aws.ToString(function.LoggingConfig.LogGroup) != "" {
+				deps.LogGroup = aws.ToString(function.LoggingConfig.LogGroup)
+			}
+			if function.VpcConfig != nil {
+				deps.SecurityGroups = function.VpcConfig.SecurityGroupIds
+			}
+			dependencies[name] = deps
+		}
+	}
+	return dependencies, nil
+}
+
+// functionKey はタグ・ガードレールの確認に使用するARNのリソース部分を返す
+func functionKey(name string) string {
+	return "function:" + name
+}
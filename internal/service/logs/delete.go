@@ -1,36 +1,42 @@
 package logs
 
 import (
-	"awstk/internal/service/common"
 	"context"
+	"errors"
 	"fmt"
 	"sync"
 	"time"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 )
 
 // DeleteLogGroups は指定されたオプションに基づいてロググループを削除します
 // Force=true の場合、削除保護が有効なロググループも保護を解除して削除します
-func DeleteLogGroups(client *cloudwatchlogs.Client, opts DeleteOptions) error {
+func DeleteLogGroups(ctx context.Context, client LogsApi, opts DeleteOptions) error {
 	// 削除対象のロググループを収集
-	targetGroups, err := collectTargetLogGroups(client, opts)
+	targetGroups, err := collectTargetLogGroups(ctx, client, opts)
 	if err != nil {
-		return fmt.Errorf("削除対象の収集に失敗: %w", err)
+		return fmt.Errorf(i18n.T("削除対象の収集に失敗: %w"), err)
 	}
 
 	if len(targetGroups) == 0 {
-		fmt.Println("削除対象のロググループがありません")
+		common.Infof(ctx, "%s\n", i18n.T("削除対象のロググループがありません"))
 		return nil
 	}
 
 	// 削除保護の状態を事前チェック
 	var protectedGroups []string
 	for _, groupName := range targetGroups {
-		protected, err := isDeletionProtected(client, groupName)
+		protected, err := isDeletionProtected(ctx, client, groupName)
 		if err != nil {
-			return fmt.Errorf("削除保護状態の確認エラー (%s): %w", groupName, err)
+			return fmt.Errorf(i18n.T("削除保護状態の確認エラー (%s): %w"), groupName, err)
 		}
 		if protected {
 			protectedGroups = append(protectedGroups, groupName)
@@ -40,54 +46,33 @@ func DeleteLogGroups(client *cloudwatchlogs.Client, opts DeleteOptions) error {
 	// 削除保護が有効なロググループがある場合
 	if len(protectedGroups) > 0 {
 		if !opts.Force {
-			fmt.Printf("⚠️  %d件のロググループで削除保護が有効です:\n", len(protectedGroups))
+			common.Warnf(ctx, i18n.T("⚠️  %d件のロググループで削除保護が有効です:\n"), len(protectedGroups))
 			for _, name := range protectedGroups {
-				fmt.Printf("   🔒 %s\n", name)
+				common.Warnf(ctx, "   🔒 %s\n", name)
 			}
-			fmt.Println("\n削除保護を解除して削除するには --force オプションを指定してください")
-			return fmt.Errorf("削除保護が有効なロググループがあります")
+			common.Warnf(ctx, "%s\n", i18n.T("\n削除保護を解除して削除するには --force オプションを指定してください"))
+			return errors.New(i18n.T("削除保護が有効なロググループがあります"))
 		}
-		fmt.Printf("⚠️  %d件のロググループで削除保護が有効です。--force により削除前に解除されます。\n\n", len(protectedGroups))
+		common.Warnf(ctx, i18n.T("⚠️  %d件のロググループで削除保護が有効です。--force により削除前に解除されます。\n\n"), len(protectedGroups))
 	}
 
-	// 並列実行数を設定（最大20並列）
-	maxWorkers := 20
-	if len(targetGroups) < maxWorkers {
-		maxWorkers = len(targetGroups)
+	// プラン作成モードの場合は削除せずにプランへ追加
+	if opts.Plan != nil {
+		actions, err := PlanLogGroupDeletion(ctx, client, targetGroups, opts.Force)
+		if err != nil {
+			return err
+		}
+		opts.Plan.Add(actions...)
+		return nil
 	}
 
-	executor := common.NewParallelExecutor(maxWorkers)
-	results := make([]common.ProcessResult, len(targetGroups))
-	resultsMutex := &sync.Mutex{}
-
-	fmt.Printf("🗑️  %d個のロググループを最大%d並列で削除します...\n\n", len(targetGroups), maxWorkers)
-
-	for i, logGroupName := range targetGroups {
-		idx := i
-		groupName := logGroupName
-		executor.Execute(func() {
-			err := deleteLogGroupWithProtectionCheck(client, groupName, opts.Force)
+	result := DeleteLogGroupsByName(ctx, client, targetGroups, opts.Force)
 
-			resultsMutex.Lock()
-			if err != nil {
-				fmt.Printf("❌ %s ... 失敗 (%v)\n", groupName, err)
-				results[idx] = common.ProcessResult{Item: groupName, Success: false, Error: err}
-			} else {
-				fmt.Printf("✅ %s ... 完了\n", groupName)
-				results[idx] = common.ProcessResult{Item: groupName, Success: true}
-			}
-			resultsMutex.Unlock()
-		})
+	if ctx.Err() != nil {
+		return fmt.Errorf(i18n.T("ロググループの削除が中断されました: %w"), ctx.Err())
 	}
-
-	executor.Wait()
-
-	// 結果の集計
-	successCount, failCount := common.CollectResults(results)
-	fmt.Printf("\n削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
-
-	if failCount > 0 {
-		return fmt.Errorf("%d個のロググループの削除に失敗しました", failCount)
+	if len(result.Failed) > 0 {
+		return fmt.Errorf(i18n.T("%d個のロググループの削除に失敗しました"), len(result.Failed))
 	}
 
 	return nil
@@ -95,33 +80,41 @@ func DeleteLogGroups(client *cloudwatchlogs.Client, opts DeleteOptions) error {
 
 // deleteLogGroupWithProtectionCheck は削除保護を確認・解除してからロググループを削除します
 // force=true の場合、削除保護が有効でも解除して削除します
-func deleteLogGroupWithProtectionCheck(client *cloudwatchlogs.Client, logGroupName string, force bool) error {
+func deleteLogGroupWithProtectionCheck(ctx context.Context, client LogsApi, logGroupName string, force bool) (err error) {
 	// 削除保護の確認
-	protected, err := isDeletionProtected(client, logGroupName)
+	protected, err := isDeletionProtected(ctx, client, logGroupName)
 	if err != nil {
-		return fmt.Errorf("削除保護状態の確認エラー: %w", err)
+		return fmt.Errorf(i18n.T("削除保護状態の確認エラー: %w"), err)
 	}
 
 	// 削除保護が有効な場合
 	if protected && force {
-		fmt.Printf("🔓 %s ... 削除保護を解除中\n", logGroupName)
-		if err := disableDeletionProtection(client, logGroupName); err != nil {
-			return fmt.Errorf("削除保護の解除エラー: %w", err)
+		common.Progressf(ctx, common.EventInfo, logGroupName, i18n.T("🔓 %s ... 削除保護を解除中\n"), logGroupName)
+		if err := disableDeletionProtection(ctx, client, logGroupName); err != nil {
+			return fmt.Errorf(i18n.T("削除保護の解除エラー: %w"), err)
 		}
+		// 削除前に中断された場合は削除保護を元に戻す
+		defer func() {
+			if err != nil && ctx.Err() != nil {
+				restoreDeletionProtection(ctx, client, logGroupName)
+			}
+		}()
 		// 削除保護解除が反映されるまで少し待つ
-		time.Sleep(1 * time.Second)
+		if err := common.Sleep(ctx, 1*time.Second); err != nil {
+			return err
+		}
 	}
 
 	// ロググループ削除
-	_, err = client.DeleteLogGroup(context.Background(), &cloudwatchlogs.DeleteLogGroupInput{
+	_, err = client.DeleteLogGroup(ctx, &cloudwatchlogs.DeleteLogGroupInput{
 		LogGroupName: &logGroupName,
 	})
 	return err
 }
 
 // isDeletionProtected はロググループの削除保護が有効かどうかを確認します
-func isDeletionProtected(client *cloudwatchlogs.Client, logGroupName string) (bool, error) {
-	output, err := client.DescribeLogGroups(context.Background(), &cloudwatchlogs.DescribeLogGroupsInput{
+func isDeletionProtected(ctx context.Context, client LogsApi, logGroupName string) (bool, error) {
+	output, err := client.DescribeLogGroups(ctx, &cloudwatchlogs.DescribeLogGroupsInput{
 		LogGroupNamePrefix: &logGroupName,
 	})
 	if err != nil {
@@ -142,16 +135,32 @@ func isDeletionProtected(client *cloudwatchlogs.Client, logGroupName string) (bo
 }
 
 // disableDeletionProtection はロググループの削除保護を無効化します
-func disableDeletionProtection(client *cloudwatchlogs.Client, logGroupName string) error {
-	_, err := client.PutLogGroupDeletionProtection(context.Background(), &cloudwatchlogs.PutLogGroupDeletionProtectionInput{
+func disableDeletionProtection(ctx context.Context, client LogsApi, logGroupName string) error {
+	_, err := client.PutLogGroupDeletionProtection(ctx, &cloudwatchlogs.PutLogGroupDeletionProtectionInput{
 		LogGroupIdentifier:        aws.String(logGroupName),
 		DeletionProtectionEnabled: aws.Bool(false),
 	})
 	return err
 }
 
+// restoreDeletionProtection は中断により削除しなかったロググループの削除保護を元に戻します
+func restoreDeletionProtection(ctx context.Context, client LogsApi, logGroupName string) {
+	restoreCtx, cancel := common.RestoreContext(ctx)
+	defer cancel()
+
+	_, err := client.PutLogGroupDeletionProtection(restoreCtx, &cloudwatchlogs.PutLogGroupDeletionProtectionInput{
+		LogGroupIdentifier:        aws.String(logGroupName),
+		DeletionProtectionEnabled: aws.Bool(true),
+	})
+	if err != nil {
+		common.Progressf(ctx, common.EventWarning, logGroupName, i18n.T("⚠️  %s の削除保護の復元に失敗しました: %v\n"), logGroupName, err)
+		return
+	}
+	common.Progressf(ctx, common.EventInfo, logGroupName, i18n.T("🔒 %s の削除保護を元に戻しました\n"), logGroupName)
+}
+
 // collectTargetLogGroups は削除対象のロググループを収集します
-func collectTargetLogGroups(client *cloudwatchlogs.Client, opts DeleteOptions) ([]string, error) {
+func collectTargetLogGroups(ctx context.Context, client LogsApi, opts DeleteOptions) ([]string, error) {
 	var targetGroups []string
 
 	// 位置引数で指定されたロググループを追加
@@ -159,10 +168,10 @@ func collectTargetLogGroups(client *cloudwatchlogs.Client, opts DeleteOptions) (
 		targetGroups = append(targetGroups, opts.LogGroups...)
 	}
 
-	// フィルターが指定されている場合
-	if opts.Filter != "" {
+	// フィルターまたはタグ条件が指定されている場合
+	if opts.Filter != "" || opts.Tags.Enabled() {
 		// すべてのロググループを取得
-		allGroups, err := ListLogGroups(client)
+		allGroups, err := ListLogGroups(ctx, client)
 		if err != nil {
 			return nil, err
 		}
@@ -177,11 +186,29 @@ func collectTargetLogGroups(client *cloudwatchlogs.Client, opts DeleteOptions) (
 		}
 
 		// パターンマッチングを適用
+		var matchedGroups []types.LogGroup
 		for _, group := range filteredGroups {
 			if common.MatchesFilter(*group.LogGroupName, opts.Filter, opts.Exact) {
-				targetGroups = append(targetGroups, *group.LogGroupName)
+				matchedGroups = append(matchedGroups, group)
 			}
 		}
+
+		// 経過時間・タグ条件を適用
+		matchedGroups, lastEvents, err := selectLogGroupsByAge(ctx, client, opts.Age, matchedGroups)
+		if err != nil {
+			return nil, err
+		}
+		matchedGroups, err = SelectLogGroupsByTags(ctx, opts.Tags, common.Target{}, matchedGroups)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, group := range matchedGroups {
+			if opts.Age.Enabled() {
+				common.Progressf(ctx, common.EventInfo, *group.LogGroupName, i18n.T("🔍 検出されたロググループ: %s\n"), opts.Age.Annotate(*group.LogGroupName, creationTimeOf(group), lastEvents[*group.LogGroupName]))
+			}
+			targetGroups = append(targetGroups, *group.LogGroupName)
+		}
 	}
 
 	// 重複を除去
@@ -190,63 +217,159 @@ func collectTargetLogGroups(client *cloudwatchlogs.Client, opts DeleteOptions) (
 
 // GetLogGroupsByFilter はフィルターに一致するロググループを取得します（cleanup allから呼ばれる用）
 // exact が true の場合、大文字小文字を区別します
-func GetLogGroupsByFilter(client *cloudwatchlogs.Client, searchString string, exact bool) ([]string, error) {
+// tags が指定された場合は、タグ条件にも一致するロググループのみを返します
+// age が指定された場合は、作成日時（last-activity の場合は最終イベント日時）の経過時間にも一致するロググループのみを返します
+func GetLogGroupsByFilter(ctx context.Context, client LogsApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
 	// すべてのロググループを取得
-	allGroups, err := ListLogGroups(client)
+	allGroups, err := ListLogGroups(ctx, client)
 	if err != nil {
-		return nil, fmt.Errorf("ロググループ一覧取得エラー: %w", err)
+		return nil, fmt.Errorf(i18n.T("ロググループ一覧取得エラー: %w"), err)
 	}
 
-	var matchedGroups []string
+	var matched []types.LogGroup
 	for _, group := range allGroups {
 		if common.MatchesFilter(*group.LogGroupName, searchString, exact) {
-			matchedGroups = append(matchedGroups, *group.LogGroupName)
-			fmt.Printf("🔍 検出されたロググループ: %s\n", *group.LogGroupName)
+			matched = append(matched, group)
 		}
 	}
+	matched, lastEvents, err := selectLogGroupsByAge(ctx, client, age, matched)
+	if err != nil {
+		return nil, err
+	}
+	matched, err = SelectLogGroupsByTags(ctx, tags, common.Target{}, matched)
+	if err != nil {
+		return nil, err
+	}
+
+	var matchedGroups []string
+	for _, group := range matched {
+		matchedGroups = append(matchedGroups, *group.LogGroupName)
+		common.Progressf(ctx, common.EventInfo, *group.LogGroupName, i18n.T("🔍 検出されたロググループ: %s\n"), age.Annotate(*group.LogGroupName, creationTimeOf(group), lastEvents[*group.LogGroupName]))
+	}
 
 	return matchedGroups, nil
 }
 
+// selectLogGroupsByAge は経過時間の条件に一致するロググループに絞り込みます
+// last-activity の場合はロググループごとに最終イベント日時を取得し、表示用に合わせて返します
+func selectLogGroupsByAge(ctx context.Context, client LogsApi, age *common.AgeFilter, groups []types.LogGroup) ([]types.LogGroup, map[string]*time.Time, error) {
+	lastEvents := make(map[string]*time.Time)
+	if age.UsesLastActivity() {
+		for _, group := range groups {
+			t, err := getLastEventTime(ctx, client, *group.LogGroupName)
+			if err != nil {
+				return nil, nil, fmt.Errorf(i18n.T("最終イベント日時の取得エラー (%s): %w"), *group.LogGroupName, err)
+			}
+			lastEvents[*group.LogGroupName] = t
+		}
+	}
+	selected := common.SelectByAge(age, groups, func(g types.LogGroup) (*time.Time, *time.Time) {
+		return creationTimeOf(g), lastEvents[*g.LogGroupName]
+	})
+	return selected, lastEvents, nil
+}
+
+// getLastEventTime はロググループ内で最後にイベントが記録された日時を返します（ログストリームがない場合は nil）
+func getLastEventTime(ctx context.Context, client LogsApi, logGroupName string) (*time.Time, error) {
+	output, err := client.DescribeLogStreams(ctx, &cloudwatchlogs.DescribeLogStreamsInput{
+		LogGroupName: aws.String(logGroupName),
+		OrderBy:      types.OrderByLastEventTime,
+		Descending:   aws.Bool(true),
+		Limit:        aws.Int32(1),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.LogStreams) == 0 || output.LogStreams[0].LastEventTimestamp == nil {
+		return nil, nil
+	}
+	t := time.UnixMilli(*output.LogStreams[0].LastEventTimestamp)
+	return &t, nil
+}
+
+// creationTimeOf はロググループの作成日時を返します
+func creationTimeOf(group types.LogGroup) *time.Time {
+	if group.CreationTime == nil {
+		return nil
+	}
+	t := time.UnixMilli(*group.CreationTime)
+	return &t
+}
+
 // CleanupLogGroups は指定したロググループ一覧を削除します（cleanup allから呼ばれる用）
 // cleanup allでは削除保護を自動的に解除して削除します（force=true相当）
-func CleanupLogGroups(client *cloudwatchlogs.Client, logGroupNames []string) common.CleanupResult {
+func CleanupLogGroups(ctx context.Context, client LogsApi, logGroupNames []string) common.CleanupResult {
+	return DeleteLogGroupsByName(ctx, client, logGroupNames, true)
+}
+
+// GetSubscriptionDestinations は指定したロググループごとに、サブスクリプションフィルターの送信先のARNを取得します
+// ロググループを先に削除しないと、送信先（Lambda関数等）の削除後もフィルターが残ったままになります
+func GetSubscriptionDestinations(ctx context.Context, client LogsApi, logGroupNames []string) (map[string][]string, error) {
+	destinations := make(map[string][]string)
+	for _, name := range logGroupNames {
+		paginator := cloudwatchlogs.NewDescribeSubscriptionFiltersPaginator(client, &cloudwatchlogs.DescribeSubscriptionFiltersInput{
+			LogGroupName: aws.String(name),
+		})
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(ctx)
+			if err != nil {
+				return nil, fmt.Errorf(i18n.T("サブスクリプションフィルター一覧取得エラー: %w"), err)
+			}
+			for _, filter := range output.SubscriptionFilters {
+				destinations[name] = append(destinations[name], aws.ToString(filter.DestinationArn))
+			}
+		}
+	}
+	return destinations, nil
+}
+
+// DeleteLogGroupsByName は指定したロググループ一覧を並列で削除します
+// force=true の場合、削除保護が有効なロググループも保護を解除して削除します
+func DeleteLogGroupsByName(ctx context.Context, client LogsApi, logGroupNames []string, force bool) common.CleanupResult {
 	result := common.CleanupResult{
-		ResourceType: "CloudWatch Logsグループ",
+		ResourceType: i18n.T("CloudWatch Logsグループ"),
 		Deleted:      []string{},
 		Failed:       []string{},
 	}
 
+	logGroupNames = guardrail.ExcludeNames(ctx, i18n.T("ロググループ"), tagging.TypeLogGroup, logGroupNames, func(name string) string { return "log-group:" + name })
 	if len(logGroupNames) == 0 {
 		return result
 	}
 
-	// 並列実行数を設定（最大20並列）
-	maxWorkers := 20
-	if len(logGroupNames) < maxWorkers {
-		maxWorkers = len(logGroupNames)
-	}
+	// 並列実行数を設定（既定は最大20並列、--concurrency または設定ファイルの concurrency で変更可）
+	maxWorkers := common.WorkerCount(20, len(logGroupNames))
 
 	executor := common.NewParallelExecutor(maxWorkers)
 	results := make([]common.ProcessResult, len(logGroupNames))
 	resultsMutex := &sync.Mutex{}
 
-	fmt.Printf("🚀 %d個のロググループを最大%d並列で削除します...\n\n", len(logGroupNames), maxWorkers)
+	common.Infof(ctx, i18n.T("🗑️  %d個のロググループを最大%d並列で削除します...\n\n"), len(logGroupNames), maxWorkers)
 
 	for i, logGroupName := range logGroupNames {
 		idx := i
 		groupName := logGroupName
 		executor.Execute(func() {
-			// cleanup allでは削除保護を自動解除（force=true）
-			err := deleteLogGroupWithProtectionCheck(client, groupName, true)
+			// 中断された場合は未実行として記録
+			if ctx.Err() != nil {
+				resultsMutex.Lock()
+				results[idx] = common.SkippedResult(groupName, ctx.Err())
+				resultsMutex.Unlock()
+				return
+			}
+
+			start := time.Now()
+			err := common.RetryDelete(ctx, groupName, func() error {
+				return deleteLogGroupWithProtectionCheck(ctx, client, groupName, force)
+			})
 
 			resultsMutex.Lock()
 			if err != nil {
-				fmt.Printf("❌ %s ... 失敗 (%v)\n", groupName, err)
-				results[idx] = common.ProcessResult{Item: groupName, Success: false, Error: err}
+				common.Failuref(ctx, groupName, err, i18n.T("❌ %s ... 失敗 (%v)\n"), groupName, err)
+				results[idx] = common.ProcessResult{Item: groupName, Success: false, Error: err, Duration: time.Since(start)}
 			} else {
-				fmt.Printf("✅ %s ... 完了\n", groupName)
-				results[idx] = common.ProcessResult{Item: groupName, Success: true}
+				common.Progressf(ctx, common.EventSuccess, groupName, i18n.T("✅ %s ... 完了\n"), groupName)
+				results[idx] = common.ProcessResult{Item: groupName, Success: true, Duration: time.Since(start)}
 			}
 			resultsMutex.Unlock()
 		})
@@ -255,8 +378,7 @@ func CleanupLogGroups(client *cloudwatchlogs.Client, logGroupNames []string) com
 	executor.Wait()
 
 	// 結果の集計
-	successCount, failCount := common.CollectResults(results)
-	fmt.Printf("\n✅ 削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
+	common.PrintProcessSummary(ctx, i18n.T("削除"), results)
 
-	return common.CollectCleanupResult("CloudWatch Logsグループ", results)
+	return common.CollectCleanupResult(i18n.T("CloudWatch Logsグループ"), results)
 }
@@ -0,0 +1,138 @@
+package logs_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/ngsw877/awstk/internal/awsfake"
+	logssvc "github.com/ngsw877/awstk/internal/service/logs"
+	"github.com/ngsw877/awstk/internal/service/plan"
+
+	"github.com/aws/smithy-go"
+)
+
+func TestDeleteLogGroups(t *testing.T) {
+	accessDenied := &smithy.GenericAPIError{Code: "AccessDeniedException", Message: "access denied", Fault: smithy.FaultClient}
+	groups := []awsfake.LogGroup{
+		{Name: "/aws/lambda/app-api", StoredBytes: 1024, RetentionInDays: 7},
+		{Name: "/aws/lambda/app-worker", StoredBytes: 0},
+		{Name: "/aws/lambda/app-batch", StoredBytes: 2048},
+		{Name: "/aws/lambda/other", StoredBytes: 0},
+	}
+
+	tests := []struct {
+		name        string
+		opts        logssvc.DeleteOptions
+		setup       func(f *awsfake.Logs)
+		wantErr     bool
+		wantDeleted []string
+		wantPlanned []string // プラン作成時にプランに追加されるロググループ
+	}{
+		{
+			name:        "パターンに一致するロググループを削除",
+			opts:        logssvc.DeleteOptions{Filter: "app-"},
+			wantDeleted: []string{"/aws/lambda/app-api", "/aws/lambda/app-batch", "/aws/lambda/app-worker"},
+		},
+		{
+			name:        "空のロググループのみ削除",
+			opts:        logssvc.DeleteOptions{Filter: "app-", EmptyOnly: true},
+			wantDeleted: []string{"/aws/lambda/app-worker"},
+		},
+		{
+			name:        "保存期間未設定のロググループのみ削除",
+			opts:        logssvc.DeleteOptions{Filter: "app-", NoRetention: true},
+			wantDeleted: []string{"/aws/lambda/app-batch", "/aws/lambda/app-worker"},
+		},
+		{
+			name:        "位置引数で指定したロググループを削除",
+			opts:        logssvc.DeleteOptions{LogGroups: []string{"/aws/lambda/other"}},
+			wantDeleted: []string{"/aws/lambda/other"},
+		},
+		{
+			name: "削除保護が有効なロググループがある場合は --force なしでは削除しない",
+			opts: logssvc.DeleteOptions{Filter: "app-"},
+			setup: func(f *awsfake.Logs) {
+				f.AddLogGroup(awsfake.LogGroup{Name: "/aws/lambda/app-api", DeletionProtection: true})
+			},
+			wantErr: true,
+		},
+		{
+			name: "--force の場合は削除保護を解除して削除",
+			opts: logssvc.DeleteOptions{LogGroups: []string{"/aws/lambda/app-api"}, Force: true},
+			setup: func(f *awsfake.Logs) {
+				f.AddLogGroup(awsfake.LogGroup{Name: "/aws/lambda/app-api", DeletionProtection: true})
+			},
+			wantDeleted: []string{"/aws/lambda/app-api"},
+		},
+		{
+			name: "削除に失敗したロググループがあっても残りは削除してエラーを返す",
+			opts: logssvc.DeleteOptions{Filter: "app-"},
+			setup: func(f *awsfake.Logs) {
+				f.FailOn("DeleteLogGroup", "/aws/lambda/app-batch", accessDenied)
+			},
+			wantErr:     true,
+			wantDeleted: []string{"/aws/lambda/app-api", "/aws/lambda/app-worker"},
+		},
+		{
+			name:        "プラン作成時は削除しない",
+			opts:        logssvc.DeleteOptions{Filter: "app-", EmptyOnly: true, Plan: plan.New("logs delete", "", "")},
+			wantPlanned: []string{"/aws/lambda/app-worker"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := awsfake.NewLogs()
+			fake.PageSize = 2 // 一覧の取得でページングを経由させる
+			for _, g := range groups {
+				fake.AddLogGroup(g)
+			}
+			if tt.setup != nil {
+				tt.setup(fake)
+			}
+
+			err := logssvc.DeleteLogGroups(context.Background(), fake, tt.opts)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+
+			for _, g := range groups {
+				deleted := !fake.HasLogGroup(g.Name)
+				if want := slices.Contains(tt.wantDeleted, g.Name); deleted != want {
+					t.Errorf("log group %s deleted = %v, want %v", g.Name, deleted, want)
+				}
+			}
+			if tt.opts.Plan != nil {
+				if got := plan.Names(tt.opts.Plan.Actions); !slices.Equal(got, tt.wantPlanned) {
+					t.Errorf("planned = %v, want %v", got, tt.wantPlanned)
+				}
+			}
+		})
+	}
+}
+
+func TestApplyLogGroupDeletion(t *testing.T) {
+	fake := awsfake.NewLogs()
+	fake.AddLogGroup(awsfake.LogGroup{Name: "/aws/lambda/app-api"})
+	fake.AddLogGroup(awsfake.LogGroup{Name: "/aws/lambda/app-worker", DeletionProtection: true})
+	actions := []plan.Action{
+		{Type: plan.ResourceLogGroup, Action: plan.ActionDelete, Name: "/aws/lambda/app-api"},
+		{Type: plan.ResourceLogGroup, Action: plan.ActionDelete, Name: "/aws/lambda/app-worker", Options: map[string]string{plan.OptionForce: "true"}},
+	}
+
+	// force オプションの有無ごとに削除した結果をまとめて返す
+	result := logssvc.ApplyLogGroupDeletion(context.Background(), fake, actions)
+
+	want := []string{"/aws/lambda/app-api", "/aws/lambda/app-worker"}
+	if !slices.Equal(result.Deleted, want) {
+		t.Errorf("Deleted = %v, want %v", result.Deleted, want)
+	}
+	var items []string
+	for _, r := range result.Results {
+		items = append(items, r.Item)
+	}
+	if !slices.Equal(items, want) {
+		t.Errorf("Results = %v, want %v", items, want)
+	}
+}
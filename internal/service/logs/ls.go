@@ -1,16 +1,21 @@
 package logs
 
 import (
-	"awstk/internal/service/common"
 	"context"
 	"fmt"
+	"strconv"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 )
 
 // ListLogGroups はCloudWatch Logsグループの一覧を取得する関数
-func ListLogGroups(client *cloudwatchlogs.Client) ([]types.LogGroup, error) {
+func ListLogGroups(ctx context.Context, client LogsApi) ([]types.LogGroup, error) {
 	var logGroups []types.LogGroup
 	var nextToken *string
 
@@ -19,9 +24,9 @@ func ListLogGroups(client *cloudwatchlogs.Client) ([]types.LogGroup, error) {
 			NextToken: nextToken,
 		}
 
-		result, err := client.DescribeLogGroups(context.Background(), input)
+		result, err := client.DescribeLogGroups(ctx, input)
 		if err != nil {
-			return nil, fmt.Errorf("ログループ一覧取得エラー: %w", err)
+			return nil, fmt.Errorf(i18n.T("ログループ一覧取得エラー: %w"), err)
 		}
 
 		logGroups = append(logGroups, result.LogGroups...)
@@ -74,25 +79,130 @@ func DisplayLogGroupDetails(group types.LogGroup) {
 
 	// サイズ情報
 	if group.StoredBytes != nil {
-		fmt.Printf("    サイズ: %s\n", common.FormatBytes(*group.StoredBytes))
+		fmt.Printf(i18n.T("    サイズ: %s\n"), common.FormatBytes(*group.StoredBytes))
 	} else {
-		fmt.Printf("    サイズ: 0 B (空)\n")
+		fmt.Print(i18n.T("    サイズ: 0 B (空)\n"))
 	}
 
 	// 作成日時
 	if group.CreationTime != nil {
-		fmt.Printf("    作成日: %s\n", common.FormatTimestamp(group.CreationTime))
+		fmt.Printf(i18n.T("    作成日: %s\n"), common.FormatTimestamp(group.CreationTime))
 	}
 
 	// 保存期間
 	if group.RetentionInDays != nil {
-		fmt.Printf("    保存期間: %d日\n", *group.RetentionInDays)
+		fmt.Printf(i18n.T("    保存期間: %d日\n"), *group.RetentionInDays)
 	} else {
-		fmt.Printf("    保存期間: 無期限\n")
+		fmt.Print(i18n.T("    保存期間: 無期限\n"))
 	}
 
 	// メトリクスフィルター数
 	if group.MetricFilterCount != nil && *group.MetricFilterCount > 0 {
-		fmt.Printf("    メトリクスフィルター: %d個\n", *group.MetricFilterCount)
+		fmt.Printf(i18n.T("    メトリクスフィルター: %d個\n"), *group.MetricFilterCount)
+	}
+}
+
+// DisplayLogGroupRecords はログループの詳細情報を構造化出力用のレコードとして出力する関数
+func DisplayLogGroupRecords(logGroups []types.LogGroup) {
+	columns, data := logGroupsToRecordData(logGroups)
+	common.PrintTable("", columns, data)
+}
+
+// SelectLogGroupsByTags はタグ条件に一致するロググループに絞り込む
+func SelectLogGroupsByTags(ctx context.Context, tags *tagging.Selector, target common.Target, logGroups []types.LogGroup) ([]types.LogGroup, error) {
+	return tagging.Select(ctx, tags, target, tagging.TypeLogGroup, logGroups, func(g types.LogGroup) string {
+		return "log-group:" + awssdk.ToString(g.LogGroupName)
+	})
+}
+
+// ListLogGroupsAcross は複数のアカウント・リージョンのロググループを並列に取得し、アカウント列・リージョン列付きで表示する
+func ListLogGroupsAcross(ctx context.Context, targets []common.Target, newClient func(target common.Target) LogsApi, opts ListOptions) error {
+	results := common.FetchAcrossTargets(ctx, targets, func(ctx context.Context, target common.Target) ([]types.LogGroup, error) {
+		logGroups, err := ListLogGroups(ctx, newClient(target))
+		if err != nil {
+			return nil, err
+		}
+		logGroups, err = SelectLogGroupsByTags(ctx, opts.Tags, target, logGroups)
+		if err != nil {
+			return nil, err
+		}
+		if opts.EmptyOnly {
+			logGroups = FilterEmptyLogGroups(logGroups)
+		}
+		if opts.NoRetention {
+			logGroups = FilterNoRetentionLogGroups(logGroups)
+		}
+		return logGroups, nil
+	})
+
+	toTableData := logGroupsToRecordData
+	if !opts.ShowDetails {
+		toTableData = logGroupNamesToTableData
+	}
+
+	var conditions []string
+	if opts.EmptyOnly {
+		conditions = append(conditions, i18n.T("空の"))
+	}
+	if opts.NoRetention {
+		conditions = append(conditions, i18n.T("保存期間未設定の"))
+	}
+
+	return common.DisplayTargetList(
+		results,
+		common.GenerateFilteredTitle(i18n.T("CloudWatch Logsグループ"), conditions...),
+		toTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: common.FormatEmptyMessage(i18n.T("CloudWatch Logsグループ")),
+		},
+	)
+}
+
+// logGroupNamesToTableData はロググループ名のみのテーブルデータに変換
+func logGroupNamesToTableData(logGroups []types.LogGroup) ([]common.TableColumn, [][]string) {
+	names := make([]string, len(logGroups))
+	for i, group := range logGroups {
+		names[i] = awssdk.ToString(group.LogGroupName)
+	}
+	return common.NamesToTableData(names)
+}
+
+// logGroupsToRecordData はログループの詳細情報をテーブルデータに変換
+func logGroupsToRecordData(logGroups []types.LogGroup) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("ロググループ名"), Key: "name"},
+		{Header: i18n.T("サイズ(バイト)"), Key: "storedBytes"},
+		{Header: i18n.T("作成日"), Key: "createdAt"},
+		{Header: i18n.T("保存期間(日)"), Key: "retentionInDays"},
+		{Header: i18n.T("メトリクスフィルター数"), Key: "metricFilterCount"},
+	}
+
+	data := make([][]string, len(logGroups))
+	for i, group := range logGroups {
+		storedBytes := int64(0)
+		if group.StoredBytes != nil {
+			storedBytes = *group.StoredBytes
+		}
+		createdAt := ""
+		if group.CreationTime != nil {
+			createdAt = common.FormatTimestamp(group.CreationTime)
+		}
+		retention := ""
+		if group.RetentionInDays != nil {
+			retention = strconv.Itoa(int(*group.RetentionInDays))
+		}
+		metricFilterCount := "0"
+		if group.MetricFilterCount != nil {
+			metricFilterCount = strconv.Itoa(int(*group.MetricFilterCount))
+		}
+		data[i] = []string{
+			awssdk.ToString(group.LogGroupName),
+			strconv.FormatInt(storedBytes, 10),
+			createdAt,
+			retention,
+			metricFilterCount,
+		}
 	}
+	return columns, data
 }
@@ -0,0 +1,70 @@
+package logs
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/plan"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// PlanLogGroupDeletion は指定したロググループの削除アクションを作成します
+// 存在しないロググループは結果に含まれません（apply時の再検証でも使用）
+func PlanLogGroupDeletion(ctx context.Context, client LogsApi, logGroupNames []string, force bool) ([]plan.Action, error) {
+	allGroups, err := ListLogGroups(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := make(map[string]bool, len(logGroupNames))
+	for _, name := range logGroupNames {
+		targets[name] = true
+	}
+
+	actions := []plan.Action{}
+	for _, group := range allGroups {
+		name := aws.ToString(group.LogGroupName)
+		if !targets[name] {
+			continue
+		}
+		action := plan.Action{
+			Type:   plan.ResourceLogGroup,
+			Action: plan.ActionDelete,
+			Name:   name,
+			Id:     aws.ToString(group.Arn),
+			Fingerprint: map[string]string{
+				"creationTime": strconv.FormatInt(aws.ToInt64(group.CreationTime), 10),
+			},
+		}
+		if force {
+			action.Options = map[string]string{plan.OptionForce: "true"}
+		}
+		actions = append(actions, action)
+	}
+	return actions, nil
+}
+
+// ApplyLogGroupDeletion はプランのアクションに従ってロググループを削除します
+// force オプションの有無ごとにまとめて削除します
+func ApplyLogGroupDeletion(ctx context.Context, client LogsApi, actions []plan.Action) common.CleanupResult {
+	var forced, normal []string
+	for _, a := range actions {
+		if a.Options[plan.OptionForce] == "true" {
+			forced = append(forced, a.Name)
+		} else {
+			normal = append(normal, a.Name)
+		}
+	}
+
+	result := DeleteLogGroupsByName(ctx, client, normal, false)
+	forcedResult := DeleteLogGroupsByName(ctx, client, forced, true)
+	result.Deleted = append(result.Deleted, forcedResult.Deleted...)
+	result.Failed = append(result.Failed, forcedResult.Failed...)
+	result.Skipped = append(result.Skipped, forcedResult.Skipped...)
+	result.Blocked = append(result.Blocked, forcedResult.Blocked...)
+	result.Protected = append(result.Protected, forcedResult.Protected...)
+	result.Results = append(result.Results, forcedResult.Results...)
+	return result
+}
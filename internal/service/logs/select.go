@@ -0,0 +1,36 @@
+package logs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/selector"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// SelectLogGroups はロググループ一覧から対話的に複数選択させる関数
+func SelectLogGroups(ctx context.Context, client LogsApi, title string) ([]string, error) {
+	logGroups, err := ListLogGroups(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	if len(logGroups) == 0 {
+		return nil, errors.New(i18n.T("ロググループが見つかりませんでした"))
+	}
+
+	items := make([]selector.Item, len(logGroups))
+	for i, group := range logGroups {
+		name := aws.ToString(group.LogGroupName)
+		items[i] = selector.Item{Value: name, Label: fmt.Sprintf("%s  (%s)", name, common.FormatBytes(aws.ToInt64(group.StoredBytes)))}
+	}
+
+	selected, err := selector.SelectMulti(title, items)
+	if err != nil {
+		return nil, err
+	}
+	return selector.Values(selected), nil
+}
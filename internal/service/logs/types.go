@@ -1,6 +1,10 @@
 package logs
 
 import (
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/plan"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs/types"
 )
 
@@ -20,12 +24,23 @@ type LogGroupDetail struct {
 	IsEmpty     bool
 }
 
+// ListOptions はロググループ一覧表示のオプション
+type ListOptions struct {
+	EmptyOnly   bool              // 空のロググループのみを表示
+	NoRetention bool              // 保存期間未設定のロググループのみを表示
+	ShowDetails bool              // 詳細情報を表示
+	Tags        *tagging.Selector // 指定された場合はタグ条件に一致するロググループのみを表示
+}
+
 // DeleteOptions はログ削除時のオプション
 type DeleteOptions struct {
-	Filter      string   // フィルターパターン
-	LogGroups   []string // 削除対象のロググループ名
-	EmptyOnly   bool     // 空のロググループのみ削除
-	NoRetention bool     // 保存期間未設定のロググループのみ削除
-	Exact       bool     // 大文字小文字を区別してマッチ
-	Force       bool     // 削除保護を解除して削除
+	Filter      string            // フィルターパターン
+	LogGroups   []string          // 削除対象のロググループ名
+	EmptyOnly   bool              // 空のロググループのみ削除
+	NoRetention bool              // 保存期間未設定のロググループのみ削除
+	Exact       bool              // 大文字小文字を区別してマッチ
+	Force       bool              // 削除保護を解除して削除
+	Tags        *tagging.Selector // 指定された場合は検索パターンとタグ条件の両方に一致するロググループを削除
+	Age         *common.AgeFilter // 指定された場合は作成日時（または最終イベント日時）の経過時間にも一致するロググループを削除
+	Plan        *plan.Plan        // 指定された場合は削除せず、プランにアクションを追加する
 }
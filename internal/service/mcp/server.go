@@ -0,0 +1,298 @@
+package mcp
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+)
+
+// サーバーが対応するMCPのプロトコルバージョン（新しい順）
+var supportedProtocolVersions = []string{"2025-06-18", "2025-03-26", "2024-11-05"}
+
+// JSON-RPC 2.0のエラーコード
+const (
+	codeParseError     = -32700
+	codeInvalidRequest = -32600
+	codeMethodNotFound = -32601
+	codeInvalidParams  = -32602
+	codeInternalError  = -32603
+)
+
+// server は標準入出力で1行1メッセージのJSON-RPCを処理するMCPサーバー
+type server struct {
+	tools   map[string]Tool
+	opts    Options
+	out     io.Writer
+	writeMu sync.Mutex
+
+	callsMu sync.Mutex
+	calls   map[string]context.CancelFunc // 実行中の tools/call（リクエストID → キャンセル関数）
+}
+
+// ValidateAllowedTools は許可リストのツール名が変更系ツールとして定義されているかを確認する
+func ValidateAllowedTools(tools []Tool, allowed []string) error {
+	var mutating []string
+	for _, tool := range tools {
+		if tool.Mutating {
+			mutating = append(mutating, tool.Name)
+		}
+	}
+	for _, name := range allowed {
+		if !slices.Contains(mutating, name) {
+			return fmt.Errorf(i18n.T("mcp.allowTools に未知の変更系ツール '%s' が指定されています（指定可能: %s）"), name, strings.Join(mutating, ", "))
+		}
+	}
+	return nil
+}
+
+// Serve は in からリクエストを読み取り、out にレスポンスを書き出す
+// 読み取り系のツールは常に、変更系のツールは opts.AllowedTools に含まれる場合のみ公開する
+// in が終端に達するか ctx がキャンセルされると、実行中のツールの終了を待って戻る
+func Serve(ctx context.Context, in io.Reader, out io.Writer, tools []Tool, opts Options) error {
+	if err := ValidateAllowedTools(tools, opts.AllowedTools); err != nil {
+		return err
+	}
+
+	s := &server{
+		tools: map[string]Tool{},
+		opts:  opts,
+		out:   out,
+		calls: map[string]context.CancelFunc{},
+	}
+	for _, tool := range tools {
+		if tool.Mutating && !slices.Contains(opts.AllowedTools, tool.Name) {
+			continue
+		}
+		s.tools[tool.Name] = tool
+	}
+
+	lines := make(chan []byte)
+	readErr := make(chan error, 1)
+	go func() {
+		reader := bufio.NewReader(in)
+		for {
+			line, err := reader.ReadBytes('\n')
+			if len(bytes.TrimSpace(line)) > 0 {
+				select {
+				case lines <- line:
+				case <-ctx.Done():
+					return
+				}
+			}
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					err = nil
+				}
+				readErr <- err
+				return
+			}
+		}
+	}()
+
+	var wg sync.WaitGroup
+	defer wg.Wait()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err := <-readErr:
+			return err
+		case line := <-lines:
+			s.handle(ctx, line, &wg)
+		}
+	}
+}
+
+// handle は1件のメッセージを処理する（tools/call は別のゴルーチンで実行する）
+func (s *server) handle(ctx context.Context, line []byte, wg *sync.WaitGroup) {
+	var req request
+	if err := json.Unmarshal(line, &req); err != nil {
+		s.writeError(json.RawMessage("null"), codeParseError, err.Error())
+		return
+	}
+	if req.JsonRpc != "2.0" || req.Method == "" {
+		if req.Id != nil {
+			s.writeError(req.Id, codeInvalidRequest, "invalid JSON-RPC 2.0 request")
+		}
+		return
+	}
+
+	// 通知にはレスポンスを返さない
+	if req.Id == nil {
+		if req.Method == "notifications/cancelled" {
+			s.cancelCall(req.Params)
+		}
+		return
+	}
+
+	switch req.Method {
+	case "initialize":
+		s.writeResult(req.Id, s.initialize(req.Params))
+	case "ping":
+		s.writeResult(req.Id, map[string]any{})
+	case "tools/list":
+		s.writeResult(req.Id, map[string]any{"tools": s.listTools()})
+	case "tools/call":
+		var params callParams
+		if err := json.Unmarshal(req.Params, &params); err != nil || params.Name == "" {
+			s.writeError(req.Id, codeInvalidParams, "invalid tools/call params")
+			return
+		}
+		tool, ok := s.tools[params.Name]
+		if !ok {
+			s.writeError(req.Id, codeInvalidParams, fmt.Sprintf("unknown tool: %s", params.Name))
+			return
+		}
+		callCtx := s.startCall(ctx, req.Id)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer s.finishCall(req.Id)
+			s.writeResult(req.Id, s.callTool(callCtx, tool, params.Arguments))
+		}()
+	default:
+		s.writeError(req.Id, codeMethodNotFound, fmt.Sprintf("method not found: %s", req.Method))
+	}
+}
+
+// initialize はクライアントが要求したプロトコルバージョンに対応していればそれを、そうでなければ最新のバージョンを返す
+func (s *server) initialize(params json.RawMessage) map[string]any {
+	var p struct {
+		ProtocolVersion string `json:"protocolVersion"`
+	}
+	_ = json.Unmarshal(params, &p)
+	version := supportedProtocolVersions[0]
+	if slices.Contains(supportedProtocolVersions, p.ProtocolVersion) {
+		version = p.ProtocolVersion
+	}
+	return map[string]any{
+		"protocolVersion": version,
+		"capabilities": map[string]any{
+			"tools": map[string]any{"listChanged": false},
+		},
+		"serverInfo": map[string]any{
+			"name":    "awstk",
+			"version": s.opts.Version,
+		},
+	}
+}
+
+// listTools は公開しているツールの定義を名前順に返す
+func (s *server) listTools() []toolDescriptor {
+	descriptors := make([]toolDescriptor, 0, len(s.tools))
+	for _, tool := range s.tools {
+		descriptors = append(descriptors, toolDescriptor{
+			Name:        tool.Name,
+			Description: tool.Description,
+			InputSchema: tool.InputSchema,
+			Annotations: toolAnnotations{
+				ReadOnlyHint:    !tool.Mutating,
+				DestructiveHint: tool.Mutating,
+			},
+		})
+	}
+	sort.Slice(descriptors, func(i, j int) bool {
+		return descriptors[i].Name < descriptors[j].Name
+	})
+	return descriptors
+}
+
+// callTool はツールを実行し、結果を構造化コンテンツとそのJSONテキストとして返す
+// ツールの実行エラーはプロトコルエラーではなく isError の結果として返す
+func (s *server) callTool(ctx context.Context, tool Tool, args json.RawMessage) callResult {
+	if len(args) == 0 || string(args) == "null" {
+		args = json.RawMessage("{}")
+	}
+
+	var finish func(error)
+	if tool.Mutating && s.opts.OnMutate != nil {
+		finish = s.opts.OnMutate(tool.Name, args)
+	}
+	result, err := tool.handler(ctx, args)
+	if finish != nil {
+		finish(err)
+	}
+	if err != nil {
+		return callResult{
+			Content: []textContent{{Type: "text", Text: err.Error()}},
+			IsError: true,
+		}
+	}
+
+	text, err := json.Marshal(result)
+	if err != nil {
+		return callResult{
+			Content: []textContent{{Type: "text", Text: err.Error()}},
+			IsError: true,
+		}
+	}
+	return callResult{
+		Content:           []textContent{{Type: "text", Text: string(text)}},
+		StructuredContent: result,
+	}
+}
+
+// startCall は tools/call をキャンセル可能にして登録する
+func (s *server) startCall(ctx context.Context, id json.RawMessage) context.Context {
+	callCtx, cancel := context.WithCancel(ctx)
+	s.callsMu.Lock()
+	s.calls[string(id)] = cancel
+	s.callsMu.Unlock()
+	return callCtx
+}
+
+// finishCall は終了した tools/call の登録を解除する
+func (s *server) finishCall(id json.RawMessage) {
+	s.callsMu.Lock()
+	defer s.callsMu.Unlock()
+	if cancel, ok := s.calls[string(id)]; ok {
+		cancel()
+		delete(s.calls, string(id))
+	}
+}
+
+// cancelCall は notifications/cancelled で指定された実行中の tools/call を中断する
+func (s *server) cancelCall(params json.RawMessage) {
+	var p struct {
+		RequestId json.RawMessage `json:"requestId"`
+	}
+	if err := json.Unmarshal(params, &p); err != nil || p.RequestId == nil {
+		return
+	}
+	s.callsMu.Lock()
+	defer s.callsMu.Unlock()
+	if cancel, ok := s.calls[string(p.RequestId)]; ok {
+		cancel()
+	}
+}
+
+// writeResult は成功のレスポンスを書き出す
+func (s *server) writeResult(id json.RawMessage, result any) {
+	s.write(response{JsonRpc: "2.0", Id: id, Result: result})
+}
+
+// writeError はエラーのレスポンスを書き出す
+func (s *server) writeError(id json.RawMessage, code int, message string) {
+	s.write(response{JsonRpc: "2.0", Id: id, Error: &rpcError{Code: code, Message: message}})
+}
+
+// write はメッセージを1行のJSONとして書き出す（複数のゴルーチンから呼ばれる）
+func (s *server) write(resp response) {
+	line, err := json.Marshal(resp)
+	if err != nil {
+		line, _ = json.Marshal(response{JsonRpc: "2.0", Id: resp.Id, Error: &rpcError{Code: codeInternalError, Message: err.Error()}})
+	}
+	s.writeMu.Lock()
+	defer s.writeMu.Unlock()
+	_, _ = s.out.Write(append(line, '\n'))
+}
@@ -0,0 +1,206 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+)
+
+// testResponse はテストで読み取るJSON-RPCのレスポンス
+type testResponse struct {
+	Id     json.RawMessage `json:"id"`
+	Result json.RawMessage `json:"result"`
+	Error  *rpcError       `json:"error"`
+}
+
+// testTools は読み取り系の echo と変更系の restart を返す
+// restart を呼び出した場合は restarted に引数の名前を記録する
+func testTools(restarted *[]string) []Tool {
+	type echoArgs struct {
+		Message string `json:"message"`
+	}
+	type restartArgs struct {
+		Name string `json:"name"`
+	}
+	return []Tool{
+		{
+			Name:        "restart",
+			Description: "restart a service",
+			InputSchema: objectSchema(map[string]any{"name": stringProperty("service name")}, "name"),
+			Mutating:    true,
+			handler: handle(func(ctx context.Context, args restartArgs) (any, error) {
+				if args.Name == "" {
+					return nil, errors.New("name is required")
+				}
+				*restarted = append(*restarted, args.Name)
+				return ActionResult{Message: "restarted " + args.Name}, nil
+			}),
+		},
+		{
+			Name:        "echo",
+			Description: "echo a message",
+			InputSchema: objectSchema(map[string]any{"message": stringProperty("message")}),
+			handler: handle(func(ctx context.Context, args echoArgs) (any, error) {
+				return map[string]string{"message": args.Message}, nil
+			}),
+		},
+	}
+}
+
+// serve は入力の各行をリクエストとしてサーバーに渡し、レスポンスをリクエストIDごとに返す
+func serve(t *testing.T, tools []Tool, opts Options, lines ...string) map[string]testResponse {
+	t.Helper()
+	var out bytes.Buffer
+	in := strings.NewReader(strings.Join(lines, "\n") + "\n")
+	if err := Serve(context.Background(), in, &out, tools, opts); err != nil {
+		t.Fatalf("Serve() error = %v", err)
+	}
+
+	responses := map[string]testResponse{}
+	for _, line := range strings.Split(strings.TrimSpace(out.String()), "\n") {
+		if line == "" {
+			continue
+		}
+		var resp testResponse
+		if err := json.Unmarshal([]byte(line), &resp); err != nil {
+			t.Fatalf("invalid response %q: %v", line, err)
+		}
+		responses[string(resp.Id)] = resp
+	}
+	return responses
+}
+
+func TestServe(t *testing.T) {
+	var restarted []string
+	responses := serve(t, testTools(&restarted), Options{Version: "1.2.3"},
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"protocolVersion":"2024-11-05"}}`,
+		`{"jsonrpc":"2.0","method":"notifications/initialized"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"ping"}`,
+		`{"jsonrpc":"2.0","id":3,"method":"tools/list"}`,
+		`{"jsonrpc":"2.0","id":4,"method":"tools/call","params":{"name":"echo","arguments":{"message":"hello"}}}`,
+		`{"jsonrpc":"2.0","id":5,"method":"tools/call","params":{"name":"echo","arguments":{"unknown":true}}}`,
+		`{"jsonrpc":"2.0","id":6,"method":"tools/call","params":{"name":"restart","arguments":{"name":"api"}}}`,
+		`{"jsonrpc":"2.0","id":7,"method":"resources/list"}`,
+		`{not json`,
+	)
+
+	// 通知にはレスポンスを返さない（不正なJSONには id: null で返す）
+	ids := make([]string, 0, len(responses))
+	for id := range responses {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+	if want := []string{"1", "2", "3", "4", "5", "6", "7", "null"}; !slices.Equal(ids, want) {
+		t.Fatalf("response ids = %v, want %v", ids, want)
+	}
+
+	var initialized struct {
+		ProtocolVersion string `json:"protocolVersion"`
+		ServerInfo      struct {
+			Version string `json:"version"`
+		} `json:"serverInfo"`
+	}
+	if err := json.Unmarshal(responses["1"].Result, &initialized); err != nil {
+		t.Fatal(err)
+	}
+	if initialized.ProtocolVersion != "2024-11-05" || initialized.ServerInfo.Version != "1.2.3" {
+		t.Errorf("initialize = %+v", initialized)
+	}
+
+	// 許可されていない変更系ツールは公開しない
+	var listed struct {
+		Tools []toolDescriptor `json:"tools"`
+	}
+	if err := json.Unmarshal(responses["3"].Result, &listed); err != nil {
+		t.Fatal(err)
+	}
+	if len(listed.Tools) != 1 || listed.Tools[0].Name != "echo" || !listed.Tools[0].Annotations.ReadOnlyHint {
+		t.Errorf("tools/list = %+v, want only the read-only echo tool", listed.Tools)
+	}
+
+	var echoed callResult
+	if err := json.Unmarshal(responses["4"].Result, &echoed); err != nil {
+		t.Fatal(err)
+	}
+	if echoed.IsError || len(echoed.Content) != 1 || echoed.Content[0].Text != `{"message":"hello"}` {
+		t.Errorf("tools/call echo = %+v", echoed)
+	}
+
+	// ツールの引数のエラーはプロトコルエラーではなく isError の結果として返す
+	var invalid callResult
+	if err := json.Unmarshal(responses["5"].Result, &invalid); err != nil {
+		t.Fatal(err)
+	}
+	if !invalid.IsError || responses["5"].Error != nil {
+		t.Errorf("tools/call with unknown argument = %+v, want isError result", responses["5"])
+	}
+
+	if r := responses["6"]; r.Error == nil || r.Error.Code != codeInvalidParams || len(restarted) != 0 {
+		t.Errorf("tools/call restart = %+v, restarted = %v, want invalid params without running", r, restarted)
+	}
+	if r := responses["7"]; r.Error == nil || r.Error.Code != codeMethodNotFound {
+		t.Errorf("unknown method = %+v, want method not found", r)
+	}
+	if r := responses["null"]; r.Error == nil || r.Error.Code != codeParseError {
+		t.Errorf("invalid JSON = %+v, want parse error", r)
+	}
+}
+
+func TestServeAllowedTools(t *testing.T) {
+	var restarted []string
+	var mutated []string
+	opts := Options{
+		AllowedTools: []string{"restart"},
+		OnMutate: func(tool string, args json.RawMessage) func(error) {
+			return func(err error) {
+				mutated = append(mutated, tool)
+			}
+		},
+	}
+	responses := serve(t, testTools(&restarted), opts,
+		`{"jsonrpc":"2.0","id":1,"method":"tools/list"}`,
+		`{"jsonrpc":"2.0","id":2,"method":"tools/call","params":{"name":"restart","arguments":{"name":"api"}}}`,
+	)
+
+	var listed struct {
+		Tools []toolDescriptor `json:"tools"`
+	}
+	if err := json.Unmarshal(responses["1"].Result, &listed); err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, tool := range listed.Tools {
+		names = append(names, tool.Name)
+	}
+	if want := []string{"echo", "restart"}; !slices.Equal(names, want) {
+		t.Errorf("tools/list = %v, want %v (sorted by name)", names, want)
+	}
+
+	var result callResult
+	if err := json.Unmarshal(responses["2"].Result, &result); err != nil {
+		t.Fatal(err)
+	}
+	if result.IsError || !slices.Equal(restarted, []string{"api"}) || !slices.Equal(mutated, []string{"restart"}) {
+		t.Errorf("tools/call restart = %+v, restarted = %v, mutated = %v", result, restarted, mutated)
+	}
+}
+
+func TestValidateAllowedTools(t *testing.T) {
+	tools := testTools(new([]string))
+	if err := ValidateAllowedTools(tools, []string{"restart"}); err != nil {
+		t.Errorf("ValidateAllowedTools(restart) = %v", err)
+	}
+	// 読み取り系のツールや未定義のツールは許可リストに指定できない
+	for _, name := range []string{"echo", "drop-database"} {
+		if err := ValidateAllowedTools(tools, []string{name}); err == nil {
+			t.Errorf("ValidateAllowedTools(%s) accepted", name)
+		}
+		if err := Serve(context.Background(), strings.NewReader(""), &bytes.Buffer{}, tools, Options{AllowedTools: []string{name}}); err == nil {
+			t.Errorf("Serve() started with allowed tool %s", name)
+		}
+	}
+}
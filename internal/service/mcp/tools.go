@@ -0,0 +1,420 @@
+package mcp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/aurora"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	"github.com/ngsw877/awstk/internal/service/common"
+	ecssvc "github.com/ngsw877/awstk/internal/service/ecs"
+	logssvc "github.com/ngsw877/awstk/internal/service/logs"
+	rdssvc "github.com/ngsw877/awstk/internal/service/rds"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// NewTools はMCPサーバーで公開するツールを作成する
+func NewTools(clients ClientSet) []Tool {
+	return []Tool{
+		{
+			Name:        "cfn_list_stacks",
+			Description: i18n.T("CloudFormationスタックの一覧（名前・ID・ステータス）を返します"),
+			InputSchema: objectSchema(map[string]any{
+				"all": boolProperty(i18n.T("削除済みなどを含む全ステータスのスタックを返す（省略時はアクティブなスタックのみ）")),
+			}),
+			handler: handle(func(ctx context.Context, args struct {
+				All bool `json:"all"`
+			}) (any, error) {
+				stacks, err := cfn.ListCfnStacks(ctx, clients.CfnClient, args.All)
+				if err != nil {
+					return nil, err
+				}
+				result := StackResult{Stacks: []StackItem{}}
+				for _, stack := range stacks {
+					result.Stacks = append(result.Stacks, StackItem{Name: stack.Name, Id: stack.Id, Status: stack.Status})
+				}
+				return result, nil
+			}),
+		},
+		{
+			Name:        "cfn_drift_status",
+			Description: i18n.T("CloudFormationスタックの最新のドリフト状態を返します（ドリフト検出は実行しません）"),
+			InputSchema: objectSchema(driftSelectionProperties(map[string]any{
+				"driftedOnly": boolProperty(i18n.T("ドリフトしているスタックのみ返す")),
+			})),
+			handler: handle(func(ctx context.Context, args struct {
+				driftSelection
+				DriftedOnly bool `json:"driftedOnly"`
+			}) (any, error) {
+				if err := args.validate(); err != nil {
+					return nil, err
+				}
+				statuses, err := cfn.GetDriftStatuses(ctx, clients.CfnClient, cfn.DriftStatusOptions{
+					Stacks:      args.Stacks,
+					Filter:      args.Filter,
+					All:         args.All,
+					DriftedOnly: args.DriftedOnly,
+					Exact:       args.Exact,
+				})
+				if err != nil {
+					return nil, err
+				}
+				result := DriftResult{Stacks: []DriftItem{}}
+				for _, status := range statuses {
+					item := DriftItem{StackName: status.StackName, DriftStatus: string(status.Status)}
+					if status.LastCheckTime != nil {
+						item.LastCheckTime = status.LastCheckTime.UTC().Format("2006-01-02T15:04:05Z")
+					}
+					result.Stacks = append(result.Stacks, item)
+				}
+				return result, nil
+			}),
+		},
+		{
+			Name:        "ecs_service_status",
+			Description: i18n.T("ECSサービスの状態（タスク数・タスク定義・Auto Scaling設定・タスク一覧）を返します"),
+			InputSchema: objectSchema(ecsServiceProperties()),
+			handler: handle(func(ctx context.Context, args ecsServiceArgs) (any, error) {
+				cluster, service, err := args.resolve(ctx, clients)
+				if err != nil {
+					return nil, err
+				}
+				status, err := ecssvc.GetServiceStatus(ctx, clients.EcsClient, clients.AasClient, ecssvc.StatusOptions{
+					ClusterName: cluster,
+					ServiceName: service,
+				})
+				if err != nil {
+					return nil, err
+				}
+				result := EcsStatusResult{
+					Cluster:        status.ClusterName,
+					Service:        status.ServiceName,
+					Status:         status.Status,
+					TaskDefinition: status.TaskDefinition,
+					DesiredCount:   status.DesiredCount,
+					RunningCount:   status.RunningCount,
+					PendingCount:   status.PendingCount,
+					Tasks:          []EcsTaskItem{},
+				}
+				if status.AutoScaling != nil {
+					result.MinCapacity = aws.Int32(status.AutoScaling.MinCapacity)
+					result.MaxCapacity = aws.Int32(status.AutoScaling.MaxCapacity)
+				}
+				for _, task := range status.Tasks {
+					result.Tasks = append(result.Tasks, EcsTaskItem{
+						TaskId:       task.TaskId,
+						Status:       task.Status,
+						HealthStatus: task.HealthStatus,
+						CreatedAt:    task.CreatedAt,
+					})
+				}
+				return result, nil
+			}),
+		},
+		{
+			Name:        "aurora_acu",
+			Description: i18n.T("Aurora Serverless v2クラスターのACU使用量（過去5分間の平均）と設定範囲を返します。クラスターを省略すると全Serverless v2クラスターを返します"),
+			InputSchema: objectSchema(map[string]any{
+				"cluster":   stringProperty(i18n.T("Auroraクラスター識別子")),
+				"stackName": stringProperty(i18n.T("Auroraクラスターを含むCloudFormationスタック名")),
+			}),
+			handler: handle(func(ctx context.Context, args struct {
+				Cluster   string `json:"cluster"`
+				StackName string `json:"stackName"`
+			}) (any, error) {
+				cluster := args.Cluster
+				if args.StackName != "" {
+					var err error
+					cluster, err = cfn.GetAuroraFromStack(ctx, clients.CfnClient, args.StackName)
+					if err != nil {
+						return nil, err
+					}
+				}
+
+				var infos []aurora.CapacityInfo
+				if cluster == "" {
+					var err error
+					infos, err = aurora.ListAuroraCapacityInfo(ctx, clients.RdsClient, clients.CwClient)
+					if err != nil {
+						return nil, err
+					}
+				} else {
+					info, err := aurora.GetAuroraCapacityInfo(ctx, clients.RdsClient, clients.CwClient, cluster)
+					if err != nil {
+						return nil, err
+					}
+					infos = append(infos, *info)
+				}
+
+				result := AcuResult{Clusters: []AcuItem{}}
+				for _, info := range infos {
+					item := AcuItem{
+						ClusterId:    info.ClusterId,
+						Status:       info.Status,
+						IsServerless: info.IsServerless,
+						MinAcu:       info.MinAcu,
+						MaxAcu:       info.MaxAcu,
+					}
+					if info.IsServerless && info.CurrentAcu >= 0 {
+						item.CurrentAcu = aws.Float64(info.CurrentAcu)
+					}
+					result.Clusters = append(result.Clusters, item)
+				}
+				return result, nil
+			}),
+		},
+		{
+			Name:        "logs_list_groups",
+			Description: i18n.T("CloudWatch Logsのロググループ一覧（サイズ・作成日・保存期間）を返します"),
+			InputSchema: objectSchema(map[string]any{
+				"search":      stringProperty(i18n.T("ロググループ名の検索パターン（部分一致、* ? のワイルドカード可）")),
+				"exact":       boolProperty(i18n.T("大文字小文字を区別してマッチ")),
+				"emptyOnly":   boolProperty(i18n.T("空のロググループのみ返す")),
+				"noRetention": boolProperty(i18n.T("保存期間が未設定のロググループのみ返す")),
+			}),
+			handler: handle(func(ctx context.Context, args struct {
+				Search      string `json:"search"`
+				Exact       bool   `json:"exact"`
+				EmptyOnly   bool   `json:"emptyOnly"`
+				NoRetention bool   `json:"noRetention"`
+			}) (any, error) {
+				logGroups, err := logssvc.ListLogGroups(ctx, clients.LogsClient)
+				if err != nil {
+					return nil, err
+				}
+				if args.EmptyOnly {
+					logGroups = logssvc.FilterEmptyLogGroups(logGroups)
+				}
+				if args.NoRetention {
+					logGroups = logssvc.FilterNoRetentionLogGroups(logGroups)
+				}
+
+				result := LogGroupResult{LogGroups: []LogGroupItem{}}
+				for _, group := range logGroups {
+					name := aws.ToString(group.LogGroupName)
+					if args.Search != "" && !common.MatchesFilter(name, args.Search, args.Exact) {
+						continue
+					}
+					item := LogGroupItem{
+						Name:              name,
+						StoredBytes:       aws.ToInt64(group.StoredBytes),
+						RetentionInDays:   group.RetentionInDays,
+						MetricFilterCount: aws.ToInt32(group.MetricFilterCount),
+					}
+					if group.CreationTime != nil {
+						item.CreatedAt = common.FormatTimestamp(group.CreationTime)
+					}
+					result.LogGroups = append(result.LogGroups, item)
+				}
+				return result, nil
+			}),
+		},
+
+		// 以下は変更系のツール（設定ファイルの mcp.allowTools に含まれる場合のみ公開）
+		{
+			Name:        "cfn_drift_detect",
+			Description: i18n.T("CloudFormationスタックのドリフト検出を開始します。結果は cfn_drift_status で確認します"),
+			InputSchema: objectSchema(driftSelectionProperties(map[string]any{})),
+			Mutating:    true,
+			handler: handle(func(ctx context.Context, args driftSelection) (any, error) {
+				if err := args.validate(); err != nil {
+					return nil, err
+				}
+				detections, err := cfn.DetectDrift(ctx, clients.CfnClient, cfn.DriftOptions{
+					Stacks: args.Stacks,
+					Filter: args.Filter,
+					All:    args.All,
+					Exact:  args.Exact,
+				})
+				if err != nil {
+					return nil, err
+				}
+				started := []string{}
+				var failed []string
+				for _, d := range detections {
+					if d.Err != nil {
+						failed = append(failed, fmt.Sprintf("%s: %v", d.StackName, d.Err))
+						continue
+					}
+					started = append(started, d.StackName)
+				}
+				if len(failed) > 0 {
+					return nil, fmt.Errorf(i18n.T("一部のスタックでドリフト検出の開始に失敗しました: %s"), strings.Join(failed, "; "))
+				}
+				return ActionResult{Action: "cfn_drift_detect", Resources: started, Message: i18n.T("ドリフト検出を開始しました")}, nil
+			}),
+		},
+		{
+			Name:        "ecs_redeploy",
+			Description: i18n.T("ECSサービスを強制再デプロイします（デプロイ完了は待機しません）"),
+			InputSchema: objectSchema(ecsServiceProperties()),
+			Mutating:    true,
+			handler: handle(func(ctx context.Context, args ecsServiceArgs) (any, error) {
+				cluster, service, err := args.resolve(ctx, clients)
+				if err != nil {
+					return nil, err
+				}
+				if err := ecssvc.ForceRedeployService(ctx, clients.EcsClient, cluster, service); err != nil {
+					return nil, err
+				}
+				return ActionResult{Action: "ecs_redeploy", Resources: []string{cluster + "/" + service}, Message: i18n.T("強制再デプロイを開始しました")}, nil
+			}),
+		},
+		clusterActionTool("aurora_start", i18n.T("Aurora DBクラスターを起動します"), "cluster", i18n.T("Auroraクラスター識別子"),
+			func(ctx context.Context, id string) error {
+				return aurora.StartAuroraCluster(ctx, clients.RdsClient, id)
+			}),
+		clusterActionTool("aurora_stop", i18n.T("Aurora DBクラスターを停止します"), "cluster", i18n.T("Auroraクラスター識別子"),
+			func(ctx context.Context, id string) error {
+				return aurora.StopAuroraCluster(ctx, clients.RdsClient, id)
+			}),
+		clusterActionTool("rds_start", i18n.T("RDSインスタンスを起動します"), "instance", i18n.T("RDSインスタンス識別子"),
+			func(ctx context.Context, id string) error { return rdssvc.StartRdsInstance(ctx, clients.RdsClient, id) }),
+		clusterActionTool("rds_stop", i18n.T("RDSインスタンスを停止します"), "instance", i18n.T("RDSインスタンス識別子"),
+			func(ctx context.Context, id string) error { return rdssvc.StopRdsInstance(ctx, clients.RdsClient, id) }),
+	}
+}
+
+// clusterActionTool は識別子を1つ受け取って起動・停止する変更系ツールを作成する
+func clusterActionTool(name, description, key, keyDescription string, action func(ctx context.Context, id string) error) Tool {
+	return Tool{
+		Name:        name,
+		Description: description,
+		InputSchema: objectSchema(map[string]any{key: stringProperty(keyDescription)}, key),
+		Mutating:    true,
+		handler: func(ctx context.Context, raw json.RawMessage) (any, error) {
+			var args map[string]string
+			if err := decodeArgs(raw, &args); err != nil {
+				return nil, err
+			}
+			id := args[key]
+			if id == "" {
+				return nil, fmt.Errorf(i18n.T("引数 %s を指定してください"), key)
+			}
+			if err := action(ctx, id); err != nil {
+				return nil, err
+			}
+			return ActionResult{Action: name, Resources: []string{id}, Message: i18n.T("リクエストを受け付けました")}, nil
+		},
+	}
+}
+
+// driftSelection はドリフト関連ツールの対象スタックの指定
+type driftSelection struct {
+	Stacks []string `json:"stacks"`
+	Filter string   `json:"filter"`
+	All    bool     `json:"all"`
+	Exact  bool     `json:"exact"`
+}
+
+// validate はスタック名・フィルター・全スタックのいずれか1つだけが指定されているかを確認する
+func (d driftSelection) validate() error {
+	count := 0
+	if len(d.Stacks) > 0 {
+		count++
+	}
+	if d.Filter != "" {
+		count++
+	}
+	if d.All {
+		count++
+	}
+	if count != 1 {
+		return errors.New(i18n.T("stacks, filter, all のいずれか1つを指定してください"))
+	}
+	return nil
+}
+
+// driftSelectionProperties はドリフト関連ツールの共通プロパティに extra を加えて返す
+func driftSelectionProperties(extra map[string]any) map[string]any {
+	properties := map[string]any{
+		"stacks": stringArrayProperty(i18n.T("対象のスタック名")),
+		"filter": stringProperty(i18n.T("スタック名のフィルター（部分一致）")),
+		"all":    boolProperty(i18n.T("すべてのスタックを対象")),
+		"exact":  boolProperty(i18n.T("大文字小文字を区別してマッチ")),
+	}
+	for key, value := range extra {
+		properties[key] = value
+	}
+	return properties
+}
+
+// ecsServiceArgs はECSサービスを指定するツールの引数
+type ecsServiceArgs struct {
+	StackName string `json:"stackName"`
+	Cluster   string `json:"cluster"`
+	Service   string `json:"service"`
+}
+
+// resolve はスタック名またはクラスター名・サービス名からECSサービスを決定する
+func (a ecsServiceArgs) resolve(ctx context.Context, clients ClientSet) (string, string, error) {
+	return ecssvc.ResolveClusterAndService(ctx, clients.CfnClient, ecssvc.ResolveOptions{
+		StackName:   a.StackName,
+		ClusterName: a.Cluster,
+		ServiceName: a.Service,
+	})
+}
+
+// ecsServiceProperties はECSサービスを指定するツールのプロパティを返す
+func ecsServiceProperties() map[string]any {
+	return map[string]any{
+		"stackName": stringProperty(i18n.T("ECSサービスを含むCloudFormationスタック名（cluster/service の代わりに指定）")),
+		"cluster":   stringProperty(i18n.T("ECSクラスター名")),
+		"service":   stringProperty(i18n.T("ECSサービス名")),
+	}
+}
+
+// handle は引数をデコードしてから fn を呼び出すハンドラーを作成する
+func handle[T any](fn func(ctx context.Context, args T) (any, error)) func(ctx context.Context, raw json.RawMessage) (any, error) {
+	return func(ctx context.Context, raw json.RawMessage) (any, error) {
+		var args T
+		if err := decodeArgs(raw, &args); err != nil {
+			return nil, err
+		}
+		return fn(ctx, args)
+	}
+}
+
+// decodeArgs はツールの引数をデコードする（スキーマにない引数はエラーにする）
+func decodeArgs(raw json.RawMessage, v any) error {
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(v); err != nil {
+		return fmt.Errorf(i18n.T("引数が不正です: %w"), err)
+	}
+	return nil
+}
+
+// objectSchema はツールの入力のJSONスキーマを返す
+func objectSchema(properties map[string]any, required ...string) map[string]any {
+	schema := map[string]any{
+		"type":                 "object",
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+	if len(required) > 0 {
+		schema["required"] = required
+	}
+	return schema
+}
+
+// stringProperty は文字列のプロパティを返す
+func stringProperty(description string) map[string]any {
+	return map[string]any{"type": "string", "description": description}
+}
+
+// boolProperty は真偽値のプロパティを返す
+func boolProperty(description string) map[string]any {
+	return map[string]any{"type": "boolean", "description": description}
+}
+
+// stringArrayProperty は文字列の配列のプロパティを返す
+func stringArrayProperty(description string) map[string]any {
+	return map[string]any{"type": "array", "items": map[string]any{"type": "string"}, "description": description}
+}
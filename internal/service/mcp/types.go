@@ -0,0 +1,177 @@
+package mcp
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatch"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/ecs"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+)
+
+// ClientSet はツールの実行に必要なクライアントをまとめた構造体
+type ClientSet struct {
+	CfnClient  *cloudformation.Client
+	EcsClient  *ecs.Client
+	AasClient  *applicationautoscaling.Client
+	RdsClient  *rds.Client
+	CwClient   *cloudwatch.Client
+	LogsClient *cloudwatchlogs.Client
+}
+
+// Options はMCPサーバーのオプション
+type Options struct {
+	Version      string   // initialize で返すサーバーのバージョン
+	AllowedTools []string // 公開する変更系ツール名（設定ファイルの mcp.allowTools）
+	// OnMutate は変更系ツールの実行前に呼ばれ、戻り値の関数は実行結果のエラー（成功時はnil）を受け取る
+	OnMutate func(tool string, args json.RawMessage) func(error)
+}
+
+// Tool はMCPクライアントに公開する操作
+type Tool struct {
+	Name        string
+	Description string
+	InputSchema map[string]any
+	Mutating    bool // AWSリソースを変更するツール（許可リストに含まれる場合のみ公開）
+	handler     func(ctx context.Context, args json.RawMessage) (any, error)
+}
+
+// request はJSON-RPC 2.0のリクエスト（id がない場合は通知）
+type request struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Id      json.RawMessage `json:"id,omitempty"`
+	Method  string          `json:"method"`
+	Params  json.RawMessage `json:"params,omitempty"`
+}
+
+// response はJSON-RPC 2.0のレスポンス
+type response struct {
+	JsonRpc string          `json:"jsonrpc"`
+	Id      json.RawMessage `json:"id"`
+	Result  any             `json:"result,omitempty"`
+	Error   *rpcError       `json:"error,omitempty"`
+}
+
+// rpcError はJSON-RPC 2.0のエラーオブジェクト
+type rpcError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+// toolDescriptor は tools/list で返すツールの定義
+type toolDescriptor struct {
+	Name        string          `json:"name"`
+	Description string          `json:"description"`
+	InputSchema map[string]any  `json:"inputSchema"`
+	Annotations toolAnnotations `json:"annotations"`
+}
+
+// toolAnnotations はツールの性質を示すヒント
+type toolAnnotations struct {
+	ReadOnlyHint    bool `json:"readOnlyHint"`
+	DestructiveHint bool `json:"destructiveHint"`
+}
+
+// callParams は tools/call のパラメータ
+type callParams struct {
+	Name      string          `json:"name"`
+	Arguments json.RawMessage `json:"arguments"`
+}
+
+// callResult は tools/call の結果
+type callResult struct {
+	Content           []textContent `json:"content"`
+	StructuredContent any           `json:"structuredContent,omitempty"`
+	IsError           bool          `json:"isError"`
+}
+
+// textContent はテキスト形式のコンテンツ
+type textContent struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// StackResult はスタック一覧ツールの結果
+type StackResult struct {
+	Stacks []StackItem `json:"stacks"`
+}
+
+// StackItem はCloudFormationスタックの情報
+type StackItem struct {
+	Name   string `json:"name"`
+	Id     string `json:"id"`
+	Status string `json:"status"`
+}
+
+// EcsStatusResult はECSサービス状態ツールの結果
+type EcsStatusResult struct {
+	Cluster        string        `json:"cluster"`
+	Service        string        `json:"service"`
+	Status         string        `json:"status"`
+	TaskDefinition string        `json:"taskDefinition"`
+	DesiredCount   int32         `json:"desiredCount"`
+	RunningCount   int32         `json:"runningCount"`
+	PendingCount   int32         `json:"pendingCount"`
+	MinCapacity    *int32        `json:"minCapacity,omitempty"`
+	MaxCapacity    *int32        `json:"maxCapacity,omitempty"`
+	Tasks          []EcsTaskItem `json:"tasks"`
+}
+
+// EcsTaskItem はECSタスクの情報
+type EcsTaskItem struct {
+	TaskId       string `json:"taskId"`
+	Status       string `json:"status"`
+	HealthStatus string `json:"healthStatus"`
+	CreatedAt    string `json:"createdAt,omitempty"`
+}
+
+// AcuResult はACU情報ツールの結果
+type AcuResult struct {
+	Clusters []AcuItem `json:"clusters"`
+}
+
+// AcuItem はAurora Serverless v2クラスターのACU情報（currentAcu は取得できなかった場合に省略）
+type AcuItem struct {
+	ClusterId    string   `json:"clusterId"`
+	Status       string   `json:"status"`
+	IsServerless bool     `json:"isServerless"`
+	CurrentAcu   *float64 `json:"currentAcu,omitempty"`
+	MinAcu       float64  `json:"minAcu"`
+	MaxAcu       float64  `json:"maxAcu"`
+}
+
+// DriftResult はドリフト状態ツールの結果
+type DriftResult struct {
+	Stacks []DriftItem `json:"stacks"`
+}
+
+// DriftItem はスタックのドリフト状態
+type DriftItem struct {
+	StackName     string `json:"stackName"`
+	DriftStatus   string `json:"driftStatus"`
+	LastCheckTime string `json:"lastCheckTime,omitempty"`
+}
+
+// LogGroupResult はロググループ一覧ツールの結果
+type LogGroupResult struct {
+	LogGroups []LogGroupItem `json:"logGroups"`
+}
+
+// LogGroupItem はロググループの情報（retentionInDays は無期限の場合に省略）
+type LogGroupItem struct {
+	Name              string `json:"name"`
+	StoredBytes       int64  `json:"storedBytes"`
+	CreatedAt         string `json:"createdAt,omitempty"`
+	RetentionInDays   *int32 `json:"retentionInDays,omitempty"`
+	MetricFilterCount int32  `json:"metricFilterCount"`
+}
+
+// ActionResult は変更系ツールの結果
+type ActionResult struct {
+	Action    string   `json:"action"`
+	Resources []string `json:"resources"`
+	Message   string   `json:"message"`
+}
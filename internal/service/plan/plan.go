@@ -0,0 +1,183 @@
+package plan
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+)
+
+// New は空のプランを作成する
+func New(command, profile, region string) *Plan {
+	return &Plan{
+		Version:   FormatVersion,
+		CreatedAt: time.Now().UTC(),
+		Command:   command,
+		Profile:   profile,
+		Region:    region,
+		Actions:   []Action{},
+	}
+}
+
+// Add はプランにアクションを追加する
+func (p *Plan) Add(actions ...Action) {
+	p.Actions = append(p.Actions, actions...)
+}
+
+// Types はプランに含まれるリソース種別を出現順で返す
+func (p *Plan) Types() []string {
+	seen := make(map[string]bool)
+	var types []string
+	for _, a := range p.Actions {
+		if !seen[a.Type] {
+			seen[a.Type] = true
+			types = append(types, a.Type)
+		}
+	}
+	return types
+}
+
+// ActionsOf は指定したリソース種別のアクションを返す
+func (p *Plan) ActionsOf(resourceType string) []Action {
+	var actions []Action
+	for _, a := range p.Actions {
+		if a.Type == resourceType {
+			actions = append(actions, a)
+		}
+	}
+	return actions
+}
+
+// Write はプランをJSONファイルに書き出す
+func Write(path string, p *Plan) error {
+	data, err := json.MarshalIndent(p, "", "  ")
+	if err != nil {
+		return fmt.Errorf(i18n.T("プランのエンコードに失敗: %w"), err)
+	}
+	if err := os.WriteFile(path, append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf(i18n.T("プランファイルの書き込みに失敗: %w"), err)
+	}
+	return nil
+}
+
+// Load はプランファイルを読み込む
+func Load(path string) (*Plan, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("プランファイルの読み込みに失敗: %w"), err)
+	}
+
+	var p Plan
+	if err := json.Unmarshal(data, &p); err != nil {
+		return nil, fmt.Errorf(i18n.T("プランファイルの解析に失敗: %w"), err)
+	}
+	if p.Version != FormatVersion {
+		return nil, fmt.Errorf(i18n.T("未対応のプランファイルのバージョンです: %d（対応: %d）"), p.Version, FormatVersion)
+	}
+	for i, a := range p.Actions {
+		if a.Type == "" || a.Name == "" || a.Action == "" {
+			return nil, fmt.Errorf(i18n.T("プランファイルの%d番目のアクションが不正です（type/action/name は必須）"), i+1)
+		}
+	}
+	return &p, nil
+}
+
+// Names はアクションのリソース名一覧を返す
+func Names(actions []Action) []string {
+	names := make([]string, len(actions))
+	for i, a := range actions {
+		names[i] = a.Name
+	}
+	return names
+}
+
+// Verify はプランのアクションと現在のリソース状態を比較し、差異を返す
+// current は同じリソース種別について現在の状態から作り直したアクション（存在しないリソースは含まない）
+func Verify(planned, current []Action) []Mismatch {
+	currentByName := make(map[string]Action, len(current))
+	for _, a := range current {
+		currentByName[a.Name] = a
+	}
+
+	var mismatches []Mismatch
+	for _, a := range planned {
+		now, ok := currentByName[a.Name]
+		if !ok {
+			mismatches = append(mismatches, Mismatch{Action: a, Reason: i18n.T("リソースが存在しません")})
+			continue
+		}
+		if a.Id != "" && a.Id != now.Id {
+			mismatches = append(mismatches, Mismatch{Action: a, Reason: fmt.Sprintf(i18n.T("IDが変わっています（プラン: %s, 現在: %s）"), a.Id, now.Id)})
+			continue
+		}
+		if diff := diffFingerprint(a.Fingerprint, now.Fingerprint); diff != "" {
+			mismatches = append(mismatches, Mismatch{Action: a, Reason: fmt.Sprintf(i18n.T("プラン作成後に変更されています（%s）"), diff)})
+		}
+	}
+	return mismatches
+}
+
+// diffFingerprint はフィンガープリントの差分を表示用の文字列で返す（差分がなければ空文字）
+func diffFingerprint(planned, current map[string]string) string {
+	keys := make([]string, 0, len(planned))
+	for k := range planned {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var diffs []string
+	for _, k := range keys {
+		if planned[k] != current[k] {
+			diffs = append(diffs, fmt.Sprintf("%s: %s → %s", k, planned[k], current[k]))
+		}
+	}
+	return strings.Join(diffs, ", ")
+}
+
+// Print はプランの内容を表示する
+func Print(p *Plan) {
+	fmt.Printf(i18n.T("%s プラン（作成: %s, リージョン: %s）\n"), common.InfoIcon, p.CreatedAt.Local().Format("2006-01-02 15:04:05"), p.Region)
+	if p.Command != "" {
+		fmt.Printf(i18n.T("   コマンド: %s\n"), p.Command)
+	}
+	for _, t := range p.Types() {
+		actions := p.ActionsOf(t)
+		fmt.Printf(i18n.T("\n【%s】(%d件)\n"), t, len(actions))
+		for _, a := range actions {
+			line := fmt.Sprintf("  - %s %s", a.Action, a.Name)
+			if len(a.Options) > 0 {
+				line += " " + formatOptions(a.Options)
+			}
+			fmt.Println(line)
+		}
+	}
+	fmt.Printf(i18n.T("\n合計: %d件のアクション\n"), len(p.Actions))
+}
+
+// formatOptions はオプションを表示用の文字列に変換する
+func formatOptions(options map[string]string) string {
+	keys := make([]string, 0, len(options))
+	for k := range options {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, len(keys))
+	for i, k := range keys {
+		parts[i] = k + "=" + options[k]
+	}
+	return "[" + strings.Join(parts, ", ") + "]"
+}
+
+// FormatTime はフィンガープリント用に時刻を文字列化する
+func FormatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
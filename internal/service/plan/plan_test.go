@@ -0,0 +1,68 @@
+package plan
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestVerify(t *testing.T) {
+	planned := []Action{
+		{Type: "s3", Action: ActionDelete, Name: "unchanged", Fingerprint: map[string]string{"objects": "3"}},
+		{Type: "s3", Action: ActionDelete, Name: "removed"},
+		{Type: "ecr", Action: ActionDelete, Name: "recreated", Id: "111"},
+		{Type: "s3", Action: ActionDelete, Name: "changed", Fingerprint: map[string]string{"objects": "3", "size": "10"}},
+		{Type: "ecr", Action: ActionDelete, Name: "no-id"},
+	}
+	current := []Action{
+		{Type: "s3", Action: ActionDelete, Name: "unchanged", Fingerprint: map[string]string{"objects": "3"}},
+		{Type: "ecr", Action: ActionDelete, Name: "recreated", Id: "222"},
+		{Type: "s3", Action: ActionDelete, Name: "changed", Fingerprint: map[string]string{"objects": "5", "size": "10"}},
+		{Type: "ecr", Action: ActionDelete, Name: "no-id", Id: "333"},
+		{Type: "s3", Action: ActionDelete, Name: "added-after-plan"},
+	}
+
+	mismatches := Verify(planned, current)
+
+	var names []string
+	for _, m := range mismatches {
+		names = append(names, m.Action.Name)
+	}
+	if want := []string{"removed", "recreated", "changed"}; !slices.Equal(names, want) {
+		t.Fatalf("mismatches = %v, want %v", names, want)
+	}
+	if reason := mismatches[1].Reason; !strings.Contains(reason, "111") || !strings.Contains(reason, "222") {
+		t.Errorf("ID mismatch reason = %q, want both IDs", reason)
+	}
+	if reason := mismatches[2].Reason; !strings.Contains(reason, "objects: 3 → 5") || strings.Contains(reason, "size") {
+		t.Errorf("fingerprint mismatch reason = %q, want only the changed key", reason)
+	}
+}
+
+func TestWriteLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "plan.json")
+	p := New("cleanup all", "dev", "ap-northeast-1")
+	p.Add(Action{Type: "logs", Action: ActionDelete, Name: "/aws/lambda/app", Options: map[string]string{OptionForce: "true"}})
+	if err := Write(path, p); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loaded.Command != p.Command || len(loaded.Actions) != 1 || loaded.Actions[0].Options[OptionForce] != "true" {
+		t.Errorf("Load() = %+v, want %+v", loaded, p)
+	}
+
+	// 必須項目がないアクションを含むプランは読み込まない
+	if err := os.WriteFile(path, []byte(fmt.Sprintf(`{"version":%d,"actions":[{"type":"logs","name":"x"}]}`, FormatVersion)), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := Load(path); err == nil {
+		t.Error("Load() accepted an action without action field")
+	}
+}
@@ -0,0 +1,56 @@
+package plan
+
+import "time"
+
+// FormatVersion はプランファイルの形式バージョン
+const FormatVersion = 1
+
+// アクション種別
+const (
+	ActionDelete = "delete"
+)
+
+// リソース種別（プランファイルに記録される識別子）
+const (
+	ResourceS3Bucket      = "s3-bucket"
+	ResourceEcrRepository = "ecr-repository"
+	ResourceLogGroup      = "logs-group"
+	ResourceIamRole       = "iam-role"
+	ResourceIamPolicy     = "iam-policy"
+	ResourceLoadBalancer  = "elb-load-balancer"
+	ResourceCfnStack      = "cfn-stack"
+)
+
+// アクションのオプションキー
+const (
+	OptionForce            = "force"              // 削除保護を解除して削除
+	OptionWithTargetGroups = "with-target-groups" // 関連するターゲットグループも削除
+)
+
+// Plan は破壊的コマンドの実行計画（--plan-out で書き出し、apply で実行する）
+type Plan struct {
+	Version   int       `json:"version"`
+	CreatedAt time.Time `json:"createdAt"`
+	Command   string    `json:"command"`
+	Profile   string    `json:"profile,omitempty"`
+	Account   string    `json:"account,omitempty"` // プラン作成時の認証情報のアカウントID
+	Region    string    `json:"region"`
+	Actions   []Action  `json:"actions"`
+}
+
+// Action はプラン内の1リソースに対する操作
+// Fingerprint はプラン作成時点のリソースの状態で、apply 時に再取得した値と一致しない場合は実行しない
+type Action struct {
+	Type        string            `json:"type"`
+	Action      string            `json:"action"`
+	Name        string            `json:"name"`
+	Id          string            `json:"id,omitempty"`
+	Fingerprint map[string]string `json:"fingerprint,omitempty"`
+	Options     map[string]string `json:"options,omitempty"`
+}
+
+// Mismatch はapply時の再検証で検出されたプランとの差異
+type Mismatch struct {
+	Action Action
+	Reason string
+}
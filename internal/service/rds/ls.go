@@ -4,50 +4,93 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/cfn"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
 	"github.com/aws/aws-sdk-go-v2/service/rds"
-
-	"awstk/internal/service/cfn"
-	"awstk/internal/service/common"
 )
 
 // ListRdsInstances cmdから呼ばれるメイン関数（Get + Display）
-func ListRdsInstances(rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) error {
+// tags が指定された場合はタグ条件に一致するRDSインスタンスのみを表示する
+func ListRdsInstances(ctx context.Context, rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string, tags *tagging.Selector) error {
 	// Get: データ取得
-	instances, err := getRdsInstances(rdsClient, cfnClient, stackName)
+	instances, err := getRdsInstances(ctx, rdsClient, cfnClient, stackName)
 	if err != nil {
 		if stackName != "" {
-			return fmt.Errorf("❌ CloudFormationスタックからインスタンス名の取得に失敗: %w", err)
+			return fmt.Errorf(i18n.T("❌ CloudFormationスタックからインスタンス名の取得に失敗: %w"), err)
 		}
-		return common.FormatListError("RDSインスタンス", err)
+		return common.FormatListError(i18n.T("RDSインスタンス"), err)
+	}
+	instances, err = selectRdsInstancesByTags(ctx, tags, common.Target{}, instances)
+	if err != nil {
+		return common.FormatListError(i18n.T("RDSインスタンス"), err)
 	}
 
 	// Display: 共通表示処理
 	return common.DisplayList(
 		instances,
-		"RDSインスタンス一覧",
+		i18n.T("RDSインスタンス一覧"),
 		rdsInstancesToTableData,
 		&common.DisplayOptions{
 			ShowCount:    true,
-			EmptyMessage: "RDSインスタンスが見つかりませんでした",
+			EmptyMessage: i18n.T("RDSインスタンスが見つかりませんでした"),
 		},
 	)
 }
 
+// ListRdsInstancesAcross は複数のアカウント・リージョンのRDSインスタンスを並列に取得し、アカウント列・リージョン列付きで表示する
+func ListRdsInstancesAcross(ctx context.Context, targets []common.Target, newClient func(target common.Target) *rds.Client, tags *tagging.Selector) error {
+	results := common.FetchAcrossTargets(ctx, targets, func(ctx context.Context, target common.Target) ([]Instance, error) {
+		instances, err := getAllRdsInstances(ctx, newClient(target))
+		if err != nil {
+			return nil, err
+		}
+		return selectRdsInstancesByTags(ctx, tags, target, instances)
+	})
+	return common.DisplayTargetList(
+		results,
+		i18n.T("RDSインスタンス一覧"),
+		rdsInstancesToTableData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: i18n.T("RDSインスタンスが見つかりませんでした"),
+		},
+	)
+}
+
+// FindRdsInstancesByTags はタグ条件に一致するRDSインスタンスを取得する
+func FindRdsInstancesByTags(ctx context.Context, rdsClient *rds.Client, tags *tagging.Selector) ([]Instance, error) {
+	instances, err := getAllRdsInstances(ctx, rdsClient)
+	if err != nil {
+		return nil, err
+	}
+	return selectRdsInstancesByTags(ctx, tags, common.Target{}, instances)
+}
+
+// selectRdsInstancesByTags はタグ条件に一致するRDSインスタンスに絞り込む
+func selectRdsInstancesByTags(ctx context.Context, tags *tagging.Selector, target common.Target, instances []Instance) ([]Instance, error) {
+	return tagging.Select(ctx, tags, target, tagging.TypeRdsInstance, instances, func(i Instance) string {
+		return "db:" + i.InstanceId
+	})
+}
+
 // getRdsInstances データ取得内部関数
-func getRdsInstances(rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) ([]Instance, error) {
+func getRdsInstances(ctx context.Context, rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) ([]Instance, error) {
 	if stackName != "" {
-		return getRdsInstancesByStackName(rdsClient, cfnClient, stackName)
+		return getRdsInstancesByStackName(ctx, rdsClient, cfnClient, stackName)
 	}
-	return getAllRdsInstances(rdsClient)
+	return getAllRdsInstances(ctx, rdsClient)
 }
 
 // getAllRdsInstances 現在のリージョンの全RDSインスタンスを取得
-func getAllRdsInstances(rdsClient *rds.Client) ([]Instance, error) {
-	resp, err := rdsClient.DescribeDBInstances(context.Background(), &rds.DescribeDBInstancesInput{})
+func getAllRdsInstances(ctx context.Context, rdsClient *rds.Client) ([]Instance, error) {
+	resp, err := rdsClient.DescribeDBInstances(ctx, &rds.DescribeDBInstancesInput{})
 	if err != nil {
-		return nil, fmt.Errorf("RDSインスタンス一覧の取得に失敗: %w", err)
+		return nil, fmt.Errorf(i18n.T("RDSインスタンス一覧の取得に失敗: %w"), err)
 	}
 
 	instances := make([]Instance, 0, len(resp.DBInstances))
@@ -63,8 +106,8 @@ func getAllRdsInstances(rdsClient *rds.Client) ([]Instance, error) {
 }
 
 // getRdsInstancesByStackName 指定されたCloudFormationスタック名でフィルタリングしたRDSインスタンス一覧を取得
-func getRdsInstancesByStackName(rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) ([]Instance, error) {
-	ids, err := cfn.GetAllRdsFromStack(cfnClient, stackName)
+func getRdsInstancesByStackName(ctx context.Context, rdsClient *rds.Client, cfnClient *cloudformation.Client, stackName string) ([]Instance, error) {
+	ids, err := cfn.GetAllRdsFromStack(ctx, cfnClient, stackName)
 	if err != nil {
 		return nil, err
 	}
@@ -73,7 +116,7 @@ func getRdsInstancesByStackName(rdsClient *rds.Client, cfnClient *cloudformation
 		return []Instance{}, nil
 	}
 
-	all, err := getAllRdsInstances(rdsClient)
+	all, err := getAllRdsInstances(ctx, rdsClient)
 	if err != nil {
 		return nil, err
 	}
@@ -96,9 +139,9 @@ func getRdsInstancesByStackName(rdsClient *rds.Client, cfnClient *cloudformation
 // rdsInstancesToTableData RDSインスタンス情報をテーブルデータに変換
 func rdsInstancesToTableData(instances []Instance) ([]common.TableColumn, [][]string) {
 	columns := []common.TableColumn{
-		{Header: "インスタンスID"},
-		{Header: "エンジン"},
-		{Header: "ステータス"},
+		{Header: i18n.T("インスタンスID"), Key: "instanceId"},
+		{Header: i18n.T("エンジン"), Key: "engine"},
+		{Header: i18n.T("ステータス"), Key: "status"},
 	}
 
 	data := make([][]string, len(instances))
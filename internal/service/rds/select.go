@@ -0,0 +1,34 @@
+package rds
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/selector"
+
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+)
+
+// SelectRdsInstance 現在のリージョンのRDSインスタンス一覧から対話的に選択させる
+func SelectRdsInstance(ctx context.Context, rdsClient *rds.Client) (string, error) {
+	instances, err := getAllRdsInstances(ctx, rdsClient)
+	if err != nil {
+		return "", fmt.Errorf(i18n.T("❌ エラー: %w"), err)
+	}
+	if len(instances) == 0 {
+		return "", errors.New(i18n.T("❌ RDSインスタンスが見つかりません"))
+	}
+
+	items := make([]selector.Item, len(instances))
+	for i, ins := range instances {
+		items[i] = selector.Item{Value: ins.InstanceId, Label: fmt.Sprintf("%s  (%s, %s)", ins.InstanceId, ins.Engine, ins.Status)}
+	}
+
+	selected, err := selector.Select(i18n.T("RDSインスタンスを選択してください"), items)
+	if err != nil {
+		return "", err
+	}
+	return selected.Value, nil
+}
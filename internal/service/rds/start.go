@@ -4,18 +4,20 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 )
 
 // StartRdsInstance RDSインスタンスを起動する
-func StartRdsInstance(rdsClient *rds.Client, instanceId string) error {
+func StartRdsInstance(ctx context.Context, rdsClient *rds.Client, instanceId string) error {
 	input := &rds.StartDBInstanceInput{
 		DBInstanceIdentifier: &instanceId,
 	}
 
-	_, err := rdsClient.StartDBInstance(context.Background(), input)
+	_, err := rdsClient.StartDBInstance(ctx, input)
 	if err != nil {
-		return fmt.Errorf("RDSインスタンス起動エラー: %w", err)
+		return fmt.Errorf(i18n.T("RDSインスタンス起動エラー: %w"), err)
 	}
 
 	return nil
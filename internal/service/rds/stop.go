@@ -4,18 +4,20 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+
 	"github.com/aws/aws-sdk-go-v2/service/rds"
 )
 
 // StopRdsInstance RDSインスタンスを停止する
-func StopRdsInstance(rdsClient *rds.Client, instanceId string) error {
+func StopRdsInstance(ctx context.Context, rdsClient *rds.Client, instanceId string) error {
 	input := &rds.StopDBInstanceInput{
 		DBInstanceIdentifier: &instanceId,
 	}
 
-	_, err := rdsClient.StopDBInstance(context.Background(), input)
+	_, err := rdsClient.StopDBInstance(ctx, input)
 	if err != nil {
-		return fmt.Errorf("RDSインスタンス停止エラー: %w", err)
+		return fmt.Errorf(i18n.T("RDSインスタンス停止エラー: %w"), err)
 	}
 
 	return nil
@@ -4,12 +4,15 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 )
 
 // ListRegions はAWSリージョンの一覧を取得する関数 (公開)
-func ListRegions(ec2Client *ec2.Client, showAllRegions bool) ([]AwsRegion, error) {
-	regions, err := listRegions(ec2Client, showAllRegions)
+func ListRegions(ctx context.Context, ec2Client *ec2.Client, showAllRegions bool) ([]AwsRegion, error) {
+	regions, err := listRegions(ctx, ec2Client, showAllRegions)
 	if err != nil {
 		return nil, err
 	}
@@ -42,15 +45,30 @@ func GroupRegions(regions []AwsRegion) ([]AwsRegion, []AwsRegion) {
 	return available, disabled
 }
 
+// DisplayRegionRecords はリージョン一覧を構造化出力用のレコードとして出力する関数 (公開)
+func DisplayRegionRecords(regions []AwsRegion) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("リージョン"), Key: "regionName"},
+		{Header: i18n.T("オプトイン状態"), Key: "optInStatus"},
+	}
+
+	data := make([][]string, len(regions))
+	for i, region := range regions {
+		data[i] = []string{region.RegionName, region.OptInStatus}
+	}
+
+	common.PrintTable("", columns, data)
+}
+
 // listRegions retrieves all AWS regions (private)
-func listRegions(ec2Client *ec2.Client, showAllRegions bool) ([]awsRegion, error) {
+func listRegions(ctx context.Context, ec2Client *ec2.Client, showAllRegions bool) ([]awsRegion, error) {
 	input := &ec2.DescribeRegionsInput{
 		AllRegions: &showAllRegions,
 	}
 
-	result, err := ec2Client.DescribeRegions(context.Background(), input)
+	result, err := ec2Client.DescribeRegions(ctx, input)
 	if err != nil {
-		return nil, fmt.Errorf("リージョン一覧の取得に失敗: %w", err)
+		return nil, fmt.Errorf(i18n.T("リージョン一覧の取得に失敗: %w"), err)
 	}
 
 	var regions []awsRegion
@@ -0,0 +1,223 @@
+package report
+
+import (
+	"encoding/xml"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+)
+
+// statusOrder はMarkdownの表でリソースを並べる順序（削除できなかったものを先に表示する）
+var statusOrder = []string{StatusFailed, StatusBlocked, StatusProtected, StatusSkipped, StatusDeleted}
+
+// statusLabel はステータスの表示名を返す
+func statusLabel(status string) string {
+	switch status {
+	case StatusDeleted:
+		return i18n.T("✅ 削除成功")
+	case StatusFailed:
+		return i18n.T("❌ 削除失敗")
+	case StatusSkipped:
+		return i18n.T("⚠️ 中断により未実行")
+	case StatusBlocked:
+		return i18n.T("⛔ 参照元を削除できず未実行")
+	case StatusProtected:
+		return i18n.T("🛡️ ガードレールにより未実行")
+	}
+	return status
+}
+
+// multiAccount は複数のアカウントのリソースを含むレポートかを返す（アカウントごとに分けて表示するかの判定に使用）
+func multiAccount(r *Report) bool {
+	for _, res := range r.Resources {
+		if res.Account != r.Resources[0].Account {
+			return true
+		}
+	}
+	return false
+}
+
+// formatDuration はミリ秒を秒単位の文字列に変換する（例: 1.5s）
+func formatDuration(ms int64) string {
+	return fmt.Sprintf("%.1fs", float64(ms)/1000)
+}
+
+// detail はリソースのエラーメッセージ、または削除したオブジェクトの数とサイズを返す
+func detail(res Resource) string {
+	if res.Error != "" {
+		return res.Error
+	}
+	if res.ObjectsRemoved > 0 {
+		return fmt.Sprintf(i18n.T("オブジェクト %d件（%s）を削除"), res.ObjectsRemoved, common.FormatBytes(res.BytesRemoved))
+	}
+	return ""
+}
+
+// markdownCell は表のセルに入れられるよう、区切り文字と改行をエスケープする
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "\r\n", "<br>")
+	return strings.ReplaceAll(s, "\n", "<br>")
+}
+
+// markdown はレポートをMarkdown形式（プルリクエストのコメント等に貼り付ける表）に変換する
+func markdown(r *Report) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s\n\n", i18n.T("クリーンアップ レポート"))
+	fmt.Fprintf(&b, "- %s: `%s`\n", i18n.T("コマンド"), r.Command)
+	if r.Profile != "" {
+		fmt.Fprintf(&b, "- %s: %s\n", i18n.T("プロファイル"), r.Profile)
+	}
+	if r.Region != "" {
+		fmt.Fprintf(&b, "- %s: %s\n", i18n.T("リージョン"), r.Region)
+	}
+	fmt.Fprintf(&b, i18n.T("- %s: %s（%s）\n\n"), i18n.T("実行日時"), r.StartedAt.Format(time.RFC3339), formatDuration(r.DurationMs))
+	fmt.Fprintf(&b, i18n.T("**削除成功 %d件 / 削除失敗 %d件 / 未実行 %d件**\n"), r.Summary.Deleted, r.Summary.Failed, r.Summary.Skipped+r.Summary.Blocked+r.Summary.Protected)
+
+	if len(r.Resources) == 0 {
+		fmt.Fprintf(&b, "\n%s\n", i18n.T("削除対象のリソースはありませんでした"))
+		return b.String()
+	}
+
+	showAccount := multiAccount(r)
+	headers := []string{i18n.T("結果")}
+	if showAccount {
+		headers = append(headers, i18n.T("アカウント"))
+	}
+	headers = append(headers, i18n.T("種類"), i18n.T("名前"), "ARN", i18n.T("所要時間"), i18n.T("詳細"))
+	fmt.Fprintf(&b, "\n| %s |\n", strings.Join(headers, " | "))
+	fmt.Fprintf(&b, "|%s\n", strings.Repeat("---|", len(headers)))
+
+	resources := slices.Clone(r.Resources)
+	slices.SortStableFunc(resources, func(a, b Resource) int {
+		return slices.Index(statusOrder, a.Status) - slices.Index(statusOrder, b.Status)
+	})
+	for _, res := range resources {
+		cells := []string{statusLabel(res.Status)}
+		if showAccount {
+			cells = append(cells, res.Account)
+		}
+		cells = append(cells, res.TypeName, res.Name, res.Arn, formatDuration(res.DurationMs), detail(res))
+		for i, c := range cells {
+			cells[i] = markdownCell(c)
+		}
+		fmt.Fprintf(&b, "| %s |\n", strings.Join(cells, " | "))
+	}
+	return b.String()
+}
+
+// JUnit XML の要素（リソースの種類ごとにテストスイート、リソースごとにテストケースとして出力する）
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Name     string           `xml:"name,attr"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Time     string           `xml:"time,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	Classname string        `xml:"classname,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut string        `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+// junitSeconds はミリ秒をJUnit XMLの time 属性（秒）に変換する
+func junitSeconds(ms int64) string {
+	return fmt.Sprintf("%.3f", float64(ms)/1000)
+}
+
+// junit はレポートをJUnit XML形式に変換する
+// 削除に失敗したリソースは failure、実行しなかったリソースは skipped として出力する
+func junit(r *Report) ([]byte, error) {
+	suites := junitTestSuites{
+		Name:     r.Command,
+		Tests:    len(r.Resources),
+		Failures: r.Summary.Failed,
+		Skipped:  r.Summary.Skipped + r.Summary.Blocked + r.Summary.Protected,
+		Time:     junitSeconds(r.DurationMs),
+	}
+
+	// リソースの種類（複数アカウントの場合はアカウントと種類）ごとに、出現順にテストスイートにまとめる
+	showAccount := multiAccount(r)
+	index := make(map[string]int)
+	suiteMs := make(map[string]int64)
+	for _, res := range r.Resources {
+		name := res.TypeName
+		if showAccount {
+			name = res.Account + "/" + name
+		}
+		i, ok := index[name]
+		if !ok {
+			i = len(suites.Suites)
+			index[name] = i
+			suites.Suites = append(suites.Suites, junitTestSuite{Name: name})
+		}
+		suite := &suites.Suites[i]
+
+		classname := "awstk.cleanup"
+		if res.Type != "" {
+			classname += "." + res.Type
+		}
+		tc := junitTestCase{
+			Name:      res.Name,
+			Classname: classname,
+			Time:      junitSeconds(res.DurationMs),
+		}
+		var out []string
+		if res.Arn != "" {
+			out = append(out, "ARN: "+res.Arn)
+		}
+		switch res.Status {
+		case StatusFailed:
+			tc.Failure = &junitMessage{Message: res.Error, Text: res.Error}
+			suite.Failures++
+		case StatusSkipped, StatusBlocked, StatusProtected:
+			message := res.Error
+			if message == "" {
+				message = statusLabel(res.Status)
+			}
+			tc.Skipped = &junitMessage{Message: message}
+			suite.Skipped++
+		default:
+			if d := detail(res); d != "" {
+				out = append(out, d)
+			}
+		}
+		tc.SystemOut = strings.Join(out, ", ")
+		suite.Tests++
+		suiteMs[name] += res.DurationMs
+		suite.Cases = append(suite.Cases, tc)
+	}
+	for name, i := range index {
+		suites.Suites[i].Time = junitSeconds(suiteMs[name])
+	}
+
+	data, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), append(data, '\n')...), nil
+}
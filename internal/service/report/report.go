@@ -0,0 +1,137 @@
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+)
+
+// Formats は --report-format で指定できるレポートの形式
+var Formats = []string{FormatJSON, FormatMarkdown, FormatJUnit}
+
+// New は空のレポートを作成する（作成した時点を開始日時とする）
+func New(command, profile, region string) *Report {
+	return &Report{
+		Version:   FormatVersion,
+		Command:   command,
+		Profile:   profile,
+		Region:    region,
+		StartedAt: time.Now().UTC(),
+		Resources: []Resource{},
+	}
+}
+
+// Add はクリーンアップの結果をレポートに追加する
+// account は複数アカウントで実行する場合のアカウントID（単一アカウントの場合は空でもよい）
+func (r *Report) Add(account string, results []common.CleanupResult) {
+	for _, result := range results {
+		details := make(map[string]common.ProcessResult, len(result.Results))
+		for _, d := range result.Results {
+			details[d.Item] = d
+		}
+		for _, group := range []struct {
+			status string
+			names  []string
+		}{
+			{StatusDeleted, result.Deleted},
+			{StatusFailed, result.Failed},
+			{StatusSkipped, result.Skipped},
+			{StatusBlocked, result.Blocked},
+			{StatusProtected, result.Protected},
+		} {
+			for _, name := range group.names {
+				d := details[name]
+				resource := Resource{
+					Account:        account,
+					Type:           result.Type,
+					TypeName:       result.ResourceType,
+					Name:           name,
+					Arn:            d.Arn,
+					Action:         common.ActionDelete,
+					Status:         group.status,
+					DurationMs:     d.Duration.Milliseconds(),
+					ObjectsRemoved: d.Objects,
+					BytesRemoved:   d.Bytes,
+				}
+				if d.Error != nil {
+					resource.Error = d.Error.Error()
+				}
+				r.Resources = append(r.Resources, resource)
+			}
+		}
+	}
+}
+
+// finish は終了日時・所要時間・ステータスごとのリソース数を設定する
+func (r *Report) finish() {
+	r.FinishedAt = time.Now().UTC()
+	r.DurationMs = r.FinishedAt.Sub(r.StartedAt).Milliseconds()
+	r.Summary = Summary{}
+	for _, res := range r.Resources {
+		switch res.Status {
+		case StatusDeleted:
+			r.Summary.Deleted++
+		case StatusFailed:
+			r.Summary.Failed++
+		case StatusSkipped:
+			r.Summary.Skipped++
+		case StatusBlocked:
+			r.Summary.Blocked++
+		case StatusProtected:
+			r.Summary.Protected++
+		}
+	}
+}
+
+// FormatOf はレポートの形式を返す
+// format が空の場合はファイルの拡張子（.json・.md・.xml）から判別する
+func FormatOf(path, format string) (string, error) {
+	if format != "" {
+		if !slices.Contains(Formats, format) {
+			return "", fmt.Errorf(i18n.T("未対応のレポートの形式です: %s（%s のいずれかを指定してください）"), format, strings.Join(Formats, ", "))
+		}
+		return format, nil
+	}
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return FormatJSON, nil
+	case ".md", ".markdown":
+		return FormatMarkdown, nil
+	case ".xml":
+		return FormatJUnit, nil
+	}
+	return "", fmt.Errorf(i18n.T("拡張子からレポートの形式を判別できません: %s（--report-format で %s のいずれかを指定してください）"), path, strings.Join(Formats, ", "))
+}
+
+// Write はレポートを指定した形式でファイルに書き出す
+func Write(path, format string, r *Report) error {
+	r.finish()
+
+	var data []byte
+	var err error
+	switch format {
+	case FormatJSON:
+		data, err = json.MarshalIndent(r, "", "  ")
+		data = append(data, '\n')
+	case FormatMarkdown:
+		data = []byte(markdown(r))
+	case FormatJUnit:
+		data, err = junit(r)
+	default:
+		return fmt.Errorf(i18n.T("未対応のレポートの形式です: %s（%s のいずれかを指定してください）"), format, strings.Join(Formats, ", "))
+	}
+	if err != nil {
+		return fmt.Errorf(i18n.T("レポートのエンコードに失敗: %w"), err)
+	}
+	if err := os.WriteFile(path, data, 0o600); err != nil {
+		return fmt.Errorf(i18n.T("レポートファイルの書き込みに失敗: %w"), err)
+	}
+	return nil
+}
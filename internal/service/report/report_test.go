@@ -0,0 +1,127 @@
+package report
+
+import (
+	"encoding/xml"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/service/common"
+)
+
+// sampleReport はステータスがすべて異なるリソースを含むレポートを作成する
+func sampleReport() *Report {
+	r := New("cleanup all", "dev", "ap-northeast-1")
+	r.Add("", []common.CleanupResult{
+		{
+			ResourceType: "S3バケット",
+			Type:         "s3",
+			Deleted:      []string{"app-logs"},
+			Failed:       []string{"app-data"},
+			Results: []common.ProcessResult{
+				{Item: "app-logs", Success: true, Duration: 1500 * time.Millisecond, Objects: 3, Bytes: 2048, Arn: "arn:aws:s3:::app-logs"},
+				{Item: "app-data", Error: errors.New("AccessDenied: bucket | policy")},
+			},
+		},
+		{
+			ResourceType: "セキュリティグループ",
+			Type:         "security-group",
+			Blocked:      []string{"sg-0123"},
+			Protected:    []string{"prod-sg"},
+			Skipped:      []string{"sg-0456"},
+			Results: []common.ProcessResult{
+				common.SkippedResult("sg-0123", errors.New("blocked by app-lb")),
+				common.SkippedResult("prod-sg", errors.New("guardrail")),
+			},
+		},
+	})
+	return r
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.md")
+	if err := Write(path, FormatMarkdown, sampleReport()); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out := string(data)
+
+	// 削除できなかったリソースから順に表示する
+	var rows []string
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "| ") && !strings.Contains(line, "ARN") {
+			rows = append(rows, line)
+		}
+	}
+	wantOrder := []string{StatusFailed, StatusBlocked, StatusProtected, StatusSkipped, StatusDeleted}
+	if len(rows) != len(wantOrder) {
+		t.Fatalf("rows = %q, want %d rows", rows, len(wantOrder))
+	}
+	for i, status := range wantOrder {
+		if !strings.HasPrefix(rows[i], "| "+statusLabel(status)+" |") {
+			t.Errorf("row %d = %q, want status %s", i, rows[i], status)
+		}
+	}
+	// 区切り文字をエスケープし、所要時間とARNを表示する
+	if !strings.Contains(rows[0], `bucket \| policy`) {
+		t.Errorf("pipe in error is not escaped: %q", rows[0])
+	}
+	if !strings.Contains(rows[4], "arn:aws:s3:::app-logs") || !strings.Contains(rows[4], "1.5s") {
+		t.Errorf("deleted row = %q, want ARN and duration", rows[4])
+	}
+}
+
+func TestWriteJUnit(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "report.xml")
+	if err := Write(path, FormatJUnit, sampleReport()); err != nil {
+		t.Fatal(err)
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got junitTestSuites
+	if err := xml.Unmarshal(data, &got); err != nil {
+		t.Fatalf("invalid JUnit XML: %v\n%s", err, data)
+	}
+	if got.Tests != 5 || got.Failures != 1 || got.Skipped != 3 {
+		t.Errorf("testsuites tests/failures/skipped = %d/%d/%d, want 5/1/3", got.Tests, got.Failures, got.Skipped)
+	}
+	if len(got.Suites) != 2 {
+		t.Fatalf("suites = %d, want 2", len(got.Suites))
+	}
+
+	s3 := got.Suites[0]
+	if s3.Name != "S3バケット" || s3.Tests != 2 || s3.Failures != 1 || s3.Time != "1.500" {
+		t.Errorf("s3 suite = %+v", s3)
+	}
+	for _, tc := range s3.Cases {
+		if tc.Classname != "awstk.cleanup.s3" {
+			t.Errorf("classname = %s, want awstk.cleanup.s3", tc.Classname)
+		}
+		if tc.Name == "app-data" && (tc.Failure == nil || !strings.Contains(tc.Failure.Message, "AccessDenied")) {
+			t.Errorf("failed case = %+v, want failure with the error", tc)
+		}
+	}
+
+	sg := got.Suites[1]
+	if sg.Skipped != 3 || sg.Failures != 0 {
+		t.Errorf("security group suite skipped/failures = %d/%d, want 3/0", sg.Skipped, sg.Failures)
+	}
+	for _, tc := range sg.Cases {
+		if tc.Skipped == nil {
+			t.Errorf("case %s is not skipped", tc.Name)
+		}
+		// エラーがない場合はステータスの表示名を理由にする
+		if tc.Name == "sg-0456" && tc.Skipped != nil && tc.Skipped.Message != statusLabel(StatusSkipped) {
+			t.Errorf("skipped message = %q, want %q", tc.Skipped.Message, statusLabel(StatusSkipped))
+		}
+	}
+}
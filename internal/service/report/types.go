@@ -0,0 +1,59 @@
+package report
+
+import "time"
+
+// FormatVersion はレポートの形式バージョン（JSON形式のレポートに記録する）
+const FormatVersion = 1
+
+// レポートの形式
+const (
+	FormatJSON     = "json"
+	FormatMarkdown = "markdown" // プルリクエストのコメント等に貼り付ける表
+	FormatJUnit    = "junit"    // CIのテスト結果として表示するJUnit XML
+)
+
+// リソースの処理結果のステータス
+const (
+	StatusDeleted   = "deleted"
+	StatusFailed    = "failed"
+	StatusSkipped   = "skipped"   // 中断により実行しなかった
+	StatusBlocked   = "blocked"   // 先に削除する必要があるリソースを削除できなかったため、実行しなかった
+	StatusProtected = "protected" // ガードレールにより実行しなかった
+)
+
+// Report はクリーンアップの結果のレポート（--report で書き出す）
+type Report struct {
+	Version    int        `json:"version"`
+	Command    string     `json:"command"`
+	Profile    string     `json:"profile,omitempty"`
+	Region     string     `json:"region,omitempty"`
+	StartedAt  time.Time  `json:"startedAt"`
+	FinishedAt time.Time  `json:"finishedAt"`
+	DurationMs int64      `json:"durationMs"`
+	Summary    Summary    `json:"summary"`
+	Resources  []Resource `json:"resources"`
+}
+
+// Summary はステータスごとのリソース数
+type Summary struct {
+	Deleted   int `json:"deleted"`
+	Failed    int `json:"failed"`
+	Skipped   int `json:"skipped"`
+	Blocked   int `json:"blocked"`
+	Protected int `json:"protected"`
+}
+
+// Resource は1つのリソースの処理結果
+type Resource struct {
+	Account        string `json:"account,omitempty"`
+	Type           string `json:"type,omitempty"` // リソースの種類の名前（cleanup all の --types で指定する名前。例: "s3"）
+	TypeName       string `json:"typeName"`       // リソースの種類の表示名
+	Name           string `json:"name"`
+	Arn            string `json:"arn,omitempty"`
+	Action         string `json:"action"`
+	Status         string `json:"status"`
+	DurationMs     int64  `json:"durationMs"`
+	Error          string `json:"error,omitempty"`
+	ObjectsRemoved int64  `json:"objectsRemoved,omitempty"` // 削除したオブジェクト数（S3バケット等、中身を削除した場合のみ）
+	BytesRemoved   int64  `json:"bytesRemoved,omitempty"`   // 削除したオブジェクトの合計サイズ（バイト）
+}
@@ -1,19 +1,22 @@
 package route53
 
 import (
-	"bufio"
 	"context"
 	"fmt"
-	"os"
 	"strings"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/confirm"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/route53/types"
 )
 
 // DeleteHostedZone DeleteHostedZoneはRoute53のホストゾーンとすべてのレコードを削除します
-func DeleteHostedZone(client *route53.Client, identifier string, opts DeleteOptions) error {
-	ctx := context.Background()
+func DeleteHostedZone(ctx context.Context, client *route53.Client, identifier string, opts DeleteOptions) error {
 	var zoneId string
 	var zoneName string
 	var err error
@@ -22,9 +25,9 @@ func DeleteHostedZone(client *route53.Client, identifier string, opts DeleteOpti
 	if opts.UseId {
 		zoneId = identifier
 		// ゾーン詳細を取得して名前を取得
-		zone, err := getHostedZoneDetails(client, zoneId)
+		zone, err := getHostedZoneDetails(ctx, client, zoneId)
 		if err != nil {
-			return fmt.Errorf("ホストゾーン詳細の取得エラー: %w", err)
+			return fmt.Errorf(i18n.T("ホストゾーン詳細の取得エラー: %w"), err)
 		}
 		zoneName = *zone.Name
 	} else {
@@ -33,18 +36,18 @@ func DeleteHostedZone(client *route53.Client, identifier string, opts DeleteOpti
 		if !strings.HasSuffix(zoneName, ".") {
 			zoneName += "."
 		}
-		zoneId, err = getHostedZoneIdByName(client, zoneName)
+		zoneId, err = getHostedZoneIdByName(ctx, client, zoneName)
 		if err != nil {
 			return err
 		}
 	}
 
-	fmt.Printf("🔍 ホストゾーンが見つかりました: %s (ID: %s)\n", zoneName, zoneId)
+	common.Infof(ctx, i18n.T("🔍 ホストゾーンが見つかりました: %s (ID: %s)\n"), zoneName, zoneId)
 
 	// すべてのレコードを一覧取得
-	records, err := listAllRecords(client, zoneId)
+	records, err := listAllRecords(ctx, client, zoneId)
 	if err != nil {
-		return fmt.Errorf("レコード一覧の取得エラー: %w", err)
+		return fmt.Errorf(i18n.T("レコード一覧の取得エラー: %w"), err)
 	}
 
 	// NSとSOAレコードを除外
@@ -56,56 +59,66 @@ func DeleteHostedZone(client *route53.Client, identifier string, opts DeleteOpti
 	}
 
 	if opts.DryRun {
-		fmt.Println("\n[ドライラン] 以下を削除します:")
-		fmt.Printf("- ホストゾーン: %s (ID: %s)\n", zoneName, zoneId)
-		fmt.Printf("- %d個のリソースレコードセット:\n", len(recordsToDelete))
+		fmt.Println(i18n.T("\n[ドライラン] 以下を削除します:"))
+		fmt.Printf(i18n.T("- ホストゾーン: %s (ID: %s)\n"), zoneName, zoneId)
+		fmt.Printf(i18n.T("- %d個のリソースレコードセット:\n"), len(recordsToDelete))
 		for _, record := range recordsToDelete {
 			fmt.Printf("  - %s (%s)\n", record.Name, record.Type)
 		}
 		return nil
 	}
 
+	if err := guardrail.Check(ctx, i18n.T("ホストゾーン"), tagging.TypeHostedZone, zoneName, "hostedzone/"+strings.TrimPrefix(zoneId, "/hostedzone/")); err != nil {
+		return err
+	}
+
 	// 削除確認
 	if !opts.Force {
-		fmt.Printf("\n⚠️  以下を完全に削除します:\n")
-		fmt.Printf("- ホストゾーン: %s (ID: %s)\n", zoneName, zoneId)
-		fmt.Printf("- %d個のリソースレコードセット\n", len(recordsToDelete))
-
-		if !confirmPrompt("\n本当に続行しますか？") {
-			fmt.Println("削除がキャンセルされました。")
+		ok, err := confirm.Ask(confirm.Request{
+			Message: i18n.T("\n⚠️  以下を完全に削除します:"),
+			Targets: []string{
+				fmt.Sprintf(i18n.T("ホストゾーン: %s (ID: %s)"), zoneName, zoneId),
+				fmt.Sprintf(i18n.T("%d個のリソースレコードセット"), len(recordsToDelete)),
+			},
+			Prompt: i18n.T("\n本当に続行しますか？"),
+		})
+		if err != nil {
+			return err
+		}
+		if !ok {
+			common.Infof(ctx, "%s\n", i18n.T("削除がキャンセルされました。"))
 			return nil
 		}
 	}
 
 	// レコード削除
 	if len(recordsToDelete) > 0 {
-		fmt.Printf("\n🗑️  %d個のレコードを削除中...\n", len(recordsToDelete))
-		deletedCount, failedCount := deleteRecords(client, zoneId, recordsToDelete)
+		common.Infof(ctx, i18n.T("\n🗑️  %d個のレコードを削除中...\n"), len(recordsToDelete))
+		deletedCount, failedCount := deleteRecords(ctx, client, zoneId, recordsToDelete)
 
 		if failedCount > 0 {
-			fmt.Printf("⚠️  %d個のレコードの削除に失敗しました\n", failedCount)
+			common.Warnf(ctx, i18n.T("⚠️  %d個のレコードの削除に失敗しました\n"), failedCount)
 		}
 		if deletedCount > 0 {
-			fmt.Printf("✅ %d個のレコードを削除しました\n", deletedCount)
+			common.Infof(ctx, i18n.T("✅ %d個のレコードを削除しました\n"), deletedCount)
 		}
 	}
 
 	// ホストゾーン削除
-	fmt.Printf("\n🗑️  ホストゾーンを削除中...\n")
+	common.Infof(ctx, "%s", i18n.T("\n🗑️  ホストゾーンを削除中...\n"))
 	_, err = client.DeleteHostedZone(ctx, &route53.DeleteHostedZoneInput{
 		Id: &zoneId,
 	})
 	if err != nil {
-		return fmt.Errorf("ホストゾーンの削除エラー: %w", err)
+		return fmt.Errorf(i18n.T("ホストゾーンの削除エラー: %w"), err)
 	}
 
-	fmt.Printf("✅ ホストゾーンを正常に削除しました: %s (ID: %s)\n", zoneName, zoneId)
+	common.Infof(ctx, i18n.T("✅ ホストゾーンを正常に削除しました: %s (ID: %s)\n"), zoneName, zoneId)
 	return nil
 }
 
 // listAllRecordsはホストゾーン内のすべてのリソースレコードセットを一覧取得します
-func listAllRecords(client *route53.Client, zoneId string) ([]RecordSetInfo, error) {
-	ctx := context.Background()
+func listAllRecords(ctx context.Context, client *route53.Client, zoneId string) ([]RecordSetInfo, error) {
 	var records []RecordSetInfo
 	paginator := route53.NewListResourceRecordSetsPaginator(client, &route53.ListResourceRecordSetsInput{
 		HostedZoneId: &zoneId,
@@ -114,7 +127,7 @@ func listAllRecords(client *route53.Client, zoneId string) ([]RecordSetInfo, err
 	for paginator.HasMorePages() {
 		output, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("リソースレコードセット一覧の取得エラー: %w", err)
+			return nil, fmt.Errorf(i18n.T("リソースレコードセット一覧の取得エラー: %w"), err)
 		}
 
 		for _, recordSet := range output.ResourceRecordSets {
@@ -145,8 +158,7 @@ func listAllRecords(client *route53.Client, zoneId string) ([]RecordSetInfo, err
 }
 
 // deleteRecordsは複数のリソースレコードセットを削除します
-func deleteRecords(client *route53.Client, zoneId string, records []RecordSetInfo) (deleted, failed int) {
-	ctx := context.Background()
+func deleteRecords(ctx context.Context, client *route53.Client, zoneId string, records []RecordSetInfo) (deleted, failed int) {
 	// レコードをバッチ処理（Route53は1リクエストあたり最大1000変更までサポート）
 	batchSize := 100
 
@@ -197,26 +209,12 @@ func deleteRecords(client *route53.Client, zoneId string, records []RecordSetInf
 
 		if err != nil {
 			failed += len(batch)
-			fmt.Printf("  ❌ %d個のレコードのバッチ削除に失敗: %v\n", len(batch), err)
+			common.Warnf(ctx, i18n.T("  ❌ %d個のレコードのバッチ削除に失敗: %v\n"), len(batch), err)
 		} else {
 			deleted += len(batch)
-			fmt.Printf("  ✓ %d個のレコードをバッチ削除\n", len(batch))
+			common.Infof(ctx, i18n.T("  ✓ %d個のレコードをバッチ削除\n"), len(batch))
 		}
 	}
 
 	return deleted, failed
 }
-
-// confirmPromptはユーザーに確認を求めます
-func confirmPrompt(message string) bool {
-	reader := bufio.NewReader(os.Stdin)
-	fmt.Printf("%s [y/N]: ", message)
-
-	response, err := reader.ReadString('\n')
-	if err != nil {
-		return false
-	}
-
-	response = strings.ToLower(strings.TrimSpace(response))
-	return response == "y" || response == "yes"
-}
@@ -3,22 +3,58 @@ package route53
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"strings"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/service/route53"
 	"github.com/aws/aws-sdk-go-v2/service/route53/types"
 )
 
 // ListHostedZones ListHostedZonesはRoute53のホストゾーンを一覧表示します
-func ListHostedZones(client *route53.Client) error {
-	ctx := context.Background()
+// tags が指定された場合はタグ条件に一致するホストゾーンのみを表示します
+func ListHostedZones(ctx context.Context, client *route53.Client, tags *tagging.Selector) error {
+	zones, err := GetHostedZones(ctx, client)
+	if err != nil {
+		return err
+	}
+	zones, err = SelectHostedZonesByTags(ctx, tags, zones)
+	if err != nil {
+		return err
+	}
+	displayHostedZones(zones)
+	return nil
+}
+
+// FindHostedZonesByTags はタグ条件に一致するホストゾーンを取得します
+func FindHostedZonesByTags(ctx context.Context, client *route53.Client, tags *tagging.Selector) ([]HostedZoneInfo, error) {
+	zones, err := GetHostedZones(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+	return SelectHostedZonesByTags(ctx, tags, zones)
+}
+
+// SelectHostedZonesByTags はタグ条件に一致するホストゾーンに絞り込みます
+// Route 53 はグローバルサービスのため、タグは GlobalRegion で取得します
+func SelectHostedZonesByTags(ctx context.Context, tags *tagging.Selector, zones []HostedZoneInfo) ([]HostedZoneInfo, error) {
+	return tagging.Select(ctx, tags, common.Target{Region: tagging.GlobalRegion}, tagging.TypeHostedZone, zones, func(z HostedZoneInfo) string {
+		return "hostedzone/" + z.Id
+	})
+}
+
+// GetHostedZones GetHostedZonesはRoute53のホストゾーンの一覧を取得します
+func GetHostedZones(ctx context.Context, client *route53.Client) ([]HostedZoneInfo, error) {
 	var zones []HostedZoneInfo
 	paginator := route53.NewListHostedZonesPaginator(client, &route53.ListHostedZonesInput{})
 
 	for paginator.HasMorePages() {
 		output, err := paginator.NextPage(ctx)
 		if err != nil {
-			return fmt.Errorf("ホストゾーン一覧の取得エラー: %w", err)
+			return nil, fmt.Errorf(i18n.T("ホストゾーン一覧の取得エラー: %w"), err)
 		}
 
 		for _, zone := range output.HostedZones {
@@ -41,13 +77,25 @@ func ListHostedZones(client *route53.Client) error {
 		}
 	}
 
+	return zones, nil
+}
+
+// displayHostedZonesはホストゾーンの一覧を表示します
+func displayHostedZones(zones []HostedZoneInfo) {
+	// 構造化出力の場合はレコードとして出力
+	if common.IsStructuredOutput() {
+		columns, data := hostedZonesToRecordData(zones)
+		common.PrintTable("", columns, data)
+		return
+	}
+
 	if len(zones) == 0 {
-		fmt.Println("ホストゾーンが見つかりませんでした。")
-		return nil
+		fmt.Println(i18n.T("ホストゾーンが見つかりませんでした。"))
+		return
 	}
 
 	// Display zones
-	fmt.Printf("🔍 %d個のホストゾーンが見つかりました:\n\n", len(zones))
+	fmt.Printf(i18n.T("🔍 %d個のホストゾーンが見つかりました:\n\n"), len(zones))
 
 	// Calculate column widths
 	maxNameLen := 20
@@ -63,18 +111,18 @@ func ListHostedZones(client *route53.Client) error {
 
 	// ヘッダーを表示
 	fmt.Printf("%-*s  %-*s  %-10s  %-12s  %s\n",
-		maxNameLen, "ドメイン名",
-		maxIdLen, "ゾーンID",
-		"レコード数",
-		"タイプ",
-		"コメント")
+		maxNameLen, i18n.T("ドメイン名"),
+		maxIdLen, i18n.T("ゾーンID"),
+		i18n.T("レコード数"),
+		i18n.T("タイプ"),
+		i18n.T("コメント"))
 	fmt.Println(strings.Repeat("-", maxNameLen+maxIdLen+50))
 
 	// Print zones
 	for _, zone := range zones {
-		zoneType := "パブリック"
+		zoneType := i18n.T("パブリック")
 		if zone.IsPrivate {
-			zoneType = "プライベート"
+			zoneType = i18n.T("プライベート")
 		}
 
 		comment := zone.Comment
@@ -89,13 +137,37 @@ func ListHostedZones(client *route53.Client) error {
 			zoneType,
 			comment)
 	}
+}
 
-	return nil
+// hostedZonesToRecordDataはホストゾーン一覧を構造化出力用のテーブルデータに変換します
+func hostedZonesToRecordData(zones []HostedZoneInfo) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: i18n.T("ドメイン名"), Key: "name"},
+		{Header: i18n.T("ゾーンID"), Key: "id"},
+		{Header: i18n.T("レコード数"), Key: "recordCount"},
+		{Header: i18n.T("タイプ"), Key: "type"},
+		{Header: i18n.T("コメント"), Key: "comment"},
+	}
+
+	data := make([][]string, len(zones))
+	for i, zone := range zones {
+		zoneType := "public"
+		if zone.IsPrivate {
+			zoneType = "private"
+		}
+		data[i] = []string{
+			zone.Name,
+			zone.Id,
+			strconv.FormatInt(zone.RecordCount, 10),
+			zoneType,
+			zone.Comment,
+		}
+	}
+	return columns, data
 }
 
 // getHostedZoneIdByNameはドメイン名からホストゾーンIDを取得します
-func getHostedZoneIdByName(client *route53.Client, domainName string) (string, error) {
-	ctx := context.Background()
+func getHostedZoneIdByName(ctx context.Context, client *route53.Client, domainName string) (string, error) {
 	// Ensure domain name ends with a dot
 	if !strings.HasSuffix(domainName, ".") {
 		domainName += "."
@@ -106,7 +178,7 @@ func getHostedZoneIdByName(client *route53.Client, domainName string) (string, e
 	for paginator.HasMorePages() {
 		output, err := paginator.NextPage(ctx)
 		if err != nil {
-			return "", fmt.Errorf("ホストゾーン一覧の取得エラー: %w", err)
+			return "", fmt.Errorf(i18n.T("ホストゾーン一覧の取得エラー: %w"), err)
 		}
 
 		for _, zone := range output.HostedZones {
@@ -116,7 +188,7 @@ func getHostedZoneIdByName(client *route53.Client, domainName string) (string, e
 		}
 	}
 
-	return "", fmt.Errorf("ドメイン %s のホストゾーンが見つかりませんでした", domainName)
+	return "", fmt.Errorf(i18n.T("ドメイン %s のホストゾーンが見つかりませんでした"), domainName)
 }
 
 // extractZoneIdは完全なリソースIDからゾーンIDを抽出します
@@ -130,13 +202,12 @@ func extractZoneId(fullId string) string {
 }
 
 // getHostedZoneDetailsは特定のホストゾーンの詳細情報を取得します
-func getHostedZoneDetails(client *route53.Client, zoneId string) (*types.HostedZone, error) {
-	ctx := context.Background()
+func getHostedZoneDetails(ctx context.Context, client *route53.Client, zoneId string) (*types.HostedZone, error) {
 	output, err := client.GetHostedZone(ctx, &route53.GetHostedZoneInput{
 		Id: &zoneId,
 	})
 	if err != nil {
-		return nil, fmt.Errorf("ホストゾーン詳細の取得エラー: %w", err)
+		return nil, fmt.Errorf(i18n.T("ホストゾーン詳細の取得エラー: %w"), err)
 	}
 	return output.HostedZone, nil
 }
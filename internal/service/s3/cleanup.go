@@ -1,90 +1,128 @@
 package s3
 
 import (
-	"awstk/internal/service/common"
 	"context"
 	"fmt"
 	"sync"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 )
 
+// オブジェクトバージョン一覧の再開位置の記録に使用するキー
+const (
+	versionMarkerKey   = "keyMarker"
+	versionIdMarkerKey = "versionIdMarker"
+)
+
 // GetS3BucketsByFilter はフィルターに一致するS3バケット名の一覧を取得します
 // exact が true の場合、大文字小文字を区別します
-func GetS3BucketsByFilter(s3Client *s3.Client, searchString string, exact bool) ([]string, error) {
+// tags が指定された場合は、タグ条件にも一致するバケットのみを返します
+// age が指定された場合は、作成日時の経過時間にも一致するバケットのみを返します（S3バケットは最終アクティビティ日時を取得できないため、常に作成日時で判定します）
+func GetS3BucketsByFilter(ctx context.Context, s3Client S3Api, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
 	// バケット一覧を取得
-	listBucketsOutput, err := s3Client.ListBuckets(context.Background(), &s3.ListBucketsInput{})
+	listBucketsOutput, err := s3Client.ListBuckets(ctx, &s3.ListBucketsInput{})
 	if err != nil {
-		return nil, fmt.Errorf("s3バケット一覧取得エラー: %w", err)
+		return nil, fmt.Errorf(i18n.T("s3バケット一覧取得エラー: %w"), err)
 	}
 
-	foundBuckets := []string{}
+	var matched []types.Bucket
 	for _, bucket := range listBucketsOutput.Buckets {
 		if common.MatchesFilter(*bucket.Name, searchString, exact) {
-			foundBuckets = append(foundBuckets, *bucket.Name)
-			fmt.Printf("🔍 検出されたS3バケット: %s\n", *bucket.Name)
+			matched = append(matched, bucket)
 		}
 	}
+	matched = common.SelectByAge(age, matched, func(b types.Bucket) (*time.Time, *time.Time) {
+		return b.CreationDate, nil
+	})
+	matched, err = selectBucketsByTags(ctx, tags, matched)
+	if err != nil {
+		return nil, err
+	}
+
+	foundBuckets := []string{}
+	for _, bucket := range matched {
+		foundBuckets = append(foundBuckets, *bucket.Name)
+		common.Progressf(ctx, common.EventInfo, *bucket.Name, i18n.T("🔍 検出されたS3バケット: %s\n"), age.Annotate(*bucket.Name, bucket.CreationDate, nil))
+	}
 
 	return foundBuckets, nil
 }
 
 // CleanupS3Buckets は指定したS3バケット一覧を削除します
-func CleanupS3Buckets(s3Client *s3.Client, bucketNames []string) common.CleanupResult {
+func CleanupS3Buckets(ctx context.Context, s3Client S3Api, bucketNames []string) common.CleanupResult {
 	result := common.CleanupResult{
-		ResourceType: "S3バケット",
+		ResourceType: i18n.T("S3バケット"),
 		Deleted:      []string{},
 		Failed:       []string{},
 	}
 
+	bucketNames = guardrail.ExcludeNames(ctx, i18n.T("S3バケット"), tagging.TypeS3Bucket, bucketNames, func(name string) string { return name })
 	if len(bucketNames) == 0 {
 		return result
 	}
 
-	// 並列実行数を設定（最大10並列）
-	maxWorkers := 10
-	if len(bucketNames) < maxWorkers {
-		maxWorkers = len(bucketNames)
-	}
+	// 並列実行数を設定（既定は最大10並列、--concurrency または設定ファイルの concurrency で変更可）
+	maxWorkers := common.WorkerCount(10, len(bucketNames))
 
 	executor := common.NewParallelExecutor(maxWorkers)
 	results := make([]common.ProcessResult, len(bucketNames))
 	resultsMutex := &sync.Mutex{}
 
-	fmt.Printf("🚀 %d個のバケットを最大%d並列で削除します...\n\n", len(bucketNames), maxWorkers)
+	common.Infof(ctx, i18n.T("🚀 %d個のバケットを最大%d並列で削除します...\n\n"), len(bucketNames), maxWorkers)
 
 	for i, bucket := range bucketNames {
 		idx := i
 		bucketName := bucket
 		executor.Execute(func() {
-			fmt.Printf("バケット %s を空にして削除中...\n", bucketName)
+			// 中断された場合は未実行として記録
+			if ctx.Err() != nil {
+				resultsMutex.Lock()
+				results[idx] = common.SkippedResult(bucketName, ctx.Err())
+				resultsMutex.Unlock()
+				return
+			}
+
+			common.Progressf(ctx, common.EventStart, bucketName, i18n.T("バケット %s を空にして削除中...\n"), bucketName)
 
 			// バケットを空にする (バージョン管理対応)
-			err := emptyS3Bucket(s3Client, bucketName)
+			start := time.Now()
+			var removed removedObjects
+			err := common.Retry(ctx, bucketName, func() error {
+				return emptyS3Bucket(ctx, s3Client, bucketName, &removed)
+			})
 			if err != nil {
-				fmt.Printf("❌ バケット %s を空にするのに失敗しました: %v\n", bucketName, err)
+				common.Failuref(ctx, bucketName, err, i18n.T("❌ バケット %s を空にするのに失敗しました: %v\n"), bucketName, err)
 				resultsMutex.Lock()
-				results[idx] = common.ProcessResult{Item: bucketName, Success: false, Error: err}
+				results[idx] = removed.result(bucketName, err, start)
 				resultsMutex.Unlock()
 				return
 			}
 
 			// バケットの削除
-			fmt.Printf("  バケット削除中: %s\n", bucketName)
-			_, err = s3Client.DeleteBucket(context.Background(), &s3.DeleteBucketInput{
-				Bucket: aws.String(bucketName),
+			common.Progressf(ctx, common.EventInfo, bucketName, i18n.T("  バケット削除中: %s\n"), bucketName)
+			err = common.RetryDelete(ctx, bucketName, func() error {
+				_, err := s3Client.DeleteBucket(ctx, &s3.DeleteBucketInput{
+					Bucket: aws.String(bucketName),
+				})
+				return err
 			})
 
 			resultsMutex.Lock()
 			if err != nil {
-				fmt.Printf("❌ バケット %s の削除に失敗しました: %v\n", bucketName, err)
-				results[idx] = common.ProcessResult{Item: bucketName, Success: false, Error: err}
+				common.Failuref(ctx, bucketName, err, i18n.T("❌ バケット %s の削除に失敗しました: %v\n"), bucketName, err)
 			} else {
-				fmt.Printf("✅ バケット %s を削除しました\n", bucketName)
-				results[idx] = common.ProcessResult{Item: bucketName, Success: true}
+				common.Progressf(ctx, common.EventSuccess, bucketName, i18n.T("✅ バケット %s を削除しました\n"), bucketName)
+				common.MarkDone(ctx, bucketName)
 			}
+			results[idx] = removed.result(bucketName, err, start)
 			resultsMutex.Unlock()
 		})
 	}
@@ -92,17 +130,67 @@ func CleanupS3Buckets(s3Client *s3.Client, bucketNames []string) common.CleanupR
 	executor.Wait()
 
 	// 結果の集計
-	successCount, failCount := common.CollectResults(results)
-	fmt.Printf("\n✅ 削除完了: 成功 %d個, 失敗 %d個\n", successCount, failCount)
+	common.PrintProcessSummary(ctx, i18n.T("削除"), results)
+
+	return common.CollectCleanupResult(i18n.T("S3バケット"), results)
+}
+
+// GetBucketNotificationDestinations は指定したバケットごとに、イベント通知の送信先（SNSトピック・SQSキュー・Lambda関数）のARNを取得します
+// 送信先を先に削除すると、バケットの通知設定が存在しない送信先を参照したままになります
+func GetBucketNotificationDestinations(ctx context.Context, s3Client S3Api, bucketNames []string) (map[string][]string, error) {
+	destinations := make(map[string][]string)
+	for _, bucketName := range bucketNames {
+		output, err := s3Client.GetBucketNotificationConfiguration(ctx, &s3.GetBucketNotificationConfigurationInput{
+			Bucket: aws.String(bucketName),
+		})
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("バケット %s の通知設定取得エラー: %w"), bucketName, err)
+		}
+		for _, c := range output.TopicConfigurations {
+			destinations[bucketName] = append(destinations[bucketName], aws.ToString(c.TopicArn))
+		}
+		for _, c := range output.QueueConfigurations {
+			destinations[bucketName] = append(destinations[bucketName], aws.ToString(c.QueueArn))
+		}
+		for _, c := range output.LambdaFunctionConfigurations {
+			destinations[bucketName] = append(destinations[bucketName], aws.ToString(c.LambdaFunctionArn))
+		}
+	}
+	return destinations, nil
+}
 
-	return common.CollectCleanupResult("S3バケット", results)
+// removedObjects はバケットを空にする際に削除したオブジェクト（バージョン・削除マーカーを含む）の数と合計サイズ
+type removedObjects struct {
+	objects int64
+	bytes   int64
+}
+
+// result は削除したオブジェクトの数・合計サイズを含むバケットの処理結果を返す
+func (r removedObjects) result(bucketName string, err error, start time.Time) common.ProcessResult {
+	return common.ProcessResult{
+		Item:     bucketName,
+		Success:  err == nil,
+		Error:    err,
+		Duration: time.Since(start),
+		Objects:  r.objects,
+		Bytes:    r.bytes,
+	}
 }
 
 // emptyS3Bucket は指定したS3バケットの中身をすべて削除します (バージョン管理対応)
-func emptyS3Bucket(s3Client *s3.Client, bucketName string) error {
+// 削除済みのページの次の位置を再開位置として記録し、中断後の再実行では前回の続きから一覧を取得します
+// 削除したオブジェクトの数と合計サイズを removed に加算します（再試行時も前回までの分に加算します）
+func emptyS3Bucket(ctx context.Context, s3Client S3Api, bucketName string, removed *removedObjects) error {
 	// ページネーション対応のループ
 	var keyMarker *string
 	var versionIdMarker *string
+	if marker := common.ResumeMarker(ctx, bucketName); marker[versionMarkerKey] != "" {
+		keyMarker = aws.String(marker[versionMarkerKey])
+		if marker[versionIdMarkerKey] != "" {
+			versionIdMarker = aws.String(marker[versionIdMarkerKey])
+		}
+		common.Infof(ctx, i18n.T("  前回の続き（キー %s）からオブジェクトを削除します\n"), marker[versionMarkerKey])
+	}
 
 	for {
 		// バケット内のオブジェクトとバージョンをリスト
@@ -114,19 +202,21 @@ func emptyS3Bucket(s3Client *s3.Client, bucketName string) error {
 			listVersionsInput.VersionIdMarker = versionIdMarker
 		}
 
-		listVersionsOutput, err := s3Client.ListObjectVersions(context.Background(), listVersionsInput)
+		listVersionsOutput, err := s3Client.ListObjectVersions(ctx, listVersionsInput)
 		if err != nil {
-			return fmt.Errorf("バケット内のオブジェクトバージョン一覧取得エラー: %w", err)
+			return fmt.Errorf(i18n.T("バケット内のオブジェクトバージョン一覧取得エラー: %w"), err)
 		}
 
 		// 削除対象のオブジェクトと削除マーカーのリストを作成
 		deleteObjects := []types.ObjectIdentifier{}
+		sizes := make(map[string]int64) // 削除したサイズの集計用（キーとバージョンIDごと）
 		if listVersionsOutput.Versions != nil {
 			for _, version := range listVersionsOutput.Versions {
 				deleteObjects = append(deleteObjects, types.ObjectIdentifier{
 					Key:       version.Key,
 					VersionId: version.VersionId,
 				})
+				sizes[objectVersionKey(version.Key, version.VersionId)] = aws.ToInt64(version.Size)
 			}
 		}
 		if listVersionsOutput.DeleteMarkers != nil {
@@ -149,8 +239,8 @@ func emptyS3Bucket(s3Client *s3.Client, bucketName string) error {
 				}
 				batch := deleteObjects[i:end]
 
-				fmt.Printf("  %d件のオブジェクトを削除中...\n", len(batch))
-				deleteOutput, err := s3Client.DeleteObjects(context.Background(), &s3.DeleteObjectsInput{
+				common.Progressf(ctx, common.EventInfo, bucketName, i18n.T("  %d件のオブジェクトを削除中...\n"), len(batch))
+				deleteOutput, err := s3Client.DeleteObjects(ctx, &s3.DeleteObjectsInput{
 					Bucket: aws.String(bucketName),
 					Delete: &types.Delete{
 						Objects: batch,
@@ -158,13 +248,25 @@ func emptyS3Bucket(s3Client *s3.Client, bucketName string) error {
 					},
 				})
 				if err != nil {
-					return fmt.Errorf("オブジェクトの一括削除エラー: %w", err)
+					return fmt.Errorf(i18n.T("オブジェクトの一括削除エラー: %w"), err)
+				}
+
+				// 削除に失敗したものを除いて、削除したオブジェクトの数とサイズを集計
+				failed := make(map[string]bool, len(deleteOutput.Errors))
+				for _, deleteErr := range deleteOutput.Errors {
+					failed[objectVersionKey(deleteErr.Key, deleteErr.VersionId)] = true
+				}
+				for _, obj := range batch {
+					if key := objectVersionKey(obj.Key, obj.VersionId); !failed[key] {
+						removed.objects++
+						removed.bytes += sizes[key]
+					}
 				}
 
 				// 削除エラーがあった場合は警告を表示
 				if len(deleteOutput.Errors) > 0 {
 					for _, deleteErr := range deleteOutput.Errors {
-						fmt.Printf("  ⚠️  オブジェクト削除エラー: %s (バージョンID: %s) - %s\n",
+						common.Progressf(ctx, common.EventWarning, bucketName, i18n.T("  ⚠️  オブジェクト削除エラー: %s (バージョンID: %s) - %s\n"),
 							*deleteErr.Key,
 							aws.ToString(deleteErr.VersionId),
 							aws.ToString(deleteErr.Message))
@@ -178,11 +280,22 @@ func emptyS3Bucket(s3Client *s3.Client, bucketName string) error {
 			break
 		}
 
-		// 次のページのマーカーを設定
+		// 次のページのマーカーを設定し、中断した場合の再開位置として記録する
 		keyMarker = listVersionsOutput.NextKeyMarker
 		versionIdMarker = listVersionsOutput.NextVersionIdMarker
+		common.SaveMarker(ctx, bucketName, map[string]string{
+			versionMarkerKey:   aws.ToString(keyMarker),
+			versionIdMarkerKey: aws.ToString(versionIdMarker),
+		})
 	}
 
-	fmt.Println("  バケットを空にしました。")
+	// 削除に失敗したオブジェクトが残っている場合に備え、次回は先頭から一覧を取得する
+	common.SaveMarker(ctx, bucketName, nil)
+	common.Infof(ctx, "%s\n", i18n.T("  バケットを空にしました。"))
 	return nil
 }
+
+// objectVersionKey はオブジェクトのキーとバージョンIDを組み合わせた集計用のキーを返す
+func objectVersionKey(key, versionId *string) string {
+	return aws.ToString(key) + "\x00" + aws.ToString(versionId)
+}
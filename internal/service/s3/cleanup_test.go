@@ -0,0 +1,118 @@
+package s3_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/ngsw877/awstk/internal/awsfake"
+	s3svc "github.com/ngsw877/awstk/internal/service/s3"
+
+	"github.com/aws/smithy-go"
+)
+
+func TestCleanupS3Buckets(t *testing.T) {
+	accessDenied := &smithy.GenericAPIError{Code: "AccessDenied", Message: "access denied", Fault: smithy.FaultClient}
+
+	tests := []struct {
+		name        string
+		pageSize    int
+		setup       func(f *awsfake.S3)
+		buckets     []string
+		wantDeleted []string
+		wantFailed  []string
+		wantObjects map[string]int64 // バケットごとの削除したオブジェクト数（バージョン・削除マーカーを含む）
+	}{
+		{
+			name:        "空のバケットを削除",
+			setup:       func(f *awsfake.S3) { f.AddBucket("empty-bucket") },
+			buckets:     []string{"empty-bucket"},
+			wantDeleted: []string{"empty-bucket"},
+			wantFailed:  []string{},
+		},
+		{
+			name:     "バージョンと削除マーカーをページごとに削除してから削除",
+			pageSize: 2,
+			setup: func(f *awsfake.S3) {
+				f.AddBucket("versioned-bucket")
+				f.PutObject("versioned-bucket", "a.txt")
+				f.PutObject("versioned-bucket", "a.txt")
+				f.PutDeleteMarker("versioned-bucket", "a.txt")
+				f.PutObject("versioned-bucket", "b.txt")
+				f.PutObject("versioned-bucket", "c.txt")
+			},
+			buckets:     []string{"versioned-bucket"},
+			wantDeleted: []string{"versioned-bucket"},
+			wantFailed:  []string{},
+			wantObjects: map[string]int64{"versioned-bucket": 5},
+		},
+		{
+			name: "削除に失敗したバケットがあっても残りのバケットは削除",
+			setup: func(f *awsfake.S3) {
+				f.AddBucket("ok-bucket")
+				f.AddBucket("locked-bucket")
+				f.PutObject("locked-bucket", "data.bin")
+				f.FailOn("DeleteBucket", "locked-bucket", accessDenied)
+			},
+			buckets:     []string{"ok-bucket", "locked-bucket"},
+			wantDeleted: []string{"ok-bucket"},
+			wantFailed:  []string{"locked-bucket"},
+			wantObjects: map[string]int64{"locked-bucket": 1},
+		},
+		{
+			name:        "存在しないバケットは失敗",
+			setup:       func(f *awsfake.S3) {},
+			buckets:     []string{"missing-bucket"},
+			wantDeleted: []string{},
+			wantFailed:  []string{"missing-bucket"},
+		},
+		{
+			name:        "対象がない場合は何もしない",
+			setup:       func(f *awsfake.S3) {},
+			buckets:     nil,
+			wantDeleted: []string{},
+			wantFailed:  []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake := awsfake.NewS3()
+			fake.PageSize = tt.pageSize
+			tt.setup(fake)
+
+			result := s3svc.CleanupS3Buckets(context.Background(), fake, tt.buckets)
+
+			if !sameItems(result.Deleted, tt.wantDeleted) {
+				t.Errorf("Deleted = %v, want %v", result.Deleted, tt.wantDeleted)
+			}
+			if !sameItems(result.Failed, tt.wantFailed) {
+				t.Errorf("Failed = %v, want %v", result.Failed, tt.wantFailed)
+			}
+			for _, name := range tt.wantDeleted {
+				if fake.HasBucket(name) {
+					t.Errorf("bucket %s still exists", name)
+				}
+			}
+			for _, name := range tt.wantFailed {
+				if name != "missing-bucket" && !fake.HasBucket(name) {
+					t.Errorf("bucket %s was deleted despite the failure", name)
+				}
+			}
+			for _, r := range result.Results {
+				if want := tt.wantObjects[r.Item]; r.Objects != want {
+					t.Errorf("Objects of %s = %d, want %d", r.Item, r.Objects, want)
+				}
+			}
+		})
+	}
+}
+
+// sameItems は順序を無視して2つの一覧が同じ要素を持つかを返す（並列に削除するため結果の順序は不定）
+func sameItems(got, want []string) bool {
+	got = slices.Clone(got)
+	want = slices.Clone(want)
+	slices.Sort(got)
+	slices.Sort(want)
+	return slices.Equal(got, want)
+}
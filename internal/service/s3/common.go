@@ -1,8 +1,10 @@
 package s3
 
 import (
-	"fmt"
+	"errors"
 	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
 )
 
 // parseS3Url はユーザー入力の S3 パスをバケット名とプレフィックスに分解します。
@@ -19,7 +21,7 @@ func parseS3Url(s3path string) (bucket, prefix string, err error) {
 	parts := strings.SplitN(s3path, "/", 2)
 	bucket = parts[0]
 	if bucket == "" {
-		return "", "", fmt.Errorf("バケット名が空です")
+		return "", "", errors.New(i18n.T("バケット名が空です"))
 	}
 
 	if len(parts) > 1 {
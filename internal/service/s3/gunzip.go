@@ -3,18 +3,21 @@ package s3
 import (
 	"compress/gzip"
 	"context"
+	"errors"
 	"fmt"
 	"io"
 	"os"
 	"path/filepath"
 	"strings"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 )
 
 // DownloadAndExtractGzFiles 指定S3パス配下の.gzファイルを一括ダウンロード＆解凍
-func DownloadAndExtractGzFiles(s3Client *s3.Client, s3url, outDir string) error {
-	ctx := context.Background()
+func DownloadAndExtractGzFiles(ctx context.Context, s3Client *s3.Client, s3url, outDir string) error {
 	bucket, prefix, err := parseS3Url(s3url)
 	if err != nil {
 		return err
@@ -27,15 +30,15 @@ func DownloadAndExtractGzFiles(s3Client *s3.Client, s3url, outDir string) error
 	}
 	resp, err := s3Client.ListObjectsV2(ctx, listInput)
 	if err != nil {
-		return fmt.Errorf("s3リスト取得失敗: %w", err)
+		return fmt.Errorf(i18n.T("s3リスト取得失敗: %w"), err)
 	}
 	if len(resp.Contents) == 0 {
-		return fmt.Errorf("指定されたパス配下に .gz ファイルが見つかりませんでした")
+		return errors.New(i18n.T("指定されたパス配下に .gz ファイルが見つかりませんでした"))
 	}
 
 	// 出力ディレクトリを作成
 	if err := os.MkdirAll(outDir, 0755); err != nil {
-		return fmt.Errorf("出力ディレクトリの作成に失敗: %w", err)
+		return fmt.Errorf(i18n.T("出力ディレクトリの作成に失敗: %w"), err)
 	}
 
 	gzCount := 0
@@ -46,7 +49,7 @@ func DownloadAndExtractGzFiles(s3Client *s3.Client, s3url, outDir string) error
 		}
 		gzCount++
 
-		fmt.Printf("📦 %s をダウンロード中...\n", key)
+		common.Infof(ctx, i18n.T("📦 %s をダウンロード中...\n"), key)
 		// ダウンロード
 		getObjectInput := &s3.GetObjectInput{
 			Bucket: &bucket,
@@ -54,7 +57,7 @@ func DownloadAndExtractGzFiles(s3Client *s3.Client, s3url, outDir string) error
 		}
 		getResp, err := s3Client.GetObject(ctx, getObjectInput)
 		if err != nil {
-			fmt.Printf("❌ %s のダウンロードに失敗: %v\n", key, err)
+			common.Warnf(ctx, i18n.T("❌ %s のダウンロードに失敗: %v\n"), key, err)
 			continue
 		}
 
@@ -65,9 +68,9 @@ func DownloadAndExtractGzFiles(s3Client *s3.Client, s3url, outDir string) error
 		// gzip解凍
 		gzr, err := gzip.NewReader(getResp.Body)
 		if err != nil {
-			fmt.Printf("❌ %s のgzip解凍に失敗: %v\n", key, err)
+			common.Warnf(ctx, i18n.T("❌ %s のgzip解凍に失敗: %v\n"), key, err)
 			if closeErr := getResp.Body.Close(); closeErr != nil {
-				fmt.Printf("⚠️  S3レスポンスボディのクローズに失敗: %v\n", closeErr)
+				common.Warnf(ctx, i18n.T("⚠️  S3レスポンスボディのクローズに失敗: %v\n"), closeErr)
 			}
 			continue
 		}
@@ -75,12 +78,12 @@ func DownloadAndExtractGzFiles(s3Client *s3.Client, s3url, outDir string) error
 		// ファイル作成
 		outFile, err := os.Create(outPath)
 		if err != nil {
-			fmt.Printf("❌ %s のファイル作成に失敗: %v\n", outPath, err)
+			common.Warnf(ctx, i18n.T("❌ %s のファイル作成に失敗: %v\n"), outPath, err)
 			if closeErr := gzr.Close(); closeErr != nil {
-				fmt.Printf("⚠️  %s のgzipリーダーのクローズに失敗: %v\n", key, closeErr)
+				common.Warnf(ctx, i18n.T("⚠️  %s のgzipリーダーのクローズに失敗: %v\n"), key, closeErr)
 			}
 			if closeErr := getResp.Body.Close(); closeErr != nil {
-				fmt.Printf("⚠️  S3レスポンスボディのクローズに失敗: %v\n", closeErr)
+				common.Warnf(ctx, i18n.T("⚠️  S3レスポンスボディのクローズに失敗: %v\n"), closeErr)
 			}
 			continue
 		}
@@ -88,27 +91,27 @@ func DownloadAndExtractGzFiles(s3Client *s3.Client, s3url, outDir string) error
 		// 解凍データをファイルに書き込み
 		_, err = io.Copy(outFile, gzr)
 		if closeErr := gzr.Close(); closeErr != nil {
-			fmt.Printf("⚠️  %s のgzipリーダーのクローズに失敗: %v\n", key, closeErr)
+			common.Warnf(ctx, i18n.T("⚠️  %s のgzipリーダーのクローズに失敗: %v\n"), key, closeErr)
 		}
 		if closeErr := outFile.Close(); closeErr != nil {
-			fmt.Printf("⚠️  %s のファイルクローズに失敗: %v\n", outPath, closeErr)
+			common.Warnf(ctx, i18n.T("⚠️  %s のファイルクローズに失敗: %v\n"), outPath, closeErr)
 		}
 		if err != nil {
-			fmt.Printf("❌ %s の書き込みに失敗: %v\n", outPath, err)
+			common.Warnf(ctx, i18n.T("❌ %s の書き込みに失敗: %v\n"), outPath, err)
 			if closeErr := getResp.Body.Close(); closeErr != nil {
-				fmt.Printf("⚠️  S3レスポンスボディのクローズに失敗: %v\n", closeErr)
+				common.Warnf(ctx, i18n.T("⚠️  S3レスポンスボディのクローズに失敗: %v\n"), closeErr)
 			}
 			continue
 		}
 		if closeErr := getResp.Body.Close(); closeErr != nil {
-			fmt.Printf("⚠️  S3レスポンスボディのクローズに失敗: %v\n", closeErr)
+			common.Warnf(ctx, i18n.T("⚠️  S3レスポンスボディのクローズに失敗: %v\n"), closeErr)
 		}
-		fmt.Printf("✅ %s → %s\n", key, outPath)
+		common.Infof(ctx, "✅ %s → %s\n", key, outPath)
 	}
 
 	if gzCount == 0 {
-		return fmt.Errorf("指定されたパス配下に .gz ファイルが見つかりませんでした")
+		return errors.New(i18n.T("指定されたパス配下に .gz ファイルが見つかりませんでした"))
 	}
-	fmt.Printf("🎉 %d個の .gz ファイルの処理が完了しました\n", gzCount)
+	common.Infof(ctx, i18n.T("🎉 %d個の .gz ファイルの処理が完了しました\n"), gzCount)
 	return nil
 }
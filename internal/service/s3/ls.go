@@ -5,31 +5,81 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
 )
 
 // ListS3Buckets はS3バケット名の一覧を返す関数
-func ListS3Buckets(s3Client *s3.Client) ([]string, error) {
-	result, err := s3Client.ListBuckets(context.Background(), &s3.ListBucketsInput{})
+// tags が指定された場合はタグ条件に一致するバケットのみを返す
+func ListS3Buckets(ctx context.Context, s3Client *s3.Client, tags *tagging.Selector) ([]string, error) {
+	result, err := s3Client.ListBuckets(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		return nil, err
+	}
+
+	selected, err := selectBucketsByTags(ctx, tags, result.Buckets)
 	if err != nil {
 		return nil, err
 	}
 
-	buckets := make([]string, 0, len(result.Buckets))
-	for _, bucket := range result.Buckets {
+	buckets := make([]string, 0, len(selected))
+	for _, bucket := range selected {
 		buckets = append(buckets, *bucket.Name)
 	}
 	return buckets, nil
 }
 
+// selectBucketsByTags はタグ条件に一致するバケットに絞り込む
+// Resource Groups Tagging API はリージョン単位のため、バケットが存在するリージョンごとにタグを取得する
+func selectBucketsByTags(ctx context.Context, tags *tagging.Selector, buckets []types.Bucket) ([]types.Bucket, error) {
+	if !tags.Enabled() {
+		return buckets, nil
+	}
+
+	var regions []string
+	byRegion := make(map[string][]types.Bucket)
+	for _, bucket := range buckets {
+		region := aws.ToString(bucket.BucketRegion)
+		if _, ok := byRegion[region]; !ok {
+			regions = append(regions, region)
+		}
+		byRegion[region] = append(byRegion[region], bucket)
+	}
+
+	matched := make(map[string]bool)
+	for _, region := range regions {
+		selected, err := tagging.Select(ctx, tags, common.Target{Region: region}, tagging.TypeS3Bucket, byRegion[region], func(b types.Bucket) string {
+			return aws.ToString(b.Name)
+		})
+		if err != nil {
+			return nil, err
+		}
+		for _, bucket := range selected {
+			matched[aws.ToString(bucket.Name)] = true
+		}
+	}
+
+	result := make([]types.Bucket, 0, len(matched))
+	for _, bucket := range buckets {
+		if matched[aws.ToString(bucket.Name)] {
+			result = append(result, bucket)
+		}
+	}
+	return result, nil
+}
+
 // FilterEmptyBuckets は指定されたバケットの中から空のバケットのみを返す関数
-func FilterEmptyBuckets(s3Client *s3.Client, buckets []string) ([]string, error) {
+func FilterEmptyBuckets(ctx context.Context, s3Client *s3.Client, buckets []string) ([]string, error) {
 	var emptyBuckets []string
 
 	for _, bucket := range buckets {
 		// バケットが空かどうかをチェック
-		isEmpty, err := isBucketEmpty(s3Client, bucket)
+		isEmpty, err := isBucketEmpty(ctx, s3Client, bucket)
 		if err != nil {
 			// エラーが発生してもスキップして続行
 			continue
@@ -43,9 +93,9 @@ func FilterEmptyBuckets(s3Client *s3.Client, buckets []string) ([]string, error)
 }
 
 // isBucketEmpty はバケットが空かどうかをチェックする関数
-func isBucketEmpty(s3Client *s3.Client, bucketName string) (bool, error) {
+func isBucketEmpty(ctx context.Context, s3Client *s3.Client, bucketName string) (bool, error) {
 	// MaxKeys=1で最初のオブジェクトのみ取得を試みる
-	result, err := s3Client.ListObjectsV2(context.Background(), &s3.ListObjectsV2Input{
+	result, err := s3Client.ListObjectsV2(ctx, &s3.ListObjectsV2Input{
 		Bucket:  aws.String(bucketName),
 		MaxKeys: aws.Int32(1),
 	})
@@ -58,7 +108,7 @@ func isBucketEmpty(s3Client *s3.Client, bucketName string) (bool, error) {
 }
 
 // listS3Objects はS3バケット内のオブジェクト一覧を取得します
-func listS3Objects(s3Client *s3.Client, bucketName string, prefix string) ([]S3Object, error) {
+func listS3Objects(ctx context.Context, s3Client *s3.Client, bucketName string, prefix string) ([]S3Object, error) {
 	var objects []S3Object
 
 	// ListObjectsV2を使用してオブジェクト一覧を取得
@@ -68,9 +118,9 @@ func listS3Objects(s3Client *s3.Client, bucketName string, prefix string) ([]S3O
 	})
 
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(ctx)
 		if err != nil {
-			return nil, fmt.Errorf("s3オブジェクト一覧取得エラー: %w", err)
+			return nil, fmt.Errorf(i18n.T("s3オブジェクト一覧取得エラー: %w"), err)
 		}
 
 		for _, obj := range page.Contents {
@@ -86,20 +136,20 @@ func listS3Objects(s3Client *s3.Client, bucketName string, prefix string) ([]S3O
 }
 
 // ListS3TreeView 指定されたS3パスをツリー形式で表示します
-func ListS3TreeView(s3Client *s3.Client, s3Path string, showTime bool) error {
+func ListS3TreeView(ctx context.Context, s3Client *s3.Client, s3Path string, showTime bool) error {
 	bucket, prefix, err := parseS3Url(s3Path)
 	if err != nil {
 		return err
 	}
 
 	// S3オブジェクト一覧を取得
-	objects, err := listS3Objects(s3Client, bucket, prefix)
+	objects, err := listS3Objects(ctx, s3Client, bucket, prefix)
 	if err != nil {
 		return err
 	}
 
 	if len(objects) == 0 {
-		fmt.Printf("🔍 %s には何も見つかりませんでした\n", s3Path)
+		fmt.Printf(i18n.T("🔍 %s には何も見つかりませんでした\n"), s3Path)
 		return nil
 	}
 
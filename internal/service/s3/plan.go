@@ -0,0 +1,49 @@
+package s3
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/plan"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+// PlanBucketDeletion は指定したS3バケットの削除アクションを作成します
+// 存在しないバケットは結果に含まれません（apply時の再検証でも使用）
+func PlanBucketDeletion(ctx context.Context, s3Client S3Api, bucketNames []string) ([]plan.Action, error) {
+	output, err := s3Client.ListBuckets(ctx, &s3.ListBucketsInput{})
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("s3バケット一覧取得エラー: %w"), err)
+	}
+
+	targets := make(map[string]bool, len(bucketNames))
+	for _, name := range bucketNames {
+		targets[name] = true
+	}
+
+	actions := []plan.Action{}
+	for _, bucket := range output.Buckets {
+		name := aws.ToString(bucket.Name)
+		if !targets[name] {
+			continue
+		}
+		actions = append(actions, plan.Action{
+			Type:   plan.ResourceS3Bucket,
+			Action: plan.ActionDelete,
+			Name:   name,
+			Fingerprint: map[string]string{
+				"creationDate": plan.FormatTime(bucket.CreationDate),
+			},
+		})
+	}
+	return actions, nil
+}
+
+// ApplyBucketDeletion はプランのアクションに従ってS3バケットを削除します
+func ApplyBucketDeletion(ctx context.Context, s3Client S3Api, actions []plan.Action) common.CleanupResult {
+	return CleanupS3Buckets(ctx, s3Client, plan.Names(actions))
+}
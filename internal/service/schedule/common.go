@@ -1,21 +1,30 @@
 package schedule
 
 import (
-	"awstk/internal/service/common"
 	"context"
 	"fmt"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
 )
 
 // DisplaySchedules はスケジュール一覧を表示する
 func DisplaySchedules(schedules []Schedule) {
+	// 構造化出力の場合はRulesとSchedulerを1つのレコード列にまとめて出力
+	if common.IsStructuredOutput() {
+		columns, data := schedulesToRecordData(schedules)
+		common.PrintTable("", columns, data)
+		return
+	}
+
 	// タイトル表示
-	fmt.Printf("\n📅 スケジュール一覧\n")
+	fmt.Print(i18n.T("\n📅 スケジュール一覧\n"))
 
 	if len(schedules) == 0 {
-		fmt.Println("スケジュールが見つかりませんでした")
+		fmt.Println(i18n.T("スケジュールが見つかりませんでした"))
 		return
 	}
 
@@ -63,16 +72,33 @@ func DisplaySchedules(schedules []Schedule) {
 	}
 
 	// 合計表示
-	fmt.Printf("\n合計: %d個のスケジュール", len(schedules))
+	fmt.Printf(i18n.T("\n合計: %d個のスケジュール"), len(schedules))
 	if len(ruleData) > 0 || len(schedulerData) > 0 {
 		fmt.Printf(" (Rules: %d, Scheduler: %d)", len(ruleData), len(schedulerData))
 	}
 	fmt.Println()
 }
 
+// schedulesToRecordData はスケジュール一覧を構造化出力用のテーブルデータに変換
+func schedulesToRecordData(schedules []Schedule) ([]common.TableColumn, [][]string) {
+	columns := []common.TableColumn{
+		{Header: "Name", Key: "name"},
+		{Header: "Type", Key: "type"},
+		{Header: "Schedule", Key: "schedule"},
+		{Header: "State", Key: "state"},
+		{Header: "Target", Key: "target"},
+		{Header: "Arn", Key: "arn"},
+	}
+
+	data := make([][]string, len(schedules))
+	for i, s := range schedules {
+		data[i] = []string{s.Name, s.Type, s.Expression, s.State, s.Target, s.Arn}
+	}
+	return columns, data
+}
+
 // listEventBridgeRulesWithFilter はフィルターにマッチするEventBridge Rulesを取得する
-func listEventBridgeRulesWithFilter(client *eventbridge.Client, filter string) ([]*eventbridge.DescribeRuleOutput, error) {
-	ctx := context.Background()
+func listEventBridgeRulesWithFilter(ctx context.Context, client *eventbridge.Client, filter string) ([]*eventbridge.DescribeRuleOutput, error) {
 	var matchedRules []*eventbridge.DescribeRuleOutput
 
 	// 全ルールを取得
@@ -106,8 +132,7 @@ func listEventBridgeRulesWithFilter(client *eventbridge.Client, filter string) (
 }
 
 // listEventBridgeSchedulersWithFilter はフィルターにマッチするEventBridge Schedulersを取得する
-func listEventBridgeSchedulersWithFilter(client *scheduler.Client, filter string) ([]*scheduler.GetScheduleOutput, error) {
-	ctx := context.Background()
+func listEventBridgeSchedulersWithFilter(ctx context.Context, client *scheduler.Client, filter string) ([]*scheduler.GetScheduleOutput, error) {
 	var matchedSchedules []*scheduler.GetScheduleOutput
 
 	// 全スケジュールを取得
@@ -5,6 +5,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	eventbridgetypes "github.com/aws/aws-sdk-go-v2/service/eventbridge/types"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
@@ -12,15 +16,20 @@ import (
 )
 
 // ListSchedules はスケジュール一覧を取得する
-func ListSchedules(eventBridgeClient *eventbridge.Client, schedulerClient *scheduler.Client, opts ListOptions) ([]Schedule, error) {
+func ListSchedules(ctx context.Context, eventBridgeClient *eventbridge.Client, schedulerClient *scheduler.Client, opts ListOptions) ([]Schedule, error) {
 	var schedules []Schedule
-	ctx := context.Background()
 
 	// EventBridge Rulesを取得
 	if opts.Type == "all" || opts.Type == "rule" {
 		rules, err := listEventBridgeRules(ctx, eventBridgeClient)
 		if err != nil {
-			return nil, fmt.Errorf("EventBridge Rules取得エラー: %w", err)
+			return nil, fmt.Errorf(i18n.T("EventBridge Rules取得エラー: %w"), err)
+		}
+		rules, err = tagging.Select(ctx, opts.Tags, common.Target{}, tagging.TypeEventRule, rules, func(s Schedule) string {
+			return s.Arn
+		})
+		if err != nil {
+			return nil, err
 		}
 		schedules = append(schedules, rules...)
 	}
@@ -29,7 +38,13 @@ func ListSchedules(eventBridgeClient *eventbridge.Client, schedulerClient *sched
 	if opts.Type == "all" || opts.Type == "scheduler" {
 		schedulerList, err := listEventBridgeSchedulers(ctx, schedulerClient)
 		if err != nil {
-			return nil, fmt.Errorf("EventBridge Scheduler取得エラー: %w", err)
+			return nil, fmt.Errorf(i18n.T("EventBridge Scheduler取得エラー: %w"), err)
+		}
+		schedulerList, err = tagging.Select(ctx, opts.Tags, common.Target{}, tagging.TypeScheduleGroup, schedulerList, func(s Schedule) string {
+			return "schedule-group/" + s.Group
+		})
+		if err != nil {
+			return nil, err
 		}
 		schedules = append(schedules, schedulerList...)
 	}
@@ -37,6 +52,25 @@ func ListSchedules(eventBridgeClient *eventbridge.Client, schedulerClient *sched
 	return schedules, nil
 }
 
+// ListSchedulesAcross は複数のアカウント・リージョンのスケジュールを並列に取得し、アカウント列・リージョン列付きで表示する
+func ListSchedulesAcross(ctx context.Context, targets []common.Target, newClients func(target common.Target) (*eventbridge.Client, *scheduler.Client), opts ListOptions) error {
+	results := common.FetchAcrossTargets(ctx, targets, func(ctx context.Context, target common.Target) ([]Schedule, error) {
+		eventBridgeClient, schedulerClient := newClients(target)
+		targetOpts := opts
+		targetOpts.Tags = opts.Tags.ForTarget(target)
+		return ListSchedules(ctx, eventBridgeClient, schedulerClient, targetOpts)
+	})
+	return common.DisplayTargetList(
+		results,
+		i18n.T("スケジュール一覧"),
+		schedulesToRecordData,
+		&common.DisplayOptions{
+			ShowCount:    true,
+			EmptyMessage: i18n.T("スケジュールが見つかりませんでした"),
+		},
+	)
+}
+
 // listEventBridgeRules はEventBridge Rules（スケジュールタイプ）を取得
 func listEventBridgeRules(ctx context.Context, client *eventbridge.Client) ([]Schedule, error) {
 	var schedules []Schedule
@@ -58,7 +92,7 @@ func listEventBridgeRules(ctx context.Context, client *eventbridge.Client) ([]Sc
 			Rule: rule.Name,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("ルール %s のターゲット取得エラー: %w", *rule.Name, err)
+			return nil, fmt.Errorf(i18n.T("ルール %s のターゲット取得エラー: %w"), *rule.Name, err)
 		}
 
 		// ターゲットを簡潔に表現
@@ -93,7 +127,7 @@ func listEventBridgeSchedulers(ctx context.Context, client *scheduler.Client) ([
 			Name: sched.Name,
 		})
 		if err != nil {
-			return nil, fmt.Errorf("スケジュール %s の詳細取得エラー: %w", *sched.Name, err)
+			return nil, fmt.Errorf(i18n.T("スケジュール %s の詳細取得エラー: %w"), *sched.Name, err)
 		}
 
 		// スケジュール式を構築
@@ -109,6 +143,7 @@ func listEventBridgeSchedulers(ctx context.Context, client *scheduler.Client) ([
 			State:      string(getOutput.State),
 			Target:     target,
 			Arn:        getString(sched.Arn),
+			Group:      getString(sched.GroupName),
 		})
 	}
 
@@ -118,7 +153,7 @@ func listEventBridgeSchedulers(ctx context.Context, client *scheduler.Client) ([
 // formatTargets はEventBridge Rulesのターゲットを簡潔に表現
 func formatTargets(targets []eventbridgetypes.Target) string {
 	if len(targets) == 0 {
-		return "なし"
+		return i18n.T("なし")
 	}
 
 	var targetStrs []string
@@ -153,7 +188,7 @@ func formatTargets(targets []eventbridgetypes.Target) string {
 // formatSchedulerTarget はEventBridge Schedulerのターゲットを簡潔に表現
 func formatSchedulerTarget(target *schedulertypes.Target) string {
 	if target == nil || target.Arn == nil {
-		return "なし"
+		return i18n.T("なし")
 	}
 
 	// ARNからサービスとリソース名を抽出
@@ -198,7 +233,7 @@ func formatScheduleExpression(schedule *scheduler.GetScheduleOutput) string {
 		}
 	}
 
-	return "不明"
+	return i18n.T("不明")
 }
 
 // getString は*stringを安全にstringに変換
@@ -0,0 +1,41 @@
+package schedule
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/selector"
+
+	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
+	"github.com/aws/aws-sdk-go-v2/service/scheduler"
+)
+
+// SelectSchedule はスケジュール一覧から対話的に選択させる
+func SelectSchedule(ctx context.Context, eventBridgeClient *eventbridge.Client, schedulerClient *scheduler.Client) (Schedule, error) {
+	schedules, err := ListSchedules(ctx, eventBridgeClient, schedulerClient, ListOptions{Type: "all"})
+	if err != nil {
+		return Schedule{}, err
+	}
+	if len(schedules) == 0 {
+		return Schedule{}, errors.New(i18n.T("スケジュールが見つかりませんでした"))
+	}
+
+	items := make([]selector.Item, len(schedules))
+	for i, s := range schedules {
+		items[i] = selector.Item{
+			Value: strconv.Itoa(i),
+			Label: fmt.Sprintf("%s  [%s]  %s  %s", s.Name, s.Type, s.Expression, s.State),
+		}
+	}
+
+	selected, err := selector.Select(i18n.T("実行するスケジュールを選択してください"), items)
+	if err != nil {
+		return Schedule{}, err
+	}
+	// 同名のルールとスケジューラを区別するため、選択肢の値には一覧のインデックスを使用する
+	index, _ := strconv.Atoi(selected.Value)
+	return schedules[index], nil
+}
@@ -1,13 +1,16 @@
 package schedule
 
 import (
-	"bufio"
 	"context"
 	"fmt"
 	"os"
-	"strings"
 	"time"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/selector"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/eventbridge"
 	"github.com/aws/aws-sdk-go-v2/service/scheduler"
@@ -16,18 +19,22 @@ import (
 
 // TriggerOptions はトリガー実行時のオプション
 type TriggerOptions struct {
-	Timeout int  // 実行待機時間（秒）
-	NoWait  bool // 実行を待たずに終了
+	Timeout int    // 実行待機時間（秒）
+	NoWait  bool   // 実行を待たずに終了
+	Type    string // スケジュールタイプ（"rule" or "scheduler"。空の場合は自動判別）
 }
 
 // TriggerSchedule はスケジュールを手動実行する
-func TriggerSchedule(eventBridgeClient *eventbridge.Client, schedulerClient *scheduler.Client, name string, opts TriggerOptions) error {
-	ctx := context.Background()
-
-	// スケジュールタイプの判別
-	scheduleType, err := detectScheduleType(eventBridgeClient, schedulerClient, name)
-	if err != nil {
-		return err
+func TriggerSchedule(ctx context.Context, eventBridgeClient *eventbridge.Client, schedulerClient *scheduler.Client, name string, opts TriggerOptions) error {
+
+	// スケジュールタイプの判別（指定されている場合はそのまま使用）
+	scheduleType := opts.Type
+	if scheduleType == "" {
+		var err error
+		scheduleType, err = detectScheduleType(ctx, eventBridgeClient, schedulerClient, name)
+		if err != nil {
+			return err
+		}
 	}
 
 	// タイプに応じて処理を分岐
@@ -38,8 +45,7 @@ func TriggerSchedule(eventBridgeClient *eventbridge.Client, schedulerClient *sch
 }
 
 // detectScheduleType はスケジュールのタイプを自動判別する
-func detectScheduleType(eventBridgeClient *eventbridge.Client, schedulerClient *scheduler.Client, name string) (string, error) {
-	ctx := context.Background()
+func detectScheduleType(ctx context.Context, eventBridgeClient *eventbridge.Client, schedulerClient *scheduler.Client, name string) (string, error) {
 	// 並列でチェック
 	type result struct {
 		scheduleType string
@@ -98,53 +104,40 @@ func detectScheduleType(eventBridgeClient *eventbridge.Client, schedulerClient *
 		return "scheduler", nil
 	}
 
-	return "", fmt.Errorf("スケジュール '%s' が見つかりません", name)
+	return "", fmt.Errorf(i18n.T("スケジュール '%s' が見つかりません"), name)
 }
 
 // selectScheduleTypeInteractive は対話的にスケジュールタイプを選択する
 func selectScheduleTypeInteractive(name string) (string, error) {
-	fmt.Printf("\n⚠️  '%s' はEventBridge RuleとSchedulerの両方に存在します。\n", name)
-	fmt.Println("どちらを操作しますか？")
-	fmt.Println()
-	fmt.Println("  1) EventBridge Rule")
-	fmt.Println("  2) EventBridge Scheduler")
-	fmt.Println()
-	fmt.Print("選択してください (1 or 2): ")
-
-	reader := bufio.NewReader(os.Stdin)
-	choice, _ := reader.ReadString('\n')
-	choice = strings.TrimSpace(choice)
-
-	switch choice {
-	case "1":
-		fmt.Println("→ EventBridge Rule を選択しました")
-		return "rule", nil
-	case "2":
-		fmt.Println("→ EventBridge Scheduler を選択しました")
-		return "scheduler", nil
-	default:
-		return "", fmt.Errorf("無効な選択です: %s", choice)
+	fmt.Fprintf(os.Stderr, i18n.T("\n⚠️  '%s' はEventBridge RuleとSchedulerの両方に存在します。\n"), name)
+	selected, err := selector.Select(i18n.T("どちらを操作しますか？"), []selector.Item{
+		{Value: "rule", Label: "EventBridge Rule"},
+		{Value: "scheduler", Label: "EventBridge Scheduler"},
+	})
+	if err != nil {
+		return "", err
 	}
+	return selected.Value, nil
 }
 
 // triggerEventBridgeRule はEventBridge Ruleを手動実行する
 func triggerEventBridgeRule(ctx context.Context, client *eventbridge.Client, name string, opts TriggerOptions) error {
 	// 1. 現在のルール情報を取得
-	fmt.Printf("📝 現在のスケジュール設定を取得中...\n")
+	common.Infof(ctx, "%s", i18n.T("📝 現在のスケジュール設定を取得中...\n"))
 	describeOutput, err := client.DescribeRule(ctx, &eventbridge.DescribeRuleInput{
 		Name: aws.String(name),
 	})
 	if err != nil {
-		return fmt.Errorf("ルールの取得に失敗: %w", err)
+		return fmt.Errorf(i18n.T("ルールの取得に失敗: %w"), err)
 	}
 
 	// スケジュールルールでない場合はエラー
 	if describeOutput.ScheduleExpression == nil || *describeOutput.ScheduleExpression == "" {
-		return fmt.Errorf("'%s' はスケジュールルールではありません", name)
+		return fmt.Errorf(i18n.T("'%s' はスケジュールルールではありません"), name)
 	}
 
 	originalSchedule := *describeOutput.ScheduleExpression
-	fmt.Printf("  └─ 現在の設定: %s\n", originalSchedule)
+	common.Infof(ctx, i18n.T("  └─ 現在の設定: %s\n"), originalSchedule)
 
 	// 元の状態を保存
 	originalState := describeOutput.State
@@ -153,7 +146,7 @@ func triggerEventBridgeRule(ctx context.Context, client *eventbridge.Client, nam
 	shouldRestore := false
 	defer func() {
 		if shouldRestore && !opts.NoWait {
-			fmt.Println("\n🔄 元のスケジュールに復元中...")
+			common.Infof(ctx, "%s\n", i18n.T("\n🔄 元のスケジュールに復元中..."))
 			putRuleInput := &eventbridge.PutRuleInput{
 				Name:               aws.String(name),
 				ScheduleExpression: aws.String(originalSchedule),
@@ -166,16 +159,19 @@ func triggerEventBridgeRule(ctx context.Context, client *eventbridge.Client, nam
 				putRuleInput.EventBusName = describeOutput.EventBusName
 			}
 
-			if _, err := client.PutRule(ctx, putRuleInput); err != nil {
-				fmt.Printf("⚠️  スケジュールの復元に失敗: %v\n", err)
+			// 中断（Ctrl-C）された場合でも復元できるよう、キャンセルされないコンテキストを使う
+			restoreCtx, cancel := common.RestoreContext(ctx)
+			defer cancel()
+			if _, err := client.PutRule(restoreCtx, putRuleInput); err != nil {
+				common.Warnf(ctx, i18n.T("⚠️  スケジュールの復元に失敗: %v\n"), err)
 			} else {
-				fmt.Printf("  └─ 復元後: %s\n", originalSchedule)
+				common.Infof(ctx, i18n.T("  └─ 復元後: %s\n"), originalSchedule)
 			}
 		}
 	}()
 
 	// 3. スケジュールを rate(1 minute) に変更
-	fmt.Println("\n🔄 スケジュールを1分後実行に変更中...")
+	common.Infof(ctx, "%s\n", i18n.T("\n🔄 スケジュールを1分後実行に変更中..."))
 	newSchedule := "rate(1 minute)"
 	putRuleInput := &eventbridge.PutRuleInput{
 		Name:               aws.String(name),
@@ -190,38 +186,38 @@ func triggerEventBridgeRule(ctx context.Context, client *eventbridge.Client, nam
 	}
 
 	if _, err := client.PutRule(ctx, putRuleInput); err != nil {
-		return fmt.Errorf("スケジュール変更に失敗: %w", err)
+		return fmt.Errorf(i18n.T("スケジュール変更に失敗: %w"), err)
 	}
-	fmt.Printf("  └─ 新しい設定: %s\n", newSchedule)
+	common.Infof(ctx, i18n.T("  └─ 新しい設定: %s\n"), newSchedule)
 	shouldRestore = true
 
 	// 4. 実行待機
 	if !opts.NoWait {
-		if err := waitForExecution(name, opts.Timeout); err != nil {
+		if err := waitForExecution(ctx, name, opts.Timeout); err != nil {
 			return err
 		}
 	} else {
-		fmt.Println("\n⚠️  --no-waitが指定されました。スケジュールは自動的に復元されません。")
+		common.Warnf(ctx, "%s\n", i18n.T("\n⚠️  --no-waitが指定されました。スケジュールは自動的に復元されません。"))
 		shouldRestore = false
 	}
 
-	fmt.Println("\n✅ 処理が完了しました")
+	common.Infof(ctx, "%s\n", i18n.T("\n✅ 処理が完了しました"))
 	return nil
 }
 
 // triggerEventBridgeScheduler はEventBridge Schedulerを手動実行する
 func triggerEventBridgeScheduler(ctx context.Context, client *scheduler.Client, name string, opts TriggerOptions) error {
 	// 1. 現在のスケジュール情報を取得
-	fmt.Printf("📝 現在のスケジュール設定を取得中...\n")
+	common.Infof(ctx, "%s", i18n.T("📝 現在のスケジュール設定を取得中...\n"))
 	getOutput, err := client.GetSchedule(ctx, &scheduler.GetScheduleInput{
 		Name: aws.String(name),
 	})
 	if err != nil {
-		return fmt.Errorf("スケジュールの取得に失敗: %w", err)
+		return fmt.Errorf(i18n.T("スケジュールの取得に失敗: %w"), err)
 	}
 
 	originalSchedule := *getOutput.ScheduleExpression
-	fmt.Printf("  └─ 現在の設定: %s\n", originalSchedule)
+	common.Infof(ctx, i18n.T("  └─ 現在の設定: %s\n"), originalSchedule)
 
 	// 元の状態を保存
 	originalState := getOutput.State
@@ -230,7 +226,7 @@ func triggerEventBridgeScheduler(ctx context.Context, client *scheduler.Client,
 	shouldRestore := false
 	defer func() {
 		if shouldRestore && !opts.NoWait {
-			fmt.Println("\n🔄 元のスケジュールに復元中...")
+			common.Infof(ctx, "%s\n", i18n.T("\n🔄 元のスケジュールに復元中..."))
 			updateInput := &scheduler.UpdateScheduleInput{
 				Name:               aws.String(name),
 				ScheduleExpression: aws.String(originalSchedule),
@@ -245,16 +241,19 @@ func triggerEventBridgeScheduler(ctx context.Context, client *scheduler.Client,
 				updateInput.GroupName = getOutput.GroupName
 			}
 
-			if _, err := client.UpdateSchedule(ctx, updateInput); err != nil {
-				fmt.Printf("⚠️  スケジュールの復元に失敗: %v\n", err)
+			// 中断（Ctrl-C）された場合でも復元できるよう、キャンセルされないコンテキストを使う
+			restoreCtx, cancel := common.RestoreContext(ctx)
+			defer cancel()
+			if _, err := client.UpdateSchedule(restoreCtx, updateInput); err != nil {
+				common.Warnf(ctx, i18n.T("⚠️  スケジュールの復元に失敗: %v\n"), err)
 			} else {
-				fmt.Printf("  └─ 復元後: %s\n", originalSchedule)
+				common.Infof(ctx, i18n.T("  └─ 復元後: %s\n"), originalSchedule)
 			}
 		}
 	}()
 
 	// 3. スケジュールを rate(1 minute) に変更
-	fmt.Println("\n🔄 スケジュールを1分後実行に変更中...")
+	common.Infof(ctx, "%s\n", i18n.T("\n🔄 スケジュールを1分後実行に変更中..."))
 	newSchedule := "rate(1 minute)"
 	updateInput := &scheduler.UpdateScheduleInput{
 		Name:               aws.String(name),
@@ -271,43 +270,44 @@ func triggerEventBridgeScheduler(ctx context.Context, client *scheduler.Client,
 	}
 
 	if _, err := client.UpdateSchedule(ctx, updateInput); err != nil {
-		return fmt.Errorf("スケジュール変更に失敗: %w", err)
+		return fmt.Errorf(i18n.T("スケジュール変更に失敗: %w"), err)
 	}
-	fmt.Printf("  └─ 新しい設定: %s\n", newSchedule)
+	common.Infof(ctx, i18n.T("  └─ 新しい設定: %s\n"), newSchedule)
 	shouldRestore = true
 
 	// 4. 実行待機
 	if !opts.NoWait {
-		if err := waitForExecution(name, opts.Timeout); err != nil {
+		if err := waitForExecution(ctx, name, opts.Timeout); err != nil {
 			return err
 		}
 	} else {
-		fmt.Println("\n⚠️  --no-waitが指定されました。スケジュールは自動的に復元されません。")
+		common.Warnf(ctx, "%s\n", i18n.T("\n⚠️  --no-waitが指定されました。スケジュールは自動的に復元されません。"))
 		shouldRestore = false
 	}
 
-	fmt.Println("\n✅ 処理が完了しました")
+	common.Infof(ctx, "%s\n", i18n.T("\n✅ 処理が完了しました"))
 	return nil
 }
 
-// waitForExecution は実行を待機する
-func waitForExecution(name string, timeout int) error {
+// waitForExecution は実行を待機する（中断された場合はエラーを返し、呼び出し元のdeferで復元する）
+func waitForExecution(ctx context.Context, name string, timeout int) error {
 	// EventBridgeがスケジュール変更を認識するまでの時間 + rate(1 minute)の実行時間を考慮
 	minWaitTime := 70
 	actualWaitTime := timeout
 	if actualWaitTime < minWaitTime {
 		actualWaitTime = minWaitTime
-		fmt.Printf("\n⚠️  最低待機時間%d秒に調整しました\n", minWaitTime)
+		common.Warnf(ctx, i18n.T("\n⚠️  最低待機時間%d秒に調整しました\n"), minWaitTime)
 	}
 
-	fmt.Printf("\n⏳ スケジュール実行を待機中（%d秒）...\n", actualWaitTime)
+	common.Infof(ctx, i18n.T("\n⏳ スケジュール実行を待機中（%d秒）...\n"), actualWaitTime)
 
 	// プログレスバー表示
 	bar := progressbar.NewOptions(actualWaitTime,
+		progressbar.OptionSetWriter(logging.ProgressWriter()),
 		progressbar.OptionEnableColorCodes(true),
 		progressbar.OptionShowBytes(false),
 		progressbar.OptionSetWidth(40),
-		progressbar.OptionSetDescription("待機中..."),
+		progressbar.OptionSetDescription(i18n.T("待機中...")),
 		progressbar.OptionSetTheme(progressbar.Theme{
 			Saucer:        "=",
 			SaucerHead:    ">",
@@ -320,16 +320,19 @@ func waitForExecution(name string, timeout int) error {
 	)
 
 	for i := 0; i < actualWaitTime; i++ {
-		time.Sleep(1 * time.Second)
+		if err := common.Sleep(ctx, 1*time.Second); err != nil {
+			common.Warnf(ctx, i18n.T("\n⚠️  待機を中断しました（経過: %d秒 / %d秒）\n"), i, actualWaitTime)
+			return fmt.Errorf(i18n.T("スケジュール '%s' の実行待機が中断されました: %w"), name, err)
+		}
 		if err := bar.Add(1); err != nil {
-			fmt.Printf("⚠️  プログレスバー更新エラー: %v\n", err)
+			common.Warnf(ctx, i18n.T("⚠️  プログレスバー更新エラー: %v\n"), err)
 		}
 	}
 
 	if err := bar.Finish(); err != nil {
-		fmt.Printf("⚠️  プログレスバー終了エラー: %v\n", err)
+		common.Warnf(ctx, i18n.T("⚠️  プログレスバー終了エラー: %v\n"), err)
 	}
-	fmt.Println("\n✓ 実行待機完了")
+	common.Infof(ctx, "%s\n", i18n.T("\n✓ 実行待機完了"))
 
 	return nil
 }
@@ -1,5 +1,7 @@
 package schedule
 
+import "github.com/ngsw877/awstk/internal/service/tagging"
+
 // Schedule はスケジュール情報を表す構造体
 type Schedule struct {
 	Name       string // スケジュール名
@@ -8,9 +10,11 @@ type Schedule struct {
 	State      string // "ENABLED" or "DISABLED"
 	Target     string // ターゲットの簡潔な表現
 	Arn        string // リソースARN
+	Group      string // スケジュールグループ名（Schedulerのみ）
 }
 
 // ListOptions はスケジュール一覧取得のオプション
 type ListOptions struct {
-	Type string // "all", "rule", "scheduler"
+	Type string            // "all", "rule", "scheduler"
+	Tags *tagging.Selector // タグ条件（Schedulerのスケジュールはタグを持たないため、スケジュールグループのタグで判定する）
 }
@@ -0,0 +1,99 @@
+package secretsmanager
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+// GetSecretsByFilter はフィルターに一致するシークレット名の一覧を取得します
+// exact が true の場合、大文字小文字を区別します
+// tags が指定された場合は、タグ条件にも一致するシークレットのみを返します
+// age が指定された場合は、作成日時（last-activity の場合は最終アクセス日）の経過時間にも一致するシークレットのみを返します
+func GetSecretsByFilter(ctx context.Context, client SecretsManagerApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
+	secrets, err := listSecrets(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []types.SecretListEntry
+	for _, secret := range secrets {
+		if common.MatchesFilter(aws.ToString(secret.Name), searchString, exact) {
+			matched = append(matched, secret)
+		}
+	}
+	matched = common.SelectByAge(age, matched, func(s types.SecretListEntry) (*time.Time, *time.Time) {
+		return s.CreatedDate, s.LastAccessedDate
+	})
+	matched, err = tagging.Select(ctx, tags, common.Target{}, tagging.TypeSecret, matched, func(s types.SecretListEntry) string {
+		return aws.ToString(s.ARN)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	foundSecrets := []string{}
+	for _, secret := range matched {
+		name := aws.ToString(secret.Name)
+		foundSecrets = append(foundSecrets, name)
+		common.Progressf(ctx, common.EventInfo, name, i18n.T("🔍 検出されたシークレット: %s\n"), age.Annotate(name, secret.CreatedDate, secret.LastAccessedDate))
+	}
+	return foundSecrets, nil
+}
+
+// cleanupRecoveryWindowDays はクリーンアップで削除したシークレットを復元できる日数（指定できる最短の日数）
+const cleanupRecoveryWindowDays = 7
+
+// CleanupSecrets は指定したシークレット一覧を、復旧期間（7日間）を設けて削除します
+// 誤って削除した場合も、復旧期間内であれば restore-secret で復元できます
+func CleanupSecrets(ctx context.Context, client SecretsManagerApi, secretNames []string) common.CleanupResult {
+	if len(secretNames) == 0 {
+		return common.CleanupResult{ResourceType: i18n.T("シークレット"), Deleted: []string{}, Failed: []string{}}
+	}
+
+	// ガードレールの確認にはARNが必要なため、シークレット名から引けるようにする（取得できない場合は名前で確認する）
+	arns := make(map[string]string)
+	secrets, err := listSecrets(ctx, client)
+	if err != nil {
+		common.Warnf(ctx, "%s  %v\n", common.WarningIcon, err)
+	}
+	for _, secret := range secrets {
+		arns[aws.ToString(secret.Name)] = aws.ToString(secret.ARN)
+	}
+	secretNames = guardrail.ExcludeNames(ctx, i18n.T("シークレット"), tagging.TypeSecret, secretNames, func(name string) string {
+		if arn, ok := arns[name]; ok {
+			return arn
+		}
+		return name
+	})
+
+	return common.DeleteInParallel(ctx, i18n.T("シークレット"), secretNames, 10, func(name string) error {
+		_, err := client.DeleteSecret(ctx, &secretsmanager.DeleteSecretInput{
+			SecretId:             aws.String(name),
+			RecoveryWindowInDays: aws.Int64(cleanupRecoveryWindowDays),
+		})
+		return err
+	})
+}
+
+// SecretNameFromArn はシークレットのARNからシークレット名を取り出します（CloudFormationの物理IDはシークレットのARN）
+// ARNのシークレット名の末尾には「-」と6文字のランダムな文字列が付いているため取り除きます
+func SecretNameFromArn(secretArn string) string {
+	_, name, ok := strings.Cut(secretArn, ":secret:")
+	if !ok {
+		return secretArn
+	}
+	if i := strings.LastIndex(name, "-"); i >= 0 && len(name)-i == 7 {
+		return name[:i]
+	}
+	return name
+}
@@ -3,20 +3,35 @@ package secretsmanager
 import (
 	"context"
 	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	awsSecretsManager "github.com/aws/aws-sdk-go-v2/service/secretsmanager"
 )
 
 // DeleteSecret deletes a secret immediately and without a recovery window.
 // It depends on the concrete client type from AWS SDK.
-func DeleteSecret(client *awsSecretsManager.Client, secretId string) error {
+func DeleteSecret(ctx context.Context, client *awsSecretsManager.Client, secretId string) error {
+	// ガードレールの確認にはシークレット名とARNが必要なため、削除前に取得する
+	secret, err := client.DescribeSecret(ctx, &awsSecretsManager.DescribeSecretInput{
+		SecretId: aws.String(secretId),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to describe secret %s: %w", secretId, err)
+	}
+	if err := guardrail.Check(ctx, i18n.T("シークレット"), tagging.TypeSecret, aws.ToString(secret.Name), aws.ToString(secret.ARN)); err != nil {
+		return err
+	}
+
 	input := &awsSecretsManager.DeleteSecretInput{
 		SecretId:                   aws.String(secretId),
 		ForceDeleteWithoutRecovery: aws.Bool(true), // 復旧期間なしで即時削除
 	}
 
-	// Use context.Background() as this is a background task not tied to a specific request.
-	_, err := client.DeleteSecret(context.Background(), input)
+	_, err = client.DeleteSecret(ctx, input)
 	if err != nil {
 		return fmt.Errorf("failed to delete secret %s: %w", secretId, err)
 	}
@@ -0,0 +1,66 @@
+package secretsmanager
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/selector"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager/types"
+)
+
+// SelectSecret シークレット一覧から対話的に選択させる
+func SelectSecret(ctx context.Context, secretsClient *secretsmanager.Client) (string, error) {
+	secrets, err := listSecrets(ctx, secretsClient)
+	if err != nil {
+		return "", err
+	}
+	if len(secrets) == 0 {
+		return "", errors.New(i18n.T("シークレットが見つかりませんでした"))
+	}
+
+	items := make([]selector.Item, len(secrets))
+	for i, secret := range secrets {
+		items[i] = selector.Item{Value: aws.ToString(secret.Name)}
+		if description := aws.ToString(secret.Description); description != "" {
+			items[i].Label = fmt.Sprintf("%s  (%s)", items[i].Value, description)
+		}
+	}
+
+	selected, err := selector.Select(i18n.T("シークレットを選択してください"), items)
+	if err != nil {
+		return "", err
+	}
+	return selected.Value, nil
+}
+
+// ListSecretNames シークレット名の一覧を返す
+func ListSecretNames(ctx context.Context, secretsClient *secretsmanager.Client) ([]string, error) {
+	secrets, err := listSecrets(ctx, secretsClient)
+	if err != nil {
+		return nil, err
+	}
+	names := make([]string, len(secrets))
+	for i, secret := range secrets {
+		names[i] = aws.ToString(secret.Name)
+	}
+	return names, nil
+}
+
+// listSecrets シークレット一覧をすべて取得する
+func listSecrets(ctx context.Context, secretsClient SecretsManagerApi) ([]types.SecretListEntry, error) {
+	var secrets []types.SecretListEntry
+	paginator := secretsmanager.NewListSecretsPaginator(secretsClient, &secretsmanager.ListSecretsInput{})
+	for paginator.HasMorePages() {
+		page, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("シークレット一覧の取得に失敗: %w"), err)
+		}
+		secrets = append(secrets, page.SecretList...)
+	}
+	return secrets, nil
+}
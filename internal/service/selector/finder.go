@@ -0,0 +1,226 @@
+package selector
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+
+	"github.com/mattn/go-runewidth"
+)
+
+// maxVisible は一度に表示する選択肢の最大数
+const maxVisible = 10
+
+// action はキー入力を処理した結果
+type action int
+
+const (
+	actionNone   action = iota // 選択を続ける
+	actionDone                 // 選択を確定した
+	actionCancel               // 選択をキャンセルした
+)
+
+// finder はあいまい検索による選択の状態
+type finder struct {
+	title   string
+	items   []Item
+	multi   bool
+	query   []rune
+	matches []int        // 絞り込み後の選択肢のインデックス（一致度の高い順）
+	cursor  int          // matches 内のカーソル位置
+	offset  int          // 表示を開始する matches 内の位置
+	chosen  map[int]bool // 複数選択で選択済みの選択肢のインデックス
+}
+
+// newFinder は選択の初期状態を作成する
+func newFinder(title string, items []Item, multi bool) *finder {
+	f := &finder{title: title, items: items, multi: multi, chosen: make(map[int]bool)}
+	f.filter()
+	return f
+}
+
+// handle はキー入力を処理する
+func (f *finder) handle(input []byte) action {
+	switch string(input) {
+	case "\x03", "\x1b": // Ctrl-C, Esc
+		return actionCancel
+	case "\r", "\n":
+		if len(f.matches) == 0 {
+			return actionNone
+		}
+		return actionDone
+	case "\t":
+		if f.multi && len(f.matches) > 0 {
+			index := f.matches[f.cursor]
+			f.chosen[index] = !f.chosen[index]
+			f.move(1)
+		}
+	case "\x1b[A", "\x1bOA", "\x10": // ↑, Ctrl-P
+		f.move(-1)
+	case "\x1b[B", "\x1bOB", "\x0e": // ↓, Ctrl-N
+		f.move(1)
+	case "\x7f", "\x08": // Backspace
+		if len(f.query) > 0 {
+			f.query = f.query[:len(f.query)-1]
+			f.filter()
+		}
+	case "\x15": // Ctrl-U
+		f.query = nil
+		f.filter()
+	default:
+		// 認識しないエスケープシーケンス・制御文字は無視し、それ以外は検索文字列に追加する
+		if input[0] == 0x1b {
+			return actionNone
+		}
+		changed := false
+		for len(input) > 0 {
+			r, size := utf8.DecodeRune(input)
+			input = input[size:]
+			if r != utf8.RuneError && unicode.IsPrint(r) {
+				f.query = append(f.query, r)
+				changed = true
+			}
+		}
+		if changed {
+			f.filter()
+		}
+	}
+	return actionNone
+}
+
+// move はカーソルを移動する（端では反対側に移る）
+func (f *finder) move(delta int) {
+	if len(f.matches) == 0 {
+		return
+	}
+	f.cursor = (f.cursor + delta + len(f.matches)) % len(f.matches)
+	if f.cursor < f.offset {
+		f.offset = f.cursor
+	}
+	if f.cursor >= f.offset+maxVisible {
+		f.offset = f.cursor - maxVisible + 1
+	}
+}
+
+// filter は検索文字列に一致する選択肢を一致度の高い順に並べる
+func (f *finder) filter() {
+	type match struct {
+		index int
+		score int
+	}
+	var matched []match
+	for i, item := range f.items {
+		if score, ok := fuzzyScore(item.display(), string(f.query)); ok {
+			matched = append(matched, match{index: i, score: score})
+		}
+	}
+	sort.SliceStable(matched, func(i, j int) bool { return matched[i].score < matched[j].score })
+
+	f.matches = f.matches[:0]
+	for _, m := range matched {
+		f.matches = append(f.matches, m.index)
+	}
+	f.cursor = 0
+	f.offset = 0
+}
+
+// result は選択した項目を返す
+// 複数選択で何も選択していない場合はカーソル位置の項目を返す
+func (f *finder) result() []Item {
+	var selected []Item
+	if f.multi {
+		for i, item := range f.items {
+			if f.chosen[i] {
+				selected = append(selected, item)
+			}
+		}
+	}
+	if len(selected) == 0 {
+		selected = append(selected, f.items[f.matches[f.cursor]])
+	}
+	return selected
+}
+
+// lines は画面に表示する行を返す（各行は端末の幅に収まるよう切り詰める）
+func (f *finder) lines(width int) []string {
+	lines := []string{f.title, "> " + string(f.query)}
+
+	end := min(f.offset+maxVisible, len(f.matches))
+	for pos := f.offset; pos < end; pos++ {
+		index := f.matches[pos]
+		prefix := "  "
+		if pos == f.cursor {
+			prefix = "❯ "
+		}
+		if f.multi {
+			if f.chosen[index] {
+				prefix += "[x] "
+			} else {
+				prefix += "[ ] "
+			}
+		}
+		line := prefix + f.items[index].display()
+		if pos == f.cursor {
+			line = "\x1b[7m" + runewidth.Truncate(line, width-1, "…") + "\x1b[0m"
+		}
+		lines = append(lines, line)
+	}
+
+	help := i18n.T("↑↓: 移動  Enter: 決定  Esc: キャンセル")
+	if f.multi {
+		help = i18n.T("↑↓: 移動  Tab: 選択  Enter: 決定  Esc: キャンセル")
+	}
+	lines = append(lines, fmt.Sprintf("  %d/%d  %s", len(f.matches), len(f.items), help))
+
+	for i, line := range lines {
+		if !strings.HasPrefix(line, "\x1b[7m") {
+			lines[i] = runewidth.Truncate(line, width-1, "…")
+		}
+	}
+	return lines
+}
+
+// fuzzyScore は検索文字列に一致するかと一致度のスコア（小さいほど一致度が高い）を返す
+// 空白で区切った各語の文字が順に含まれていれば一致とし（大文字小文字を区別しない）、連続して含まれるものを優先する
+func fuzzyScore(text, query string) (int, bool) {
+	text = strings.ToLower(text)
+	score := 0
+	for _, word := range strings.Fields(strings.ToLower(query)) {
+		s, ok := wordScore(text, word)
+		if !ok {
+			return 0, false
+		}
+		score += s
+	}
+	return score, true
+}
+
+// wordScore は1語の一致度のスコアを返す
+// 連続して含まれる場合は出現位置、それ以外は文字の間の隙間の合計を、連続一致より大きくなるよう加算して返す
+func wordScore(text, word string) (int, bool) {
+	if index := strings.Index(text, word); index >= 0 {
+		return utf8.RuneCountInString(text[:index]), true
+	}
+
+	runes := []rune(text)
+	score := len(runes)
+	pos, last := 0, -1
+	for _, r := range word {
+		for pos < len(runes) && runes[pos] != r {
+			pos++
+		}
+		if pos == len(runes) {
+			return 0, false
+		}
+		if last >= 0 {
+			score += pos - last - 1
+		}
+		last = pos
+		pos++
+	}
+	return score, true
+}
@@ -0,0 +1,45 @@
+package selector
+
+import (
+	"fmt"
+	"io"
+	"strings"
+)
+
+// screen は選択画面の描画先
+// 前回描画した行数を記録し、再描画時に同じ位置から上書きする
+type screen struct {
+	out    io.Writer
+	height int
+}
+
+// draw は前回の描画を消去して行を描画する（カーソルは非表示にする）
+func (s *screen) draw(lines []string) {
+	var b strings.Builder
+	b.WriteString("\x1b[?25l")
+	s.rewind(&b)
+	b.WriteString(strings.Join(lines, "\r\n"))
+	s.height = len(lines)
+	fmt.Fprint(s.out, b.String())
+}
+
+// clear は描画した選択画面を消去し、カーソルを表示する
+func (s *screen) clear() {
+	var b strings.Builder
+	s.rewind(&b)
+	s.height = 0
+	b.WriteString("\x1b[?25h")
+	fmt.Fprint(s.out, b.String())
+}
+
+// rewind は描画の開始位置に戻り、以降の表示を消去する
+func (s *screen) rewind(b *strings.Builder) {
+	if s.height == 0 {
+		return
+	}
+	b.WriteString("\r")
+	if s.height > 1 {
+		fmt.Fprintf(b, "\x1b[%dA", s.height-1)
+	}
+	b.WriteString("\x1b[J")
+}
@@ -0,0 +1,134 @@
+package selector
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
+	"golang.org/x/term"
+)
+
+// Available は対話的に選択できるか（標準入力と標準エラー出力が端末か）を返す
+// 識別子の指定を省略したときに選択させるかどうかの判定に使用する
+func Available() bool {
+	return term.IsTerminal(int(os.Stdin.Fd())) && term.IsTerminal(int(os.Stderr.Fd()))
+}
+
+// Select は選択肢から1つを選択させる
+// 端末では入力した文字列によるあいまい検索で絞り込み、矢印キーで選択する
+// 端末でない場合は一覧を表示して番号の入力で選択する
+func Select(title string, items []Item) (Item, error) {
+	selected, err := run(title, items, false)
+	if err != nil {
+		return Item{}, err
+	}
+	return selected[0], nil
+}
+
+// SelectMulti は選択肢から複数を選択させる
+// 端末では Tab で選択を切り替え、Enter で確定する（何も選択していない場合はカーソル位置の項目を選択する）
+func SelectMulti(title string, items []Item) ([]Item, error) {
+	return run(title, items, true)
+}
+
+// run は端末かどうかに応じた方法で選択させ、選択した項目を表示する
+func run(title string, items []Item, multi bool) ([]Item, error) {
+	if len(items) == 0 {
+		return nil, errors.New(i18n.T("選択できる項目がありません"))
+	}
+
+	var selected []Item
+	var err error
+	if Available() {
+		selected, err = runInteractive(title, items, multi)
+	} else {
+		selected, err = runNumbered(title, items, multi)
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range selected {
+		fmt.Fprintf(os.Stderr, i18n.T("✅ %s を選択しました\n"), item.display())
+	}
+	return selected, nil
+}
+
+// runInteractive は端末をRAWモードにして、あいまい検索で絞り込みながら選択させる
+func runInteractive(title string, items []Item, multi bool) ([]Item, error) {
+	fd := int(os.Stdin.Fd())
+	saved, err := term.MakeRaw(fd)
+	if err != nil {
+		return runNumbered(title, items, multi)
+	}
+	defer term.Restore(fd, saved)
+
+	f := newFinder(title, items, multi)
+	s := &screen{out: os.Stderr}
+	defer s.clear()
+
+	buf := make([]byte, 256)
+	for {
+		s.draw(f.lines(terminalWidth()))
+		n, err := common.Stdin.Read(buf)
+		if err != nil {
+			return nil, err
+		}
+		switch f.handle(buf[:n]) {
+		case actionDone:
+			return f.result(), nil
+		case actionCancel:
+			return nil, errors.New(i18n.T("選択をキャンセルしました"))
+		}
+	}
+}
+
+// runNumbered は一覧を番号付きで表示し、番号の入力で選択させる
+func runNumbered(title string, items []Item, multi bool) ([]Item, error) {
+	fmt.Fprintln(os.Stderr, title)
+	for i, item := range items {
+		fmt.Fprintf(os.Stderr, "  %d. %s\n", i+1, item.display())
+	}
+	if multi {
+		fmt.Fprintf(os.Stderr, i18n.T("番号を入力してください（カンマ区切りで複数指定可） (1-%d): "), len(items))
+	} else {
+		fmt.Fprintf(os.Stderr, i18n.T("番号を入力してください (1-%d): "), len(items))
+	}
+
+	input, err := common.Stdin.ReadString('\n')
+	if err != nil && input == "" {
+		return nil, fmt.Errorf(i18n.T("入力の読み取りに失敗しました: %w"), err)
+	}
+	fields := strings.Split(strings.TrimSpace(input), ",")
+	if !multi && len(fields) > 1 {
+		return nil, fmt.Errorf(i18n.T("無効な選択です: %s"), strings.TrimSpace(input))
+	}
+
+	var selected []Item
+	seen := make(map[int]bool)
+	for _, field := range fields {
+		field = strings.TrimSpace(field)
+		n, err := strconv.Atoi(field)
+		if err != nil || n < 1 || n > len(items) {
+			return nil, fmt.Errorf(i18n.T("無効な選択です: %s"), field)
+		}
+		if !seen[n] {
+			seen[n] = true
+			selected = append(selected, items[n-1])
+		}
+	}
+	return selected, nil
+}
+
+// terminalWidth は標準エラー出力の端末の幅を返す（取得できない場合は80）
+func terminalWidth() int {
+	width, _, err := term.GetSize(int(os.Stderr.Fd()))
+	if err != nil || width <= 0 {
+		return 80
+	}
+	return width
+}
@@ -0,0 +1,24 @@
+package selector
+
+// Item は選択肢
+type Item struct {
+	Value string // 選択時に返す値（リソースID・名前等）
+	Label string // 一覧に表示し、絞り込みの対象にする文字列（省略時は Value）
+}
+
+// display は一覧に表示する文字列を返す
+func (i Item) display() string {
+	if i.Label == "" {
+		return i.Value
+	}
+	return i.Label
+}
+
+// Values は選択肢の値の一覧を返す
+func Values(items []Item) []string {
+	values := make([]string, 0, len(items))
+	for _, item := range items {
+		values = append(values, item.Value)
+	}
+	return values
+}
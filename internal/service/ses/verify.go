@@ -1,28 +1,32 @@
 package ses
 
 import (
-	"awstk/internal/service/common"
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 	"sync"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ses"
 )
 
 // VerifyEmailsFromFile はファイルからメールアドレスを読み込んで検証する
-func VerifyEmailsFromFile(opts VerifyOptions) (*VerifyResult, error) {
+func VerifyEmailsFromFile(ctx context.Context, opts VerifyOptions) (*VerifyResult, error) {
 	// ファイルからメールアドレスを読み込み
 	emails, err := readEmailsFromFile(opts.FilePath)
 	if err != nil {
-		return nil, fmt.Errorf("ファイル読み込みエラー: %w", err)
+		return nil, fmt.Errorf(i18n.T("ファイル読み込みエラー: %w"), err)
 	}
 
 	if len(emails) == 0 {
-		return nil, fmt.Errorf("ファイルにメールアドレスが見つかりませんでした")
+		return nil, errors.New(i18n.T("ファイルにメールアドレスが見つかりませんでした"))
 	}
 
 	originalCount := len(emails)
@@ -32,9 +36,9 @@ func VerifyEmailsFromFile(opts VerifyOptions) (*VerifyResult, error) {
 	duplicateRemoved := originalCount - len(emails)
 
 	// メールアドレスを検証
-	failedEmails, details, err := verifySesEmails(opts.SesClient, emails)
+	failedEmails, details, err := verifySesEmails(ctx, opts.SesClient, emails)
 	if err != nil {
-		return nil, fmt.Errorf("SES検証エラー: %w", err)
+		return nil, fmt.Errorf(i18n.T("SES検証エラー: %w"), err)
 	}
 
 	result := &VerifyResult{
@@ -56,7 +60,7 @@ func readEmailsFromFile(filename string) ([]string, error) {
 	}
 	defer func() {
 		if err := file.Close(); err != nil {
-			fmt.Printf("⚠️  ファイルのクローズに失敗: %v\n", err)
+			logging.Warnf(i18n.T("⚠️  ファイルのクローズに失敗: %v\n"), err)
 		}
 	}()
 
@@ -101,16 +105,13 @@ func removeDuplicateEmails(emails []string) []string {
 }
 
 // verifySesEmails 指定されたメールアドレス一覧をSESで検証する
-func verifySesEmails(sesClient *ses.Client, emails []string) ([]string, []EmailVerificationDetail, error) {
+func verifySesEmails(ctx context.Context, sesClient *ses.Client, emails []string) ([]string, []EmailVerificationDetail, error) {
 	if len(emails) == 0 {
 		return nil, nil, nil
 	}
 
-	// 並列実行数を設定（最大10並列）
-	maxWorkers := 10
-	if len(emails) < maxWorkers {
-		maxWorkers = len(emails)
-	}
+	// 並列実行数を設定（既定は最大10並列、--concurrency または設定ファイルの concurrency で変更可）
+	maxWorkers := common.WorkerCount(10, len(emails))
 
 	executor := common.NewParallelExecutor(maxWorkers)
 	details := make([]EmailVerificationDetail, len(emails))
@@ -118,14 +119,17 @@ func verifySesEmails(sesClient *ses.Client, emails []string) ([]string, []EmailV
 	failedEmailsMutex := &sync.Mutex{}
 	var failedEmails []string
 
-	fmt.Printf("🚀 %d件のメールアドレスを最大%d並列で検証します...\n\n", len(emails), maxWorkers)
+	common.Infof(ctx, i18n.T("🚀 %d件のメールアドレスを最大%d並列で検証します...\n\n"), len(emails), maxWorkers)
 
 	for i, email := range emails {
 		idx := i
 		emailAddr := email
 		executor.Execute(func() {
-			_, err := sesClient.VerifyEmailIdentity(context.Background(), &ses.VerifyEmailIdentityInput{
-				EmailAddress: aws.String(emailAddr),
+			err := common.Retry(ctx, emailAddr, func() error {
+				_, err := sesClient.VerifyEmailIdentity(ctx, &ses.VerifyEmailIdentityInput{
+					EmailAddress: aws.String(emailAddr),
+				})
+				return err
 			})
 
 			detail := EmailVerificationDetail{
@@ -154,7 +158,7 @@ func verifySesEmails(sesClient *ses.Client, emails []string) ([]string, []EmailV
 // DisplayVerifyResult は検証結果を表示する
 func DisplayVerifyResult(result *VerifyResult) {
 	// 成功したメールアドレス
-	fmt.Printf("✅ 検証成功: %d件\n", result.SuccessfulEmails)
+	fmt.Printf(i18n.T("✅ 検証成功: %d件\n"), result.SuccessfulEmails)
 	for _, detail := range result.VerificationDetails {
 		if detail.Success {
 			fmt.Printf("  - %s\n", detail.Email)
@@ -163,7 +167,7 @@ func DisplayVerifyResult(result *VerifyResult) {
 
 	// 失敗したメールアドレス
 	if len(result.FailedEmails) > 0 {
-		fmt.Printf("\n❌ 検証失敗: %d件\n", len(result.FailedEmails))
+		fmt.Printf(i18n.T("\n❌ 検証失敗: %d件\n"), len(result.FailedEmails))
 		for _, email := range result.FailedEmails {
 			fmt.Printf("  - %s\n", email)
 		}
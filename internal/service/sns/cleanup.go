@@ -0,0 +1,99 @@
+package sns
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+)
+
+// GetSnsTopicsByFilter はフィルターに一致するSNSトピック名の一覧を取得します
+// exact が true の場合、大文字小文字を区別します
+// tags が指定された場合は、タグ条件にも一致するトピックのみを返します
+// SNSトピックは作成日時を取得できないため、age が指定された場合は対象外になります
+func GetSnsTopicsByFilter(ctx context.Context, client SnsApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
+	topicArns, err := listTopicArns(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	var matched []string
+	for name := range topicArns {
+		if common.MatchesFilter(name, searchString, exact) {
+			matched = append(matched, name)
+		}
+	}
+	sort.Strings(matched)
+	matched = common.SelectByAge(age, matched, func(string) (*time.Time, *time.Time) {
+		return nil, nil
+	})
+
+	// SNSのARNのリソース部分はトピック名のみ
+	matched, err = tagging.Select(ctx, tags, common.Target{}, tagging.TypeSnsTopic, matched, func(name string) string { return name })
+	if err != nil {
+		return nil, err
+	}
+
+	foundTopics := []string{}
+	for _, name := range matched {
+		foundTopics = append(foundTopics, name)
+		common.Progressf(ctx, common.EventInfo, name, i18n.T("🔍 検出されたSNSトピック: %s\n"), name)
+	}
+	return foundTopics, nil
+}
+
+// listTopicArns はトピック名をキーにトピックのARNを取得します
+func listTopicArns(ctx context.Context, client SnsApi) (map[string]string, error) {
+	topicArns := make(map[string]string)
+	paginator := sns.NewListTopicsPaginator(client, &sns.ListTopicsInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("snsトピック一覧取得エラー: %w"), err)
+		}
+		for _, topic := range output.Topics {
+			arn := aws.ToString(topic.TopicArn)
+			topicArns[TopicNameFromArn(arn)] = arn
+		}
+	}
+	return topicArns, nil
+}
+
+// CleanupSnsTopics は指定したSNSトピック一覧を削除します（トピックのサブスクリプションも削除されます）
+func CleanupSnsTopics(ctx context.Context, client SnsApi, topicNames []string) common.CleanupResult {
+	topicNames = guardrail.ExcludeNames(ctx, i18n.T("SNSトピック"), tagging.TypeSnsTopic, topicNames, func(name string) string { return name })
+	if len(topicNames) == 0 {
+		return common.CleanupResult{ResourceType: i18n.T("SNSトピック"), Deleted: []string{}, Failed: []string{}}
+	}
+
+	// 削除にはARNが必要なため、トピック名から引けるようにする（取得に失敗した場合は各トピックの削除の失敗として扱う）
+	topicArns, listErr := listTopicArns(ctx, client)
+
+	result := common.DeleteInParallel(ctx, i18n.T("SNSトピック"), topicNames, 10, func(name string) error {
+		if listErr != nil {
+			return listErr
+		}
+		arn, ok := topicArns[name]
+		if !ok {
+			return fmt.Errorf(i18n.T("トピック %s が見つかりません"), name)
+		}
+		_, err := client.DeleteTopic(ctx, &sns.DeleteTopicInput{TopicArn: aws.String(arn)})
+		return err
+	})
+	result.SetArns(func(name string) string { return topicArns[name] })
+	return result
+}
+
+// TopicNameFromArn はトピックのARNからトピック名を取り出します（CloudFormationの物理IDはトピックのARN）
+func TopicNameFromArn(topicArn string) string {
+	return topicArn[strings.LastIndex(topicArn, ":")+1:]
+}
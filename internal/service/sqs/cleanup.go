@@ -0,0 +1,106 @@
+package sqs
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/sqs/types"
+)
+
+// GetSqsQueuesByFilter はフィルターに一致するSQSキュー名の一覧を取得します
+// exact が true の場合、大文字小文字を区別します
+// tags が指定された場合は、タグ条件にも一致するキューのみを返します
+// age が指定された場合は、作成日時の経過時間にも一致するキューのみを返します（作成日時の取得のため、キューごとにGetQueueAttributesを呼び出します）
+func GetSqsQueuesByFilter(ctx context.Context, client SqsApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
+	urlsByName := make(map[string]string)
+	var matched []string
+	paginator := sqs.NewListQueuesPaginator(client, &sqs.ListQueuesInput{MaxResults: aws.Int32(1000)})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("sqsキュー一覧取得エラー: %w"), err)
+		}
+		for _, url := range output.QueueUrls {
+			name := QueueNameFromUrl(url)
+			if common.MatchesFilter(name, searchString, exact) {
+				urlsByName[name] = url
+				matched = append(matched, name)
+			}
+		}
+	}
+
+	// SQSのARNのリソース部分はキュー名のみ
+	matched, err := tagging.Select(ctx, tags, common.Target{}, tagging.TypeSqsQueue, matched, func(name string) string { return name })
+	if err != nil {
+		return nil, err
+	}
+
+	// 経過時間で絞り込み（作成日時は一覧に含まれないため、条件が指定された場合のみ取得する）
+	created := make(map[string]*time.Time)
+	if age.Enabled() {
+		for _, name := range matched {
+			t, err := getQueueCreatedTime(ctx, client, urlsByName[name])
+			if err != nil {
+				return nil, fmt.Errorf(i18n.T("sqsキューの属性取得エラー (%s): %w"), name, err)
+			}
+			created[name] = t
+		}
+	}
+	matched = common.SelectByAge(age, matched, func(name string) (*time.Time, *time.Time) {
+		return created[name], nil
+	})
+
+	foundQueues := []string{}
+	for _, name := range matched {
+		foundQueues = append(foundQueues, name)
+		common.Progressf(ctx, common.EventInfo, name, i18n.T("🔍 検出されたSQSキュー: %s\n"), age.Annotate(name, created[name], nil))
+	}
+	return foundQueues, nil
+}
+
+// getQueueCreatedTime はキューの作成日時を取得します
+func getQueueCreatedTime(ctx context.Context, client SqsApi, queueUrl string) (*time.Time, error) {
+	output, err := client.GetQueueAttributes(ctx, &sqs.GetQueueAttributesInput{
+		QueueUrl:       aws.String(queueUrl),
+		AttributeNames: []types.QueueAttributeName{types.QueueAttributeNameCreatedTimestamp},
+	})
+	if err != nil {
+		return nil, err
+	}
+	seconds, err := strconv.ParseInt(output.Attributes[string(types.QueueAttributeNameCreatedTimestamp)], 10, 64)
+	if err != nil {
+		return nil, nil
+	}
+	t := time.Unix(seconds, 0)
+	return &t, nil
+}
+
+// CleanupSqsQueues は指定したSQSキュー一覧を削除します
+// 削除したキューと同じ名前のキューは、削除から60秒間は作成できません
+func CleanupSqsQueues(ctx context.Context, client SqsApi, queueNames []string) common.CleanupResult {
+	queueNames = guardrail.ExcludeNames(ctx, i18n.T("SQSキュー"), tagging.TypeSqsQueue, queueNames, func(name string) string { return name })
+
+	return common.DeleteInParallel(ctx, i18n.T("SQSキュー"), queueNames, 10, func(name string) error {
+		urlOutput, err := client.GetQueueUrl(ctx, &sqs.GetQueueUrlInput{QueueName: aws.String(name)})
+		if err != nil {
+			return err
+		}
+		_, err = client.DeleteQueue(ctx, &sqs.DeleteQueueInput{QueueUrl: urlOutput.QueueUrl})
+		return err
+	})
+}
+
+// QueueNameFromUrl はキューのURLからキュー名を取り出します（CloudFormationの物理IDはキューのURL）
+func QueueNameFromUrl(queueUrl string) string {
+	return queueUrl[strings.LastIndex(queueUrl, "/")+1:]
+}
@@ -0,0 +1,70 @@
+package ssm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
+)
+
+// GetParametersByFilter はフィルターに一致するSSMパラメータ名の一覧を取得します
+// exact が true の場合、大文字小文字を区別します
+// tags が指定された場合は、タグ条件にも一致するパラメータのみを返します
+// age が指定された場合は、最終更新日時の経過時間にも一致するパラメータのみを返します（パラメータは作成日時を取得できないため、常に最終更新日時で判定します）
+func GetParametersByFilter(ctx context.Context, client SsmApi, searchString string, exact bool, tags *tagging.Selector, age *common.AgeFilter) ([]string, error) {
+	var matched []types.ParameterMetadata
+	paginator := ssm.NewDescribeParametersPaginator(client, &ssm.DescribeParametersInput{})
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("ssmパラメータ一覧取得エラー: %w"), err)
+		}
+		for _, param := range output.Parameters {
+			if common.MatchesFilter(aws.ToString(param.Name), searchString, exact) {
+				matched = append(matched, param)
+			}
+		}
+	}
+
+	matched = common.SelectByAge(age, matched, func(p types.ParameterMetadata) (*time.Time, *time.Time) {
+		return p.LastModifiedDate, nil
+	})
+	matched, err := tagging.Select(ctx, tags, common.Target{}, tagging.TypeSsmParameter, matched, func(p types.ParameterMetadata) string {
+		return parameterKey(aws.ToString(p.Name))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	foundParams := []string{}
+	for _, param := range matched {
+		name := aws.ToString(param.Name)
+		foundParams = append(foundParams, name)
+		common.Progressf(ctx, common.EventInfo, name, i18n.T("🔍 検出されたSSMパラメータ: %s\n"), age.Annotate(name, param.LastModifiedDate, nil))
+	}
+	return foundParams, nil
+}
+
+// CleanupParameters は指定したSSMパラメータ一覧を削除します
+func CleanupParameters(ctx context.Context, client SsmApi, paramNames []string) common.CleanupResult {
+	paramNames = guardrail.ExcludeNames(ctx, i18n.T("SSMパラメータ"), tagging.TypeSsmParameter, paramNames, parameterKey)
+
+	return common.DeleteInParallel(ctx, i18n.T("SSMパラメータ"), paramNames, 10, func(name string) error {
+		return deleteParameter(ctx, client, name)
+	})
+}
+
+// parameterKey はタグ・ガードレールの確認に使用するARNのリソース部分を返す
+// 階層化されたパラメータ（/で始まる名前）はARNでは先頭の/が1つにまとめられる
+func parameterKey(name string) string {
+	return "parameter/" + strings.TrimPrefix(name, "/")
+}
@@ -3,103 +3,145 @@ package ssm
 import (
 	"bufio"
 	"context"
+	"errors"
 	"fmt"
 	"os"
 	"strings"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/confirm"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 )
 
 // DeleteParametersFromFile はファイルからパラメータ名を読み込んでParameter Storeから削除する
-func DeleteParametersFromFile(ssmClient *ssm.Client, opts DeleteParamsOptions) error {
-	// ファイルの存在確認
-	if _, err := os.Stat(opts.FilePath); os.IsNotExist(err) {
-		return fmt.Errorf("ファイルが見つかりません: %s", opts.FilePath)
-	}
-
-	// パラメータ名の読み込み
-	paramNames, err := loadParameterNamesFromFile(opts.FilePath)
+// Tags が指定された場合は、タグ条件に一致するパラメータのみを削除する（FilePath を省略した場合はすべてのパラメータが対象）
+func DeleteParametersFromFile(ctx context.Context, ssmClient *ssm.Client, opts DeleteParamsOptions) error {
+	paramNames, err := loadParameterNames(ctx, ssmClient, opts)
 	if err != nil {
-		return fmt.Errorf("ファイルの読み込みに失敗しました: %w", err)
+		return err
 	}
 
 	if len(paramNames) == 0 {
-		return fmt.Errorf("削除するパラメータが見つかりません")
-	}
-
-	// プレフィックスの適用
-	if opts.Prefix != "" {
-		for i := range paramNames {
-			paramNames[i] = normalizeParameterName(opts.Prefix, paramNames[i])
-		}
+		return errors.New(i18n.T("削除するパラメータが見つかりません"))
 	}
 
 	// ドライランの場合は内容を表示して終了
 	if opts.DryRun {
-		fmt.Println("🗑️  以下のパラメータが削除されます:")
-		fmt.Println(strings.Repeat("-", 80))
+		common.Infof(ctx, "%s\n", i18n.T("🗑️  以下のパラメータが削除されます:"))
+		common.Infof(ctx, "%s\n", strings.Repeat("-", 80))
 		for _, name := range paramNames {
-			fmt.Printf("  %s\n", name)
+			common.Infof(ctx, "  %s\n", name)
 		}
-		fmt.Println(strings.Repeat("-", 80))
-		fmt.Printf("📊 合計: %d 件\n", len(paramNames))
+		common.Infof(ctx, "%s\n", strings.Repeat("-", 80))
+		common.Infof(ctx, i18n.T("📊 合計: %d 件\n"), len(paramNames))
 		return nil
 	}
 
 	// 確認プロンプト（--forceでない場合）
 	if !opts.Force {
-		fmt.Printf("⚠️  %d 件のパラメータを削除しようとしています。\n", len(paramNames))
-		fmt.Print("本当に削除しますか？ [y/N]: ")
-
-		var response string
-		if _, err := fmt.Scanln(&response); err != nil {
-			fmt.Printf("⚠️  入力エラー: %v\n", err)
-			fmt.Println("削除をキャンセルしました。")
-			return nil
+		ok, err := confirm.Ask(confirm.Request{
+			Message: fmt.Sprintf(i18n.T("⚠️  %d 件のパラメータを削除しようとしています。"), len(paramNames)),
+			Targets: paramNames,
+			Prompt:  i18n.T("本当に削除しますか？"),
+		})
+		if err != nil {
+			return err
 		}
-		if strings.ToLower(response) != "y" {
-			fmt.Println("削除をキャンセルしました。")
+		if !ok {
+			common.Infof(ctx, "%s\n", i18n.T("削除をキャンセルしました。"))
 			return nil
 		}
 	}
 
 	// パラメータの削除
 	var successCount, failCount, notFoundCount int
+	results := make([]common.ProcessResult, 0, len(paramNames))
 	for _, name := range paramNames {
-		err := deleteParameter(ssmClient, name)
+		err := common.Retry(ctx, name, func() error {
+			return deleteParameter(ctx, ssmClient, name)
+		})
 		if err != nil {
 			if strings.Contains(err.Error(), "ParameterNotFound") {
-				fmt.Printf("⚠️  %s は存在しません（スキップ）\n", name)
+				common.Warnf(ctx, i18n.T("⚠️  %s は存在しません（スキップ）\n"), name)
 				notFoundCount++
+				results = append(results, common.SkippedResult(name, err))
 			} else {
-				fmt.Printf("❌ %s の削除に失敗しました: %v\n", name, err)
+				common.Warnf(ctx, i18n.T("❌ %s の削除に失敗しました: %v\n"), name, err)
 				failCount++
+				results = append(results, common.ProcessResult{Item: name, Success: false, Error: err})
 			}
 		} else {
-			fmt.Printf("✅ %s を削除しました\n", name)
+			common.Infof(ctx, i18n.T("✅ %s を削除しました\n"), name)
 			successCount++
+			results = append(results, common.ProcessResult{Item: name, Success: true})
 		}
 	}
+	common.RecordResults(i18n.T("SSMパラメータ"), common.ActionDelete, results)
 
-	fmt.Printf("\n📊 削除結果: 成功 %d / 失敗 %d / 存在しない %d / 合計 %d\n",
+	common.Infof(ctx, i18n.T("\n📊 削除結果: 成功 %d / 失敗 %d / 存在しない %d / 合計 %d\n"),
 		successCount, failCount, notFoundCount, len(paramNames))
 
 	if failCount > 0 {
-		return fmt.Errorf("%d 件のパラメータ削除に失敗しました", failCount)
+		return fmt.Errorf(i18n.T("%d 件のパラメータ削除に失敗しました"), failCount)
 	}
 
 	return nil
 }
 
+// loadParameterNames は削除対象のパラメータ名を読み込み、プレフィックスとタグ条件を適用する
+func loadParameterNames(ctx context.Context, ssmClient *ssm.Client, opts DeleteParamsOptions) ([]string, error) {
+	if opts.FilePath == "" {
+		paramNames, err := GetParametersByFilter(ctx, ssmClient, "", false, opts.Tags, nil)
+		if err != nil {
+			return nil, err
+		}
+		if opts.Prefix == "" {
+			return paramNames, nil
+		}
+		prefix := normalizeParameterName(opts.Prefix, "")
+		var matched []string
+		for _, name := range paramNames {
+			if strings.HasPrefix(name, prefix) {
+				matched = append(matched, name)
+			}
+		}
+		return matched, nil
+	}
+
+	// ファイルの存在確認
+	if _, err := os.Stat(opts.FilePath); os.IsNotExist(err) {
+		return nil, fmt.Errorf(i18n.T("ファイルが見つかりません: %s"), opts.FilePath)
+	}
+
+	// パラメータ名の読み込み
+	paramNames, err := loadParameterNamesFromFile(opts.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf(i18n.T("ファイルの読み込みに失敗しました: %w"), err)
+	}
+
+	// プレフィックスの適用
+	if opts.Prefix != "" {
+		for i := range paramNames {
+			paramNames[i] = normalizeParameterName(opts.Prefix, paramNames[i])
+		}
+	}
+
+	return tagging.Select(ctx, opts.Tags, common.Target{}, tagging.TypeSsmParameter, paramNames, parameterKey)
+}
+
 // loadParameterNamesFromFile はファイルからパラメータ名を読み込む
 func loadParameterNamesFromFile(filePath string) ([]string, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("ファイルを開けません: %w", err)
+		return nil, fmt.Errorf(i18n.T("ファイルを開けません: %w"), err)
 	}
 	defer func() {
 		if err := file.Close(); err != nil {
-			fmt.Printf("⚠️  ファイルのクローズに失敗: %v\n", err)
+			logging.Warnf(i18n.T("⚠️  ファイルのクローズに失敗: %v\n"), err)
 		}
 	}()
 
@@ -118,7 +160,7 @@ func loadParameterNamesFromFile(filePath string) ([]string, error) {
 
 		// パラメータ名の妥当性チェック
 		if !isValidParameterName(line) {
-			fmt.Printf("⚠️  行 %d: 無効なパラメータ名をスキップ: %s\n", lineNum, line)
+			logging.Warnf(i18n.T("⚠️  行 %d: 無効なパラメータ名をスキップ: %s\n"), lineNum, line)
 			continue
 		}
 
@@ -126,7 +168,7 @@ func loadParameterNamesFromFile(filePath string) ([]string, error) {
 	}
 
 	if err := scanner.Err(); err != nil {
-		return nil, fmt.Errorf("ファイルの読み込みエラー: %w", err)
+		return nil, fmt.Errorf(i18n.T("ファイルの読み込みエラー: %w"), err)
 	}
 
 	return paramNames, nil
@@ -153,11 +195,11 @@ func isValidParameterName(name string) bool {
 }
 
 // deleteParameter は単一のパラメータをParameter Storeから削除する
-func deleteParameter(client *ssm.Client, name string) error {
+func deleteParameter(ctx context.Context, client SsmApi, name string) error {
 	input := &ssm.DeleteParameterInput{
 		Name: &name,
 	}
 
-	_, err := client.DeleteParameter(context.Background(), input)
+	_, err := client.DeleteParameter(ctx, input)
 	return err
 }
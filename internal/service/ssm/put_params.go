@@ -1,10 +1,10 @@
 package ssm
 
 import (
-	"awstk/internal/service/common"
 	"context"
 	"encoding/csv"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"os"
@@ -12,26 +12,30 @@ import (
 	"strings"
 	"sync"
 
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/logging"
+	"github.com/ngsw877/awstk/internal/service/common"
+
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/ssm"
 	"github.com/aws/aws-sdk-go-v2/service/ssm/types"
 )
 
 // PutParametersFromFile はファイルからパラメータを読み込んでParameter Storeに登録する
-func PutParametersFromFile(ssmClient *ssm.Client, opts PutParamsOptions) error {
+func PutParametersFromFile(ctx context.Context, ssmClient *ssm.Client, opts PutParamsOptions) error {
 	// ファイルの存在確認
 	if _, err := os.Stat(opts.FilePath); os.IsNotExist(err) {
-		return fmt.Errorf("ファイルが見つかりません: %s", opts.FilePath)
+		return fmt.Errorf(i18n.T("ファイルが見つかりません: %s"), opts.FilePath)
 	}
 
 	// パラメータの読み込み
 	params, err := loadParametersFromFile(opts.FilePath)
 	if err != nil {
-		return fmt.Errorf("ファイルの読み込みに失敗しました: %w", err)
+		return fmt.Errorf(i18n.T("ファイルの読み込みに失敗しました: %w"), err)
 	}
 
 	if len(params) == 0 {
-		return fmt.Errorf("登録するパラメータが見つかりません")
+		return errors.New(i18n.T("登録するパラメータが見つかりません"))
 	}
 
 	// プレフィックスの適用
@@ -43,49 +47,56 @@ func PutParametersFromFile(ssmClient *ssm.Client, opts PutParamsOptions) error {
 
 	// ドライランの場合は内容を表示して終了
 	if opts.DryRun {
-		fmt.Println("📋 以下のパラメータが登録されます:")
-		fmt.Println(strings.Repeat("-", 80))
+		common.Infof(ctx, "%s\n", i18n.T("📋 以下のパラメータが登録されます:"))
+		common.Infof(ctx, "%s\n", strings.Repeat("-", 80))
 		for _, param := range params {
-			fmt.Printf("Name: %s\n", param.Name)
-			fmt.Printf("Type: %s\n", param.Type)
+			common.Infof(ctx, "Name: %s\n", param.Name)
+			common.Infof(ctx, "Type: %s\n", param.Type)
 			if param.Type != "SecureString" {
-				fmt.Printf("Value: %s\n", param.Value)
+				common.Infof(ctx, "Value: %s\n", param.Value)
 			} else {
-				fmt.Printf("Value: ****** (SecureString)\n")
+				common.Infof(ctx, "Value: ****** (SecureString)\n")
 			}
 			if param.Description != "" {
-				fmt.Printf("Description: %s\n", param.Description)
+				common.Infof(ctx, "Description: %s\n", param.Description)
 			}
-			fmt.Println(strings.Repeat("-", 80))
+			common.Infof(ctx, "%s\n", strings.Repeat("-", 80))
 		}
 		return nil
 	}
 
-	// 並列実行数を設定（最大10並列）
-	maxWorkers := 10
-	if len(params) < maxWorkers {
-		maxWorkers = len(params)
-	}
+	// 並列実行数を設定（既定は最大10並列、--concurrency または設定ファイルの concurrency で変更可）
+	maxWorkers := common.WorkerCount(10, len(params))
 
 	executor := common.NewParallelExecutor(maxWorkers)
 	results := make([]common.ProcessResult, len(params))
 	resultsMutex := &sync.Mutex{}
 
-	fmt.Printf("🚀 %d個のパラメータを最大%d並列で登録します...\n\n", len(params), maxWorkers)
+	common.Infof(ctx, i18n.T("🚀 %d個のパラメータを最大%d並列で登録します...\n\n"), len(params), maxWorkers)
 
 	// パラメータの登録
 	for i, param := range params {
 		idx := i
 		p := param
 		executor.Execute(func() {
-			err := putParameter(ssmClient, p)
+			// 中断された場合は未実行として記録
+			if ctx.Err() != nil {
+				resultsMutex.Lock()
+				results[idx] = common.SkippedResult(p.Name, ctx.Err())
+				resultsMutex.Unlock()
+				return
+			}
+
+			err := common.Retry(ctx, p.Name, func() error {
+				return putParameter(ctx, ssmClient, p)
+			})
 
 			resultsMutex.Lock()
 			if err != nil {
-				fmt.Printf("❌ %s の登録に失敗しました: %v\n", p.Name, err)
+				common.Warnf(ctx, i18n.T("❌ %s の登録に失敗しました: %v\n"), p.Name, err)
 				results[idx] = common.ProcessResult{Item: p.Name, Success: false, Error: err}
 			} else {
-				fmt.Printf("✅ %s を登録しました\n", p.Name)
+				common.Infof(ctx, i18n.T("✅ %s を登録しました\n"), p.Name)
 				results[idx] = common.ProcessResult{Item: p.Name, Success: true}
 			}
 			resultsMutex.Unlock()
@@ -95,11 +106,17 @@ func PutParametersFromFile(ssmClient *ssm.Client, opts PutParamsOptions) error {
 	executor.Wait()
 
 	// 結果の集計
+	common.RecordResults(i18n.T("SSMパラメータ"), common.ActionPut, results)
 	successCount, failCount := common.CollectResults(results)
-	fmt.Printf("\n📊 登録結果: 成功 %d / 失敗 %d / 合計 %d\n", successCount, failCount, len(params))
+	common.Infof(ctx, i18n.T("\n📊 登録結果: 成功 %d / 失敗 %d / 合計 %d\n"), successCount, failCount, len(params))
+
+	if skipped := common.CountSkipped(results); skipped > 0 {
+		common.Warnf(ctx, i18n.T("⚠️  中断により %d 件のパラメータは登録していません\n"), skipped)
+		return fmt.Errorf(i18n.T("パラメータ登録が中断されました: %w"), ctx.Err())
+	}
 
 	if failCount > 0 {
-		return fmt.Errorf("%d 件のパラメータ登録に失敗しました", failCount)
+		return fmt.Errorf(i18n.T("%d 件のパラメータ登録に失敗しました"), failCount)
 	}
 
 	return nil
@@ -115,7 +132,7 @@ func loadParametersFromFile(filePath string) ([]parameter, error) {
 	case ".csv":
 		return loadParametersFromCSV(filePath)
 	default:
-		return nil, fmt.Errorf("サポートされていないファイル形式: %s", ext)
+		return nil, fmt.Errorf(i18n.T("サポートされていないファイル形式: %s"), ext)
 	}
 }
 
@@ -123,24 +140,24 @@ func loadParametersFromFile(filePath string) ([]parameter, error) {
 func loadParametersFromJSON(filePath string) ([]parameter, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("ファイルを開けません: %w", err)
+		return nil, fmt.Errorf(i18n.T("ファイルを開けません: %w"), err)
 	}
 	defer func() {
 		if err := file.Close(); err != nil {
-			fmt.Printf("⚠️  ファイルのクローズに失敗: %v\n", err)
+			logging.Warnf(i18n.T("⚠️  ファイルのクローズに失敗: %v\n"), err)
 		}
 	}()
 
 	var paramFile parametersFile
 	decoder := json.NewDecoder(file)
 	if err := decoder.Decode(&paramFile); err != nil {
-		return nil, fmt.Errorf("JSONの解析に失敗しました: %w", err)
+		return nil, fmt.Errorf(i18n.T("JSONの解析に失敗しました: %w"), err)
 	}
 
 	// バリデーション
 	for i, param := range paramFile.Parameters {
 		if err := validateParameter(param); err != nil {
-			return nil, fmt.Errorf("パラメータ[%d]のバリデーションエラー: %w", i, err)
+			return nil, fmt.Errorf(i18n.T("パラメータ[%d]のバリデーションエラー: %w"), i, err)
 		}
 	}
 
@@ -151,11 +168,11 @@ func loadParametersFromJSON(filePath string) ([]parameter, error) {
 func loadParametersFromCSV(filePath string) ([]parameter, error) {
 	file, err := os.Open(filePath)
 	if err != nil {
-		return nil, fmt.Errorf("ファイルを開けません: %w", err)
+		return nil, fmt.Errorf(i18n.T("ファイルを開けません: %w"), err)
 	}
 	defer func() {
 		if err := file.Close(); err != nil {
-			fmt.Printf("⚠️  ファイルのクローズに失敗: %v\n", err)
+			logging.Warnf(i18n.T("⚠️  ファイルのクローズに失敗: %v\n"), err)
 		}
 	}()
 
@@ -164,17 +181,17 @@ func loadParametersFromCSV(filePath string) ([]parameter, error) {
 	// ヘッダー行を読み込む
 	headers, err := reader.Read()
 	if err != nil {
-		return nil, fmt.Errorf("CSVヘッダーの読み込みに失敗しました: %w", err)
+		return nil, fmt.Errorf(i18n.T("CSVヘッダーの読み込みに失敗しました: %w"), err)
 	}
 
 	// ヘッダーの検証
 	expectedHeaders := []string{"name", "value", "type", "description"}
 	if len(headers) < 3 {
-		return nil, fmt.Errorf("CSVヘッダーが不正です。最低限 name, value, type が必要です")
+		return nil, errors.New(i18n.T("CSVヘッダーが不正です。最低限 name, value, type が必要です"))
 	}
 	for i, expected := range expectedHeaders[:3] {
 		if i < len(headers) && strings.ToLower(headers[i]) != expected {
-			return nil, fmt.Errorf("CSVヘッダーが不正です。期待: %s, 実際: %s", expected, headers[i])
+			return nil, fmt.Errorf(i18n.T("CSVヘッダーが不正です。期待: %s, 実際: %s"), expected, headers[i])
 		}
 	}
 
@@ -186,12 +203,12 @@ func loadParametersFromCSV(filePath string) ([]parameter, error) {
 			break
 		}
 		if err != nil {
-			return nil, fmt.Errorf("CSV行 %d の読み込みに失敗しました: %w", lineNum+1, err)
+			return nil, fmt.Errorf(i18n.T("CSV行 %d の読み込みに失敗しました: %w"), lineNum+1, err)
 		}
 		lineNum++
 
 		if len(record) < 3 {
-			return nil, fmt.Errorf("CSV行 %d のカラム数が不足しています", lineNum)
+			return nil, fmt.Errorf(i18n.T("CSV行 %d のカラム数が不足しています"), lineNum)
 		}
 
 		param := parameter{
@@ -207,7 +224,7 @@ func loadParametersFromCSV(filePath string) ([]parameter, error) {
 
 		// バリデーション
 		if err := validateParameter(param); err != nil {
-			return nil, fmt.Errorf("CSV行 %d のバリデーションエラー: %w", lineNum, err)
+			return nil, fmt.Errorf(i18n.T("CSV行 %d のバリデーションエラー: %w"), lineNum, err)
 		}
 
 		params = append(params, param)
@@ -219,13 +236,13 @@ func loadParametersFromCSV(filePath string) ([]parameter, error) {
 // validateParameter はパラメータのバリデーションを行う
 func validateParameter(param parameter) error {
 	if param.Name == "" {
-		return fmt.Errorf("nameが空です")
+		return errors.New(i18n.T("nameが空です"))
 	}
 	if param.Value == "" {
-		return fmt.Errorf("valueが空です")
+		return errors.New(i18n.T("valueが空です"))
 	}
 	if param.Type == "" {
-		return fmt.Errorf("typeが空です")
+		return errors.New(i18n.T("typeが空です"))
 	}
 
 	// 型の検証
@@ -238,19 +255,19 @@ func validateParameter(param parameter) error {
 		}
 	}
 	if !isValidType {
-		return fmt.Errorf("無効なtype: %s (有効な値: %s)", param.Type, strings.Join(validTypes, ", "))
+		return fmt.Errorf(i18n.T("無効なtype: %s (有効な値: %s)"), param.Type, strings.Join(validTypes, ", "))
 	}
 
 	// パラメータ名の検証
 	if !strings.HasPrefix(param.Name, "/") {
-		return fmt.Errorf("パラメータ名は / で始まる必要があります: %s", param.Name)
+		return fmt.Errorf(i18n.T("パラメータ名は / で始まる必要があります: %s"), param.Name)
 	}
 
 	return nil
 }
 
 // putParameter は単一のパラメータをParameter Storeに登録する
-func putParameter(client *ssm.Client, param parameter) error {
+func putParameter(ctx context.Context, client *ssm.Client, param parameter) error {
 	input := &ssm.PutParameterInput{
 		Name:      aws.String(param.Name),
 		Value:     aws.String(param.Value),
@@ -262,6 +279,6 @@ func putParameter(client *ssm.Client, param parameter) error {
 		input.Description = aws.String(param.Description)
 	}
 
-	_, err := client.PutParameter(context.Background(), input)
+	_, err := client.PutParameter(ctx, input)
 	return err
 }
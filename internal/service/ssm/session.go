@@ -1,10 +1,15 @@
 package ssm
 
 import (
-	"awstk/internal/aws"
-	"awstk/internal/cli"
-	ec2svc "awstk/internal/service/ec2"
+	"context"
 	"fmt"
+	"os"
+
+	"github.com/ngsw877/awstk/internal/aws"
+	"github.com/ngsw877/awstk/internal/cli"
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+	ec2svc "github.com/ngsw877/awstk/internal/service/ec2"
 
 	"github.com/aws/aws-sdk-go-v2/service/ec2"
 )
@@ -22,19 +27,19 @@ func StartSsmSession(awsCtx aws.Context, opts SessionOptions) error {
 }
 
 // SelectAndStartSession はインスタンスを選択してSSMセッションを開始する
-func SelectAndStartSession(awsCtx aws.Context, ec2Client *ec2.Client, instanceId string) error {
+func SelectAndStartSession(ctx context.Context, awsCtx aws.Context, ec2Client *ec2.Client, instanceId string) error {
 	// インスタンスIDが指定されていない場合は、インタラクティブに選択
 	if instanceId == "" {
-		fmt.Println("🖥️  利用可能なEC2インスタンスから選択してください:")
+		fmt.Fprintln(os.Stderr, i18n.T("🖥️  利用可能なEC2インスタンスから選択してください:"))
 
-		selectedInstanceId, err := ec2svc.SelectInstanceInteractively(ec2Client)
+		selectedInstanceId, err := ec2svc.SelectInstanceInteractively(ctx, ec2Client)
 		if err != nil {
-			return fmt.Errorf("❌ インスタンス選択でエラー: %w", err)
+			return fmt.Errorf(i18n.T("❌ インスタンス選択でエラー: %w"), err)
 		}
 		instanceId = selectedInstanceId
 	}
 
-	fmt.Printf("EC2インスタンス (%s) にSSMで接続します...\n", instanceId)
+	common.Infof(ctx, i18n.T("EC2インスタンス (%s) にSSMで接続します...\n"), instanceId)
 
 	opts := SessionOptions{
 		InstanceId: instanceId,
@@ -42,9 +47,9 @@ func SelectAndStartSession(awsCtx aws.Context, ec2Client *ec2.Client, instanceId
 
 	err := StartSsmSession(awsCtx, opts)
 	if err != nil {
-		return fmt.Errorf("❌ SSMセッションの開始に失敗しました: %w", err)
+		return fmt.Errorf(i18n.T("❌ SSMセッションの開始に失敗しました: %w"), err)
 	}
 
-	fmt.Println("✅ SSMセッションを開始しました。")
+	common.Infof(ctx, "%s\n", i18n.T("✅ SSMセッションを開始しました。"))
 	return nil
 }
@@ -1,5 +1,7 @@
 package ssm
 
+import "github.com/ngsw877/awstk/internal/service/tagging"
+
 // SessionOptions SsmSessionOptions はSSMセッション開始のパラメータを格納する構造体
 type SessionOptions struct {
 	InstanceId string
@@ -27,8 +29,9 @@ type parametersFile struct {
 
 // DeleteParamsOptions はパラメータ一括削除のオプション
 type DeleteParamsOptions struct {
-	FilePath string // 必須: JSONファイルのパス
-	Prefix   string // オプション: パラメータ名のプレフィックス
-	DryRun   bool   // オプション: ドライラン実行
-	Force    bool   // オプション: 強制削除フラグ
+	FilePath string            // JSONファイルのパス（Tags を指定しない場合は必須）
+	Prefix   string            // オプション: パラメータ名のプレフィックス
+	DryRun   bool              // オプション: ドライラン実行
+	Force    bool              // オプション: 強制削除フラグ
+	Tags     *tagging.Selector // オプション: タグ条件
 }
@@ -0,0 +1,13 @@
+package tagging
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+)
+
+// TaggingApi はtaggingパッケージが使用するResource Groups Tagging APIのインターフェース
+// *resourcegroupstaggingapi.Client はこのインターフェースを満たす
+type TaggingApi interface {
+	GetResources(ctx context.Context, params *resourcegroupstaggingapi.GetResourcesInput, optFns ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error)
+}
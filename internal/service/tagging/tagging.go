@@ -0,0 +1,213 @@
+package tagging
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+)
+
+// ParseFilters は --tag の指定値をタグ条件に変換する
+func ParseFilters(values []string) ([]Filter, error) {
+	filters := make([]Filter, 0, len(values))
+	for _, v := range values {
+		f, err := parseFilter(v)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, f)
+	}
+	return filters, nil
+}
+
+// parseFilter は1つの --tag の指定値をタグ条件に変換する
+func parseFilter(value string) (Filter, error) {
+	s := strings.TrimSpace(value)
+	var f Filter
+	switch {
+	case strings.HasPrefix(s, "!"):
+		f = Filter{Key: strings.TrimSpace(s[1:]), Negate: true}
+		if strings.Contains(f.Key, "=") {
+			return Filter{}, invalidFilterError(value)
+		}
+	case strings.Contains(s, "!="):
+		key, val, _ := strings.Cut(s, "!=")
+		f = Filter{Key: strings.TrimSpace(key), Value: strings.TrimSpace(val), HasValue: true, Negate: true}
+	case strings.Contains(s, "="):
+		key, val, _ := strings.Cut(s, "=")
+		f = Filter{Key: strings.TrimSpace(key), Value: strings.TrimSpace(val), HasValue: true}
+	default:
+		f = Filter{Key: s}
+	}
+	if f.Key == "" {
+		return Filter{}, invalidFilterError(value)
+	}
+	return f, nil
+}
+
+// invalidFilterError は --tag の指定値が不正な場合のエラーを返す
+func invalidFilterError(value string) error {
+	return fmt.Errorf(i18n.T("--tag の指定が不正です: %s（key=value、key、!key、key!=value のいずれかで指定してください）"), value)
+}
+
+// String はタグ条件を --tag の指定形式で返す
+func (f Filter) String() string {
+	switch {
+	case f.Negate && f.HasValue:
+		return f.Key + "!=" + f.Value
+	case f.Negate:
+		return "!" + f.Key
+	case f.HasValue:
+		return f.Key + "=" + f.Value
+	default:
+		return f.Key
+	}
+}
+
+// Matches はタグの組がタグ条件を満たすか判定する（タグが付いていないリソースは空のタグとして判定する）
+func (f Filter) Matches(tags map[string]string) bool {
+	value, ok := tags[f.Key]
+	matched := ok && (!f.HasValue || value == f.Value)
+	return matched != f.Negate
+}
+
+// Enabled はタグ条件が指定されているかを返す（nil の Selector は条件なしとして扱う）
+func (s *Selector) Enabled() bool {
+	return s != nil && len(s.Filters) > 0
+}
+
+// Matches はタグの組がすべてのタグ条件を満たすか判定する
+func (s *Selector) Matches(tags map[string]string) bool {
+	if !s.Enabled() {
+		return true
+	}
+	for _, f := range s.Filters {
+		if !f.Matches(tags) {
+			return false
+		}
+	}
+	return true
+}
+
+// String はタグ条件を表示用の文字列で返す（例: "env=dev, !keep"）
+func (s *Selector) String() string {
+	if !s.Enabled() {
+		return ""
+	}
+	parts := make([]string, len(s.Filters))
+	for i, f := range s.Filters {
+		parts[i] = f.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// ForTarget は実行対象のアカウント・リージョンでタグを取得するタグ条件を返す
+// 複数アカウントで順に処理する場合など、呼び出し先が実行対象を意識せずに Select できるようにする
+func (s *Selector) ForTarget(target common.Target) *Selector {
+	if !s.Enabled() {
+		return s
+	}
+	newClient := s.NewClient
+	return &Selector{
+		Filters: s.Filters,
+		NewClient: func(t common.Target) TaggingApi {
+			if t.Account == "" {
+				t.Account = target.Account
+			}
+			if t.Region == "" {
+				t.Region = target.Region
+			}
+			return newClient(t)
+		},
+	}
+}
+
+// Select はタグ条件に一致するリソースだけを返す（タグ条件が指定されていない場合はそのまま返す）
+// resourceOf は各リソースのARN、またはARNのリソース部分（例: instance/i-xxxx, db:my-db）を返す
+// Resource Groups Tagging API はタグが付いていないリソースを返さないため、結果に含まれないリソースはタグなしとして判定する
+func Select[T any](ctx context.Context, sel *Selector, target common.Target, resourceType string, items []T, resourceOf func(T) string) ([]T, error) {
+	if !sel.Enabled() || len(items) == 0 {
+		return items, nil
+	}
+
+	tagsByResource, err := FetchTags(ctx, sel.NewClient(target), sel.Filters, resourceType)
+	if err != nil {
+		return nil, err
+	}
+
+	selected := make([]T, 0, len(items))
+	for _, item := range items {
+		if sel.Matches(tagsByResource[ResourceKey(resourceOf(item))]) {
+			selected = append(selected, item)
+		}
+	}
+	return selected, nil
+}
+
+// FetchTags は指定したリソースタイプのリソースのタグをARNのリソース部分（ResourceKey）ごとに取得する
+// 否定形でない条件はAPI側でも絞り込む（同じキーを複数指定した場合はAND条件にならないため、そのキーはAPI側では絞り込まない）
+func FetchTags(ctx context.Context, client TaggingApi, filters []Filter, resourceType string) (map[string]map[string]string, error) {
+	input := &resourcegroupstaggingapi.GetResourcesInput{
+		ResourceTypeFilters: []string{resourceType},
+		TagFilters:          serverSideTagFilters(filters),
+	}
+
+	tagsByResource := make(map[string]map[string]string)
+	paginator := resourcegroupstaggingapi.NewGetResourcesPaginator(client, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(ctx)
+		if err != nil {
+			return nil, fmt.Errorf(i18n.T("タグの取得に失敗: %w"), err)
+		}
+		for _, mapping := range output.ResourceTagMappingList {
+			tags := make(map[string]string, len(mapping.Tags))
+			for _, tag := range mapping.Tags {
+				tags[aws.ToString(tag.Key)] = aws.ToString(tag.Value)
+			}
+			tagsByResource[ResourceKey(aws.ToString(mapping.ResourceARN))] = tags
+		}
+	}
+	return tagsByResource, nil
+}
+
+// serverSideTagFilters はAPI側で絞り込むタグ条件を作成する
+func serverSideTagFilters(filters []Filter) []types.TagFilter {
+	counts := make(map[string]int)
+	for _, f := range filters {
+		if !f.Negate {
+			counts[f.Key]++
+		}
+	}
+
+	var tagFilters []types.TagFilter
+	for _, f := range filters {
+		if f.Negate || counts[f.Key] != 1 {
+			continue
+		}
+		tf := types.TagFilter{Key: aws.String(f.Key)}
+		if f.HasValue {
+			tf.Values = []string{f.Value}
+		}
+		tagFilters = append(tagFilters, tf)
+	}
+	return tagFilters
+}
+
+// ResourceKey はARNのリソース部分を返す（ARNでない場合はそのまま返す）
+// パーティション・リージョン・アカウントは実行対象で決まるため比較に使用しない
+func ResourceKey(s string) string {
+	if !strings.HasPrefix(s, "arn:") {
+		return s
+	}
+	parts := strings.SplitN(s, ":", 6)
+	if len(parts) < 6 {
+		return s
+	}
+	return parts[5]
+}
@@ -0,0 +1,183 @@
+package tagging
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/ngsw877/awstk/internal/service/common"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi/types"
+)
+
+func TestParseFilters(t *testing.T) {
+	tests := []struct {
+		name    string
+		values  []string
+		want    []Filter
+		wantErr bool
+	}{
+		{name: "key=value", values: []string{"env=dev"}, want: []Filter{{Key: "env", Value: "dev", HasValue: true}}},
+		{name: "key", values: []string{"owner"}, want: []Filter{{Key: "owner"}}},
+		{name: "!key", values: []string{"!keep"}, want: []Filter{{Key: "keep", Negate: true}}},
+		{name: "key!=value", values: []string{"env!=prd"}, want: []Filter{{Key: "env", Value: "prd", HasValue: true, Negate: true}}},
+		{name: "前後の空白は無視", values: []string{" env = dev "}, want: []Filter{{Key: "env", Value: "dev", HasValue: true}}},
+		{name: "空の値", values: []string{"env="}, want: []Filter{{Key: "env", HasValue: true}}},
+		{name: "値に = を含む", values: []string{"expr=a=b"}, want: []Filter{{Key: "expr", Value: "a=b", HasValue: true}}},
+		{name: "複数指定", values: []string{"env=dev", "!keep"}, want: []Filter{{Key: "env", Value: "dev", HasValue: true}, {Key: "keep", Negate: true}}},
+		{name: "キーが空", values: []string{"=dev"}, wantErr: true},
+		{name: "! のみ", values: []string{"!"}, wantErr: true},
+		{name: "!key=value は不正", values: []string{"!env=dev"}, wantErr: true},
+		{name: "空文字", values: []string{""}, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ParseFilters(tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !slices.Equal(got, tt.want) {
+				t.Errorf("ParseFilters(%q) = %+v, want %+v", tt.values, got, tt.want)
+			}
+			// 表示用の文字列は指定形式に戻る
+			for i, f := range got {
+				if reparsed, err := ParseFilters([]string{f.String()}); err != nil || reparsed[0] != got[i] {
+					t.Errorf("String() = %q does not round-trip: %+v, %v", f.String(), reparsed, err)
+				}
+			}
+		})
+	}
+}
+
+func TestSelectorMatches(t *testing.T) {
+	tests := []struct {
+		name    string
+		filters []string
+		tags    map[string]string
+		want    bool
+	}{
+		{name: "条件なし", tags: nil, want: true},
+		{name: "値が一致", filters: []string{"env=dev"}, tags: map[string]string{"env": "dev"}, want: true},
+		{name: "値が異なる", filters: []string{"env=dev"}, tags: map[string]string{"env": "stg"}, want: false},
+		{name: "キーのみで値は問わない", filters: []string{"env"}, tags: map[string]string{"env": ""}, want: true},
+		{name: "キーが付いていない", filters: []string{"env"}, tags: map[string]string{"owner": "me"}, want: false},
+		{name: "!key でキーが付いていない", filters: []string{"!keep"}, tags: nil, want: true},
+		{name: "!key でキーが付いている", filters: []string{"!keep"}, tags: map[string]string{"keep": "false"}, want: false},
+		{name: "key!=value で値が異なる", filters: []string{"env!=prd"}, tags: map[string]string{"env": "dev"}, want: true},
+		{name: "key!=value でキーが付いていない", filters: []string{"env!=prd"}, tags: nil, want: true},
+		{name: "key!=value で値が一致", filters: []string{"env!=prd"}, tags: map[string]string{"env": "prd"}, want: false},
+		{name: "すべての条件を満たす", filters: []string{"env=dev", "!keep"}, tags: map[string]string{"env": "dev"}, want: true},
+		{name: "一部の条件を満たさない", filters: []string{"env=dev", "!keep"}, tags: map[string]string{"env": "dev", "keep": "true"}, want: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			filters, err := ParseFilters(tt.filters)
+			if err != nil {
+				t.Fatal(err)
+			}
+			sel := &Selector{Filters: filters}
+			if got := sel.Matches(tt.tags); got != tt.want {
+				t.Errorf("Matches(%v) with %s = %v, want %v", tt.tags, sel, got, tt.want)
+			}
+		})
+	}
+
+	var nilSelector *Selector
+	if nilSelector.Enabled() || !nilSelector.Matches(nil) {
+		t.Error("nil Selector must match everything")
+	}
+}
+
+func TestServerSideTagFilters(t *testing.T) {
+	filters, err := ParseFilters([]string{"env=dev", "owner", "!keep", "team=a", "team=b", "stage!=prd"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := serverSideTagFilters(filters)
+
+	// 否定形と、同じキーを複数指定した条件はAPI側では絞り込まない
+	want := []types.TagFilter{
+		{Key: aws.String("env"), Values: []string{"dev"}},
+		{Key: aws.String("owner")},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("serverSideTagFilters = %+v, want %+v", got, want)
+	}
+	for i := range want {
+		if aws.ToString(got[i].Key) != aws.ToString(want[i].Key) || !slices.Equal(got[i].Values, want[i].Values) {
+			t.Errorf("filter[%d] = %s %v, want %s %v", i, aws.ToString(got[i].Key), got[i].Values, aws.ToString(want[i].Key), want[i].Values)
+		}
+	}
+}
+
+// fakeTagging はページごとにタグの一覧を返す TaggingApi
+type fakeTagging struct {
+	pages  [][]types.ResourceTagMapping
+	inputs []*resourcegroupstaggingapi.GetResourcesInput
+}
+
+func (f *fakeTagging) GetResources(ctx context.Context, params *resourcegroupstaggingapi.GetResourcesInput, optFns ...func(*resourcegroupstaggingapi.Options)) (*resourcegroupstaggingapi.GetResourcesOutput, error) {
+	f.inputs = append(f.inputs, params)
+	page := len(f.inputs) - 1
+	output := &resourcegroupstaggingapi.GetResourcesOutput{ResourceTagMappingList: f.pages[page]}
+	if page+1 < len(f.pages) {
+		output.PaginationToken = aws.String("next")
+	}
+	return output, nil
+}
+
+// mapping はARNとタグからタグの一覧の1件を作成する
+func mapping(arn string, tags map[string]string) types.ResourceTagMapping {
+	m := types.ResourceTagMapping{ResourceARN: aws.String(arn)}
+	for k, v := range tags {
+		m.Tags = append(m.Tags, types.Tag{Key: aws.String(k), Value: aws.String(v)})
+	}
+	return m
+}
+
+func TestSelect(t *testing.T) {
+	fake := &fakeTagging{pages: [][]types.ResourceTagMapping{
+		{mapping("arn:aws:ec2:ap-northeast-1:123456789012:instance/i-dev", map[string]string{"env": "dev"})},
+		{
+			mapping("arn:aws:ec2:ap-northeast-1:123456789012:instance/i-keep", map[string]string{"env": "dev", "keep": "true"}),
+			mapping("arn:aws:ec2:ap-northeast-1:123456789012:instance/i-stg", map[string]string{"env": "stg"}),
+		},
+	}}
+	filters, err := ParseFilters([]string{"env=dev", "!keep"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sel := &Selector{Filters: filters, NewClient: func(common.Target) TaggingApi { return fake }}
+
+	// リソースはARNのリソース部分で照合し、タグが付いていないリソースはタグなしとして判定する
+	items := []string{"instance/i-dev", "instance/i-keep", "instance/i-stg", "instance/i-untagged"}
+	got, err := Select(context.Background(), sel, common.Target{}, TypeEc2Instance, items, func(s string) string { return s })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"instance/i-dev"}; !slices.Equal(got, want) {
+		t.Errorf("Select() = %v, want %v", got, want)
+	}
+	if len(fake.inputs) != 2 || !slices.Equal(fake.inputs[0].ResourceTypeFilters, []string{TypeEc2Instance}) {
+		t.Errorf("GetResources inputs = %+v, want 2 pages of %s", fake.inputs, TypeEc2Instance)
+	}
+}
+
+func TestResourceKey(t *testing.T) {
+	tests := map[string]string{
+		"arn:aws:ec2:ap-northeast-1:123456789012:instance/i-0123": "instance/i-0123",
+		"arn:aws:rds:ap-northeast-1:123456789012:db:my-db":        "db:my-db",
+		"arn:aws:s3:::my-bucket":                                  "my-bucket",
+		"my-bucket":                                               "my-bucket",
+		"arn:aws:incomplete":                                      "arn:aws:incomplete",
+	}
+	for in, want := range tests {
+		if got := ResourceKey(in); got != want {
+			t.Errorf("ResourceKey(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,57 @@
+package tagging
+
+import "github.com/ngsw877/awstk/internal/service/common"
+
+// Resource Groups Tagging API のリソースタイプ（ResourceTypeFilters に指定する値）
+const (
+	TypeEc2Instance      = "ec2:instance"
+	TypeS3Bucket         = "s3"
+	TypeEcrRepository    = "ecr:repository"
+	TypeLogGroup         = "logs:log-group"
+	TypeRdsInstance      = "rds:db"
+	TypeRdsCluster       = "rds:cluster"
+	TypeCanary           = "synthetics:canary"
+	TypeEcsService       = "ecs:service"
+	TypeCfnStack         = "cloudformation:stack"
+	TypeLoadBalancer     = "elasticloadbalancing:loadbalancer"
+	TypeTargetGroup      = "elasticloadbalancing:targetgroup"
+	TypeIamRole          = "iam:role"
+	TypeIamPolicy        = "iam:policy"
+	TypeHostedZone       = "route53:hostedzone"
+	TypeSecret           = "secretsmanager:secret"
+	TypeDynamoDbTable    = "dynamodb:table"
+	TypeSqsQueue         = "sqs"
+	TypeSnsTopic         = "sns"
+	TypeLambda           = "lambda:function"
+	TypeSsmParameter     = "ssm:parameter"
+	TypeKmsKey           = "kms:key"
+	TypeEbsSnapshot      = "ec2:snapshot"
+	TypeSecurityGroup    = "ec2:security-group"
+	TypeNetworkInterface = "ec2:network-interface"
+	TypeEventRule        = "events:rule"
+	TypeScheduleGroup    = "scheduler:schedule-group"
+)
+
+// GlobalRegion はグローバルサービス（IAM・Route 53）のタグを取得するリージョン
+const GlobalRegion = "us-east-1"
+
+// Filter は --tag で指定された1つのタグ条件
+//   - key=value  : タグ key の値が value
+//   - key        : タグ key が付いている（値は問わない）
+//   - !key       : タグ key が付いていない
+//   - key!=value : タグ key の値が value ではない（タグ key が付いていない場合を含む）
+type Filter struct {
+	Key      string
+	Value    string
+	HasValue bool // 値を指定しているか（key=value / key!=value）
+	Negate   bool // 否定形か（!key / key!=value）
+}
+
+// Selector はタグ条件でリソースを絞り込むための設定
+// 複数の条件はすべて満たすもの（AND）に一致する
+type Selector struct {
+	Filters []Filter
+	// NewClient は実行対象のアカウント・リージョン用のクライアントを作成する
+	// 単一アカウント・単一リージョンで実行する場合は空の Target が渡される
+	NewClient func(target common.Target) TaggingApi
+}
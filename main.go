@@ -1,7 +1,7 @@
 package main
 
 import (
-	"awstk/cmd"
+	"github.com/ngsw877/awstk/cmd"
 )
 
 func main() {
@@ -0,0 +1,85 @@
+// Package awstk は awstk の操作を Go のプログラムから呼び出すためのライブラリです。
+//
+// 各操作は CLI を介さずに実行でき、結果を型付きの値で返します。
+// 処理の進捗は WithEventSink で指定した受け取り先にイベントとして通知されます
+// （指定しない場合は CLI と同じメッセージが標準エラー出力に表示されます）。
+//
+//	cfg, _ := config.LoadDefaultConfig(ctx, config.WithSharedConfigProfile("dev"))
+//	client := awstk.New(cfg, awstk.WithEventSink(func(e awstk.Event) {
+//		log.Printf("[%s] %s", e.Kind, e.Message)
+//	}))
+//	result, err := client.StopStack(ctx, "my-stack")
+package awstk
+
+import (
+	"context"
+
+	"github.com/ngsw877/awstk/internal/i18n"
+	"github.com/ngsw877/awstk/internal/service/common"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+)
+
+// Event は処理の進捗を表すイベント
+type Event = common.Event
+
+// EventKind は進捗イベントの種類
+type EventKind = common.EventKind
+
+// EventSink は進捗イベントの受け取り先
+// 並列処理中のイベントも直列化して渡されるため、排他制御をする必要はない
+type EventSink = common.EventSink
+
+// 進捗イベントの種類
+const (
+	EventInfo    = common.EventInfo    // 対象の一覧や処理の開始などの情報
+	EventStart   = common.EventStart   // リソースに対する操作の開始
+	EventSuccess = common.EventSuccess // リソースに対する操作の成功
+	EventFailure = common.EventFailure // リソースに対する操作の失敗
+	EventWarning = common.EventWarning // 処理は継続する警告（ガードレールによる除外等）
+	EventDebug   = common.EventDebug   // 詳細な情報
+)
+
+// Client は awstk の操作を実行するクライアント
+type Client struct {
+	cfg  aws.Config
+	sink EventSink
+}
+
+// Option は Client のオプション
+type Option func(*Client)
+
+// WithEventSink は進捗イベントの受け取り先を指定する
+func WithEventSink(sink EventSink) Option {
+	return func(c *Client) {
+		c.sink = sink
+	}
+}
+
+// New は指定したAWS設定で操作を実行するクライアントを作成する
+func New(cfg aws.Config, opts ...Option) *Client {
+	c := &Client{cfg: cfg}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// SetLanguage はイベントのメッセージやエラーの表示言語（"ja" または "en"）を設定する
+// プロセス全体の設定のため、複数のクライアントで異なる言語は使用できない
+func SetLanguage(lang string) error {
+	l, err := i18n.ParseLang(lang)
+	if err != nil {
+		return err
+	}
+	i18n.SetLang(l)
+	return nil
+}
+
+// withSink は進捗イベントの受け取り先を設定したコンテキストを返す
+func (c *Client) withSink(ctx context.Context) context.Context {
+	if c.sink == nil {
+		return ctx
+	}
+	return common.WithEventSink(ctx, c.sink)
+}
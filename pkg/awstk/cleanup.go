@@ -0,0 +1,114 @@
+package awstk
+
+import (
+	"context"
+
+	"github.com/ngsw877/awstk/internal/service/cleanup"
+	"github.com/ngsw877/awstk/internal/service/common"
+	"github.com/ngsw877/awstk/internal/service/guardrail"
+	"github.com/ngsw877/awstk/internal/service/tagging"
+
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/ecr"
+	"github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2"
+	"github.com/aws/aws-sdk-go-v2/service/kms"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	"github.com/aws/aws-sdk-go-v2/service/resourcegroupstaggingapi"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
+	"github.com/aws/aws-sdk-go-v2/service/sns"
+	"github.com/aws/aws-sdk-go-v2/service/sqs"
+	"github.com/aws/aws-sdk-go-v2/service/ssm"
+)
+
+// CleanupResult はリソースの種類ごとの削除結果
+type CleanupResult = common.CleanupResult
+
+// CleanupOptions はクリーンアップの対象の指定（SearchString, StackName, StackId のいずれか1つを指定）
+type CleanupOptions struct {
+	SearchString string // 名前に含まれる文字列
+	StackName    string // CloudFormationスタック名（スタックに含まれるリソースが対象）
+	StackId      string // CloudFormationスタックID（削除済みスタックのARNも指定可）
+	Exact        bool   // 大文字小文字を区別してマッチ
+	// Types は対象にするリソースの種類（"s3"、"dynamodb" 等。"all" ですべて、"!kms" で除外。空の場合は既定の s3・ecr・logs）
+	// 指定できる値は CleanupTypes で取得できる
+	Types []string
+	// Tags はタグ条件（CLI の --tag と同じく "key=value"、"key"、"!key"、"key!=value"。複数指定時はすべてに一致するものが対象）
+	Tags []string
+	// OlderThan・NewerThan は作成日時（AgeBy が "last-activity" の場合は最終アクティビティ日時）の経過時間の条件（例: "14d"、"36h"）
+	OlderThan string
+	NewerThan string
+	AgeBy     string // 経過時間の判定に使用する日時（"created" または "last-activity"。空の場合は "created"）
+	// Guardrail は削除前に適用するガードレール（nil の場合は組み込みの拒否パターンのみ適用する）
+	Guardrail *GuardrailOptions
+}
+
+// GuardrailOptions は削除前に適用するガードレール
+// 拒否パターンに一致するリソース、または必須タグが付いていないリソースは削除せず、結果の Protected に含める
+type GuardrailOptions struct {
+	Deny            []string          // 削除を拒否するリソース名のパターン（部分一致またはワイルドカード、大文字小文字を区別しない）
+	RequiredTags    map[string]string // 削除に必要なタグ（値が空の場合はキーが付いていればよい）
+	DisableDefaults bool              // true の場合は組み込みの拒否パターン（本番環境らしい名前・CDK等の基盤リソース）を適用しない
+}
+
+// CleanupTypes はクリーンアップの対象にできるリソースの種類の名前を返す
+func CleanupTypes() []string {
+	return cleanup.TypeNames()
+}
+
+// Cleanup は対象のリソース（S3バケット・ECRリポジトリ・CloudWatch Logsグループ・DynamoDBテーブル等）を削除し、種類ごとの削除結果を返す
+// CLI と異なり削除前の確認は行わないため、対象の確認は呼び出し側の責任で行うこと
+// ctx がキャンセルされた場合や削除できなかったリソースがある場合も、それまでの結果とともにエラーを返す
+func (c *Client) Cleanup(ctx context.Context, opts CleanupOptions) ([]CleanupResult, error) {
+	clients := cleanup.ClientSet{
+		S3Client:       s3.NewFromConfig(c.cfg),
+		EcrClient:      ecr.NewFromConfig(c.cfg),
+		CfnClient:      cloudformation.NewFromConfig(c.cfg),
+		LogsClient:     cloudwatchlogs.NewFromConfig(c.cfg),
+		DynamoDbClient: dynamodb.NewFromConfig(c.cfg),
+		SqsClient:      sqs.NewFromConfig(c.cfg),
+		SnsClient:      sns.NewFromConfig(c.cfg),
+		LambdaClient:   lambda.NewFromConfig(c.cfg),
+		SecretsClient:  secretsmanager.NewFromConfig(c.cfg),
+		SsmClient:      ssm.NewFromConfig(c.cfg),
+		KmsClient:      kms.NewFromConfig(c.cfg),
+		Ec2Client:      ec2.NewFromConfig(c.cfg),
+		ElbClient:      elasticloadbalancingv2.NewFromConfig(c.cfg),
+	}
+	newTagClient := func(common.Target) tagging.TaggingApi {
+		return resourcegroupstaggingapi.NewFromConfig(c.cfg)
+	}
+
+	var tags *tagging.Selector
+	if len(opts.Tags) > 0 {
+		filters, err := tagging.ParseFilters(opts.Tags)
+		if err != nil {
+			return nil, err
+		}
+		tags = &tagging.Selector{Filters: filters, NewClient: newTagClient}
+	}
+	age, err := common.NewAgeFilter(opts.OlderThan, opts.NewerThan, opts.AgeBy)
+	if err != nil {
+		return nil, err
+	}
+
+	policy := guardrail.NewPolicy(nil, nil, true)
+	if g := opts.Guardrail; g != nil {
+		policy = guardrail.NewPolicy(g.Deny, g.RequiredTags, !g.DisableDefaults)
+	}
+	ctx = guardrail.WithPolicy(c.withSink(ctx), policy, newTagClient)
+
+	return cleanup.CleanupResources(ctx, clients, cleanup.Options{
+		SearchString: opts.SearchString,
+		StackName:    opts.StackName,
+		StackId:      opts.StackId,
+		Exact:        opts.Exact,
+		Types:        opts.Types,
+		Tags:         tags,
+		Age:          age,
+		NoConfirm:    true,
+	})
+}
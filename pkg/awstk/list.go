@@ -0,0 +1,27 @@
+package awstk
+
+import (
+	"context"
+
+	"github.com/ngsw877/awstk/internal/service/canary"
+	route53svc "github.com/ngsw877/awstk/internal/service/route53"
+
+	"github.com/aws/aws-sdk-go-v2/service/route53"
+	"github.com/aws/aws-sdk-go-v2/service/synthetics"
+)
+
+// Canary はCloudWatch Synthetics Canaryの情報
+type Canary = canary.Canary
+
+// HostedZone はRoute53ホストゾーンの情報
+type HostedZone = route53svc.HostedZoneInfo
+
+// ListCanaries はCanaryの一覧を成功率・最新の実行結果とともに返す
+func (c *Client) ListCanaries(ctx context.Context) ([]Canary, error) {
+	return canary.GetCanaries(c.withSink(ctx), synthetics.NewFromConfig(c.cfg), nil)
+}
+
+// ListHostedZones はRoute53のホストゾーンの一覧を返す
+func (c *Client) ListHostedZones(ctx context.Context) ([]HostedZone, error) {
+	return route53svc.GetHostedZones(c.withSink(ctx), route53.NewFromConfig(c.cfg))
+}
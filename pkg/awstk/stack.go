@@ -0,0 +1,63 @@
+package awstk
+
+import (
+	"context"
+
+	"github.com/ngsw877/awstk/internal/service/cfn"
+
+	"github.com/aws/aws-sdk-go-v2/service/applicationautoscaling"
+	"github.com/aws/aws-sdk-go-v2/service/cloudformation"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	"github.com/aws/aws-sdk-go-v2/service/rds"
+)
+
+// StackOperationResult はスタック内のリソースの起動・停止の結果
+type StackOperationResult = cfn.StackOperationResult
+
+// ResourceOperation はスタック内のリソースに対する起動・停止の結果
+type ResourceOperation = cfn.ResourceOperation
+
+// StackResources はスタックから検出した起動・停止可能なリソース
+type StackResources = cfn.StackResources
+
+// DriftOptions はドリフト検出の対象スタックの指定（Stacks, Filter, All のいずれか1つを指定）
+type DriftOptions = cfn.DriftOptions
+
+// DriftDetection はスタックのドリフト検出の開始結果
+type DriftDetection = cfn.DriftDetection
+
+// 起動・停止の対象となるリソースタイプ（ResourceOperation.ResourceType）
+const (
+	ResourceTypeEc2Instance = cfn.ResourceTypeEc2Instance
+	ResourceTypeDbInstance  = cfn.ResourceTypeDbInstance
+	ResourceTypeDbCluster   = cfn.ResourceTypeDbCluster
+	ResourceTypeEcsService  = cfn.ResourceTypeEcsService
+)
+
+// StartStack はスタック内のEC2インスタンス・RDSインスタンス・Aurora DBクラスター・ECSサービスを起動する
+// 一部のリソースの起動に失敗した場合も、結果とともにエラーを返す（失敗したリソースは result.Failed() で確認できる）
+func (c *Client) StartStack(ctx context.Context, stackName string) (StackOperationResult, error) {
+	return cfn.StartAllStackResources(c.withSink(ctx),
+		cloudformation.NewFromConfig(c.cfg),
+		ec2.NewFromConfig(c.cfg),
+		rds.NewFromConfig(c.cfg),
+		applicationautoscaling.NewFromConfig(c.cfg),
+		stackName)
+}
+
+// StopStack はスタック内のEC2インスタンス・RDSインスタンス・Aurora DBクラスター・ECSサービスを停止する
+// 一部のリソースの停止に失敗した場合も、結果とともにエラーを返す（失敗したリソースは result.Failed() で確認できる）
+func (c *Client) StopStack(ctx context.Context, stackName string) (StackOperationResult, error) {
+	return cfn.StopAllStackResources(c.withSink(ctx),
+		cloudformation.NewFromConfig(c.cfg),
+		ec2.NewFromConfig(c.cfg),
+		rds.NewFromConfig(c.cfg),
+		applicationautoscaling.NewFromConfig(c.cfg),
+		stackName)
+}
+
+// DetectDrift は条件に一致するスタックのドリフト検出を開始し、スタックごとの開始結果を返す
+// 検出の完了は待たない
+func (c *Client) DetectDrift(ctx context.Context, opts DriftOptions) ([]DriftDetection, error) {
+	return cfn.DetectDrift(c.withSink(ctx), cloudformation.NewFromConfig(c.cfg), opts)
+}
@@ -1,7 +1,6 @@
 package main
 
 import (
-	"awstk/cmd"
 	"bytes"
 	"fmt"
 	"log"
@@ -10,6 +9,9 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/ngsw877/awstk/cmd"
+	"github.com/ngsw877/awstk/internal/i18n"
+
 	"github.com/spf13/cobra"
 	"github.com/spf13/cobra/doc"
 )
@@ -22,6 +24,17 @@ func main() {
 		log.Fatalf("Failed to clean docs directory: %v", err)
 	}
 
+	// 日本語版はdocs直下に生成
+	generateDocs(docsDir)
+
+	// 英語版はdocs/enに生成
+	i18n.SetLang(i18n.En)
+	cmd.LocalizeCommands(cmd.RootCmd)
+	generateDocs(filepath.Join(docsDir, "en"))
+}
+
+// generateDocs は現在の表示言語でコマンドのドキュメントを生成
+func generateDocs(docsDir string) {
 	// ディレクトリ作成
 	if err := os.MkdirAll(docsDir, 0755); err != nil {
 		log.Fatalf("Failed to create docs directory: %v", err)